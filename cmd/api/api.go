@@ -1,23 +1,51 @@
 package api
 
 import (
+	"context"
 	"database/sql"
 	"log"
 	"net/http"
+	"os"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/chi/v5/middleware"
 	"github.com/go-chi/jwtauth"
 
+	auditApp "github.com/happYness-Project/taskManagementGolang/internal/audit/application"
+	auditRepo "github.com/happYness-Project/taskManagementGolang/internal/audit/repository"
+	"github.com/happYness-Project/taskManagementGolang/internal/authorization"
+	feedRepo "github.com/happYness-Project/taskManagementGolang/internal/feed/repository"
+	labelRepo "github.com/happYness-Project/taskManagementGolang/internal/label/repository"
+	notificationApp "github.com/happYness-Project/taskManagementGolang/internal/notification/application"
+	notificationRepo "github.com/happYness-Project/taskManagementGolang/internal/notification/repository"
+	outboxApp "github.com/happYness-Project/taskManagementGolang/internal/outbox/application"
+	outboxRepo "github.com/happYness-Project/taskManagementGolang/internal/outbox/repository"
+	searchRepo "github.com/happYness-Project/taskManagementGolang/internal/search/repository"
+	streamApp "github.com/happYness-Project/taskManagementGolang/internal/stream/application"
 	taskRepo "github.com/happYness-Project/taskManagementGolang/internal/task/repository"
 	containerRepo "github.com/happYness-Project/taskManagementGolang/internal/taskcontainer/repository"
+	trashApp "github.com/happYness-Project/taskManagementGolang/internal/trash/application"
 	userRepo "github.com/happYness-Project/taskManagementGolang/internal/user/repository"
 	usergroupRepo "github.com/happYness-Project/taskManagementGolang/internal/usergroup/repository"
+	viewRepo "github.com/happYness-Project/taskManagementGolang/internal/view/repository"
+	webhookApp "github.com/happYness-Project/taskManagementGolang/internal/webhook/application"
+	webhookRepo "github.com/happYness-Project/taskManagementGolang/internal/webhook/repository"
 
+	archiveRoute "github.com/happYness-Project/taskManagementGolang/internal/archive/route"
+	auditRoute "github.com/happYness-Project/taskManagementGolang/internal/audit/route"
+	feedRoute "github.com/happYness-Project/taskManagementGolang/internal/feed/route"
+	labelRoute "github.com/happYness-Project/taskManagementGolang/internal/label/route"
+	notificationRoute "github.com/happYness-Project/taskManagementGolang/internal/notification/route"
+	searchRoute "github.com/happYness-Project/taskManagementGolang/internal/search/route"
+	streamRoute "github.com/happYness-Project/taskManagementGolang/internal/stream/route"
 	taskRoute "github.com/happYness-Project/taskManagementGolang/internal/task/route"
 	containerRoute "github.com/happYness-Project/taskManagementGolang/internal/taskcontainer/route"
+	trashRoute "github.com/happYness-Project/taskManagementGolang/internal/trash/route"
 	userRoute "github.com/happYness-Project/taskManagementGolang/internal/user/route"
 	usergroupRoute "github.com/happYness-Project/taskManagementGolang/internal/usergroup/route"
+	viewRoute "github.com/happYness-Project/taskManagementGolang/internal/view/route"
+	webhookRoute "github.com/happYness-Project/taskManagementGolang/internal/webhook/route"
+	"github.com/happYness-Project/taskManagementGolang/pkg/dbs"
 	"github.com/happYness-Project/taskManagementGolang/pkg/loggers"
 	"github.com/happYness-Project/taskManagementGolang/pkg/middlewares"
 	"github.com/happYness-Project/taskManagementGolang/pkg/response"
@@ -28,6 +56,18 @@ type ApiServer struct {
 	db        *sql.DB
 	tokenAuth *jwtauth.JWTAuth
 	logger    *loggers.AppLogger
+
+	dispatcher        *outboxApp.Dispatcher
+	webhookWorker     *webhookApp.DeliveryWorker
+	trashPurger       *trashApp.Purger
+	reminderScheduler *notificationApp.Scheduler
+	emailWorker       *notificationApp.EmailWorker
+	streamHub         *streamApp.Hub
+
+	// TrashRetentionDays is how long deleted tasks and containers are kept; 0 keeps the purger's default
+	TrashRetentionDays int
+	// EmailSender emails reminders to the users who turned email on; nil sends no emails
+	EmailSender notificationApp.EmailSender
 }
 
 func NewApiServer(addr string, accessToken string, db *sql.DB, logger *loggers.AppLogger) *ApiServer {
@@ -43,7 +83,7 @@ func NewApiServer(addr string, accessToken string, db *sql.DB, logger *loggers.A
 
 func (s *ApiServer) Setup() *chi.Mux {
 	mux := chi.NewRouter()
-	mux.Use(middleware.Logger)
+	mux.Use(middlewares.ConsoleLogger(log.New(os.Stdout, "", log.LstdFlags)))
 	mux.Use(middleware.Recoverer)
 	mux.Use(middlewares.EnableCORS)
 	mux.Use(middlewares.RequestIdMiddleware)
@@ -55,12 +95,50 @@ func (s *ApiServer) Setup() *chi.Mux {
 	userRepo := userRepo.NewUserRepository(s.db)
 	usergroupRepo := usergroupRepo.NewUserGroupRepository(s.db)
 	taskRepo := taskRepo.NewTaskRepository(s.db)
+	templateRepo := containerRepo.NewTemplateRepository(s.db)
 	containerRepo := containerRepo.NewContainerRepository(s.db)
+	policy := authorization.NewPolicy(userRepo)
+	uow := dbs.NewUnitOfWork(s.db)
+	activityRepo := auditRepo.NewActivityRepository(s.db)
+	recorder := auditApp.NewRecorder(activityRepo, s.logger)
+	outboxRepo := outboxRepo.NewOutboxRepository(s.db)
+	webhooksRepo := webhookRepo.NewWebhookRepository(s.db)
+	deliveryRepo := webhookRepo.NewDeliveryRepository(s.db)
+	searchRepo := searchRepo.NewSearchRepository(s.db)
+	viewRepo := viewRepo.NewViewRepository(s.db)
+	labelRepo := labelRepo.NewLabelRepository(s.db)
+	feedTokenRepo := feedRepo.NewFeedTokenRepository(s.db)
+	reminderRepo := notificationRepo.NewReminderRepository(s.db)
+	emailDeliveryRepo := notificationRepo.NewEmailDeliveryRepository(s.db)
+	notificationRepo := notificationRepo.NewNotificationRepository(s.db)
+	s.streamHub = streamApp.NewHub(outboxRepo, s.logger)
+	sinks := []outboxApp.Sink{
+		outboxApp.NewLogSink(s.logger),
+		webhookApp.NewWebhookSink(webhooksRepo, deliveryRepo),
+	}
+	if s.EmailSender != nil {
+		sinks = append(sinks, notificationApp.NewEmailSink(reminderRepo, emailDeliveryRepo))
+		s.emailWorker = notificationApp.NewEmailWorker(emailDeliveryRepo, notificationRepo, reminderRepo, userRepo, s.EmailSender, s.logger)
+	}
+	s.dispatcher = outboxApp.NewDispatcher(outboxRepo, s.logger, sinks...)
+	s.webhookWorker = webhookApp.NewDeliveryWorker(webhooksRepo, deliveryRepo, s.logger)
+	s.trashPurger = trashApp.NewPurger(taskRepo, containerRepo, s.logger, s.TrashRetentionDays)
+	s.reminderScheduler = notificationApp.NewScheduler(reminderRepo, notificationRepo, outboxRepo, uow, s.logger)
 
-	userHandler := userRoute.NewHandler(s.logger, userRepo, usergroupRepo)
-	usergroupHandler := usergroupRoute.NewHandler(s.logger, usergroupRepo, userRepo)
-	taskHandler := taskRoute.NewHandler(s.logger, taskRepo, containerRepo, usergroupRepo)
-	containerHandler := containerRoute.NewHandler(s.logger, containerRepo, userRepo)
+	userHandler := userRoute.NewHandler(s.logger, userRepo, usergroupRepo, policy, uow, recorder)
+	usergroupHandler := usergroupRoute.NewHandler(s.logger, usergroupRepo, userRepo, policy, uow, recorder, outboxRepo)
+	taskHandler := taskRoute.NewHandler(s.logger, taskRepo, containerRepo, usergroupRepo, userRepo, labelRepo, policy, uow, recorder, outboxRepo)
+	containerHandler := containerRoute.NewHandler(s.logger, containerRepo, templateRepo, taskRepo, labelRepo, userRepo, policy, uow, recorder, outboxRepo)
+	auditHandler := auditRoute.NewHandler(s.logger, activityRepo, policy)
+	webhookHandler := webhookRoute.NewHandler(s.logger, webhooksRepo, deliveryRepo, policy, uow, recorder)
+	streamHandler := streamRoute.NewHandler(s.logger, s.streamHub, policy)
+	trashHandler := trashRoute.NewHandler(s.logger, taskRepo, containerRepo, policy)
+	searchHandler := searchRoute.NewHandler(s.logger, searchRepo, policy)
+	viewHandler := viewRoute.NewHandler(s.logger, viewRepo, taskRepo, containerRepo, policy, uow, recorder)
+	labelHandler := labelRoute.NewHandler(s.logger, labelRepo, policy, uow, recorder)
+	notificationHandler := notificationRoute.NewHandler(s.logger, reminderRepo, notificationRepo, policy, uow, recorder)
+	feedHandler := feedRoute.NewHandler(s.logger, feedTokenRepo, taskRepo, containerRepo, policy, uow, recorder)
+	archiveHandler := archiveRoute.NewHandler(s.logger, usergroupRepo, userRepo, labelRepo, containerRepo, taskRepo, policy, uow, recorder, outboxRepo)
 
 	mux.Group(func(r chi.Router) {
 		r.Use(jwtauth.Verifier(s.tokenAuth))
@@ -69,12 +147,47 @@ func (s *ApiServer) Setup() *chi.Mux {
 		usergroupHandler.RegisterRoutes(r)
 		taskHandler.RegisterRoutes(r)
 		containerHandler.RegisterRoutes(r)
+		auditHandler.RegisterRoutes(r)
+		webhookHandler.RegisterRoutes(r)
+		streamHandler.RegisterRoutes(r)
+		trashHandler.RegisterRoutes(r)
+		searchHandler.RegisterRoutes(r)
+		viewHandler.RegisterRoutes(r)
+		labelHandler.RegisterRoutes(r)
+		archiveHandler.RegisterRoutes(r)
+		feedHandler.RegisterRoutes(r)
+		notificationHandler.RegisterRoutes(r)
 	})
+	// Calendar feeds are authenticated by their feed token
+	feedHandler.RegisterFeedRoutes(mux)
 
 	return mux
 }
 
+// Run serves the API and, while it runs, dispatches the outbox, delivers
+// webhooks and emails, purges the trash, sends reminders and streams group events in the background
 func (s *ApiServer) Run(mux *chi.Mux) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	if s.dispatcher != nil {
+		go s.dispatcher.Run(ctx)
+	}
+	if s.webhookWorker != nil {
+		go s.webhookWorker.Run(ctx)
+	}
+	if s.trashPurger != nil {
+		go s.trashPurger.Run(ctx)
+	}
+	if s.reminderScheduler != nil {
+		go s.reminderScheduler.Run(ctx)
+	}
+	if s.emailWorker != nil {
+		go s.emailWorker.Run(ctx)
+	}
+	if s.streamHub != nil {
+		go s.streamHub.Run(ctx)
+	}
+
 	log.Println("Listening on ", s.addr)
 	return http.ListenAndServe(s.addr, mux)
 }
@@ -0,0 +1,88 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/happYness-Project/taskManagementGolang/internal/archive/application/command"
+	"github.com/happYness-Project/taskManagementGolang/internal/archive/application/query"
+	"github.com/happYness-Project/taskManagementGolang/internal/archive/domain"
+)
+
+// export writes the archive of a group to a file, or to the output when no file is given
+func (c *CLI) export(ctx context.Context, args []string) error {
+	flags := c.flagSet("export")
+	groupId := flags.Int("group", 0, "ID of the user group")
+	output := flags.String("o", "", "file to write the archive to, the standard output when empty")
+	if err := parse(flags, args); err != nil {
+		return err
+	}
+	if *groupId <= 0 {
+		return c.usageError("-group must be a group ID")
+	}
+
+	result, err := c.archiveQueryBus.Execute(ctx, query.ExportGroupQuery{GroupId: *groupId})
+	if err != nil {
+		return err
+	}
+	export := result.(*query.GroupExport)
+
+	var w io.Writer = c.out
+	if *output != "" {
+		file, err := os.Create(*output)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		w = file
+	}
+	if _, err := export.WriteTo(w); err != nil {
+		return fmt.Errorf("failed to write the archive: %w", err)
+	}
+	if *output != "" {
+		fmt.Fprintf(c.out, "exported group %s (%d) to %s\n", export.GroupName, export.GroupId, *output)
+	}
+	return nil
+}
+
+// importGroup runs "import", recreating the group of an archive with the owner as its admin
+func (c *CLI) importGroup(ctx context.Context, args []string) error {
+	flags := c.flagSet("import")
+	path := flags.String("file", "", "archive written by export")
+	owner := flags.String("owner", "", "UUID or email of the user who becomes the group's admin")
+	onConflict := flags.String("on-conflict", "link", "members whose email is already a user: link, skip or fail")
+	if err := parse(flags, args, "file", "owner"); err != nil {
+		return err
+	}
+	policy, err := domain.NewConflictPolicy(*onConflict)
+	if err != nil {
+		return c.usageError("%v", err)
+	}
+	user, err := c.findUser(*owner)
+	if err != nil {
+		return err
+	}
+
+	file, err := os.Open(*path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	var archive domain.Archive
+	if err := json.NewDecoder(file).Decode(&archive); err != nil {
+		return fmt.Errorf("%w: %v", domain.ErrInvalidArchive, err)
+	}
+
+	result, err := c.archiveBus.Execute(ctx, command.ImportGroupCommand{Archive: archive, OnConflict: policy, RequesterId: user.UserId})
+	if err != nil {
+		return err
+	}
+	imported := result.(*command.ImportResult)
+	fmt.Fprintf(c.out, "imported group %s (%d): %d members created, %d linked, %d skipped, %d labels, %d containers, %d tasks\n",
+		archive.Group.Name, imported.GroupId, imported.MembersCreated, imported.MembersLinked, imported.MembersSkipped,
+		imported.Labels, imported.Containers, imported.Tasks)
+	return nil
+}
@@ -0,0 +1,34 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestArchiveCommands(t *testing.T) {
+	invalid := map[string][]string{
+		"export without group":               {"export"},
+		"export with a group that is no ID":  {"export", "-group", "-3"},
+		"export with an unknown flag":        {"export", "-group", "1", "-format", "xml"},
+		"import without file":                {"import", "-owner", "dana@example.com"},
+		"import without owner":               {"import", "-file", "family.json"},
+		"import with an unknown on-conflict": {"import", "-file", "family.json", "-owner", "dana@example.com", "-on-conflict", "merge"},
+	}
+	for name, commandLine := range invalid {
+		t.Run("when "+name+", Then the usage is printed and nothing runs", func(t *testing.T) {
+			// Arrange
+			var out bytes.Buffer
+			cli := &CLI{out: &out}
+
+			// Act
+			err := cli.Run(context.Background(), commandLine[0], commandLine[1:])
+
+			// Assert
+			assert.ErrorIs(t, err, ErrUsage)
+			assert.NotEmpty(t, out.String())
+		})
+	}
+}
@@ -0,0 +1,160 @@
+package cli
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+
+	archiveApp "github.com/happYness-Project/taskManagementGolang/internal/archive/application"
+	auditApp "github.com/happYness-Project/taskManagementGolang/internal/audit/application"
+	auditRepo "github.com/happYness-Project/taskManagementGolang/internal/audit/repository"
+	"github.com/happYness-Project/taskManagementGolang/internal/authorization"
+	labelRepo "github.com/happYness-Project/taskManagementGolang/internal/label/repository"
+	outboxRepo "github.com/happYness-Project/taskManagementGolang/internal/outbox/repository"
+	taskApp "github.com/happYness-Project/taskManagementGolang/internal/task/application"
+	taskRepo "github.com/happYness-Project/taskManagementGolang/internal/task/repository"
+	containerApp "github.com/happYness-Project/taskManagementGolang/internal/taskcontainer/application"
+	containerRepo "github.com/happYness-Project/taskManagementGolang/internal/taskcontainer/repository"
+	userApp "github.com/happYness-Project/taskManagementGolang/internal/user/application"
+	userDomain "github.com/happYness-Project/taskManagementGolang/internal/user/domain"
+	userRepo "github.com/happYness-Project/taskManagementGolang/internal/user/repository"
+	usergroupApp "github.com/happYness-Project/taskManagementGolang/internal/usergroup/application"
+	usergroupRepo "github.com/happYness-Project/taskManagementGolang/internal/usergroup/repository"
+	"github.com/happYness-Project/taskManagementGolang/pkg/dbs"
+	"github.com/happYness-Project/taskManagementGolang/pkg/loggers"
+)
+
+// ErrUsage reports a command line the CLI cannot run; the usage has already been printed
+var ErrUsage = errors.New("invalid command line")
+
+const usage = `Usage: api <command> [arguments]
+
+Commands:
+  serve                                   start the HTTP server (default)
+  migrate [up|down [steps]|status]        apply, revert or list database migrations
+  seed <fixture.yaml>                     load users, groups, containers and tasks from a fixture
+  user create -username NAME -email EMAIL [-first-name NAME] [-last-name NAME] [-user-id UUID]
+  user deactivate USER                    deactivate a user, given by UUID or email
+  group add-member -group ID -user USER   add a user, given by UUID or email, to a group
+  group set-role -group ID -user USER -role admin|member
+  export -group ID [-o FILE]              write a group, its members, containers and tasks as a JSON archive
+  import -file FILE -owner USER [-on-conflict link|skip|fail]
+`
+
+// CLI runs the management commands. They execute through the same command buses as the HTTP API, as an operator:
+// domain validation applies, group, user and export policies do not, and the activity log records the operator as actor.
+type CLI struct {
+	db  *sql.DB
+	out io.Writer
+
+	userRepo     userRepo.UserRepository
+	userBus      *userApp.CommandBus
+	groupBus     *usergroupApp.CommandBus
+	containerBus *containerApp.CommandBus
+	taskBus      *taskApp.CommandBus
+
+	archiveBus      *archiveApp.CommandBus
+	archiveQueryBus *archiveApp.QueryBus
+}
+
+func New(db *sql.DB, logger *loggers.AppLogger, out io.Writer) *CLI {
+	userRepo := userRepo.NewUserRepository(db)
+	usergroupRepo := usergroupRepo.NewUserGroupRepository(db)
+	taskRepo := taskRepo.NewTaskRepository(db)
+	templateRepo := containerRepo.NewTemplateRepository(db)
+	containerRepo := containerRepo.NewContainerRepository(db)
+	labelRepo := labelRepo.NewLabelRepository(db)
+	outboxRepo := outboxRepo.NewOutboxRepository(db)
+	policy := authorization.NewPolicy(userRepo)
+	uow := dbs.NewUnitOfWork(db)
+	recorder := auditApp.NewRecorder(auditRepo.NewActivityRepository(db), logger)
+
+	return &CLI{
+		db:           db,
+		out:          out,
+		userRepo:     userRepo,
+		userBus:      userApp.NewCommandBus(userRepo, policy, uow, recorder),
+		groupBus:     usergroupApp.NewCommandBus(usergroupRepo, userRepo, policy, uow, recorder, outboxRepo),
+		containerBus: containerApp.NewCommandBus(containerRepo, templateRepo, taskRepo, labelRepo, policy, uow, recorder, outboxRepo),
+		taskBus:      taskApp.NewCommandBus(taskRepo, containerRepo, usergroupRepo, userRepo, labelRepo, policy, uow, recorder, outboxRepo),
+
+		archiveBus:      archiveApp.NewCommandBus(usergroupRepo, userRepo, labelRepo, containerRepo, taskRepo, uow, recorder, outboxRepo),
+		archiveQueryBus: archiveApp.NewQueryBus(usergroupRepo, userRepo, labelRepo, containerRepo, taskRepo, policy),
+	}
+}
+
+// Usage writes the list of commands to w
+func Usage(w io.Writer) {
+	fmt.Fprint(w, usage)
+}
+
+// Run executes the management command with its arguments
+func (c *CLI) Run(ctx context.Context, command string, args []string) error {
+	ctx = authorization.AsOperator(ctx)
+	switch command {
+	case "migrate":
+		return c.migrate(ctx, args)
+	case "seed":
+		return c.seed(ctx, args)
+	case "user":
+		return c.user(ctx, args)
+	case "group":
+		return c.group(ctx, args)
+	case "export":
+		return c.export(ctx, args)
+	case "import":
+		return c.importGroup(ctx, args)
+	default:
+		return c.usageError("unknown command %q", command)
+	}
+}
+
+func (c *CLI) usageError(format string, args ...any) error {
+	fmt.Fprintf(c.out, format+"\n\n", args...)
+	Usage(c.out)
+	return ErrUsage
+}
+
+// flagSet creates the flag set of a subcommand, printing its errors and defaults to the CLI's output
+func (c *CLI) flagSet(name string) *flag.FlagSet {
+	flags := flag.NewFlagSet(name, flag.ContinueOnError)
+	flags.SetOutput(c.out)
+	return flags
+}
+
+// parse parses the arguments and requires the named flags to be set
+func parse(flags *flag.FlagSet, args []string, required ...string) error {
+	if err := flags.Parse(args); err != nil {
+		return ErrUsage
+	}
+	for _, name := range required {
+		if strings.TrimSpace(flags.Lookup(name).Value.String()) == "" {
+			fmt.Fprintf(flags.Output(), "-%s is required\n", name)
+			flags.PrintDefaults()
+			return ErrUsage
+		}
+	}
+	return nil
+}
+
+// findUser resolves a user given by UUID or, when it contains an @, by email
+func (c *CLI) findUser(value string) (*userDomain.User, error) {
+	var user *userDomain.User
+	var err error
+	if strings.Contains(value, "@") {
+		user, err = c.userRepo.GetUserByEmail(value)
+	} else {
+		user, err = c.userRepo.GetUserByUserId(value)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find user %s: %w", value, err)
+	}
+	if user == nil || user.UserId == "" {
+		return nil, fmt.Errorf("%w: %s", userDomain.ErrUserNotFound, value)
+	}
+	return user, nil
+}
@@ -0,0 +1,61 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/happYness-Project/taskManagementGolang/internal/authorization"
+	"github.com/happYness-Project/taskManagementGolang/internal/usergroup/application/command"
+)
+
+// group runs "group add-member" and "group set-role"
+func (c *CLI) group(ctx context.Context, args []string) error {
+	if len(args) == 0 {
+		return c.usageError("group needs a command: add-member or set-role")
+	}
+	flags := c.flagSet("group " + args[0])
+	groupId := flags.Int("group", 0, "ID of the user group")
+	userValue := flags.String("user", "", "UUID or email of the user")
+
+	switch args[0] {
+	case "add-member":
+		if err := parse(flags, args[1:], "user"); err != nil {
+			return err
+		}
+		if *groupId <= 0 {
+			return c.usageError("-group must be a group ID")
+		}
+		user, err := c.findUser(*userValue)
+		if err != nil {
+			return err
+		}
+
+		_, err = c.groupBus.Execute(ctx, command.AddMemberCommand{GroupId: *groupId, UserId: user.UserId, RequesterId: authorization.OperatorId})
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(c.out, "added %s to group %d\n", user.UserName, *groupId)
+		return nil
+	case "set-role":
+		role := flags.String("role", "", "new role: admin or member")
+		if err := parse(flags, args[1:], "user", "role"); err != nil {
+			return err
+		}
+		if *groupId <= 0 {
+			return c.usageError("-group must be a group ID")
+		}
+		user, err := c.findUser(*userValue)
+		if err != nil {
+			return err
+		}
+
+		_, err = c.groupBus.Execute(ctx, command.ChangeMemberRoleCommand{GroupId: *groupId, UserId: user.UserId, NewRole: *role, RequesterId: authorization.OperatorId})
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(c.out, "%s is now %s of group %d\n", user.UserName, *role, *groupId)
+		return nil
+	default:
+		return c.usageError("unknown group command %q", args[0])
+	}
+}
@@ -0,0 +1,58 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/happYness-Project/taskManagementGolang/pkg/dbs/migrations"
+)
+
+// migrate runs "migrate [up]", "migrate down [steps]" or "migrate status"
+func (c *CLI) migrate(ctx context.Context, args []string) error {
+	migrator, err := migrations.New(c.db)
+	if err != nil {
+		return err
+	}
+
+	action := "up"
+	if len(args) > 0 {
+		action = args[0]
+	}
+	switch action {
+	case "up":
+		applied, err := migrator.Up(ctx)
+		for _, migration := range applied {
+			fmt.Fprintf(c.out, "applied %04d_%s\n", migration.Version, migration.Name)
+		}
+		if err == nil && len(applied) == 0 {
+			fmt.Fprintln(c.out, "database is up to date")
+		}
+		return err
+	case "down":
+		steps := 1
+		if len(args) > 1 {
+			if steps, err = strconv.Atoi(args[1]); err != nil || steps < 1 {
+				return c.usageError("steps must be a positive number, got %q", args[1])
+			}
+		}
+		reverted, err := migrator.Down(ctx, steps)
+		for _, migration := range reverted {
+			fmt.Fprintf(c.out, "reverted %04d_%s\n", migration.Version, migration.Name)
+		}
+		return err
+	case "status":
+		statuses, err := migrator.Status(ctx)
+		for _, status := range statuses {
+			appliedAt := "pending"
+			if status.AppliedAt != nil {
+				appliedAt = status.AppliedAt.UTC().Format(time.RFC3339)
+			}
+			fmt.Fprintf(c.out, "%04d_%s\t%s\n", status.Version, status.Name, appliedAt)
+		}
+		return err
+	default:
+		return c.usageError("unknown migrate command %q", action)
+	}
+}
@@ -0,0 +1,236 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	taskCommand "github.com/happYness-Project/taskManagementGolang/internal/task/application/command"
+	containerCommand "github.com/happYness-Project/taskManagementGolang/internal/taskcontainer/application/command"
+	userCommand "github.com/happYness-Project/taskManagementGolang/internal/user/application/command"
+	groupCommand "github.com/happYness-Project/taskManagementGolang/internal/usergroup/application/command"
+	"gopkg.in/yaml.v3"
+)
+
+var ErrInvalidFixture = errors.New("invalid fixture")
+
+// Fixture is the YAML document loaded by the seed command, e.g.
+//
+//	users:
+//	  - username: alice
+//	    email: alice@example.com
+//	groups:
+//	  - name: Family
+//	    owner: alice
+//	    members: [{user: bob, role: admin}]
+//	    containers:
+//	      - name: grocery
+//	        tasks: [{name: Apples, priority: high, due_in_days: 3}]
+type Fixture struct {
+	Users  []FixtureUser  `yaml:"users"`
+	Groups []FixtureGroup `yaml:"groups"`
+}
+
+type FixtureUser struct {
+	UserId    string `yaml:"user_id"` // generated when empty
+	UserName  string `yaml:"username"`
+	FirstName string `yaml:"first_name"`
+	LastName  string `yaml:"last_name"`
+	Email     string `yaml:"email"`
+}
+
+type FixtureGroup struct {
+	Name        string             `yaml:"name"`
+	Description string             `yaml:"description"`
+	Type        string             `yaml:"type"`
+	Owner       string             `yaml:"owner"` // username of the creator, the group's first admin
+	Members     []FixtureMember    `yaml:"members"`
+	Containers  []FixtureContainer `yaml:"containers"`
+}
+
+type FixtureMember struct {
+	User string `yaml:"user"` // username
+	Role string `yaml:"role"` // member when empty
+}
+
+type FixtureContainer struct {
+	Name        string        `yaml:"name"`
+	Description string        `yaml:"description"`
+	Type        string        `yaml:"type"`
+	Tasks       []FixtureTask `yaml:"tasks"`
+}
+
+type FixtureTask struct {
+	Name           string `yaml:"name"`
+	Description    string `yaml:"description"`
+	Priority       string `yaml:"priority"`
+	DueInDays      *int   `yaml:"due_in_days"` // target date counted in days from the day the fixture is seeded
+	RecurrenceRule string `yaml:"recurrence_rule"`
+}
+
+// readFixture decodes a fixture, rejecting unknown fields and users it cannot tell apart
+func readFixture(r io.Reader) (*Fixture, error) {
+	decoder := yaml.NewDecoder(r)
+	decoder.KnownFields(true)
+	var fixture Fixture
+	if err := decoder.Decode(&fixture); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidFixture, err)
+	}
+
+	usernames := map[string]bool{}
+	for _, user := range fixture.Users {
+		if strings.TrimSpace(user.UserName) == "" || strings.TrimSpace(user.Email) == "" {
+			return nil, fmt.Errorf("%w: every user needs a username and an email", ErrInvalidFixture)
+		}
+		if usernames[user.UserName] {
+			return nil, fmt.Errorf("%w: username %q is used twice", ErrInvalidFixture, user.UserName)
+		}
+		usernames[user.UserName] = true
+	}
+	for _, group := range fixture.Groups {
+		if strings.TrimSpace(group.Owner) == "" {
+			return nil, fmt.Errorf("%w: group %q has no owner", ErrInvalidFixture, group.Name)
+		}
+	}
+	return &fixture, nil
+}
+
+// seed loads a fixture. Users that already exist, by username, are reused rather than created again.
+// Each group is created by its owner, who then adds its members, containers and tasks as any admin would.
+// Every command commits on its own, so a fixture failing halfway leaves what was seeded before the failure.
+func (c *CLI) seed(ctx context.Context, args []string) error {
+	if len(args) != 1 {
+		return c.usageError("seed needs a fixture file")
+	}
+	file, err := os.Open(args[0])
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	fixture, err := readFixture(file)
+	if err != nil {
+		return err
+	}
+
+	userIds := map[string]string{} // username to UUID
+	for _, user := range fixture.Users {
+		existing, err := c.userRepo.GetUserByUsername(user.UserName)
+		if err != nil {
+			return fmt.Errorf("failed to find user %s: %w", user.UserName, err)
+		}
+		if existing != nil && existing.UserId != "" {
+			userIds[user.UserName] = existing.UserId
+			fmt.Fprintf(c.out, "user %s already exists\n", user.UserName)
+			continue
+		}
+
+		userId := user.UserId
+		if userId == "" {
+			userId = uuid.NewString()
+		}
+		_, err = c.userBus.Execute(ctx, userCommand.CreateUserCommand{
+			UserId:    userId,
+			UserName:  user.UserName,
+			FirstName: user.FirstName,
+			LastName:  user.LastName,
+			Email:     user.Email,
+		})
+		if err != nil {
+			return fmt.Errorf("user %s: %w", user.UserName, err)
+		}
+		userIds[user.UserName] = userId
+		fmt.Fprintf(c.out, "created user %s\n", user.UserName)
+	}
+	userIdOf := func(username string) (string, error) {
+		if userId, ok := userIds[username]; ok {
+			return userId, nil
+		}
+		user, err := c.userRepo.GetUserByUsername(username)
+		if err != nil {
+			return "", fmt.Errorf("failed to find user %s: %w", username, err)
+		}
+		if user == nil || user.UserId == "" {
+			return "", fmt.Errorf("%w: user %q is neither in the fixture nor in the database", ErrInvalidFixture, username)
+		}
+		userIds[username] = user.UserId
+		return user.UserId, nil
+	}
+
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+	for _, group := range fixture.Groups {
+		ownerId, err := userIdOf(group.Owner)
+		if err != nil {
+			return err
+		}
+		result, err := c.groupBus.Execute(ctx, groupCommand.CreateGroupCommand{
+			GroupName: group.Name,
+			GroupDesc: group.Description,
+			GroupType: group.Type,
+			CreatorId: ownerId,
+		})
+		if err != nil {
+			return fmt.Errorf("group %s: %w", group.Name, err)
+		}
+		groupId := result.(int)
+
+		for _, member := range group.Members {
+			memberId, err := userIdOf(member.User)
+			if err != nil {
+				return err
+			}
+			_, err = c.groupBus.Execute(ctx, groupCommand.AddMemberCommand{GroupId: groupId, UserId: memberId, RequesterId: ownerId})
+			if err != nil {
+				return fmt.Errorf("group %s: member %s: %w", group.Name, member.User, err)
+			}
+			if member.Role != "" {
+				_, err = c.groupBus.Execute(ctx, groupCommand.ChangeMemberRoleCommand{GroupId: groupId, UserId: memberId, NewRole: member.Role, RequesterId: ownerId})
+				if err != nil {
+					return fmt.Errorf("group %s: member %s: %w", group.Name, member.User, err)
+				}
+			}
+		}
+
+		tasks := 0
+		for _, container := range group.Containers {
+			result, err := c.containerBus.Execute(ctx, containerCommand.CreateContainerCommand{
+				Name:        container.Name,
+				Description: container.Description,
+				Type:        container.Type,
+				UserGroupId: groupId,
+				RequesterId: ownerId,
+			})
+			if err != nil {
+				return fmt.Errorf("group %s: container %s: %w", group.Name, container.Name, err)
+			}
+			containerId := result.(string)
+
+			for _, task := range container.Tasks {
+				var targetDate time.Time
+				if task.DueInDays != nil {
+					targetDate = today.AddDate(0, 0, *task.DueInDays)
+				}
+				_, err := c.taskBus.Execute(ctx, taskCommand.CreateTaskCommand{
+					ContainerId:    containerId,
+					TaskName:       task.Name,
+					TaskDesc:       task.Description,
+					TargetDate:     targetDate,
+					Priority:       task.Priority,
+					RecurrenceRule: task.RecurrenceRule,
+					RequesterId:    ownerId,
+				})
+				if err != nil {
+					return fmt.Errorf("group %s: container %s: task %s: %w", group.Name, container.Name, task.Name, err)
+				}
+				tasks++
+			}
+		}
+		fmt.Fprintf(c.out, "created group %s (%d) with %d members, %d containers and %d tasks\n",
+			group.Name, groupId, len(group.Members)+1, len(group.Containers), tasks)
+	}
+	return nil
+}
@@ -0,0 +1,49 @@
+package cli
+
+import (
+	"errors"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadFixture(t *testing.T) {
+	t.Run("when fixture is the development sample, Then it is read", func(t *testing.T) {
+		file, err := os.Open("../../dev-env/fixtures/family.yaml")
+		require.NoError(t, err)
+		defer file.Close()
+
+		fixture, err := readFixture(file)
+
+		require.NoError(t, err)
+		assert.Len(t, fixture.Users, 3)
+		require.Len(t, fixture.Groups, 1)
+		assert.Equal(t, "alice", fixture.Groups[0].Owner)
+		assert.Equal(t, 3, *fixture.Groups[0].Containers[0].Tasks[1].DueInDays)
+	})
+
+	invalid := map[string]string{
+		"unknown field":       "users:\n  - username: alice\n    email: alice@example.com\n    nickname: al\n",
+		"user without email":  "users:\n  - username: alice\n",
+		"duplicate username":  "users:\n  - {username: alice, email: a@example.com}\n  - {username: alice, email: b@example.com}\n",
+		"group without owner": "groups:\n  - name: Family\n",
+	}
+	for name, document := range invalid {
+		t.Run("when fixture has "+name+", Then ErrInvalidFixture is returned", func(t *testing.T) {
+			_, err := readFixture(strings.NewReader(document))
+
+			assert.True(t, errors.Is(err, ErrInvalidFixture), "error = %v", err)
+		})
+	}
+
+	t.Run("when fixture is empty, Then it seeds nothing", func(t *testing.T) {
+		fixture, err := readFixture(strings.NewReader(""))
+
+		require.NoError(t, err)
+		assert.Empty(t, fixture.Users)
+		assert.Empty(t, fixture.Groups)
+	})
+}
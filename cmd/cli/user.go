@@ -0,0 +1,62 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/happYness-Project/taskManagementGolang/internal/authorization"
+	"github.com/happYness-Project/taskManagementGolang/internal/user/application/command"
+)
+
+// user runs "user create" and "user deactivate"
+func (c *CLI) user(ctx context.Context, args []string) error {
+	if len(args) == 0 {
+		return c.usageError("user needs a command: create or deactivate")
+	}
+	switch args[0] {
+	case "create":
+		flags := c.flagSet("user create")
+		userId := flags.String("user-id", "", "UUID of the user in the identity provider; generated when empty")
+		userName := flags.String("username", "", "username")
+		email := flags.String("email", "", "email address")
+		firstName := flags.String("first-name", "", "first name")
+		lastName := flags.String("last-name", "", "last name")
+		if err := parse(flags, args[1:], "username", "email"); err != nil {
+			return err
+		}
+		if *userId == "" {
+			*userId = uuid.NewString()
+		}
+
+		_, err := c.userBus.Execute(ctx, command.CreateUserCommand{
+			UserId:    *userId,
+			UserName:  *userName,
+			FirstName: *firstName,
+			LastName:  *lastName,
+			Email:     *email,
+		})
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(c.out, "created user %s (%s)\n", *userName, *userId)
+		return nil
+	case "deactivate":
+		if len(args) != 2 {
+			return c.usageError("user deactivate needs a user UUID or email")
+		}
+		user, err := c.findUser(args[1])
+		if err != nil {
+			return err
+		}
+
+		_, err = c.userBus.Execute(ctx, command.DeactivateUserCommand{UserId: user.UserId, RequesterId: authorization.OperatorId})
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(c.out, "deactivated user %s (%s)\n", user.UserName, user.UserId)
+		return nil
+	default:
+		return c.usageError("unknown user command %q", args[0])
+	}
+}
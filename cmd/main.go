@@ -1,16 +1,29 @@
 package main
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"os"
 
 	"github.com/happYness-Project/taskManagementGolang/cmd/api"
+	"github.com/happYness-Project/taskManagementGolang/cmd/cli"
+	notificationApp "github.com/happYness-Project/taskManagementGolang/internal/notification/application"
 	"github.com/happYness-Project/taskManagementGolang/pkg/configs"
 	"github.com/happYness-Project/taskManagementGolang/pkg/dbs"
 	"github.com/happYness-Project/taskManagementGolang/pkg/loggers"
 )
 
 func main() {
+	command, args := "serve", os.Args[1:]
+	if len(args) > 0 {
+		command, args = args[0], args[1:]
+	}
+	if command == "help" || command == "-h" || command == "--help" {
+		cli.Usage(os.Stdout)
+		return
+	}
+
 	var current_env = os.Getenv("APP_ENV")
 	fmt.Println("Current Environment : " + current_env)
 	env := configs.InitConfig(current_env)
@@ -24,8 +37,10 @@ func main() {
 		connStr += "sslmode=require"
 	}
 
-	logger.Info().Msg(connStr)
-	logger.Info().Msg(env.AccessTokenSecret)
+	if command == "serve" {
+		logger.Info().Msg(connStr)
+		logger.Info().Msg(env.AccessTokenSecret)
+	}
 	database, err := dbs.ConnectToDb(connStr)
 	if err != nil {
 		logger.Fatal().Err(err).Msg("Unable to connect to the database.")
@@ -33,7 +48,22 @@ func main() {
 	}
 	defer database.Close()
 
+	if command != "serve" {
+		if err := cli.New(database, logger, os.Stdout).Run(context.Background(), command, args); err != nil {
+			if !errors.Is(err, cli.ErrUsage) {
+				logger.Error().Err(err).Msg("Command failed: " + command)
+			}
+			database.Close()
+			os.Exit(1)
+		}
+		return
+	}
+
 	server := api.NewApiServer(fmt.Sprintf("%s:%s", env.Host, env.Port), env.AccessTokenSecret, database, logger)
+	server.TrashRetentionDays = env.TrashRetentionDays
+	if env.SMTPHost != "" {
+		server.EmailSender = notificationApp.NewSMTPSender(env.SMTPHost, env.SMTPPort, env.SMTPUsername, env.SMTPPassword, env.SMTPFrom)
+	}
 	r := server.Setup()
 	if err := server.Run(r); err != nil {
 		logger.Error().Err(err).Msg("Unable to set up the server.")
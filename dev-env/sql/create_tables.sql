@@ -1,3 +1,5 @@
+-- Development bootstrap run once by Docker Compose: the schema at the latest migration plus seed data.
+-- Schema changes belong in a new migration under pkg/dbs/migrations/sql, mirrored here.
 
 SET statement_timeout = 0;
 SET lock_timeout = 0;
@@ -65,8 +67,14 @@ CREATE TABLE IF NOT EXISTS container.usergroup (
     type CHARACTER VARYING(30),
     thumbnailUrl CHARACTER VARYING(255),
     is_active boolean,
+    version int NOT NULL DEFAULT 1,
+    -- full-text search over the name, weighted above the description
+    search_vector tsvector GENERATED ALWAYS AS (
+        setweight(to_tsvector('english', coalesce(name, '')), 'A') ||
+        setweight(to_tsvector('english', coalesce(description, '')), 'B')) STORED,
     CONSTRAINT pk_usergroup PRIMARY KEY (id)
 );
+CREATE INDEX IF NOT EXISTS idx_usergroup_search ON container.usergroup USING GIN (search_vector);
 
 CREATE TABLE IF NOT EXISTS container.task (
     id uuid NOT NULL,
@@ -76,12 +84,21 @@ CREATE TABLE IF NOT EXISTS container.task (
     created_at timestamp with time zone,
     updated_at timestamp with time zone,
     target_date timestamp with time zone,
-    priority character varying(50),
-    category character varying(20),
+    priority character varying(50) NOT NULL DEFAULT 'medium',
     is_completed boolean NOT NULL,
     is_important boolean NOT NULL,
-    CONSTRAINT pk_task PRIMARY KEY (id)
+    recurrence_rule character varying(255) NOT NULL DEFAULT '',
+    assignee_id uuid,
+    version int NOT NULL DEFAULT 1,
+    deleted_at timestamp with time zone, -- set while the task is in the trash
+    search_vector tsvector GENERATED ALWAYS AS (
+        setweight(to_tsvector('english', coalesce(name, '')), 'A') ||
+        setweight(to_tsvector('english', coalesce(description, '')), 'B')) STORED,
+    CONSTRAINT pk_task PRIMARY KEY (id),
+    CONSTRAINT chk_task_priority CHECK (priority IN ('low', 'medium', 'high', 'urgent'))
 );
+CREATE INDEX IF NOT EXISTS idx_task_deleted_at ON container.task(deleted_at) WHERE deleted_at IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_task_search ON container.task USING GIN (search_vector);
 
 CREATE TABLE IF NOT EXISTS container.taskcontainer (
     id uuid NOT NULL,
@@ -91,10 +108,46 @@ CREATE TABLE IF NOT EXISTS container.taskcontainer (
     activity_level INT,
     type CHARACTER (50),
     usergroup_id bigint,
+    version int NOT NULL DEFAULT 1,
+    deleted_at timestamp with time zone, -- set while the container is in the trash
+    search_vector tsvector GENERATED ALWAYS AS (
+        setweight(to_tsvector('english', coalesce(name, '')), 'A') ||
+        setweight(to_tsvector('english', coalesce(description, '')), 'B')) STORED,
     CONSTRAINT pk_taskcontainer PRIMARY KEY (id),
     CONSTRAINT fk_usergroup_id_taskcontainer_usergroupId FOREIGN KEY (usergroup_id)
         REFERENCES container.usergroup ON DELETE CASCADE
 );
+CREATE INDEX IF NOT EXISTS idx_taskcontainer_deleted_at ON container.taskcontainer(deleted_at) WHERE deleted_at IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_taskcontainer_search ON container.taskcontainer USING GIN (search_vector);
+
+-- Reusable snapshot of a container; tasks holds the template tasks with relative target dates
+CREATE TABLE IF NOT EXISTS container.taskcontainer_template (
+    id uuid NOT NULL,
+    usergroup_id bigint NOT NULL,
+    name CHARACTER VARYING(100) NOT NULL,
+    description CHARACTER VARYING(255),
+    type CHARACTER VARYING(50),
+    tasks jsonb NOT NULL DEFAULT '[]',
+    created_by CHARACTER VARYING(36) NOT NULL,
+    created_at timestamp with time zone NOT NULL,
+    CONSTRAINT pk_taskcontainer_template PRIMARY KEY (id),
+    CONSTRAINT fk_taskcontainer_template_usergroup_id FOREIGN KEY (usergroup_id) REFERENCES container.usergroup(id) ON DELETE CASCADE
+);
+CREATE INDEX IF NOT EXISTS idx_taskcontainer_template_usergroup_id ON container.taskcontainer_template(usergroup_id);
+
+-- Personal task filters ("smart lists"); filter holds the criteria, with target dates that may be relative
+CREATE TABLE IF NOT EXISTS container.saved_view (
+    id uuid NOT NULL,
+    user_id bigint NOT NULL,
+    name CHARACTER VARYING(100) NOT NULL,
+    filter jsonb NOT NULL DEFAULT '{}',
+    sort CHARACTER VARYING(20) NOT NULL DEFAULT '',
+    created_at timestamp with time zone NOT NULL,
+    updated_at timestamp with time zone NOT NULL,
+    CONSTRAINT pk_saved_view PRIMARY KEY (id),
+    CONSTRAINT fk_saved_view_user_id FOREIGN KEY (user_id) REFERENCES container.user(id) ON DELETE CASCADE
+);
+CREATE INDEX IF NOT EXISTS idx_saved_view_user_id ON container.saved_view(user_id);
 
 CREATE TABLE IF NOT EXISTS container.taskcontainer_task (
   taskcontainer_id uuid NOT NULL,
@@ -104,6 +157,97 @@ CREATE TABLE IF NOT EXISTS container.taskcontainer_task (
   CONSTRAINT fk_taskcontainer_task_task_id FOREIGN KEY(task_id) REFERENCES container.task(id) ON DELETE CASCADE
 );
 
+CREATE TABLE IF NOT EXISTS container.task_checklist_item (
+  id uuid NOT NULL,
+  task_id uuid NOT NULL,
+  title character varying(255) NOT NULL,
+  is_checked boolean NOT NULL DEFAULT false,
+  position int NOT NULL,
+  created_at timestamp with time zone,
+  updated_at timestamp with time zone,
+  CONSTRAINT pk_task_checklist_item PRIMARY KEY (id),
+  CONSTRAINT fk_task_checklist_item_task_id FOREIGN KEY(task_id) REFERENCES container.task(id) ON DELETE CASCADE
+);
+CREATE INDEX IF NOT EXISTS idx_task_checklist_item_task_id ON container.task_checklist_item(task_id, position);
+
+-- Labels belong to a group; names are unique within it regardless of case
+CREATE TABLE IF NOT EXISTS container.label (
+  id uuid NOT NULL,
+  usergroup_id bigint NOT NULL,
+  name character varying(50) NOT NULL,
+  color character(7) NOT NULL, -- #rrggbb
+  created_at timestamp with time zone NOT NULL,
+  updated_at timestamp with time zone NOT NULL,
+  CONSTRAINT pk_label PRIMARY KEY (id),
+  CONSTRAINT fk_label_usergroup_id FOREIGN KEY (usergroup_id) REFERENCES container.usergroup(id) ON DELETE CASCADE
+);
+CREATE UNIQUE INDEX IF NOT EXISTS uq_label_usergroup_name ON container.label(usergroup_id, LOWER(name));
+
+CREATE TABLE IF NOT EXISTS container.task_label (
+  task_id uuid NOT NULL,
+  label_id uuid NOT NULL,
+  PRIMARY KEY (task_id, label_id),
+  CONSTRAINT fk_task_label_task_id FOREIGN KEY(task_id) REFERENCES container.task(id) ON DELETE CASCADE,
+  CONSTRAINT fk_task_label_label_id FOREIGN KEY(label_id) REFERENCES container.label(id) ON DELETE CASCADE
+);
+CREATE INDEX IF NOT EXISTS idx_task_label_label_id ON container.task_label(label_id);
+
+CREATE TABLE IF NOT EXISTS container.feed_token (
+  user_id bigint NOT NULL,
+  token_hash character(64) NOT NULL,
+  created_at timestamp with time zone NOT NULL,
+  CONSTRAINT pk_feed_token PRIMARY KEY (user_id),
+  CONSTRAINT fk_feed_token_user_id FOREIGN KEY (user_id) REFERENCES container.user(id) ON DELETE CASCADE
+);
+CREATE UNIQUE INDEX IF NOT EXISTS uq_feed_token_token_hash ON container.feed_token(token_hash);
+
+-- Reminder settings of the users who changed the defaults; offsets are comma separated minutes before due dates.
+CREATE TABLE IF NOT EXISTS container.reminder_setting (
+  user_id bigint NOT NULL,
+  offsets_minutes character varying(100) NOT NULL DEFAULT '',
+  notify_overdue boolean NOT NULL,
+  email_enabled boolean NOT NULL,
+  updated_at timestamp with time zone NOT NULL,
+  CONSTRAINT pk_reminder_setting PRIMARY KEY (user_id),
+  CONSTRAINT fk_reminder_setting_user_id FOREIGN KEY (user_id) REFERENCES container.user(id) ON DELETE CASCADE
+);
+
+-- In-app inbox of the reminders sent by the scheduler. The unique index keeps replicas from sending a reminder twice.
+CREATE TABLE IF NOT EXISTS container.notification (
+  id uuid NOT NULL,
+  user_id bigint NOT NULL,
+  usergroup_id bigint NOT NULL,
+  task_id uuid NOT NULL,
+  kind character varying(20) NOT NULL,
+  offset_minutes int NOT NULL DEFAULT 0,
+  due_at timestamp with time zone NOT NULL,
+  title character varying(255) NOT NULL,
+  created_at timestamp with time zone NOT NULL,
+  read_at timestamp with time zone,
+  CONSTRAINT pk_notification PRIMARY KEY (id),
+  CONSTRAINT fk_notification_user_id FOREIGN KEY (user_id) REFERENCES container.user(id) ON DELETE CASCADE,
+  CONSTRAINT fk_notification_usergroup_id FOREIGN KEY (usergroup_id) REFERENCES container.usergroup(id) ON DELETE CASCADE,
+  CONSTRAINT fk_notification_task_id FOREIGN KEY (task_id) REFERENCES container.task(id) ON DELETE CASCADE,
+  CONSTRAINT chk_notification_kind CHECK (kind IN ('due_soon', 'overdue'))
+);
+CREATE UNIQUE INDEX IF NOT EXISTS uq_notification_reminder ON container.notification(user_id, task_id, kind, offset_minutes, due_at);
+CREATE INDEX IF NOT EXISTS idx_notification_user_id ON container.notification(user_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_task_target_date ON container.task(target_date) WHERE NOT is_completed AND deleted_at IS NULL;
+
+-- Reminder emails, retried by the email worker; next_attempt_at is NULL once the email was sent or given up on.
+CREATE TABLE IF NOT EXISTS container.email_delivery (
+  notification_id uuid NOT NULL,
+  status character varying(20) NOT NULL,
+  attempts int NOT NULL DEFAULT 0,
+  last_error text,
+  next_attempt_at timestamp with time zone,
+  created_at timestamp with time zone NOT NULL,
+  updated_at timestamp with time zone NOT NULL,
+  CONSTRAINT pk_email_delivery PRIMARY KEY (notification_id),
+  CONSTRAINT fk_email_delivery_notification_id FOREIGN KEY (notification_id) REFERENCES container.notification(id) ON DELETE CASCADE
+);
+CREATE INDEX IF NOT EXISTS idx_email_delivery_due ON container.email_delivery(next_attempt_at) WHERE next_attempt_at IS NOT NULL;
+
 CREATE TABLE IF NOT EXISTS container.usergroup_user (
   usergroup_id bigint NOT NULL,
   user_id bigint NOT NULL,
@@ -115,6 +259,103 @@ CREATE TABLE IF NOT EXISTS container.usergroup_user (
   CONSTRAINT chk_usergroup_user_role CHECK (role IN ('admin', 'member'))
 );
 
+-- Append-only audit log. No foreign keys: entries outlive the groups and aggregates they describe.
+CREATE TABLE IF NOT EXISTS container.activity (
+  id bigint NOT NULL GENERATED ALWAYS AS IDENTITY,
+  usergroup_id bigint,
+  actor_id character varying(36) NOT NULL,
+  action character varying(50) NOT NULL,
+  aggregate_type character varying(30) NOT NULL,
+  aggregate_id character varying(64) NOT NULL,
+  before_snapshot jsonb,
+  after_snapshot jsonb,
+  request_id character varying(64) NOT NULL DEFAULT '',
+  occurred_at timestamp with time zone NOT NULL,
+  CONSTRAINT pk_activity PRIMARY KEY (id)
+);
+CREATE INDEX IF NOT EXISTS idx_activity_usergroup_id ON container.activity(usergroup_id, id DESC);
+
+create or replace function container.reject_activity_change()
+returns trigger
+as $$
+begin
+  raise exception 'container.activity is append-only';
+end
+$$
+language plpgsql;
+
+DROP TRIGGER IF EXISTS trg_activity_append_only ON container.activity;
+CREATE TRIGGER trg_activity_append_only BEFORE UPDATE OR DELETE ON container.activity
+  FOR EACH ROW EXECUTE FUNCTION container.reject_activity_change();
+
+-- Transactional outbox: domain events are written in the transaction that raised them
+-- and delivered to the configured sinks by the outbox dispatcher.
+-- next_attempt_at is NULL once delivery has been given up.
+CREATE TABLE IF NOT EXISTS container.outbox_event (
+  id bigint NOT NULL GENERATED ALWAYS AS IDENTITY,
+  event_id uuid NOT NULL,
+  event_type character varying(50) NOT NULL,
+  aggregate_type character varying(30) NOT NULL,
+  aggregate_id character varying(64) NOT NULL,
+  usergroup_id bigint,
+  payload jsonb NOT NULL,
+  occurred_at timestamp with time zone NOT NULL,
+  attempts int NOT NULL DEFAULT 0,
+  last_error text,
+  next_attempt_at timestamp with time zone,
+  dispatched_at timestamp with time zone,
+  tx_id xid8 NOT NULL DEFAULT pg_current_xact_id(), -- transaction that stored the event; streams read in (tx_id, id) order
+  CONSTRAINT pk_outbox_event PRIMARY KEY (id),
+  CONSTRAINT uq_outbox_event_event_id UNIQUE (event_id)
+);
+CREATE INDEX IF NOT EXISTS idx_outbox_event_pending ON container.outbox_event(next_attempt_at, id) WHERE dispatched_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_outbox_event_usergroup_position ON container.outbox_event(usergroup_id, tx_id, id); -- replay for event streams
+
+CREATE TABLE IF NOT EXISTS container.webhook (
+  id uuid NOT NULL,
+  usergroup_id bigint NOT NULL,
+  url character varying(2048) NOT NULL,
+  event_types character varying(500) NOT NULL, -- comma separated
+  secret character varying(64) NOT NULL,
+  is_active boolean NOT NULL DEFAULT true,
+  created_by character varying(36) NOT NULL,
+  created_at timestamp with time zone NOT NULL,
+  updated_at timestamp with time zone NOT NULL,
+  CONSTRAINT pk_webhook PRIMARY KEY (id),
+  CONSTRAINT fk_webhook_usergroup_id FOREIGN KEY (usergroup_id) REFERENCES container.usergroup(id) ON DELETE CASCADE
+);
+CREATE INDEX IF NOT EXISTS idx_webhook_usergroup_id ON container.webhook(usergroup_id);
+
+-- next_attempt_at is NULL once the delivery has succeeded or failed for good
+CREATE TABLE IF NOT EXISTS container.webhook_delivery (
+  id uuid NOT NULL,
+  webhook_id uuid NOT NULL,
+  event_id uuid NOT NULL,
+  event_type character varying(50) NOT NULL,
+  payload jsonb NOT NULL,
+  status character varying(20) NOT NULL,
+  attempts int NOT NULL DEFAULT 0,
+  next_attempt_at timestamp with time zone,
+  created_at timestamp with time zone NOT NULL,
+  updated_at timestamp with time zone NOT NULL,
+  CONSTRAINT pk_webhook_delivery PRIMARY KEY (id),
+  CONSTRAINT uq_webhook_delivery_event UNIQUE (webhook_id, event_id),
+  CONSTRAINT fk_webhook_delivery_webhook_id FOREIGN KEY (webhook_id) REFERENCES container.webhook(id) ON DELETE CASCADE
+);
+CREATE INDEX IF NOT EXISTS idx_webhook_delivery_due ON container.webhook_delivery(next_attempt_at) WHERE next_attempt_at IS NOT NULL;
+
+CREATE TABLE IF NOT EXISTS container.webhook_delivery_attempt (
+  id bigint NOT NULL GENERATED ALWAYS AS IDENTITY,
+  delivery_id uuid NOT NULL,
+  attempted_at timestamp with time zone NOT NULL,
+  status_code int,
+  error text,
+  duration_ms bigint NOT NULL,
+  CONSTRAINT pk_webhook_delivery_attempt PRIMARY KEY (id),
+  CONSTRAINT fk_webhook_delivery_attempt_delivery_id FOREIGN KEY (delivery_id) REFERENCES container.webhook_delivery(id) ON DELETE CASCADE
+);
+CREATE INDEX IF NOT EXISTS idx_webhook_delivery_attempt_delivery_id ON container.webhook_delivery_attempt(delivery_id, id);
+
 
 INSERT INTO container."usergroup"(id, name, description, type, thumbnailurl, is_active) OVERRIDING SYSTEM VALUE VALUES (1, 'user group #1', 'Description for user group 1', 'normal', '', true);
 INSERT INTO container."usergroup"(id, name, description, type, thumbnailurl, is_active) OVERRIDING SYSTEM VALUE VALUES (2, 'user group #2', 'Description for user group 2', 'normal', '', true);
@@ -138,13 +379,21 @@ INSERT INTO container.usergroup_user(usergroup_id, user_id, role) VALUES (5, 1,
 INSERT INTO container.taskcontainer(id, name, description, is_active, activity_level, type, usergroup_id) VALUES ('5951f639-c8ce-4462-8b72-c57458c448fd', 'grocery', 'grocery container for my family', true, 0, 'normal', 1);
 INSERT INTO container.taskcontainer(id, name, description, is_active, activity_level, type, usergroup_id) VALUES ('22095f67-168a-47f4-9d77-90cf27d77c89', 'chores', 'chores container for my family', true, 0, 'normal', 1);
 INSERT INTO container.taskcontainer(id, name, description, is_active, activity_level, type, usergroup_id) VALUES ('9ccba4b5-4745-4d5c-8901-46b159c71516', 'grocery', 'grocery container for my family', true, 0, 'normal', 2);
-INSERT INTO container.task(id, name, description, type, created_at, updated_at, target_date, priority, category, is_completed, is_important) VALUES ('94d277a0-245a-4155-aea3-29f6cbabd849', 'Apple', 'need this for apple pie', '', CURRENT_DATE,CURRENT_DATE,CURRENT_DATE + INTERVAL  '6 days', 'normal', 'grocery', false, true);
-INSERT INTO container.task(id, name, description, type, created_at, updated_at, target_date, priority, category, is_completed, is_important) VALUES ('06e1840f-b5a9-4008-9add-7170272291d1', 'Banana', 'need this for breakfast', '', CURRENT_DATE,CURRENT_DATE,CURRENT_DATE + INTERVAL  '3 days', 'high', 'grocery', false, false);
-INSERT INTO container.task(id, name, description, type, created_at, updated_at, target_date, priority, category, is_completed, is_important) VALUES ('5d89f6e6-59e7-4232-9292-4f0031c43254', 'Banana', 'need this for breakfast', '', CURRENT_DATE,CURRENT_DATE,CURRENT_DATE + INTERVAL  '4 days', 'normal', 'grocery', false, false);
-INSERT INTO container.task(id, name, description, type, created_at, updated_at, target_date, priority, category, is_completed, is_important) VALUES ('85b6e084-6995-4e49-b128-2e5700b19b67', 'Green onion', 'need for kimchi', '', CURRENT_DATE,CURRENT_DATE,CURRENT_DATE + INTERVAL  '4 days', 'normal', 'grocery', false, false);
-INSERT INTO container.task(id, name, description, type, created_at, updated_at, target_date, priority, category, is_completed, is_important) VALUES ('2ce3fc41-d1c6-45b3-9111-bcb979aa943b', 'Dish Wash', '', '', CURRENT_DATE,CURRENT_DATE,CURRENT_DATE + INTERVAL  '1 days', 'urgent', 'chores', false, false);
+INSERT INTO container.task(id, name, description, type, created_at, updated_at, target_date, priority, is_completed, is_important) VALUES ('94d277a0-245a-4155-aea3-29f6cbabd849', 'Apple', 'need this for apple pie', '', CURRENT_DATE,CURRENT_DATE,CURRENT_DATE + INTERVAL  '6 days', 'medium', false, true);
+INSERT INTO container.task(id, name, description, type, created_at, updated_at, target_date, priority, is_completed, is_important) VALUES ('06e1840f-b5a9-4008-9add-7170272291d1', 'Banana', 'need this for breakfast', '', CURRENT_DATE,CURRENT_DATE,CURRENT_DATE + INTERVAL  '3 days', 'high', false, false);
+INSERT INTO container.task(id, name, description, type, created_at, updated_at, target_date, priority, is_completed, is_important) VALUES ('5d89f6e6-59e7-4232-9292-4f0031c43254', 'Banana', 'need this for breakfast', '', CURRENT_DATE,CURRENT_DATE,CURRENT_DATE + INTERVAL  '4 days', 'medium', false, false);
+INSERT INTO container.task(id, name, description, type, created_at, updated_at, target_date, priority, is_completed, is_important) VALUES ('85b6e084-6995-4e49-b128-2e5700b19b67', 'Green onion', 'need for kimchi', '', CURRENT_DATE,CURRENT_DATE,CURRENT_DATE + INTERVAL  '4 days', 'medium', false, false);
+INSERT INTO container.task(id, name, description, type, created_at, updated_at, target_date, priority, is_completed, is_important) VALUES ('2ce3fc41-d1c6-45b3-9111-bcb979aa943b', 'Dish Wash', '', '', CURRENT_DATE,CURRENT_DATE,CURRENT_DATE + INTERVAL  '1 days', 'urgent', false, false);
 INSERT INTO container.taskcontainer_task(taskcontainer_id, task_id) VALUES ('5951f639-c8ce-4462-8b72-c57458c448fd', '94d277a0-245a-4155-aea3-29f6cbabd849');
 INSERT INTO container.taskcontainer_task(taskcontainer_id, task_id) VALUES ('5951f639-c8ce-4462-8b72-c57458c448fd', '06e1840f-b5a9-4008-9add-7170272291d1');
 INSERT INTO container.taskcontainer_task(taskcontainer_id, task_id) VALUES ('5951f639-c8ce-4462-8b72-c57458c448fd', '5d89f6e6-59e7-4232-9292-4f0031c43254');
 INSERT INTO container.taskcontainer_task(taskcontainer_id, task_id) VALUES ('9ccba4b5-4745-4d5c-8901-46b159c71516', '85b6e084-6995-4e49-b128-2e5700b19b67');
 INSERT INTO container.taskcontainer_task(taskcontainer_id, task_id) VALUES ('22095f67-168a-47f4-9d77-90cf27d77c89', '2ce3fc41-d1c6-45b3-9111-bcb979aa943b');
+INSERT INTO container.label(id, usergroup_id, name, color, created_at, updated_at) VALUES ('3f1c2d4e-5a6b-4c7d-8e9f-0a1b2c3d4e5f', 1, 'grocery', '#4caf50', CURRENT_DATE, CURRENT_DATE);
+INSERT INTO container.label(id, usergroup_id, name, color, created_at, updated_at) VALUES ('7a8b9c0d-1e2f-4a3b-9c4d-5e6f7a8b9c0d', 1, 'chores', '#2196f3', CURRENT_DATE, CURRENT_DATE);
+INSERT INTO container.label(id, usergroup_id, name, color, created_at, updated_at) VALUES ('b1c2d3e4-f5a6-4b7c-8d9e-0f1a2b3c4d5e', 2, 'grocery', '#4caf50', CURRENT_DATE, CURRENT_DATE);
+INSERT INTO container.task_label(task_id, label_id) VALUES ('94d277a0-245a-4155-aea3-29f6cbabd849', '3f1c2d4e-5a6b-4c7d-8e9f-0a1b2c3d4e5f');
+INSERT INTO container.task_label(task_id, label_id) VALUES ('06e1840f-b5a9-4008-9add-7170272291d1', '3f1c2d4e-5a6b-4c7d-8e9f-0a1b2c3d4e5f');
+INSERT INTO container.task_label(task_id, label_id) VALUES ('5d89f6e6-59e7-4232-9292-4f0031c43254', '3f1c2d4e-5a6b-4c7d-8e9f-0a1b2c3d4e5f');
+INSERT INTO container.task_label(task_id, label_id) VALUES ('85b6e084-6995-4e49-b128-2e5700b19b67', 'b1c2d3e4-f5a6-4b7c-8d9e-0f1a2b3c4d5e');
+INSERT INTO container.task_label(task_id, label_id) VALUES ('2ce3fc41-d1c6-45b3-9111-bcb979aa943b', '7a8b9c0d-1e2f-4a3b-9c4d-5e6f7a8b9c0d');
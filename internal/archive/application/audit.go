@@ -0,0 +1,42 @@
+package application
+
+import (
+	"strconv"
+
+	cmd "github.com/happYness-Project/taskManagementGolang/internal/archive/application/command"
+	auditApp "github.com/happYness-Project/taskManagementGolang/internal/audit/application"
+	auditDomain "github.com/happYness-Project/taskManagementGolang/internal/audit/domain"
+)
+
+// auditSubject describes the group an import creates for the activity log
+func (bus *CommandBus) auditSubject(command interface{}) auditApp.Subject {
+	subject := auditApp.Subject{
+		Action:        auditApp.ActionOf(command),
+		AggregateType: auditDomain.AggregateUserGroup,
+		Load:          bus.loadGroup,
+	}
+
+	switch c := command.(type) {
+	case cmd.ImportGroupCommand:
+		subject.ActorId = c.RequesterId
+		subject.Resolve = func(result interface{}) (string, int) {
+			if imported, ok := result.(*cmd.ImportResult); ok {
+				return strconv.Itoa(imported.GroupId), imported.GroupId
+			}
+			return "", 0
+		}
+	}
+	return subject
+}
+
+func (bus *CommandBus) loadGroup(groupId string) (interface{}, error) {
+	id, err := strconv.Atoi(groupId)
+	if err != nil {
+		return nil, err
+	}
+	group, err := bus.groupRepo.GetById(id)
+	if err != nil || group == nil || group.GroupId == 0 {
+		return nil, err
+	}
+	return group, nil
+}
@@ -0,0 +1,222 @@
+package command
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/happYness-Project/taskManagementGolang/internal/archive/domain"
+	labelDomain "github.com/happYness-Project/taskManagementGolang/internal/label/domain"
+	labelRepo "github.com/happYness-Project/taskManagementGolang/internal/label/repository"
+	outboxRepo "github.com/happYness-Project/taskManagementGolang/internal/outbox/repository"
+	taskDomain "github.com/happYness-Project/taskManagementGolang/internal/task/domain"
+	taskRepo "github.com/happYness-Project/taskManagementGolang/internal/task/repository"
+	containerDomain "github.com/happYness-Project/taskManagementGolang/internal/taskcontainer/domain"
+	containerRepo "github.com/happYness-Project/taskManagementGolang/internal/taskcontainer/repository"
+	userDomain "github.com/happYness-Project/taskManagementGolang/internal/user/domain"
+	userRepo "github.com/happYness-Project/taskManagementGolang/internal/user/repository"
+	groupDomain "github.com/happYness-Project/taskManagementGolang/internal/usergroup/domain"
+	groupRepo "github.com/happYness-Project/taskManagementGolang/internal/usergroup/repository"
+	"github.com/happYness-Project/taskManagementGolang/pkg/dbs"
+)
+
+// ImportGroupCommand recreates an archived group under new ids, with the requester as one of its admins
+type ImportGroupCommand struct {
+	Archive     domain.Archive
+	OnConflict  domain.ConflictPolicy
+	RequesterId string // UUID from JWT
+}
+
+// ImportResult describes the group an import created
+type ImportResult struct {
+	GroupId        int `json:"group_id"`
+	MembersCreated int `json:"members_created"`
+	MembersLinked  int `json:"members_linked"`
+	MembersSkipped int `json:"members_skipped"`
+	Labels         int `json:"labels"`
+	Containers     int `json:"containers"`
+	Tasks          int `json:"tasks"`
+}
+
+// ImportGroupCommandHandler handles the import of group archives
+type ImportGroupCommandHandler struct {
+	groupRepo     groupRepo.UserGroupRepository
+	userRepo      userRepo.UserRepository
+	labelRepo     labelRepo.LabelRepository
+	containerRepo containerRepo.ContainerRepository
+	taskRepo      taskRepo.TaskRepository
+	outboxRepo    outboxRepo.OutboxRepository
+	uow           dbs.UnitOfWork
+}
+
+func NewImportGroupCommandHandler(
+	groupRepo groupRepo.UserGroupRepository,
+	userRepo userRepo.UserRepository,
+	labelRepo labelRepo.LabelRepository,
+	containerRepo containerRepo.ContainerRepository,
+	taskRepo taskRepo.TaskRepository,
+	outboxRepo outboxRepo.OutboxRepository,
+	uow dbs.UnitOfWork,
+) *ImportGroupCommandHandler {
+	return &ImportGroupCommandHandler{
+		groupRepo:     groupRepo,
+		userRepo:      userRepo,
+		labelRepo:     labelRepo,
+		containerRepo: containerRepo,
+		taskRepo:      taskRepo,
+		outboxRepo:    outboxRepo,
+		uow:           uow,
+	}
+}
+
+// Handle imports the archive in one transaction, so a failing import leaves nothing behind.
+// Members are matched with existing users by email and handled according to OnConflict; the others become new
+// users. The requester is always a member, whatever the archive says.
+func (h *ImportGroupCommandHandler) Handle(cmd ImportGroupCommand) (*ImportResult, error) {
+	if err := cmd.Archive.Validate(); err != nil {
+		return nil, err
+	}
+	group, err := groupDomain.NewUserGroup(cmd.Archive.Group.Name, cmd.Archive.Group.Description, cmd.Archive.Group.Type)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", domain.ErrInvalidArchive, err)
+	}
+
+	result := &ImportResult{}
+	err = h.uow.Do(func(tx dbs.DBTX) error {
+		groupRepo := h.groupRepo.WithTx(tx)
+		userRepo := h.userRepo.WithTx(tx)
+
+		requester, err := userRepo.GetUserByUserId(cmd.RequesterId)
+		if err != nil || requester == nil {
+			return fmt.Errorf("requester not found: %s", cmd.RequesterId)
+		}
+		group.GroupId, err = groupRepo.CreateGroupWithUsers(*group, requester.Id)
+		if err != nil {
+			return fmt.Errorf("failed to create group: %w", err)
+		}
+		result.GroupId = group.GroupId
+
+		// assignees maps the user ids of the archive to those of this deployment
+		assignees := map[string]string{}
+		for _, member := range cmd.Archive.Members {
+			if strings.EqualFold(member.Email, requester.Email) {
+				assignees[member.UserId] = requester.UserId
+				result.MembersLinked++
+				continue
+			}
+			user, created, err := h.resolveMember(userRepo, member, cmd.OnConflict)
+			if err != nil {
+				return err
+			}
+			if user == nil {
+				result.MembersSkipped++
+				continue
+			}
+			if err := h.addMember(groupRepo, group, user, member.Role); err != nil {
+				return err
+			}
+			assignees[member.UserId] = user.UserId
+			if created {
+				result.MembersCreated++
+			} else {
+				result.MembersLinked++
+			}
+		}
+
+		groupLabels := make([]taskDomain.Label, 0, len(cmd.Archive.Labels))
+		for _, archived := range cmd.Archive.Labels {
+			label, err := labelDomain.NewLabel(group.GroupId, archived.Name, archived.Color)
+			if err != nil {
+				return fmt.Errorf("%w: %v", domain.ErrInvalidArchive, err)
+			}
+			if err := h.labelRepo.WithTx(tx).CreateLabel(*label); err != nil {
+				return fmt.Errorf("failed to create label: %w", err)
+			}
+			groupLabels = append(groupLabels, label.TaskLabel())
+		}
+		result.Labels = len(groupLabels)
+
+		evts := group.PullEvents()
+		for _, archived := range cmd.Archive.Containers {
+			container := containerDomain.NewTaskContainer(archived.Name, archived.Description, "", group.GroupId)
+			container.IsActive = archived.IsActive
+			if err := h.containerRepo.WithTx(tx).CreateContainer(*container); err != nil {
+				return fmt.Errorf("failed to create container: %w", err)
+			}
+			evts = append(evts, container.PullEvents()...)
+
+			for _, archivedTask := range archived.Tasks {
+				task, err := archivedTask.Restore(assignees[archivedTask.AssigneeId], groupLabels)
+				if err != nil {
+					return err
+				}
+				if _, err := h.taskRepo.WithTx(tx).CreateTask(container.Id, *task); err != nil {
+					return fmt.Errorf("failed to create task: %w", err)
+				}
+				evts = append(evts, task.PullEvents()...)
+				result.Tasks++
+			}
+			result.Containers++
+		}
+		if err := h.outboxRepo.WithTx(tx).Append(group.GroupId, evts...); err != nil {
+			return fmt.Errorf("failed to store group events: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// resolveMember returns the user an archived member becomes and whether it was created for the import.
+// A nil user means the member is skipped.
+func (h *ImportGroupCommandHandler) resolveMember(userRepo userRepo.UserRepository, member domain.Member, onConflict domain.ConflictPolicy) (*userDomain.User, bool, error) {
+	existing, err := userRepo.GetUserByEmail(member.Email)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to find user %s: %w", member.Email, err)
+	}
+	if existing != nil && existing.UserId != "" {
+		switch onConflict {
+		case domain.ConflictSkip:
+			return nil, false, nil
+		case domain.ConflictFail:
+			return nil, false, fmt.Errorf("%w: %s", domain.ErrMemberConflict, member.Email)
+		default:
+			return existing, false, nil
+		}
+	}
+
+	// Usernames are unique too, and a username taken by someone else's email is never linked
+	taken, err := userRepo.GetUserByUsername(member.UserName)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to find user %s: %w", member.UserName, err)
+	}
+	if taken != nil && taken.UserId != "" {
+		return nil, false, fmt.Errorf("%w: username %s belongs to another user", domain.ErrMemberConflict, member.UserName)
+	}
+	user := userDomain.NewUser(uuid.NewString(), member.UserName, member.FirstName, member.LastName, member.Email)
+	if err := userRepo.CreateUser(*user); err != nil {
+		return nil, false, fmt.Errorf("failed to create user %s: %w", member.UserName, err)
+	}
+	// Reload the user for the id the database gave it
+	created, err := userRepo.GetUserByUserId(user.UserId)
+	if err != nil || created == nil {
+		return nil, false, fmt.Errorf("failed to find created user %s: %w", member.UserName, err)
+	}
+	return created, true, nil
+}
+
+func (h *ImportGroupCommandHandler) addMember(groupRepo groupRepo.UserGroupRepository, group *groupDomain.UserGroup, user *userDomain.User, role string) error {
+	member := group.AddMember(user.UserId)
+	if err := groupRepo.InsertUserGroupUserTable(group.GroupId, user.Id); err != nil {
+		return fmt.Errorf("failed to add member: %w", err)
+	}
+	if role := groupDomain.Role(role); role.IsAdmin() {
+		group.ChangeMemberRole(member, role)
+		if err := groupRepo.UpdateUserRoleInGroup(group.GroupId, user.Id, role.String()); err != nil {
+			return fmt.Errorf("failed to update member role: %w", err)
+		}
+	}
+	return nil
+}
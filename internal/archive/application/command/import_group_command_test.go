@@ -0,0 +1,139 @@
+package command
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/happYness-Project/taskManagementGolang/internal/archive/domain"
+	"github.com/happYness-Project/taskManagementGolang/internal/mocks"
+	taskDomain "github.com/happYness-Project/taskManagementGolang/internal/task/domain"
+	"github.com/happYness-Project/taskManagementGolang/internal/task/repository"
+	userDomain "github.com/happYness-Project/taskManagementGolang/internal/user/domain"
+	"github.com/happYness-Project/taskManagementGolang/pkg/dbs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// stubImportTaskRepo keeps the tasks created by an import
+type stubImportTaskRepo struct {
+	repository.TaskRepository
+	created []taskDomain.Task
+}
+
+func (s *stubImportTaskRepo) CreateTask(containerId string, task taskDomain.Task) (taskDomain.Task, error) {
+	s.created = append(s.created, task)
+	return task, nil
+}
+
+func (s *stubImportTaskRepo) WithTx(tx dbs.DBTX) repository.TaskRepository { return s }
+
+func TestImportGroupCommandHandler_Handle(t *testing.T) {
+	const groupId = 12
+	requester := &userDomain.User{Id: 1, UserId: "requester", Email: "dana@example.com"}
+	existing := &userDomain.User{Id: 2, UserId: "bob-here", Email: "bob@example.com"}
+
+	archive := domain.Archive{
+		FormatVersion: domain.FormatVersion,
+		Group:         domain.Group{Name: "Family", Type: "home"},
+		Members: []domain.Member{
+			{UserId: "dana", UserName: "dana", Email: "DANA@example.com", Role: "admin"},
+			{UserId: "bob", UserName: "bob", Email: "bob@example.com", Role: "admin"},
+			{UserId: "carol", UserName: "carol", Email: "carol@example.com", Role: "member"},
+		},
+		Labels: []domain.Label{{Name: "grocery", Color: "#00ff00"}},
+		Containers: []domain.Container{{Name: "Shopping", IsActive: true, Tasks: []domain.Task{
+			{Name: "Milk", Labels: []string{"Grocery"}, AssigneeId: "dana", CreatedAt: time.Now()},
+			{Name: "Bread", AssigneeId: "bob"},
+			{Name: "Eggs", AssigneeId: "carol"},
+		}}},
+	}
+
+	newFixture := func(t *testing.T) (*ImportGroupCommandHandler, *mocks.MockUserGroupRepo, *stubImportTaskRepo, *mocks.MockOutboxRepo) {
+		t.Helper()
+		groupRepo := new(mocks.MockUserGroupRepo)
+		groupRepo.On("CreateGroupWithUsers", mock.Anything, requester.Id).Return(groupId, nil)
+		groupRepo.On("InsertUserGroupUserTable", groupId, mock.Anything).Return(nil)
+		groupRepo.On("UpdateUserRoleInGroup", groupId, mock.Anything, "admin").Return(nil)
+		userRepo := new(mocks.MockUserRepo)
+		userRepo.On("GetUserByUserId", "requester").Return(requester, nil)
+		userRepo.On("GetUserByEmail", "bob@example.com").Return(existing, nil)
+		userRepo.On("GetUserByEmail", "carol@example.com").Return(&userDomain.User{}, nil)
+		userRepo.On("GetUserByUsername", "carol").Return(&userDomain.User{}, nil)
+		userRepo.On("CreateUser", mock.Anything).Return(nil)
+		userRepo.On("GetUserByUserId", mock.Anything).Return(&userDomain.User{Id: 3, UserId: "carol-here"}, nil)
+		labelRepo := new(mocks.MockLabelRepo)
+		labelRepo.On("CreateLabel", mock.Anything).Return(nil)
+		containerRepo := new(mocks.MockContainerRepo)
+		containerRepo.On("CreateContainer", mock.Anything).Return(nil)
+		taskRepo := &stubImportTaskRepo{}
+		outbox := &mocks.MockOutboxRepo{}
+
+		handler := NewImportGroupCommandHandler(groupRepo, userRepo, labelRepo, containerRepo, taskRepo, outbox, &mocks.MockUnitOfWork{})
+		return handler, groupRepo, taskRepo, outbox
+	}
+
+	t.Run("when members are linked, Then existing users join, the others are created and tasks follow their assignees", func(t *testing.T) {
+		// Arrange
+		handler, groupRepo, taskRepo, outbox := newFixture(t)
+
+		// Act
+		result, err := handler.Handle(ImportGroupCommand{Archive: archive, OnConflict: domain.ConflictLink, RequesterId: "requester"})
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, &ImportResult{GroupId: groupId, MembersCreated: 1, MembersLinked: 2, Labels: 1, Containers: 1, Tasks: 3}, result)
+		groupRepo.AssertCalled(t, "UpdateUserRoleInGroup", groupId, existing.Id, "admin")
+		groupRepo.AssertNotCalled(t, "UpdateUserRoleInGroup", groupId, 3, "admin")
+		require.Len(t, taskRepo.created, 3)
+		assert.Equal(t, "requester", taskRepo.created[0].AssigneeId)
+		require.Len(t, taskRepo.created[0].Labels, 1)
+		assert.Equal(t, "grocery", taskRepo.created[0].Labels[0].Name)
+		assert.Equal(t, "bob-here", taskRepo.created[1].AssigneeId)
+		assert.Equal(t, "carol-here", taskRepo.created[2].AssigneeId)
+		assert.NotEmpty(t, outbox.Events)
+		for _, id := range outbox.GroupIds {
+			assert.Equal(t, groupId, id)
+		}
+	})
+
+	t.Run("when existing members are skipped, Then their tasks are unassigned", func(t *testing.T) {
+		// Arrange
+		handler, groupRepo, taskRepo, _ := newFixture(t)
+
+		// Act
+		result, err := handler.Handle(ImportGroupCommand{Archive: archive, OnConflict: domain.ConflictSkip, RequesterId: "requester"})
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, 1, result.MembersSkipped)
+		groupRepo.AssertNotCalled(t, "InsertUserGroupUserTable", groupId, existing.Id)
+		assert.Empty(t, taskRepo.created[1].AssigneeId)
+	})
+
+	t.Run("when existing members fail the import, Then ErrMemberConflict is returned", func(t *testing.T) {
+		// Arrange
+		handler, _, _, _ := newFixture(t)
+
+		// Act
+		_, err := handler.Handle(ImportGroupCommand{Archive: archive, OnConflict: domain.ConflictFail, RequesterId: "requester"})
+
+		// Assert
+		assert.True(t, errors.Is(err, domain.ErrMemberConflict))
+	})
+
+	t.Run("when the archive is invalid, Then nothing is created", func(t *testing.T) {
+		// Arrange
+		handler, groupRepo, _, _ := newFixture(t)
+		invalid := archive
+		invalid.FormatVersion = domain.FormatVersion + 1
+
+		// Act
+		_, err := handler.Handle(ImportGroupCommand{Archive: invalid, RequesterId: "requester"})
+
+		// Assert
+		assert.True(t, errors.Is(err, domain.ErrInvalidArchive))
+		groupRepo.AssertNotCalled(t, "CreateGroupWithUsers", mock.Anything, mock.Anything)
+	})
+}
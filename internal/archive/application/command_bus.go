@@ -0,0 +1,94 @@
+package application
+
+import (
+	"context"
+	"fmt"
+
+	cmd "github.com/happYness-Project/taskManagementGolang/internal/archive/application/command"
+	auditApp "github.com/happYness-Project/taskManagementGolang/internal/audit/application"
+	labelRepo "github.com/happYness-Project/taskManagementGolang/internal/label/repository"
+	outboxRepo "github.com/happYness-Project/taskManagementGolang/internal/outbox/repository"
+	taskRepo "github.com/happYness-Project/taskManagementGolang/internal/task/repository"
+	containerRepo "github.com/happYness-Project/taskManagementGolang/internal/taskcontainer/repository"
+	userRepo "github.com/happYness-Project/taskManagementGolang/internal/user/repository"
+	groupRepo "github.com/happYness-Project/taskManagementGolang/internal/usergroup/repository"
+	"github.com/happYness-Project/taskManagementGolang/pkg/dbs"
+)
+
+// CommandBus routes commands to their handlers
+type CommandBus struct {
+	importGroupHandler *cmd.ImportGroupCommandHandler
+
+	groupRepo     groupRepo.UserGroupRepository
+	userRepo      userRepo.UserRepository
+	labelRepo     labelRepo.LabelRepository
+	containerRepo containerRepo.ContainerRepository
+	taskRepo      taskRepo.TaskRepository
+	outbox        outboxRepo.OutboxRepository
+	uow           dbs.UnitOfWork
+	recorder      *auditApp.Recorder
+}
+
+// NewCommandBus creates a new command bus with all handlers registered
+func NewCommandBus(
+	groupRepo groupRepo.UserGroupRepository,
+	userRepo userRepo.UserRepository,
+	labelRepo labelRepo.LabelRepository,
+	containerRepo containerRepo.ContainerRepository,
+	taskRepo taskRepo.TaskRepository,
+	uow dbs.UnitOfWork,
+	recorder *auditApp.Recorder,
+	outbox outboxRepo.OutboxRepository,
+) *CommandBus {
+	return &CommandBus{
+		importGroupHandler: cmd.NewImportGroupCommandHandler(groupRepo, userRepo, labelRepo, containerRepo, taskRepo, outbox, uow),
+		groupRepo:          groupRepo,
+		userRepo:           userRepo,
+		labelRepo:          labelRepo,
+		containerRepo:      containerRepo,
+		taskRepo:           taskRepo,
+		outbox:             outbox,
+		uow:                uow,
+		recorder:           recorder,
+	}
+}
+
+// withTx returns a bus whose handlers and activity log run inside the given transaction.
+// The handlers' own units of work become savepoints of it.
+func (bus *CommandBus) withTx(tx dbs.DBTX) *CommandBus {
+	return NewCommandBus(
+		bus.groupRepo.WithTx(tx),
+		bus.userRepo.WithTx(tx),
+		bus.labelRepo.WithTx(tx),
+		bus.containerRepo.WithTx(tx),
+		bus.taskRepo.WithTx(tx),
+		dbs.NewUnitOfWork(tx),
+		bus.recorder.WithTx(tx),
+		bus.outbox.WithTx(tx),
+	)
+}
+
+// Execute dispatches the command to the appropriate handler and records it in the activity log, in one transaction.
+// Any user may import a group, as they become the admin of a new one, so commands need no authorization.
+func (bus *CommandBus) Execute(ctx context.Context, command interface{}) (interface{}, error) {
+	var result interface{}
+	err := bus.uow.Do(func(tx dbs.DBTX) error {
+		txBus := bus.withTx(tx)
+		var err error
+		result, err = txBus.recorder.Track(ctx, txBus.auditSubject(command), func() (interface{}, error) {
+			return txBus.dispatch(command)
+		})
+		return err
+	})
+	return result, err
+}
+
+// dispatch routes the command to its handler
+func (bus *CommandBus) dispatch(command interface{}) (interface{}, error) {
+	switch c := command.(type) {
+	case cmd.ImportGroupCommand:
+		return bus.importGroupHandler.Handle(c)
+	default:
+		return nil, fmt.Errorf("unknown command type: %T", command)
+	}
+}
@@ -0,0 +1,186 @@
+package query
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/happYness-Project/taskManagementGolang/internal/archive/domain"
+	labelRepo "github.com/happYness-Project/taskManagementGolang/internal/label/repository"
+	taskRepo "github.com/happYness-Project/taskManagementGolang/internal/task/repository"
+	containerDomain "github.com/happYness-Project/taskManagementGolang/internal/taskcontainer/domain"
+	containerRepo "github.com/happYness-Project/taskManagementGolang/internal/taskcontainer/repository"
+	userRepo "github.com/happYness-Project/taskManagementGolang/internal/user/repository"
+	groupRepo "github.com/happYness-Project/taskManagementGolang/internal/usergroup/repository"
+)
+
+var ErrGroupNotFound = errors.New("group does not exist")
+
+// ExportGroupQuery exports a group as an archive
+type ExportGroupQuery struct {
+	GroupId     int
+	RequesterId string // UUID from JWT
+}
+
+// GroupExport is an export ready to be written. The group, its members and labels are read when the export is
+// opened; containers are read one at a time while the archive is written, so large groups are never held in memory.
+type GroupExport struct {
+	GroupId    int
+	GroupName  string
+	archive    domain.Archive
+	containers []containerDomain.TaskContainer
+	members    map[string]bool
+	taskRepo   taskRepo.TaskRepository
+}
+
+// ExportQueryHandler handles the export of groups
+type ExportQueryHandler struct {
+	groupRepo     groupRepo.UserGroupRepository
+	userRepo      userRepo.UserRepository
+	labelRepo     labelRepo.LabelRepository
+	containerRepo containerRepo.ContainerRepository
+	taskRepo      taskRepo.TaskRepository
+}
+
+func NewExportQueryHandler(
+	groupRepo groupRepo.UserGroupRepository,
+	userRepo userRepo.UserRepository,
+	labelRepo labelRepo.LabelRepository,
+	containerRepo containerRepo.ContainerRepository,
+	taskRepo taskRepo.TaskRepository,
+) *ExportQueryHandler {
+	return &ExportQueryHandler{
+		groupRepo:     groupRepo,
+		userRepo:      userRepo,
+		labelRepo:     labelRepo,
+		containerRepo: containerRepo,
+		taskRepo:      taskRepo,
+	}
+}
+
+func (h *ExportQueryHandler) HandleExportGroup(query ExportGroupQuery) (*GroupExport, error) {
+	group, err := h.groupRepo.GetById(query.GroupId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve group: %w", err)
+	}
+	if group == nil || group.GroupId == 0 {
+		return nil, ErrGroupNotFound
+	}
+	users, err := h.userRepo.GetUsersByGroupIdWithRoles(query.GroupId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve group members: %w", err)
+	}
+	labels, err := h.labelRepo.GetByGroupId(query.GroupId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve labels: %w", err)
+	}
+	containers, err := h.containerRepo.GetContainersByGroupId(query.GroupId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve containers: %w", err)
+	}
+
+	export := &GroupExport{
+		GroupId:   group.GroupId,
+		GroupName: group.GroupName,
+		archive: domain.Archive{
+			FormatVersion: domain.FormatVersion,
+			ExportedAt:    time.Now().UTC(),
+			Group:         domain.Group{Name: group.GroupName, Description: group.GroupDesc, Type: group.Type},
+			Members:       []domain.Member{},
+			Labels:        []domain.Label{},
+			Containers:    []domain.Container{},
+		},
+		containers: containers,
+		members:    map[string]bool{},
+		taskRepo:   h.taskRepo,
+	}
+	for _, user := range users {
+		export.archive.Members = append(export.archive.Members, domain.Member{
+			UserId:    user.UserId,
+			UserName:  user.UserName,
+			FirstName: user.FirstName,
+			LastName:  user.LastName,
+			Email:     user.Email,
+			Role:      user.Role,
+		})
+		export.members[user.UserId] = true
+	}
+	for _, label := range labels {
+		export.archive.Labels = append(export.archive.Labels, domain.Label{Name: label.Name, Color: label.Color})
+	}
+	return export, nil
+}
+
+// WriteTo writes the archive as JSON. Assignees who have left the group are not exported with their tasks.
+// An error after the first write leaves w with an incomplete archive, which imports reject.
+func (e *GroupExport) WriteTo(w io.Writer) (int64, error) {
+	counter := &countingWriter{w: w}
+	header, err := json.Marshal(e.archive)
+	if err != nil {
+		return 0, err
+	}
+	// Containers are the last field of the archive, marshalled empty: everything up to their closing bracket
+	// is written first, then each container as it is read
+	header = bytes.TrimSuffix(header, []byte("]}"))
+	if _, err := counter.Write(header); err != nil {
+		return counter.n, err
+	}
+
+	for i, container := range e.containers {
+		archived, err := e.exportContainer(container)
+		if err != nil {
+			return counter.n, err
+		}
+		content, err := json.Marshal(archived)
+		if err != nil {
+			return counter.n, err
+		}
+		if i > 0 {
+			content = append([]byte(","), content...)
+		}
+		if _, err := counter.Write(content); err != nil {
+			return counter.n, err
+		}
+	}
+	_, err = counter.Write([]byte("]}\n"))
+	return counter.n, err
+}
+
+func (e *GroupExport) exportContainer(container containerDomain.TaskContainer) (domain.Container, error) {
+	tasks, err := e.taskRepo.GetTasksByContainerId(container.Id)
+	if err != nil {
+		return domain.Container{}, fmt.Errorf("failed to retrieve container tasks: %w", err)
+	}
+
+	archived := domain.Container{
+		Name:        container.Name,
+		Description: container.Description,
+		IsActive:    container.IsActive,
+		Tasks:       []domain.Task{},
+	}
+	for _, task := range tasks {
+		task.Checklist, err = e.taskRepo.GetChecklistItems(task.TaskId)
+		if err != nil {
+			return domain.Container{}, fmt.Errorf("failed to retrieve task checklist: %w", err)
+		}
+		if !e.members[task.AssigneeId] {
+			task.AssigneeId = ""
+		}
+		archived.Tasks = append(archived.Tasks, domain.ExportTask(task))
+	}
+	return archived, nil
+}
+
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	return n, err
+}
@@ -0,0 +1,99 @@
+package query
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/happYness-Project/taskManagementGolang/internal/archive/domain"
+	labelDomain "github.com/happYness-Project/taskManagementGolang/internal/label/domain"
+	"github.com/happYness-Project/taskManagementGolang/internal/mocks"
+	taskDomain "github.com/happYness-Project/taskManagementGolang/internal/task/domain"
+	"github.com/happYness-Project/taskManagementGolang/internal/task/repository"
+	containerDomain "github.com/happYness-Project/taskManagementGolang/internal/taskcontainer/domain"
+	userDomain "github.com/happYness-Project/taskManagementGolang/internal/user/domain"
+	groupDomain "github.com/happYness-Project/taskManagementGolang/internal/usergroup/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubExportTaskRepo holds the tasks and checklists of containers
+type stubExportTaskRepo struct {
+	repository.TaskRepository
+	tasks      map[string][]taskDomain.Task
+	checklists map[string][]taskDomain.ChecklistItem
+}
+
+func (s *stubExportTaskRepo) GetTasksByContainerId(containerId string) ([]taskDomain.Task, error) {
+	return s.tasks[containerId], nil
+}
+
+func (s *stubExportTaskRepo) GetChecklistItems(taskId string) ([]taskDomain.ChecklistItem, error) {
+	return s.checklists[taskId], nil
+}
+
+func TestExportQueryHandler_HandleExportGroup(t *testing.T) {
+	const groupId = 4
+
+	t.Run("when the group exists, Then the archive holds its members, labels, containers and tasks", func(t *testing.T) {
+		// Arrange
+		groupRepo := new(mocks.MockUserGroupRepo)
+		groupRepo.On("GetById", groupId).Return(&groupDomain.UserGroup{GroupId: groupId, GroupName: "Family", Type: "home"}, nil)
+		userRepo := new(mocks.MockUserRepo)
+		userRepo.On("GetUsersByGroupIdWithRoles", groupId).Return([]*userDomain.UserWithRole{
+			{User: &userDomain.User{UserId: "alice", UserName: "alice", Email: "alice@example.com"}, Role: "admin"},
+		}, nil)
+		labelRepo := new(mocks.MockLabelRepo)
+		labelRepo.On("GetByGroupId", groupId).Return([]labelDomain.Label{{Id: "l1", Name: "grocery", Color: "#00ff00"}}, nil)
+		containerRepo := new(mocks.MockContainerRepo)
+		containerRepo.On("GetContainersByGroupId", groupId).Return([]containerDomain.TaskContainer{
+			{Id: "c1", Name: "Shopping", IsActive: true},
+			{Id: "c2", Name: "Chores"},
+		}, nil)
+		taskRepo := &stubExportTaskRepo{
+			tasks: map[string][]taskDomain.Task{
+				"c1": {
+					{TaskId: "t1", TaskName: "Milk", Priority: taskDomain.PriorityHigh, AssigneeId: "alice", Labels: []taskDomain.Label{{Id: "l1", Name: "grocery"}}},
+					{TaskId: "t2", TaskName: "Bread", AssigneeId: "former-member"},
+				},
+			},
+			checklists: map[string][]taskDomain.ChecklistItem{"t1": {{Title: "Whole", IsChecked: true}}},
+		}
+		handler := NewExportQueryHandler(groupRepo, userRepo, labelRepo, containerRepo, taskRepo)
+
+		// Act
+		export, err := handler.HandleExportGroup(ExportGroupQuery{GroupId: groupId})
+		require.NoError(t, err)
+		var out bytes.Buffer
+		n, err := export.WriteTo(&out)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, int64(out.Len()), n)
+		var archive domain.Archive
+		require.NoError(t, json.Unmarshal(out.Bytes(), &archive))
+		require.NoError(t, archive.Validate())
+		assert.Equal(t, domain.Group{Name: "Family", Type: "home"}, archive.Group)
+		assert.Equal(t, []domain.Member{{UserId: "alice", UserName: "alice", Email: "alice@example.com", Role: "admin"}}, archive.Members)
+		assert.Equal(t, []domain.Label{{Name: "grocery", Color: "#00ff00"}}, archive.Labels)
+		require.Len(t, archive.Containers, 2)
+		assert.Empty(t, archive.Containers[1].Tasks)
+		tasks := archive.Containers[0].Tasks
+		require.Len(t, tasks, 2)
+		assert.Equal(t, "alice", tasks[0].AssigneeId)
+		assert.Equal(t, []string{"grocery"}, tasks[0].Labels)
+		assert.Equal(t, []domain.ChecklistItem{{Title: "Whole", IsChecked: true}}, tasks[0].Checklist)
+		assert.Empty(t, tasks[1].AssigneeId, "assignees who left the group are not exported")
+	})
+
+	t.Run("when the group does not exist, Then ErrGroupNotFound is returned", func(t *testing.T) {
+		groupRepo := new(mocks.MockUserGroupRepo)
+		groupRepo.On("GetById", groupId).Return(&groupDomain.UserGroup{}, nil)
+		handler := NewExportQueryHandler(groupRepo, nil, nil, nil, nil)
+
+		_, err := handler.HandleExportGroup(ExportGroupQuery{GroupId: groupId})
+
+		assert.True(t, errors.Is(err, ErrGroupNotFound))
+	})
+}
@@ -0,0 +1,62 @@
+package application
+
+import (
+	"context"
+	"fmt"
+
+	qry "github.com/happYness-Project/taskManagementGolang/internal/archive/application/query"
+	"github.com/happYness-Project/taskManagementGolang/internal/authorization"
+	labelRepo "github.com/happYness-Project/taskManagementGolang/internal/label/repository"
+	taskRepo "github.com/happYness-Project/taskManagementGolang/internal/task/repository"
+	containerRepo "github.com/happYness-Project/taskManagementGolang/internal/taskcontainer/repository"
+	userRepo "github.com/happYness-Project/taskManagementGolang/internal/user/repository"
+	groupRepo "github.com/happYness-Project/taskManagementGolang/internal/usergroup/repository"
+)
+
+// QueryBus routes queries to their handlers
+type QueryBus struct {
+	exportHandler *qry.ExportQueryHandler
+
+	policy *authorization.Policy
+}
+
+// NewQueryBus creates a new query bus with all handlers registered
+func NewQueryBus(
+	groupRepo groupRepo.UserGroupRepository,
+	userRepo userRepo.UserRepository,
+	labelRepo labelRepo.LabelRepository,
+	containerRepo containerRepo.ContainerRepository,
+	taskRepo taskRepo.TaskRepository,
+	policy *authorization.Policy,
+) *QueryBus {
+	return &QueryBus{
+		exportHandler: qry.NewExportQueryHandler(groupRepo, userRepo, labelRepo, containerRepo, taskRepo),
+		policy:        policy,
+	}
+}
+
+// Execute dispatches the query to the appropriate handler; queries of operators are not authorized
+func (bus *QueryBus) Execute(ctx context.Context, query interface{}) (interface{}, error) {
+	if !authorization.IsOperator(ctx) {
+		if err := bus.authorize(query); err != nil {
+			return nil, err
+		}
+	}
+
+	switch q := query.(type) {
+	case qry.ExportGroupQuery:
+		return bus.exportHandler.HandleExportGroup(q)
+	default:
+		return nil, fmt.Errorf("unknown query type: %T", query)
+	}
+}
+
+// authorize checks that only admins export a group, as the archive holds the members' emails
+func (bus *QueryBus) authorize(query interface{}) error {
+	switch q := query.(type) {
+	case qry.ExportGroupQuery:
+		return bus.policy.RequireAdmin(q.RequesterId, q.GroupId)
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,197 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	taskDomain "github.com/happYness-Project/taskManagementGolang/internal/task/domain"
+	groupDomain "github.com/happYness-Project/taskManagementGolang/internal/usergroup/domain"
+)
+
+// FormatVersion is the version of the archives written by exports. Imports read every version up to it.
+const FormatVersion = 1
+
+var (
+	ErrInvalidArchive    = errors.New("invalid group archive")
+	ErrMemberConflict    = errors.New("archive member is already a user")
+	ErrInvalidOnConflict = errors.New(`on_conflict must be "link", "skip" or "fail"`)
+)
+
+// Archive is a user group exported with its members, labels, containers and tasks. Ids are those of the
+// exporting deployment; an import recreates everything under new ids. Containers and tasks in the trash are left out.
+//
+// Tasks refer to their assignee by the member's UserId and to their labels by name.
+type Archive struct {
+	FormatVersion int         `json:"format_version"`
+	ExportedAt    time.Time   `json:"exported_at"`
+	Group         Group       `json:"group"`
+	Members       []Member    `json:"members"`
+	Labels        []Label     `json:"labels"`
+	Containers    []Container `json:"containers"`
+}
+
+type Group struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Type        string `json:"type"`
+}
+
+// Member is a user of the group; an import matches it with an existing user by email
+type Member struct {
+	UserId    string `json:"user_id"`
+	UserName  string `json:"username"`
+	FirstName string `json:"first_name"`
+	LastName  string `json:"last_name"`
+	Email     string `json:"email"`
+	Role      string `json:"role"`
+}
+
+type Label struct {
+	Name  string `json:"name"`
+	Color string `json:"color"`
+}
+
+type Container struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	IsActive    bool   `json:"is_active"`
+	Tasks       []Task `json:"tasks"`
+}
+
+type Task struct {
+	Name           string          `json:"name"`
+	Description    string          `json:"description"`
+	TargetDate     *time.Time      `json:"target_date,omitempty"`
+	Priority       string          `json:"priority"`
+	Labels         []string        `json:"labels,omitempty"`
+	IsCompleted    bool            `json:"is_completed"`
+	IsImportant    bool            `json:"is_important"`
+	RecurrenceRule string          `json:"recurrence_rule,omitempty"`
+	AssigneeId     string          `json:"assignee_id,omitempty"` // UserId of a member
+	Checklist      []ChecklistItem `json:"checklist,omitempty"`
+	CreatedAt      time.Time       `json:"created_at"`
+}
+
+type ChecklistItem struct {
+	Title     string `json:"title"`
+	IsChecked bool   `json:"is_checked"`
+}
+
+// ConflictPolicy decides what an import does with a member whose email already belongs to a user
+type ConflictPolicy string
+
+const (
+	ConflictLink ConflictPolicy = "link" // the existing user becomes the member
+	ConflictSkip ConflictPolicy = "skip" // the member is left out and their tasks are unassigned
+	ConflictFail ConflictPolicy = "fail" // the import is refused
+)
+
+// NewConflictPolicy reads a conflict policy; an empty value links existing users
+func NewConflictPolicy(value string) (ConflictPolicy, error) {
+	switch policy := ConflictPolicy(strings.ToLower(strings.TrimSpace(value))); policy {
+	case "":
+		return ConflictLink, nil
+	case ConflictLink, ConflictSkip, ConflictFail:
+		return policy, nil
+	default:
+		return "", fmt.Errorf("%w, got %q", ErrInvalidOnConflict, value)
+	}
+}
+
+// Validate checks that the archive can be imported: a known format version, members with distinct ids, an email
+// and a valid role, distinct label names, and assignees that are members
+func (a *Archive) Validate() error {
+	if a.FormatVersion < 1 || a.FormatVersion > FormatVersion {
+		return fmt.Errorf("%w: format version %d is not supported, expected 1 to %d", ErrInvalidArchive, a.FormatVersion, FormatVersion)
+	}
+	if strings.TrimSpace(a.Group.Name) == "" {
+		return fmt.Errorf("%w: the group has no name", ErrInvalidArchive)
+	}
+
+	members := map[string]bool{}
+	for _, member := range a.Members {
+		if member.UserId == "" || members[member.UserId] {
+			return fmt.Errorf("%w: every member needs a distinct user_id", ErrInvalidArchive)
+		}
+		if strings.TrimSpace(member.Email) == "" {
+			return fmt.Errorf("%w: member %s has no email", ErrInvalidArchive, member.UserId)
+		}
+		if _, err := groupDomain.NewRole(member.Role); err != nil {
+			return fmt.Errorf("%w: member %s: %v", ErrInvalidArchive, member.UserId, err)
+		}
+		members[member.UserId] = true
+	}
+
+	labels := map[string]bool{}
+	for _, label := range a.Labels {
+		name := strings.ToLower(strings.TrimSpace(label.Name))
+		if labels[name] {
+			return fmt.Errorf("%w: label %q is listed twice", ErrInvalidArchive, label.Name)
+		}
+		labels[name] = true
+	}
+
+	for _, container := range a.Containers {
+		for _, task := range container.Tasks {
+			if task.AssigneeId != "" && !members[task.AssigneeId] {
+				return fmt.Errorf("%w: task %q is assigned to %s, who is not a member", ErrInvalidArchive, task.Name, task.AssigneeId)
+			}
+		}
+	}
+	return nil
+}
+
+// ExportTask returns the task as it is archived, with its checklist
+func ExportTask(task taskDomain.Task) Task {
+	archived := Task{
+		Name:           task.TaskName,
+		Description:    task.TaskDesc,
+		Priority:       task.Priority.String(),
+		Labels:         task.LabelNames(),
+		IsCompleted:    task.IsCompleted,
+		IsImportant:    task.IsImportant,
+		RecurrenceRule: task.RecurrenceRule,
+		AssigneeId:     task.AssigneeId,
+		CreatedAt:      task.CreatedAt,
+	}
+	if !task.TargetDate.IsZero() {
+		targetDate := task.TargetDate
+		archived.TargetDate = &targetDate
+	}
+	for _, item := range task.Checklist {
+		archived.Checklist = append(archived.Checklist, ChecklistItem{Title: item.Title, IsChecked: item.IsChecked})
+	}
+	return archived
+}
+
+// Restore creates the archived task under a new id, raising TaskCreated. It is assigned to assigneeId, and its
+// labels are the group labels of the archived names.
+func (t Task) Restore(assigneeId string, groupLabels []taskDomain.Label) (*taskDomain.Task, error) {
+	var targetDate time.Time
+	if t.TargetDate != nil {
+		targetDate = *t.TargetDate
+	}
+	task, err := taskDomain.CreateTask(t.Name, t.Description, targetDate, t.Priority)
+	if err != nil {
+		return nil, fmt.Errorf("%w: task %q: %v", ErrInvalidArchive, t.Name, err)
+	}
+	if err := task.SetRecurrence(t.RecurrenceRule); err != nil {
+		return nil, fmt.Errorf("%w: task %q: %v", ErrInvalidArchive, t.Name, err)
+	}
+	for _, archived := range t.Checklist {
+		if _, err := task.AddChecklistItem(archived.Title); err != nil {
+			return nil, fmt.Errorf("%w: task %q: %v", ErrInvalidArchive, t.Name, err)
+		}
+		task.Checklist[len(task.Checklist)-1].IsChecked = archived.IsChecked
+	}
+	if !t.CreatedAt.IsZero() {
+		task.CreatedAt = t.CreatedAt
+	}
+	task.IsCompleted = t.IsCompleted
+	task.IsImportant = t.IsImportant
+	task.AssigneeId = assigneeId
+	task.Labels = taskDomain.LabelsNamed(t.Labels, groupLabels)
+	return task, nil
+}
@@ -0,0 +1,111 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	taskDomain "github.com/happYness-Project/taskManagementGolang/internal/task/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewConflictPolicy(t *testing.T) {
+	t.Run("when the value is empty, Then existing users are linked", func(t *testing.T) {
+		policy, err := NewConflictPolicy("")
+
+		require.NoError(t, err)
+		assert.Equal(t, ConflictLink, policy)
+	})
+
+	t.Run("when the value is known, Then it is normalized", func(t *testing.T) {
+		policy, err := NewConflictPolicy(" Skip ")
+
+		require.NoError(t, err)
+		assert.Equal(t, ConflictSkip, policy)
+	})
+
+	t.Run("when the value is unknown, Then ErrInvalidOnConflict is returned", func(t *testing.T) {
+		_, err := NewConflictPolicy("merge")
+
+		assert.True(t, errors.Is(err, ErrInvalidOnConflict))
+	})
+}
+
+func TestArchiveValidate(t *testing.T) {
+	newArchive := func() *Archive {
+		return &Archive{
+			FormatVersion: FormatVersion,
+			Group:         Group{Name: "Family"},
+			Members:       []Member{{UserId: "alice", Email: "alice@example.com", Role: "admin"}},
+			Labels:        []Label{{Name: "grocery"}},
+			Containers:    []Container{{Name: "Shopping", Tasks: []Task{{Name: "Milk", AssigneeId: "alice"}}}},
+		}
+	}
+
+	t.Run("when the archive is consistent, Then it is valid", func(t *testing.T) {
+		assert.NoError(t, newArchive().Validate())
+	})
+
+	cases := []struct {
+		name   string
+		modify func(a *Archive)
+	}{
+		{"format version is newer", func(a *Archive) { a.FormatVersion = FormatVersion + 1 }},
+		{"format version is missing", func(a *Archive) { a.FormatVersion = 0 }},
+		{"group has no name", func(a *Archive) { a.Group.Name = " " }},
+		{"member is listed twice", func(a *Archive) { a.Members = append(a.Members, a.Members[0]) }},
+		{"member has no email", func(a *Archive) { a.Members[0].Email = "" }},
+		{"member role is unknown", func(a *Archive) { a.Members[0].Role = "owner" }},
+		{"label names differ only by case", func(a *Archive) { a.Labels = append(a.Labels, Label{Name: "Grocery"}) }},
+		{"assignee is not a member", func(a *Archive) { a.Containers[0].Tasks[0].AssigneeId = "bob" }},
+	}
+	for _, c := range cases {
+		t.Run("when "+c.name+", Then ErrInvalidArchive is returned", func(t *testing.T) {
+			archive := newArchive()
+			c.modify(archive)
+
+			assert.True(t, errors.Is(archive.Validate(), ErrInvalidArchive))
+		})
+	}
+}
+
+func TestTaskRestore(t *testing.T) {
+	t.Run("when an exported task is restored, Then it is recreated under a new id with its content", func(t *testing.T) {
+		// Arrange
+		targetDate := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+		task, err := taskDomain.CreateTask("Milk", "2 litres", targetDate, "high")
+		require.NoError(t, err)
+		task.IsImportant = true
+		task.AssigneeId = "alice"
+		task.Labels = []taskDomain.Label{{Id: "old-grocery", Name: "grocery"}}
+		_, err = task.AddChecklistItem("Whole")
+		require.NoError(t, err)
+		task.Checklist[0].IsChecked = true
+		groupLabels := []taskDomain.Label{{Id: "new-grocery", Name: "Grocery"}}
+
+		// Act
+		restored, err := ExportTask(*task).Restore("alice-here", groupLabels)
+
+		// Assert
+		require.NoError(t, err)
+		assert.NotEqual(t, task.TaskId, restored.TaskId)
+		assert.Equal(t, "Milk", restored.TaskName)
+		assert.Equal(t, "2 litres", restored.TaskDesc)
+		assert.True(t, restored.TargetDate.Equal(targetDate))
+		assert.Equal(t, "high", restored.Priority.String())
+		assert.True(t, restored.IsImportant)
+		assert.Equal(t, "alice-here", restored.AssigneeId)
+		assert.Equal(t, groupLabels, restored.Labels)
+		require.Len(t, restored.Checklist, 1)
+		assert.Equal(t, "Whole", restored.Checklist[0].Title)
+		assert.True(t, restored.Checklist[0].IsChecked)
+		assert.Equal(t, restored.TaskId, restored.Checklist[0].TaskId)
+	})
+
+	t.Run("when the archived priority is unknown, Then ErrInvalidArchive is returned", func(t *testing.T) {
+		_, err := Task{Name: "Milk", Priority: "critical"}.Restore("", nil)
+
+		assert.True(t, errors.Is(err, ErrInvalidArchive))
+	})
+}
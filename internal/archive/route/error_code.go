@@ -0,0 +1,12 @@
+package route
+
+const prefix = "archives_"
+
+const (
+	ArchiveExportNotFound    = prefix + "export_not_found"
+	ArchiveExportServerError = prefix + "export_server_error"
+
+	ArchiveImportInvalidInput = prefix + "import_invalid_input"
+	ArchiveImportConflict     = prefix + "import_member_conflict"
+	ArchiveImportServerError  = prefix + "import_server_error"
+)
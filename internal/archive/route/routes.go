@@ -0,0 +1,138 @@
+package route
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/happYness-Project/taskManagementGolang/internal/archive/application"
+	"github.com/happYness-Project/taskManagementGolang/internal/archive/application/command"
+	"github.com/happYness-Project/taskManagementGolang/internal/archive/application/query"
+	"github.com/happYness-Project/taskManagementGolang/internal/archive/domain"
+	auditApp "github.com/happYness-Project/taskManagementGolang/internal/audit/application"
+	"github.com/happYness-Project/taskManagementGolang/internal/authorization"
+	labelRepo "github.com/happYness-Project/taskManagementGolang/internal/label/repository"
+	outboxRepo "github.com/happYness-Project/taskManagementGolang/internal/outbox/repository"
+	taskRepo "github.com/happYness-Project/taskManagementGolang/internal/task/repository"
+	containerRepo "github.com/happYness-Project/taskManagementGolang/internal/taskcontainer/repository"
+	userRepo "github.com/happYness-Project/taskManagementGolang/internal/user/repository"
+	groupRepo "github.com/happYness-Project/taskManagementGolang/internal/usergroup/repository"
+	"github.com/happYness-Project/taskManagementGolang/pkg/constants"
+	"github.com/happYness-Project/taskManagementGolang/pkg/dbs"
+	"github.com/happYness-Project/taskManagementGolang/pkg/loggers"
+	"github.com/happYness-Project/taskManagementGolang/pkg/response"
+)
+
+// maxArchiveSize bounds the body of an import
+const maxArchiveSize = 32 << 20
+
+type Handler struct {
+	logger     *loggers.AppLogger
+	commandBus *application.CommandBus
+	queryBus   *application.QueryBus
+}
+
+func NewHandler(
+	logger *loggers.AppLogger,
+	groupRepo groupRepo.UserGroupRepository,
+	userRepo userRepo.UserRepository,
+	labelRepo labelRepo.LabelRepository,
+	containerRepo containerRepo.ContainerRepository,
+	taskRepo taskRepo.TaskRepository,
+	policy *authorization.Policy,
+	uow dbs.UnitOfWork,
+	recorder *auditApp.Recorder,
+	outbox outboxRepo.OutboxRepository,
+) *Handler {
+	return &Handler{
+		logger:     logger,
+		commandBus: application.NewCommandBus(groupRepo, userRepo, labelRepo, containerRepo, taskRepo, uow, recorder, outbox),
+		queryBus:   application.NewQueryBus(groupRepo, userRepo, labelRepo, containerRepo, taskRepo, policy),
+	}
+}
+
+func (h *Handler) RegisterRoutes(router chi.Router) {
+	router.Get("/api/user-groups/{groupID}/export", h.handleExportGroup)
+	router.Post("/api/user-groups/import", h.handleImportGroup)
+}
+
+func (h *Handler) handleExportGroup(w http.ResponseWriter, r *http.Request) {
+	groupId, err := strconv.Atoi(chi.URLParam(r, "groupID"))
+	if err != nil {
+		h.logger.Error().Err(err).Msg("invalid Group ID")
+		response.ErrorResponse(w, http.StatusBadRequest, *(response.New(constants.InvalidParameter, "Invalid Group ID")))
+		return
+	}
+
+	// Use Query Bus
+	result, err := h.queryBus.Execute(r.Context(), query.ExportGroupQuery{GroupId: groupId, RequesterId: authorization.RequesterId(r)})
+	if authorization.IsForbiddenError(err) {
+		h.logger.Error().Err(err).Str("ErrorCode", constants.PermissionDenied).Msg(err.Error())
+		response.Forbidden(w, constants.PermissionDenied, err.Error())
+		return
+	}
+	if errors.Is(err, query.ErrGroupNotFound) {
+		h.logger.Error().Err(err).Str("ErrorCode", ArchiveExportNotFound).Msg(err.Error())
+		response.NotFound(w, ArchiveExportNotFound, err.Error())
+		return
+	}
+	if err != nil {
+		h.logger.Error().Err(err).Str("ErrorCode", ArchiveExportServerError).Msg("Error occurred during ExportGroup")
+		response.ErrorResponse(w, http.StatusInternalServerError, *(response.New(ArchiveExportServerError, "Failed to export group", err.Error())))
+		return
+	}
+
+	export := result.(*query.GroupExport)
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="group-%d.json"`, export.GroupId))
+	w.WriteHeader(http.StatusOK)
+	// The status is sent by now; a failure can only cut the archive short
+	if _, err := export.WriteTo(w); err != nil {
+		h.logger.Error().Err(err).Str("ErrorCode", ArchiveExportServerError).Msg("Error occurred while writing the archive")
+	}
+}
+
+// handleImportGroup recreates the group of an archive, with the requester as admin.
+// on_conflict decides what happens to members whose email already belongs to a user: link (default), skip or fail.
+func (h *Handler) handleImportGroup(w http.ResponseWriter, r *http.Request) {
+	onConflict, err := domain.NewConflictPolicy(r.URL.Query().Get("on_conflict"))
+	if err != nil {
+		h.logger.Error().Err(err).Str("ErrorCode", ArchiveImportInvalidInput).Msg(err.Error())
+		response.ErrorResponse(w, http.StatusBadRequest, *response.New(ArchiveImportInvalidInput, "Invalid parameter", err.Error()).
+			WithErrors(response.FieldError{Field: "on_conflict", Message: err.Error()}))
+		return
+	}
+
+	var archive domain.Archive
+	r.Body = http.MaxBytesReader(w, r.Body, maxArchiveSize)
+	if err := response.ParseJson(r, &archive); err != nil {
+		h.logger.Error().Err(err).Str("ErrorCode", ArchiveImportInvalidInput).Msg(err.Error())
+		response.InvalidJsonBody(w, err.Error())
+		return
+	}
+
+	// Use Command Bus
+	result, err := h.commandBus.Execute(r.Context(), command.ImportGroupCommand{
+		Archive:     archive,
+		OnConflict:  onConflict,
+		RequesterId: authorization.RequesterId(r),
+	})
+	if errors.Is(err, domain.ErrInvalidArchive) {
+		h.logger.Error().Err(err).Str("ErrorCode", ArchiveImportInvalidInput).Msg(err.Error())
+		response.ErrorResponse(w, http.StatusUnprocessableEntity, *(response.New(ArchiveImportInvalidInput, "Invalid archive", err.Error())))
+		return
+	}
+	if errors.Is(err, domain.ErrMemberConflict) {
+		h.logger.Error().Err(err).Str("ErrorCode", ArchiveImportConflict).Msg(err.Error())
+		response.ErrorResponse(w, http.StatusConflict, *(response.New(ArchiveImportConflict, "Member conflict", err.Error())))
+		return
+	}
+	if err != nil {
+		h.logger.Error().Err(err).Str("ErrorCode", ArchiveImportServerError).Msg("Error occurred during ImportGroup")
+		response.ErrorResponse(w, http.StatusInternalServerError, *(response.New(ArchiveImportServerError, "Failed to import group", err.Error())))
+		return
+	}
+	response.WriteJsonWithEncode(w, http.StatusCreated, result)
+}
@@ -0,0 +1,97 @@
+package query
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/happYness-Project/taskManagementGolang/internal/audit/domain"
+	"github.com/happYness-Project/taskManagementGolang/internal/audit/repository"
+)
+
+const (
+	DefaultActivityPageLimit = 50
+	MaxActivityPageLimit     = 200
+)
+
+var ErrInvalidActivityParams = errors.New("invalid activity parameters")
+
+// GetGroupActivityQuery reads a group's activity log, newest first
+type GetGroupActivityQuery struct {
+	GroupId       int
+	ActorId       string
+	AggregateType string
+	From          *time.Time
+	To            *time.Time
+	Cursor        string
+	Limit         int
+	RequesterId   string // UUID from JWT
+}
+
+// ActivityPage is one page of the activity log. NextCursor is empty on the last page.
+type ActivityPage struct {
+	Activities []domain.Activity `json:"activities"`
+	NextCursor string            `json:"next_cursor,omitempty"`
+}
+
+// ActivityQueryHandler handles all read operations for the activity log
+type ActivityQueryHandler struct {
+	activityRepo repository.ActivityRepository
+}
+
+func NewActivityQueryHandler(activityRepo repository.ActivityRepository) *ActivityQueryHandler {
+	return &ActivityQueryHandler{activityRepo: activityRepo}
+}
+
+func invalidParams(format string, args ...interface{}) error {
+	return fmt.Errorf("%w: %s", ErrInvalidActivityParams, fmt.Sprintf(format, args...))
+}
+
+// HandleGetGroupActivity retrieves a page of a group's activity, fetching one look-ahead row to detect the next page
+func (h *ActivityQueryHandler) HandleGetGroupActivity(query GetGroupActivityQuery) (*ActivityPage, error) {
+	filter := repository.ActivityFilter{
+		GroupId:       query.GroupId,
+		ActorId:       query.ActorId,
+		AggregateType: query.AggregateType,
+		From:          query.From,
+		To:            query.To,
+		Limit:         DefaultActivityPageLimit,
+	}
+	if filter.AggregateType != "" && !domain.IsValidAggregateType(filter.AggregateType) {
+		return nil, invalidParams("unsupported type '%s'", filter.AggregateType)
+	}
+	if filter.From != nil && filter.To != nil && filter.To.Before(*filter.From) {
+		return nil, invalidParams("time range end is before its start")
+	}
+	if query.Limit < 0 || query.Limit > MaxActivityPageLimit {
+		return nil, invalidParams("limit must be between 1 and %d", MaxActivityPageLimit)
+	}
+	if query.Limit > 0 {
+		filter.Limit = query.Limit
+	}
+	if query.Cursor != "" {
+		beforeId, err := strconv.ParseInt(query.Cursor, 10, 64)
+		if err != nil || beforeId < 1 {
+			return nil, invalidParams("malformed cursor")
+		}
+		filter.BeforeId = beforeId
+	}
+
+	limit := filter.Limit
+	filter.Limit = limit + 1
+	activities, err := h.activityRepo.ListByGroupId(filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve activity: %w", err)
+	}
+	if activities == nil {
+		activities = []domain.Activity{}
+	}
+
+	page := &ActivityPage{Activities: activities}
+	if len(activities) > limit {
+		page.Activities = activities[:limit]
+		page.NextCursor = strconv.FormatInt(page.Activities[limit-1].Id, 10)
+	}
+	return page, nil
+}
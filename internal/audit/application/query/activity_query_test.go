@@ -0,0 +1,91 @@
+package query
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/happYness-Project/taskManagementGolang/internal/audit/domain"
+	"github.com/happYness-Project/taskManagementGolang/internal/audit/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubActivityRepo returns activities with ids below the filter's BeforeId, newest first
+type stubActivityRepo struct {
+	repository.ActivityRepository
+	activities []domain.Activity
+	lastFilter repository.ActivityFilter
+}
+
+func (s *stubActivityRepo) ListByGroupId(filter repository.ActivityFilter) ([]domain.Activity, error) {
+	s.lastFilter = filter
+	var result []domain.Activity
+	for _, a := range s.activities {
+		if filter.BeforeId > 0 && a.Id >= filter.BeforeId {
+			continue
+		}
+		if len(result) == filter.Limit {
+			break
+		}
+		result = append(result, a)
+	}
+	return result, nil
+}
+
+func TestHandleGetGroupActivity(t *testing.T) {
+	repo := &stubActivityRepo{}
+	for id := int64(5); id >= 1; id-- {
+		repo.activities = append(repo.activities, domain.Activity{Id: id, GroupId: 1})
+	}
+	handler := NewActivityQueryHandler(repo)
+
+	t.Run("when more activity exists than the limit, Then next cursor continues from the last id", func(t *testing.T) {
+		// Act
+		first, err := handler.HandleGetGroupActivity(GetGroupActivityQuery{GroupId: 1, Limit: 2})
+		require.NoError(t, err)
+		second, err := handler.HandleGetGroupActivity(GetGroupActivityQuery{GroupId: 1, Limit: 2, Cursor: first.NextCursor})
+		require.NoError(t, err)
+		last, err := handler.HandleGetGroupActivity(GetGroupActivityQuery{GroupId: 1, Limit: 2, Cursor: second.NextCursor})
+		require.NoError(t, err)
+
+		// Assert
+		assert.Equal(t, "4", first.NextCursor)
+		assert.Equal(t, []int64{5, 4}, ids(first.Activities))
+		assert.Equal(t, []int64{3, 2}, ids(second.Activities))
+		assert.Equal(t, []int64{1}, ids(last.Activities))
+		assert.Empty(t, last.NextCursor)
+	})
+
+	t.Run("when no limit is given, Then default limit is used", func(t *testing.T) {
+		_, err := handler.HandleGetGroupActivity(GetGroupActivityQuery{GroupId: 1})
+
+		require.NoError(t, err)
+		assert.Equal(t, DefaultActivityPageLimit+1, repo.lastFilter.Limit)
+	})
+
+	from := time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)
+	to := from.Add(-time.Hour)
+	invalid := map[string]GetGroupActivityQuery{
+		"unknown type":     {GroupId: 1, AggregateType: "planet"},
+		"reversed range":   {GroupId: 1, From: &from, To: &to},
+		"limit too large":  {GroupId: 1, Limit: MaxActivityPageLimit + 1},
+		"malformed cursor": {GroupId: 1, Cursor: "abc"},
+		"negative cursor":  {GroupId: 1, Cursor: "-3"},
+	}
+	for name, q := range invalid {
+		t.Run("when "+name+", Then invalid params error is returned", func(t *testing.T) {
+			_, err := handler.HandleGetGroupActivity(q)
+
+			assert.True(t, errors.Is(err, ErrInvalidActivityParams))
+		})
+	}
+}
+
+func ids(activities []domain.Activity) []int64 {
+	result := make([]int64, 0, len(activities))
+	for _, a := range activities {
+		result = append(result, a.Id)
+	}
+	return result
+}
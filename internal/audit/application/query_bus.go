@@ -0,0 +1,48 @@
+package application
+
+import (
+	"fmt"
+
+	qry "github.com/happYness-Project/taskManagementGolang/internal/audit/application/query"
+	"github.com/happYness-Project/taskManagementGolang/internal/audit/repository"
+	"github.com/happYness-Project/taskManagementGolang/internal/authorization"
+)
+
+// QueryBus routes queries to their handlers
+type QueryBus struct {
+	queryHandler *qry.ActivityQueryHandler
+
+	policy *authorization.Policy
+}
+
+// NewQueryBus creates a new query bus with all handlers registered
+func NewQueryBus(activityRepo repository.ActivityRepository, policy *authorization.Policy) *QueryBus {
+	return &QueryBus{
+		queryHandler: qry.NewActivityQueryHandler(activityRepo),
+		policy:       policy,
+	}
+}
+
+// Execute dispatches the query to the appropriate handler
+func (bus *QueryBus) Execute(query interface{}) (interface{}, error) {
+	if err := bus.authorize(query); err != nil {
+		return nil, err
+	}
+
+	switch q := query.(type) {
+	case qry.GetGroupActivityQuery:
+		return bus.queryHandler.HandleGetGroupActivity(q)
+	default:
+		return nil, fmt.Errorf("unknown query type: %T", query)
+	}
+}
+
+// authorize checks that the requester is a member of the group whose activity is read
+func (bus *QueryBus) authorize(query interface{}) error {
+	switch q := query.(type) {
+	case qry.GetGroupActivityQuery:
+		return bus.policy.RequireMember(q.RequesterId, q.GroupId)
+	default:
+		return nil
+	}
+}
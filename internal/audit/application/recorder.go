@@ -0,0 +1,138 @@
+package application
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/happYness-Project/taskManagementGolang/internal/audit/domain"
+	"github.com/happYness-Project/taskManagementGolang/internal/audit/repository"
+	"github.com/happYness-Project/taskManagementGolang/pkg/dbs"
+	"github.com/happYness-Project/taskManagementGolang/pkg/loggers"
+	"github.com/happYness-Project/taskManagementGolang/pkg/utils"
+)
+
+// Subject describes the aggregate a command mutates, as seen by the activity log
+type Subject struct {
+	ActorId       string // UUID from JWT
+	Action        string
+	AggregateType string
+	AggregateId   string
+	GroupId       int // user group the activity belongs to, 0 when it has none
+
+	// Load reads the current state of the aggregate. A nil state means the aggregate does not exist.
+	Load func(aggregateId string) (interface{}, error)
+	// Resolve identifies the aggregate from the command result, for commands that create it
+	Resolve func(result interface{}) (aggregateId string, groupId int)
+}
+
+// Recorder appends an activity for every successful command.
+// Command buses hand it the transaction of the command through WithTx, so an activity commits or rolls back with
+// its mutation; a command whose activity cannot be recorded fails. A nil Recorder records nothing.
+type Recorder struct {
+	activityRepo repository.ActivityRepository
+	logger       *loggers.AppLogger
+}
+
+func NewRecorder(activityRepo repository.ActivityRepository, logger *loggers.AppLogger) *Recorder {
+	return &Recorder{activityRepo: activityRepo, logger: logger}
+}
+
+// WithTx returns a recorder appending inside the given transaction, so activities roll back with their commands
+func (r *Recorder) WithTx(tx dbs.DBTX) *Recorder {
+	if r == nil {
+		return nil
+	}
+	return &Recorder{activityRepo: r.activityRepo.WithTx(tx), logger: r.logger}
+}
+
+// Track runs execute and, when it succeeds, records the subject's state before and after it.
+// Errors reading the snapshots or appending the activity are returned, so the caller's transaction rolls back.
+func (r *Recorder) Track(ctx context.Context, subject Subject, execute func() (interface{}, error)) (interface{}, error) {
+	if r == nil {
+		return execute()
+	}
+
+	before, err := r.snapshot(subject, subject.AggregateId)
+	if err != nil {
+		return nil, err
+	}
+	result, err := execute()
+	if err != nil {
+		return result, err
+	}
+
+	if subject.Resolve != nil {
+		aggregateId, groupId := subject.Resolve(result)
+		subject.AggregateId = aggregateId
+		if groupId > 0 {
+			subject.GroupId = groupId
+		}
+	}
+
+	after, err := r.snapshot(subject, subject.AggregateId)
+	if err != nil {
+		return nil, err
+	}
+	activity := domain.Activity{
+		GroupId:       subject.GroupId,
+		ActorId:       subject.ActorId,
+		Action:        subject.Action,
+		AggregateType: subject.AggregateType,
+		AggregateId:   subject.AggregateId,
+		Before:        before,
+		After:         after,
+		RequestId:     RequestIdFrom(ctx),
+		OccurredAt:    time.Now().UTC(),
+	}
+	if _, err := r.activityRepo.Append(activity); err != nil {
+		r.logger.Error().Err(err).Str("Action", activity.Action).Str("AggregateId", activity.AggregateId).Msg("failed to record activity")
+		return nil, fmt.Errorf("failed to record activity: %w", err)
+	}
+	return result, nil
+}
+
+func (r *Recorder) snapshot(subject Subject, aggregateId string) (json.RawMessage, error) {
+	if subject.Load == nil || aggregateId == "" {
+		return nil, nil
+	}
+	state, err := subject.Load(aggregateId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load activity snapshot: %w", err)
+	}
+	raw, err := json.Marshal(state)
+	if err != nil || string(raw) == "null" {
+		return nil, nil
+	}
+	return raw, nil
+}
+
+// RequestIdFrom returns the id set by middlewares.RequestIdMiddleware, or an empty string
+func RequestIdFrom(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+	requestId, _ := ctx.Value(utils.ContextKey(utils.RequestIdentifier)).(string)
+	return requestId
+}
+
+// ActionOf names a command for the activity log, e.g. ChangeMemberRoleCommand becomes "change_member_role"
+func ActionOf(command interface{}) string {
+	name := strings.TrimSuffix(reflect.TypeOf(command).Name(), "Command")
+
+	var b strings.Builder
+	for i, c := range name {
+		if unicode.IsUpper(c) {
+			if i > 0 {
+				b.WriteByte('_')
+			}
+			c = unicode.ToLower(c)
+		}
+		b.WriteRune(c)
+	}
+	return b.String()
+}
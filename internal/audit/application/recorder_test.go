@@ -0,0 +1,168 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/happYness-Project/taskManagementGolang/internal/audit/domain"
+	"github.com/happYness-Project/taskManagementGolang/internal/audit/repository"
+	"github.com/happYness-Project/taskManagementGolang/pkg/configs"
+	"github.com/happYness-Project/taskManagementGolang/pkg/loggers"
+	"github.com/happYness-Project/taskManagementGolang/pkg/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubActivityRepo keeps appended activities in memory
+type stubActivityRepo struct {
+	repository.ActivityRepository
+	activities []domain.Activity
+	appendErr  error
+}
+
+func (s *stubActivityRepo) Append(activity domain.Activity) (int64, error) {
+	if s.appendErr != nil {
+		return 0, s.appendErr
+	}
+	s.activities = append(s.activities, activity)
+	return int64(len(s.activities)), nil
+}
+
+type stubTask struct {
+	Name string `json:"name"`
+}
+
+func TestRecorder_Track(t *testing.T) {
+	logger := loggers.Setup(configs.Env{})
+	ctx := context.WithValue(context.Background(), utils.ContextKey(utils.RequestIdentifier), "request-1")
+
+	t.Run("when command succeeds, Then activity holds actor, request id and both snapshots", func(t *testing.T) {
+		// Arrange
+		repo := &stubActivityRepo{}
+		recorder := NewRecorder(repo, logger)
+		state := &stubTask{Name: "before"}
+		subject := Subject{
+			ActorId:       "actor-1",
+			Action:        "update_task",
+			AggregateType: domain.AggregateTask,
+			AggregateId:   "task-1",
+			GroupId:       3,
+			Load:          func(string) (interface{}, error) { return state, nil },
+		}
+
+		// Act
+		_, err := recorder.Track(ctx, subject, func() (interface{}, error) {
+			state = &stubTask{Name: "after"}
+			return nil, nil
+		})
+
+		// Assert
+		require.NoError(t, err)
+		require.Len(t, repo.activities, 1)
+		activity := repo.activities[0]
+		assert.Equal(t, "actor-1", activity.ActorId)
+		assert.Equal(t, "update_task", activity.Action)
+		assert.Equal(t, 3, activity.GroupId)
+		assert.Equal(t, "request-1", activity.RequestId)
+		assert.JSONEq(t, `{"name":"before"}`, string(activity.Before))
+		assert.JSONEq(t, `{"name":"after"}`, string(activity.After))
+	})
+
+	t.Run("when aggregate is created, Then id and group are resolved from the result and before is empty", func(t *testing.T) {
+		repo := &stubActivityRepo{}
+		recorder := NewRecorder(repo, logger)
+		subject := Subject{
+			Action: "create_group",
+			Load: func(id string) (interface{}, error) {
+				if id == "" {
+					t.Fatal("Load called without an aggregate id")
+				}
+				return stubTask{Name: id}, nil
+			},
+			Resolve: func(result interface{}) (string, int) { return "7", result.(int) },
+		}
+
+		_, err := recorder.Track(ctx, subject, func() (interface{}, error) { return 7, nil })
+
+		require.NoError(t, err)
+		require.Len(t, repo.activities, 1)
+		assert.Equal(t, "7", repo.activities[0].AggregateId)
+		assert.Equal(t, 7, repo.activities[0].GroupId)
+		assert.Empty(t, repo.activities[0].Before)
+		assert.JSONEq(t, `{"name":"7"}`, string(repo.activities[0].After))
+	})
+
+	t.Run("when aggregate is deleted, Then after is empty", func(t *testing.T) {
+		repo := &stubActivityRepo{}
+		recorder := NewRecorder(repo, logger)
+		var state *stubTask = &stubTask{Name: "doomed"}
+		subject := Subject{Action: "delete_task", AggregateId: "task-1", Load: func(string) (interface{}, error) { return state, nil }}
+
+		_, err := recorder.Track(ctx, subject, func() (interface{}, error) {
+			state = nil
+			return nil, nil
+		})
+
+		require.NoError(t, err)
+		require.Len(t, repo.activities, 1)
+		assert.NotEmpty(t, repo.activities[0].Before)
+		assert.Empty(t, repo.activities[0].After)
+	})
+
+	t.Run("when command fails, Then nothing is recorded", func(t *testing.T) {
+		repo := &stubActivityRepo{}
+		recorder := NewRecorder(repo, logger)
+
+		_, err := recorder.Track(ctx, Subject{Action: "delete_task"}, func() (interface{}, error) {
+			return nil, errors.New("boom")
+		})
+
+		assert.EqualError(t, err, "boom")
+		assert.Empty(t, repo.activities)
+	})
+
+	t.Run("when activity cannot be appended, Then the error is returned so the command rolls back", func(t *testing.T) {
+		repo := &stubActivityRepo{appendErr: errors.New("disk full")}
+		recorder := NewRecorder(repo, logger)
+
+		result, err := recorder.Track(ctx, Subject{Action: "delete_task"}, func() (interface{}, error) { return "ok", nil })
+
+		assert.ErrorIs(t, err, repo.appendErr)
+		assert.Nil(t, result)
+	})
+
+	t.Run("when before snapshot cannot be loaded, Then command does not run", func(t *testing.T) {
+		repo := &stubActivityRepo{}
+		recorder := NewRecorder(repo, logger)
+		loadErr := errors.New("connection reset")
+		subject := Subject{Action: "update_task", AggregateId: "task-1", Load: func(string) (interface{}, error) { return nil, loadErr }}
+		executed := false
+
+		_, err := recorder.Track(ctx, subject, func() (interface{}, error) {
+			executed = true
+			return nil, nil
+		})
+
+		assert.ErrorIs(t, err, loadErr)
+		assert.False(t, executed)
+		assert.Empty(t, repo.activities)
+	})
+
+	t.Run("when recorder is nil, Then command still runs", func(t *testing.T) {
+		var recorder *Recorder
+
+		result, err := recorder.Track(ctx, Subject{}, func() (interface{}, error) { return "ok", nil })
+
+		require.NoError(t, err)
+		assert.Equal(t, "ok", result)
+	})
+}
+
+type ChangeMemberRoleCommand struct{}
+type CreateTaskCommand struct{}
+
+func TestActionOf(t *testing.T) {
+	assert.Equal(t, "change_member_role", ActionOf(ChangeMemberRoleCommand{}))
+	assert.Equal(t, "create_task", ActionOf(CreateTaskCommand{}))
+}
@@ -0,0 +1,45 @@
+package domain
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Aggregate types recorded in the activity log
+const (
+	AggregateTask              = "task"
+	AggregateTaskContainer     = "task_container"
+	AggregateContainerTemplate = "container_template"
+	AggregateUser              = "user"
+	AggregateUserGroup         = "usergroup"
+	AggregateUserGroupMember   = "usergroup_member"
+	AggregateWebhook           = "webhook"
+	AggregateSavedView         = "saved_view"
+	AggregateLabel             = "label"
+	AggregateFeedToken         = "feed_token"
+	AggregateReminderSettings  = "reminder_settings"
+)
+
+// Activity is one append-only entry of the audit log: who did what to which aggregate.
+// Before and After are JSON snapshots of the aggregate and are empty when it did not exist.
+type Activity struct {
+	Id            int64           `json:"id"`
+	GroupId       int             `json:"group_id,omitempty"`
+	ActorId       string          `json:"actor_id"`
+	Action        string          `json:"action"`
+	AggregateType string          `json:"aggregate_type"`
+	AggregateId   string          `json:"aggregate_id"`
+	Before        json.RawMessage `json:"before,omitempty"`
+	After         json.RawMessage `json:"after,omitempty"`
+	RequestId     string          `json:"request_id,omitempty"`
+	OccurredAt    time.Time       `json:"occurred_at"`
+}
+
+// IsValidAggregateType reports whether t is one of the recorded aggregate types
+func IsValidAggregateType(t string) bool {
+	switch t {
+	case AggregateTask, AggregateTaskContainer, AggregateContainerTemplate, AggregateUser, AggregateUserGroup, AggregateUserGroupMember, AggregateWebhook, AggregateSavedView, AggregateLabel, AggregateFeedToken, AggregateReminderSettings:
+		return true
+	}
+	return false
+}
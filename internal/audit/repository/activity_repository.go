@@ -0,0 +1,142 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/happYness-Project/taskManagementGolang/internal/audit/domain"
+	"github.com/happYness-Project/taskManagementGolang/pkg/dbs"
+)
+
+const dbTimeout = time.Second * 5
+
+// ActivityFilter selects a page of a group's activity, newest first.
+// Empty fields are not applied; BeforeId continues after a previous page.
+type ActivityFilter struct {
+	GroupId       int
+	ActorId       string
+	AggregateType string
+	From          *time.Time
+	To            *time.Time
+	BeforeId      int64
+	Limit         int
+}
+
+// ActivityRepository is append-only: entries are never updated or deleted
+type ActivityRepository interface {
+	Append(activity domain.Activity) (int64, error)
+	ListByGroupId(filter ActivityFilter) ([]domain.Activity, error)
+	WithTx(tx dbs.DBTX) ActivityRepository
+}
+type ActivityRepo struct {
+	DB dbs.DBTX
+}
+
+func NewActivityRepository(db dbs.DBTX) *ActivityRepo {
+	return &ActivityRepo{DB: db}
+}
+
+// WithTx returns a copy of the repository bound to the given transaction
+func (m *ActivityRepo) WithTx(tx dbs.DBTX) ActivityRepository {
+	return &ActivityRepo{DB: tx}
+}
+
+func (m *ActivityRepo) Append(a domain.Activity) (int64, error) {
+	var groupId interface{}
+	if a.GroupId > 0 {
+		groupId = a.GroupId
+	}
+
+	var id int64
+	err := m.DB.QueryRow(sqlAppendActivity, groupId, a.ActorId, a.Action, a.AggregateType, a.AggregateId,
+		nullableJSON(a.Before), nullableJSON(a.After), a.RequestId, a.OccurredAt).Scan(&id)
+	if err != nil {
+		return 0, fmt.Errorf("unable to insert into activity table : %w", err)
+	}
+	return id, nil
+}
+
+func (m *ActivityRepo) ListByGroupId(filter ActivityFilter) ([]domain.Activity, error) {
+	query, args := buildListActivityQuery(filter)
+
+	ctx, cancel := context.WithTimeout(context.Background(), dbTimeout)
+	defer cancel()
+
+	rows, err := m.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	activities := []domain.Activity{}
+	for rows.Next() {
+		activity, err := scanRowsIntoActivity(rows)
+		if err != nil {
+			return nil, err
+		}
+		activities = append(activities, *activity)
+	}
+	return activities, rows.Err()
+}
+
+func buildListActivityQuery(f ActivityFilter) (string, []interface{}) {
+	args := []interface{}{f.GroupId}
+	arg := func(v interface{}) int {
+		args = append(args, v)
+		return len(args)
+	}
+
+	query := sqlListActivitySelect
+	if f.ActorId != "" {
+		query += fmt.Sprintf(sqlListActivityFilterActor, arg(f.ActorId))
+	}
+	if f.AggregateType != "" {
+		query += fmt.Sprintf(sqlListActivityFilterType, arg(f.AggregateType))
+	}
+	if f.From != nil {
+		query += fmt.Sprintf(sqlListActivityFilterFrom, arg(*f.From))
+	}
+	if f.To != nil {
+		query += fmt.Sprintf(sqlListActivityFilterTo, arg(*f.To))
+	}
+	if f.BeforeId > 0 {
+		query += fmt.Sprintf(sqlListActivityBeforeId, arg(f.BeforeId))
+	}
+	query += fmt.Sprintf(sqlListActivityOrderLimit, arg(f.Limit))
+	return query, args
+}
+
+// nullableJSON stores an empty snapshot as NULL
+func nullableJSON(raw []byte) interface{} {
+	if len(raw) == 0 {
+		return nil
+	}
+	return string(raw)
+}
+
+func scanRowsIntoActivity(rows *sql.Rows) (*domain.Activity, error) {
+	activity := new(domain.Activity)
+	var groupId sql.NullInt64
+	var before, after []byte
+	err := rows.Scan(
+		&activity.Id,
+		&groupId,
+		&activity.ActorId,
+		&activity.Action,
+		&activity.AggregateType,
+		&activity.AggregateId,
+		&before,
+		&after,
+		&activity.RequestId,
+		&activity.OccurredAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	activity.GroupId = int(groupId.Int64)
+	activity.Before = before
+	activity.After = after
+	return activity, nil
+}
@@ -0,0 +1,15 @@
+package repository
+
+const (
+	sqlAppendActivity = `INSERT INTO container.activity(usergroup_id, actor_id, action, aggregate_type, aggregate_id, before_snapshot, after_snapshot, request_id, occurred_at)
+		VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9) RETURNING id`
+
+	sqlListActivitySelect = `SELECT id, usergroup_id, actor_id, action, aggregate_type, aggregate_id, before_snapshot, after_snapshot, request_id, occurred_at
+								FROM container.activity WHERE usergroup_id = $1`
+	sqlListActivityFilterActor = ` AND actor_id = $%d`
+	sqlListActivityFilterType  = ` AND aggregate_type = $%d`
+	sqlListActivityFilterFrom  = ` AND occurred_at >= $%d`
+	sqlListActivityFilterTo    = ` AND occurred_at <= $%d`
+	sqlListActivityBeforeId    = ` AND id < $%d`
+	sqlListActivityOrderLimit  = ` ORDER BY id DESC LIMIT $%d`
+)
@@ -0,0 +1,8 @@
+package route
+
+const prefix = "activity_"
+
+const (
+	ActivityGetInvalidParameter = prefix + "get_invalid_parameter"
+	ActivityGetServerError      = prefix + "get_server_error"
+)
@@ -0,0 +1,115 @@
+package route
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/happYness-Project/taskManagementGolang/internal/audit/application"
+	"github.com/happYness-Project/taskManagementGolang/internal/audit/application/query"
+	"github.com/happYness-Project/taskManagementGolang/internal/audit/repository"
+	"github.com/happYness-Project/taskManagementGolang/internal/authorization"
+	"github.com/happYness-Project/taskManagementGolang/pkg/constants"
+	"github.com/happYness-Project/taskManagementGolang/pkg/loggers"
+	"github.com/happYness-Project/taskManagementGolang/pkg/response"
+)
+
+const NextCursorHeader = "X-Next-Cursor"
+
+type Handler struct {
+	logger   *loggers.AppLogger
+	queryBus *application.QueryBus
+}
+
+func NewHandler(logger *loggers.AppLogger, activityRepo repository.ActivityRepository, policy *authorization.Policy) *Handler {
+	return &Handler{
+		logger:   logger,
+		queryBus: application.NewQueryBus(activityRepo, policy),
+	}
+}
+
+func (h *Handler) RegisterRoutes(router chi.Router) {
+	router.Get("/api/user-groups/{groupID}/activity", h.handleGetGroupActivity)
+}
+
+func (h *Handler) handleGetGroupActivity(w http.ResponseWriter, r *http.Request) {
+	groupId, err := strconv.Atoi(chi.URLParam(r, "groupID"))
+	if err != nil {
+		h.logger.Error().Err(err).Msg("invalid Group ID")
+		response.ErrorResponse(w, http.StatusBadRequest, *(response.New(constants.InvalidParameter, "Invalid Group ID")))
+		return
+	}
+
+	activityQuery, err := parseActivityQuery(r)
+	if err != nil {
+		h.logger.Error().Err(err).Str("ErrorCode", ActivityGetInvalidParameter).Msg(err.Error())
+		response.ErrorResponse(w, http.StatusBadRequest, *(response.New(ActivityGetInvalidParameter, "Invalid parameter", err.Error())))
+		return
+	}
+	activityQuery.GroupId = groupId
+	activityQuery.RequesterId = authorization.RequesterId(r)
+
+	// Use Query Bus
+	result, err := h.queryBus.Execute(activityQuery)
+	if authorization.IsForbiddenError(err) {
+		h.logger.Error().Err(err).Str("ErrorCode", constants.PermissionDenied).Msg(err.Error())
+		response.Forbidden(w, constants.PermissionDenied, err.Error())
+		return
+	}
+	if errors.Is(err, query.ErrInvalidActivityParams) {
+		h.logger.Error().Err(err).Str("ErrorCode", ActivityGetInvalidParameter).Msg(err.Error())
+		response.ErrorResponse(w, http.StatusBadRequest, *(response.New(ActivityGetInvalidParameter, "Invalid parameter", err.Error())))
+		return
+	}
+	if err != nil {
+		h.logger.Error().Err(err).Str("ErrorCode", ActivityGetServerError).Msg("Error occurred during GetGroupActivity")
+		response.ErrorResponse(w, http.StatusInternalServerError, *(response.New(ActivityGetServerError, "Failed to get activity", err.Error())))
+		return
+	}
+	page := result.(*query.ActivityPage)
+	if page.NextCursor != "" {
+		w.Header().Set(NextCursorHeader, page.NextCursor)
+	}
+	response.WriteJsonWithEncode(w, http.StatusOK, page.Activities)
+}
+
+// parseActivityQuery reads the filters from the query string: actor, type, from, to, cursor, limit
+func parseActivityQuery(r *http.Request) (query.GetGroupActivityQuery, error) {
+	values := r.URL.Query()
+	q := query.GetGroupActivityQuery{
+		ActorId:       values.Get("actor"),
+		AggregateType: values.Get("type"),
+		Cursor:        values.Get("cursor"),
+	}
+
+	if v := values.Get("limit"); v != "" {
+		limit, err := strconv.Atoi(v)
+		if err != nil || limit < 1 {
+			return q, fmt.Errorf("limit must be a positive integer")
+		}
+		q.Limit = limit
+	}
+
+	var err error
+	if q.From, err = parseOptionalTime(values.Get("from"), "from"); err != nil {
+		return q, err
+	}
+	if q.To, err = parseOptionalTime(values.Get("to"), "to"); err != nil {
+		return q, err
+	}
+	return q, nil
+}
+
+func parseOptionalTime(v, name string) (*time.Time, error) {
+	if v == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, v)
+	if err != nil {
+		return nil, fmt.Errorf("%s must be a RFC3339 timestamp", name)
+	}
+	return &t, nil
+}
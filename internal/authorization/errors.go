@@ -0,0 +1,19 @@
+package authorization
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Authorization errors
+var (
+	ErrForbidden      = errors.New("permission denied")
+	ErrNotGroupMember = fmt.Errorf("%w: user is not a member of the group", ErrForbidden)
+	ErrAdminRequired  = fmt.Errorf("%w: group admin role is required", ErrForbidden)
+	ErrNotSelf        = fmt.Errorf("%w: users can only act on their own account", ErrForbidden)
+)
+
+// IsForbiddenError checks if an error is caused by a failed policy check
+func IsForbiddenError(err error) bool {
+	return errors.Is(err, ErrForbidden)
+}
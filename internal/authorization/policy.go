@@ -0,0 +1,67 @@
+package authorization
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/happYness-Project/taskManagementGolang/internal/user/repository"
+	"github.com/happYness-Project/taskManagementGolang/internal/usergroup/domain"
+)
+
+// Policy decides whether a user may act on a user group and everything the group owns.
+// Membership is read from container.usergroup_user through the user repository.
+type Policy struct {
+	userRepo repository.UserRepository
+}
+
+func NewPolicy(userRepo repository.UserRepository) *Policy {
+	return &Policy{userRepo: userRepo}
+}
+
+// RoleInGroup returns the role of the user in the group, or ErrNotGroupMember
+func (p *Policy) RoleInGroup(userId string, groupId int) (domain.Role, error) {
+	if userId == "" || groupId <= 0 {
+		return "", ErrNotGroupMember
+	}
+
+	value, err := p.userRepo.GetUserRoleInGroup(userId, groupId)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", ErrNotGroupMember
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve group membership: %w", err)
+	}
+
+	role, err := domain.NewRole(value)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve group membership: %w", err)
+	}
+	return role, nil
+}
+
+// RequireMember allows any member of the group, regardless of role
+func (p *Policy) RequireMember(userId string, groupId int) error {
+	_, err := p.RoleInGroup(userId, groupId)
+	return err
+}
+
+// RequireAdmin allows only admins of the group
+func (p *Policy) RequireAdmin(userId string, groupId int) error {
+	role, err := p.RoleInGroup(userId, groupId)
+	if err != nil {
+		return err
+	}
+	if !role.IsAdmin() {
+		return ErrAdminRequired
+	}
+	return nil
+}
+
+// RequireSelf allows a user to act only on their own account
+func (p *Policy) RequireSelf(requesterId string, userId string) error {
+	if requesterId == "" || requesterId != userId {
+		return ErrNotSelf
+	}
+	return nil
+}
@@ -0,0 +1,74 @@
+package authorization
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/happYness-Project/taskManagementGolang/internal/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOperator(t *testing.T) {
+	t.Run("when context is marked as operator, Then IsOperator reports it", func(t *testing.T) {
+		assert.False(t, IsOperator(context.Background()))
+		assert.True(t, IsOperator(AsOperator(context.Background())))
+	})
+}
+
+func TestPolicy(t *testing.T) {
+	t.Run("when user is a member of the group, Then RequireMember succeeds and RequireAdmin is forbidden", func(t *testing.T) {
+		userRepo := new(mocks.MockUserRepo)
+		userRepo.On("GetUserRoleInGroup", "member-id", 1).Return("member", nil)
+		policy := NewPolicy(userRepo)
+
+		assert.NoError(t, policy.RequireMember("member-id", 1))
+		err := policy.RequireAdmin("member-id", 1)
+		assert.ErrorIs(t, err, ErrAdminRequired)
+		assert.True(t, IsForbiddenError(err))
+	})
+
+	t.Run("when user is an admin of the group, Then RequireAdmin succeeds", func(t *testing.T) {
+		userRepo := new(mocks.MockUserRepo)
+		userRepo.On("GetUserRoleInGroup", "admin-id", 1).Return("admin", nil)
+		policy := NewPolicy(userRepo)
+
+		assert.NoError(t, policy.RequireAdmin("admin-id", 1))
+	})
+
+	t.Run("when user is not in the group, Then access is forbidden", func(t *testing.T) {
+		userRepo := new(mocks.MockUserRepo)
+		userRepo.On("GetUserRoleInGroup", "outsider-id", 1).Return("", sql.ErrNoRows)
+		policy := NewPolicy(userRepo)
+
+		err := policy.RequireMember("outsider-id", 1)
+		assert.ErrorIs(t, err, ErrNotGroupMember)
+		assert.True(t, IsForbiddenError(err))
+	})
+
+	t.Run("when requester is missing, Then access is forbidden without lookup", func(t *testing.T) {
+		userRepo := new(mocks.MockUserRepo)
+		policy := NewPolicy(userRepo)
+
+		assert.ErrorIs(t, policy.RequireMember("", 1), ErrNotGroupMember)
+		userRepo.AssertNotCalled(t, "GetUserRoleInGroup")
+	})
+
+	t.Run("when repository fails, Then error is not treated as forbidden", func(t *testing.T) {
+		userRepo := new(mocks.MockUserRepo)
+		userRepo.On("GetUserRoleInGroup", "user-id", 1).Return("", errors.New("connection refused"))
+		policy := NewPolicy(userRepo)
+
+		err := policy.RequireMember("user-id", 1)
+		assert.Error(t, err)
+		assert.False(t, IsForbiddenError(err))
+	})
+
+	t.Run("when requester acts on another user, Then RequireSelf is forbidden", func(t *testing.T) {
+		policy := NewPolicy(new(mocks.MockUserRepo))
+
+		assert.NoError(t, policy.RequireSelf("user-id", "user-id"))
+		assert.ErrorIs(t, policy.RequireSelf("user-id", "other-id"), ErrNotSelf)
+	})
+}
@@ -0,0 +1,18 @@
+package authorization
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/go-chi/jwtauth"
+)
+
+// RequesterId returns the user UUID carried in the 'nameid' claim of the request's JWT
+func RequesterId(r *http.Request) string {
+	_, claims, _ := jwtauth.FromContext(r.Context())
+	nameId, ok := claims["nameid"]
+	if !ok || nameId == nil {
+		return ""
+	}
+	return fmt.Sprintf("%v", nameId)
+}
@@ -0,0 +1,33 @@
+package application
+
+import (
+	auditApp "github.com/happYness-Project/taskManagementGolang/internal/audit/application"
+	auditDomain "github.com/happYness-Project/taskManagementGolang/internal/audit/domain"
+	cmd "github.com/happYness-Project/taskManagementGolang/internal/feed/application/command"
+)
+
+// auditSubject describes the feed token a command mutates for the activity log.
+// Tokens are personal, so their activities belong to no group; snapshots never hold the token itself.
+func (bus *CommandBus) auditSubject(command interface{}) auditApp.Subject {
+	subject := auditApp.Subject{
+		Action:        auditApp.ActionOf(command),
+		AggregateType: auditDomain.AggregateFeedToken,
+		Load:          bus.loadToken,
+	}
+
+	switch c := command.(type) {
+	case cmd.IssueFeedTokenCommand:
+		subject.ActorId, subject.AggregateId = c.RequesterId, c.UserId
+	case cmd.RevokeFeedTokenCommand:
+		subject.ActorId, subject.AggregateId = c.RequesterId, c.UserId
+	}
+	return subject
+}
+
+func (bus *CommandBus) loadToken(userId string) (interface{}, error) {
+	token, err := bus.tokenRepo.GetByUserId(userId)
+	if err != nil || token == nil {
+		return nil, err
+	}
+	return token, nil
+}
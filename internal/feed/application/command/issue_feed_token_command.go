@@ -0,0 +1,41 @@
+package command
+
+import (
+	"time"
+
+	"github.com/happYness-Project/taskManagementGolang/internal/feed/domain"
+	"github.com/happYness-Project/taskManagementGolang/internal/feed/repository"
+)
+
+// IssueFeedTokenCommand issues a new feed token for a user, revoking the previous one
+type IssueFeedTokenCommand struct {
+	UserId      string // UUID of the user the token reads feeds as
+	RequesterId string // UUID from JWT
+}
+
+// IssuedFeedToken is a newly issued token; it cannot be read again later
+type IssuedFeedToken struct {
+	Token     string    `json:"token"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// IssueFeedTokenCommandHandler handles issuing feed tokens
+type IssueFeedTokenCommandHandler struct {
+	tokenRepo repository.FeedTokenRepository
+}
+
+func NewIssueFeedTokenCommandHandler(tokenRepo repository.FeedTokenRepository) *IssueFeedTokenCommandHandler {
+	return &IssueFeedTokenCommandHandler{tokenRepo: tokenRepo}
+}
+
+// Handle executes the issue feed token command
+func (h *IssueFeedTokenCommandHandler) Handle(cmd IssueFeedTokenCommand) (*IssuedFeedToken, error) {
+	feedToken, token, err := domain.NewFeedToken(cmd.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.tokenRepo.SaveToken(*feedToken); err != nil {
+		return nil, err
+	}
+	return &IssuedFeedToken{Token: token, CreatedAt: feedToken.CreatedAt}, nil
+}
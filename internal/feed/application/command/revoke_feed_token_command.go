@@ -0,0 +1,25 @@
+package command
+
+import (
+	"github.com/happYness-Project/taskManagementGolang/internal/feed/repository"
+)
+
+// RevokeFeedTokenCommand revokes the feed token of a user; the feeds read with it stop working
+type RevokeFeedTokenCommand struct {
+	UserId      string // UUID of the user owning the token
+	RequesterId string // UUID from JWT
+}
+
+// RevokeFeedTokenCommandHandler handles revoking feed tokens
+type RevokeFeedTokenCommandHandler struct {
+	tokenRepo repository.FeedTokenRepository
+}
+
+func NewRevokeFeedTokenCommandHandler(tokenRepo repository.FeedTokenRepository) *RevokeFeedTokenCommandHandler {
+	return &RevokeFeedTokenCommandHandler{tokenRepo: tokenRepo}
+}
+
+// Handle executes the revoke feed token command
+func (h *RevokeFeedTokenCommandHandler) Handle(cmd RevokeFeedTokenCommand) error {
+	return h.tokenRepo.DeleteToken(cmd.UserId)
+}
@@ -0,0 +1,85 @@
+package application
+
+import (
+	"context"
+	"fmt"
+
+	auditApp "github.com/happYness-Project/taskManagementGolang/internal/audit/application"
+	"github.com/happYness-Project/taskManagementGolang/internal/authorization"
+	cmd "github.com/happYness-Project/taskManagementGolang/internal/feed/application/command"
+	"github.com/happYness-Project/taskManagementGolang/internal/feed/repository"
+	"github.com/happYness-Project/taskManagementGolang/pkg/dbs"
+)
+
+// CommandBus routes commands to their handlers
+type CommandBus struct {
+	issueTokenHandler  *cmd.IssueFeedTokenCommandHandler
+	revokeTokenHandler *cmd.RevokeFeedTokenCommandHandler
+
+	tokenRepo repository.FeedTokenRepository
+	policy    *authorization.Policy
+	uow       dbs.UnitOfWork
+	recorder  *auditApp.Recorder
+}
+
+// NewCommandBus creates a new command bus with all handlers registered
+func NewCommandBus(tokenRepo repository.FeedTokenRepository, policy *authorization.Policy, uow dbs.UnitOfWork, recorder *auditApp.Recorder) *CommandBus {
+	return &CommandBus{
+		issueTokenHandler:  cmd.NewIssueFeedTokenCommandHandler(tokenRepo),
+		revokeTokenHandler: cmd.NewRevokeFeedTokenCommandHandler(tokenRepo),
+		tokenRepo:          tokenRepo,
+		policy:             policy,
+		uow:                uow,
+		recorder:           recorder,
+	}
+}
+
+// withTx returns a bus whose handlers and activity log run inside the given transaction.
+// The handlers' own units of work become savepoints of it.
+func (bus *CommandBus) withTx(tx dbs.DBTX) *CommandBus {
+	return NewCommandBus(bus.tokenRepo.WithTx(tx), bus.policy, dbs.NewUnitOfWork(tx), bus.recorder.WithTx(tx))
+}
+
+// Execute authorizes the command, then dispatches it to the appropriate handler and records it in the activity log
+// in one transaction.
+// ctx carries the request id of the HTTP request that issued the command.
+func (bus *CommandBus) Execute(ctx context.Context, command interface{}) (interface{}, error) {
+	if err := bus.authorize(command); err != nil {
+		return nil, err
+	}
+
+	var result interface{}
+	err := bus.uow.Do(func(tx dbs.DBTX) error {
+		txBus := bus.withTx(tx)
+		var err error
+		result, err = txBus.recorder.Track(ctx, txBus.auditSubject(command), func() (interface{}, error) {
+			return txBus.dispatch(command)
+		})
+		return err
+	})
+	return result, err
+}
+
+// dispatch routes the command to its handler
+func (bus *CommandBus) dispatch(command interface{}) (interface{}, error) {
+	switch c := command.(type) {
+	case cmd.IssueFeedTokenCommand:
+		return bus.issueTokenHandler.Handle(c)
+	case cmd.RevokeFeedTokenCommand:
+		return nil, bus.revokeTokenHandler.Handle(c)
+	default:
+		return nil, fmt.Errorf("unknown command type: %T", command)
+	}
+}
+
+// authorize checks that users only manage their own feed token
+func (bus *CommandBus) authorize(command interface{}) error {
+	switch c := command.(type) {
+	case cmd.IssueFeedTokenCommand:
+		return bus.policy.RequireSelf(c.RequesterId, c.UserId)
+	case cmd.RevokeFeedTokenCommand:
+		return bus.policy.RequireSelf(c.RequesterId, c.UserId)
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,119 @@
+package query
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/happYness-Project/taskManagementGolang/internal/feed/domain"
+	"github.com/happYness-Project/taskManagementGolang/internal/feed/repository"
+	taskQuery "github.com/happYness-Project/taskManagementGolang/internal/task/application/query"
+	taskDomain "github.com/happYness-Project/taskManagementGolang/internal/task/domain"
+	containerRepo "github.com/happYness-Project/taskManagementGolang/internal/taskcontainer/repository"
+)
+
+var ErrContainerNotFound = errors.New("task container not found")
+
+// GetContainerFeedQuery reads the tasks of a container for its calendar feed
+type GetContainerFeedQuery struct {
+	ContainerId string
+	Token       string // feed token of the requester
+}
+
+// GetUserFeedQuery reads the tasks assigned to a user for their calendar feed
+type GetUserFeedQuery struct {
+	UserId string
+	Token  string // feed token of the requester
+}
+
+// Feed is the content of a calendar feed
+type Feed struct {
+	Name  string
+	Tasks []taskDomain.Task
+}
+
+// TaskQueryExecutor runs task queries; the task QueryBus checks that the requester may read the tasks
+type TaskQueryExecutor interface {
+	Execute(query interface{}) (interface{}, error)
+}
+
+// FeedQueryHandler handles the reads of calendar feeds. Feeds act as the user holding the token,
+// so they list exactly the tasks that user reads through the API.
+type FeedQueryHandler struct {
+	tokenRepo     repository.FeedTokenRepository
+	containerRepo containerRepo.ContainerRepository
+	tasks         TaskQueryExecutor
+}
+
+func NewFeedQueryHandler(tokenRepo repository.FeedTokenRepository, containerRepo containerRepo.ContainerRepository, tasks TaskQueryExecutor) *FeedQueryHandler {
+	return &FeedQueryHandler{tokenRepo: tokenRepo, containerRepo: containerRepo, tasks: tasks}
+}
+
+func (h *FeedQueryHandler) HandleGetContainerFeed(query GetContainerFeedQuery) (*Feed, error) {
+	requesterId, err := h.authenticate(query.Token)
+	if err != nil {
+		return nil, err
+	}
+	container, err := h.containerRepo.GetById(query.ContainerId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve container: %w", err)
+	}
+	if container == nil || container.Id == "" {
+		return nil, ErrContainerNotFound
+	}
+
+	tasks, err := h.allTasks(func(list taskQuery.TaskListParams) interface{} {
+		return taskQuery.GetTasksByContainerIdQuery{ContainerId: query.ContainerId, List: list, RequesterId: requesterId}
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &Feed{Name: container.Name, Tasks: tasks}, nil
+}
+
+func (h *FeedQueryHandler) HandleGetUserFeed(query GetUserFeedQuery) (*Feed, error) {
+	requesterId, err := h.authenticate(query.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	tasks, err := h.allTasks(func(list taskQuery.TaskListParams) interface{} {
+		return taskQuery.GetTasksByAssigneeQuery{AssigneeId: query.UserId, List: list, RequesterId: requesterId}
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &Feed{Name: "Assigned tasks", Tasks: tasks}, nil
+}
+
+// authenticate returns the user holding the token
+func (h *FeedQueryHandler) authenticate(token string) (string, error) {
+	if token == "" {
+		return "", domain.ErrInvalidFeedToken
+	}
+	feedToken, err := h.tokenRepo.GetByHash(domain.HashFeedToken(token))
+	if err != nil {
+		return "", fmt.Errorf("failed to retrieve feed token: %w", err)
+	}
+	if feedToken == nil {
+		return "", domain.ErrInvalidFeedToken
+	}
+	return feedToken.UserId, nil
+}
+
+// allTasks reads every page of a task listing, in target date order
+func (h *FeedQueryHandler) allTasks(pageQuery func(list taskQuery.TaskListParams) interface{}) ([]taskDomain.Task, error) {
+	tasks := []taskDomain.Task{}
+	list := taskQuery.TaskListParams{Limit: taskQuery.MaxTaskPageLimit}
+	for {
+		result, err := h.tasks.Execute(pageQuery(list))
+		if err != nil {
+			return nil, err
+		}
+		page := result.(*taskQuery.TaskPage)
+		tasks = append(tasks, page.Tasks...)
+		if page.NextCursor == "" {
+			return tasks, nil
+		}
+		list.Cursor = page.NextCursor
+	}
+}
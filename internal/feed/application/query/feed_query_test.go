@@ -0,0 +1,111 @@
+package query
+
+import (
+	"testing"
+
+	"github.com/happYness-Project/taskManagementGolang/internal/authorization"
+	"github.com/happYness-Project/taskManagementGolang/internal/feed/domain"
+	"github.com/happYness-Project/taskManagementGolang/internal/feed/repository"
+	"github.com/happYness-Project/taskManagementGolang/internal/mocks"
+	taskQuery "github.com/happYness-Project/taskManagementGolang/internal/task/application/query"
+	taskDomain "github.com/happYness-Project/taskManagementGolang/internal/task/domain"
+	containerDomain "github.com/happYness-Project/taskManagementGolang/internal/taskcontainer/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubFeedTokenRepo holds the token of one user
+type stubFeedTokenRepo struct {
+	repository.FeedTokenRepository
+	token *domain.FeedToken
+}
+
+func (s *stubFeedTokenRepo) GetByHash(tokenHash string) (*domain.FeedToken, error) {
+	if s.token == nil || s.token.TokenHash != tokenHash {
+		return nil, nil
+	}
+	return s.token, nil
+}
+
+// stubTaskQueries answers task listings with pages of one task, recording the queries it ran
+type stubTaskQueries struct {
+	pages   [][]taskDomain.Task
+	err     error
+	queries []interface{}
+}
+
+func (s *stubTaskQueries) Execute(query interface{}) (interface{}, error) {
+	s.queries = append(s.queries, query)
+	if s.err != nil {
+		return nil, s.err
+	}
+	page := &taskQuery.TaskPage{Tasks: s.pages[0]}
+	s.pages = s.pages[1:]
+	if len(s.pages) > 0 {
+		page.NextCursor = "next"
+	}
+	return page, nil
+}
+
+func TestFeedQueryHandler_HandleGetContainerFeed(t *testing.T) {
+	feedToken, token, err := domain.NewFeedToken("alice")
+	require.NoError(t, err)
+	tokenRepo := &stubFeedTokenRepo{token: feedToken}
+
+	t.Run("when the token is valid, Then the feed lists every page of tasks as the token's user", func(t *testing.T) {
+		// Arrange
+		containerRepo := new(mocks.MockContainerRepo)
+		containerRepo.On("GetById", "c1").Return(&containerDomain.TaskContainer{Id: "c1", Name: "Shopping"}, nil)
+		tasks := &stubTaskQueries{pages: [][]taskDomain.Task{{{TaskId: "t1"}}, {{TaskId: "t2"}}}}
+		handler := NewFeedQueryHandler(tokenRepo, containerRepo, tasks)
+
+		// Act
+		feed, err := handler.HandleGetContainerFeed(GetContainerFeedQuery{ContainerId: "c1", Token: token})
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, "Shopping", feed.Name)
+		assert.Len(t, feed.Tasks, 2)
+		require.Len(t, tasks.queries, 2)
+		second := tasks.queries[1].(taskQuery.GetTasksByContainerIdQuery)
+		assert.Equal(t, "alice", second.RequesterId)
+		assert.Equal(t, "next", second.List.Cursor)
+	})
+
+	t.Run("when the token is unknown, Then it returns ErrInvalidFeedToken", func(t *testing.T) {
+		// Arrange
+		handler := NewFeedQueryHandler(tokenRepo, new(mocks.MockContainerRepo), &stubTaskQueries{})
+
+		// Act
+		_, err := handler.HandleGetContainerFeed(GetContainerFeedQuery{ContainerId: "c1", Token: "revoked"})
+
+		// Assert
+		assert.ErrorIs(t, err, domain.ErrInvalidFeedToken)
+	})
+
+	t.Run("when the container does not exist, Then it returns ErrContainerNotFound", func(t *testing.T) {
+		// Arrange
+		containerRepo := new(mocks.MockContainerRepo)
+		containerRepo.On("GetById", "missing").Return((*containerDomain.TaskContainer)(nil), nil)
+		handler := NewFeedQueryHandler(tokenRepo, containerRepo, &stubTaskQueries{})
+
+		// Act
+		_, err := handler.HandleGetContainerFeed(GetContainerFeedQuery{ContainerId: "missing", Token: token})
+
+		// Assert
+		assert.ErrorIs(t, err, ErrContainerNotFound)
+	})
+
+	t.Run("when the token's user may not read the tasks, Then the task listing error is returned", func(t *testing.T) {
+		// Arrange
+		containerRepo := new(mocks.MockContainerRepo)
+		containerRepo.On("GetById", "c1").Return(&containerDomain.TaskContainer{Id: "c1"}, nil)
+		handler := NewFeedQueryHandler(tokenRepo, containerRepo, &stubTaskQueries{err: authorization.ErrNotGroupMember})
+
+		// Act
+		_, err := handler.HandleGetContainerFeed(GetContainerFeedQuery{ContainerId: "c1", Token: token})
+
+		// Assert
+		assert.True(t, authorization.IsForbiddenError(err))
+	})
+}
@@ -0,0 +1,35 @@
+package application
+
+import (
+	"fmt"
+
+	qry "github.com/happYness-Project/taskManagementGolang/internal/feed/application/query"
+	"github.com/happYness-Project/taskManagementGolang/internal/feed/repository"
+	containerRepo "github.com/happYness-Project/taskManagementGolang/internal/taskcontainer/repository"
+)
+
+// QueryBus routes queries to their handlers
+type QueryBus struct {
+	queryHandler *qry.FeedQueryHandler
+}
+
+// NewQueryBus creates a new query bus with all handlers registered.
+// tasks lists the tasks of feeds; it is the task QueryBus, which checks the token's user may read them.
+func NewQueryBus(tokenRepo repository.FeedTokenRepository, containerRepo containerRepo.ContainerRepository, tasks qry.TaskQueryExecutor) *QueryBus {
+	return &QueryBus{
+		queryHandler: qry.NewFeedQueryHandler(tokenRepo, containerRepo, tasks),
+	}
+}
+
+// Execute dispatches the query to the appropriate handler.
+// Feeds are authenticated by their token rather than a JWT, so the handler authorizes them.
+func (bus *QueryBus) Execute(query interface{}) (interface{}, error) {
+	switch q := query.(type) {
+	case qry.GetContainerFeedQuery:
+		return bus.queryHandler.HandleGetContainerFeed(q)
+	case qry.GetUserFeedQuery:
+		return bus.queryHandler.HandleGetUserFeed(q)
+	default:
+		return nil, fmt.Errorf("unknown query type: %T", query)
+	}
+}
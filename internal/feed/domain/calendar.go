@@ -0,0 +1,152 @@
+package domain
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	taskDomain "github.com/happYness-Project/taskManagementGolang/internal/task/domain"
+)
+
+var ErrInvalidComponent = errors.New(`component must be "event" or "todo"`)
+
+// Component is the iCalendar component tasks are rendered as
+type Component string
+
+const (
+	// ComponentEvent renders tasks as events on their target date, which every calendar client shows
+	ComponentEvent Component = "VEVENT"
+	// ComponentTodo renders tasks as to-dos due on their target date, for clients with a task list
+	ComponentTodo Component = "VTODO"
+)
+
+// NewComponent reads a component from "event" or "todo"; an empty value renders events
+func NewComponent(value string) (Component, error) {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "", "event":
+		return ComponentEvent, nil
+	case "todo":
+		return ComponentTodo, nil
+	default:
+		return "", fmt.Errorf("%w, got %q", ErrInvalidComponent, value)
+	}
+}
+
+const (
+	icsDateTime = "20060102T150405Z"
+	icsDate     = "20060102"
+	// maxLineOctets is the longest content line iCalendar allows before it must be folded
+	maxLineOctets = 75
+)
+
+// WriteCalendar writes the tasks that have a target date as an iCalendar (RFC 5545) feed named name.
+// Target dates at midnight UTC, as set by clients that only pick a day, become all-day entries.
+func WriteCalendar(w io.Writer, name string, component Component, tasks []taskDomain.Task) error {
+	c := &calendarWriter{w: bufio.NewWriter(w)}
+	c.line("BEGIN:VCALENDAR")
+	c.line("VERSION:2.0")
+	c.line("PRODID:-//happYness-Project//Task Management//EN")
+	c.line("CALSCALE:GREGORIAN")
+	c.line("METHOD:PUBLISH")
+	c.line("X-WR-CALNAME:" + escapeText(name))
+	for _, task := range tasks {
+		if task.TargetDate.IsZero() {
+			continue
+		}
+		c.task(component, task)
+	}
+	c.line("END:VCALENDAR")
+	if c.err != nil {
+		return c.err
+	}
+	return c.w.Flush()
+}
+
+type calendarWriter struct {
+	w   *bufio.Writer
+	err error
+}
+
+func (c *calendarWriter) task(component Component, task taskDomain.Task) {
+	c.line("BEGIN:" + string(component))
+	c.line("UID:" + task.TaskId + "@tasks")
+	c.line("DTSTAMP:" + task.UpdatedAt.UTC().Format(icsDateTime))
+	c.line("CREATED:" + task.CreatedAt.UTC().Format(icsDateTime))
+	c.line("LAST-MODIFIED:" + task.UpdatedAt.UTC().Format(icsDateTime))
+	c.line("SUMMARY:" + escapeText(task.TaskName))
+	if task.TaskDesc != "" {
+		c.line("DESCRIPTION:" + escapeText(task.TaskDesc))
+	}
+	if names := task.LabelNames(); len(names) > 0 {
+		escaped := make([]string, len(names))
+		for i, name := range names {
+			escaped[i] = escapeText(name)
+		}
+		c.line("CATEGORIES:" + strings.Join(escaped, ","))
+	}
+	c.line(fmt.Sprintf("PRIORITY:%d", icsPriority(task.Priority)))
+
+	date := "DUE"
+	if component == ComponentEvent {
+		date = "DTSTART"
+		// Tasks do not make their assignee busy
+		c.line("TRANSP:TRANSPARENT")
+	} else if task.IsCompleted {
+		c.line("STATUS:COMPLETED")
+	} else {
+		c.line("STATUS:NEEDS-ACTION")
+	}
+	if target := task.TargetDate.UTC(); target.Equal(target.Truncate(24 * time.Hour)) {
+		c.line(date + ";VALUE=DATE:" + target.Format(icsDate))
+	} else {
+		c.line(date + ":" + target.Format(icsDateTime))
+	}
+	if task.RecurrenceRule != "" {
+		c.line("RRULE:" + strings.TrimPrefix(task.RecurrenceRule, "RRULE:"))
+	}
+	c.line("END:" + string(component))
+}
+
+// line writes a content line, folded into lines of at most 75 octets without splitting a character
+func (c *calendarWriter) line(content string) {
+	if c.err != nil {
+		return
+	}
+	limit := maxLineOctets
+	for len(content) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(content[cut]) {
+			cut--
+		}
+		if _, c.err = c.w.WriteString(content[:cut] + "\r\n "); c.err != nil {
+			return
+		}
+		content = content[cut:]
+		// Continuation lines start with the space that folds them
+		limit = maxLineOctets - 1
+	}
+	_, c.err = c.w.WriteString(content + "\r\n")
+}
+
+// escapeText escapes the characters iCalendar TEXT values reserve
+func escapeText(value string) string {
+	return strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`, "\r", `\n`).Replace(value)
+}
+
+// icsPriority maps a task priority onto the iCalendar scale, where 1 is the highest and 9 the lowest
+func icsPriority(priority taskDomain.Priority) int {
+	switch priority {
+	case taskDomain.PriorityUrgent:
+		return 1
+	case taskDomain.PriorityHigh:
+		return 3
+	case taskDomain.PriorityLow:
+		return 9
+	default:
+		return 5
+	}
+}
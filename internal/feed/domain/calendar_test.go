@@ -0,0 +1,142 @@
+package domain
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	taskDomain "github.com/happYness-Project/taskManagementGolang/internal/task/domain"
+)
+
+func TestNewComponent(t *testing.T) {
+	tests := []struct {
+		value string
+		want  Component
+	}{
+		{value: "", want: ComponentEvent},
+		{value: "event", want: ComponentEvent},
+		{value: "TODO", want: ComponentTodo},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := NewComponent(tt.value)
+			if err != nil || got != tt.want {
+				t.Errorf("NewComponent(%q) = %q, %v, want %q", tt.value, got, err, tt.want)
+			}
+		})
+	}
+
+	t.Run("when component is unknown, Then it returns ErrInvalidComponent", func(t *testing.T) {
+		if _, err := NewComponent("journal"); !errors.Is(err, ErrInvalidComponent) {
+			t.Errorf("NewComponent() error = %v, want ErrInvalidComponent", err)
+		}
+	})
+}
+
+func TestWriteCalendar(t *testing.T) {
+	created := time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC)
+	newTask := func(id string, target time.Time) taskDomain.Task {
+		return taskDomain.Task{
+			TaskId:     id,
+			TaskName:   "Buy milk",
+			TargetDate: target,
+			Priority:   taskDomain.PriorityHigh,
+			CreatedAt:  created,
+			UpdatedAt:  created,
+		}
+	}
+	write := func(t *testing.T, component Component, tasks ...taskDomain.Task) string {
+		t.Helper()
+		var buf bytes.Buffer
+		if err := WriteCalendar(&buf, "Groceries", component, tasks); err != nil {
+			t.Fatalf("WriteCalendar() error = %v", err)
+		}
+		return buf.String()
+	}
+
+	t.Run("when a task has a time, Then it starts an event at that time", func(t *testing.T) {
+		// Act
+		got := write(t, ComponentEvent, newTask("t1", time.Date(2025, 3, 14, 17, 30, 0, 0, time.UTC)))
+
+		// Assert
+		for _, want := range []string{
+			"BEGIN:VCALENDAR\r\n", "X-WR-CALNAME:Groceries\r\n",
+			"BEGIN:VEVENT\r\n", "UID:t1@tasks\r\n", "SUMMARY:Buy milk\r\n", "PRIORITY:3\r\n",
+			"DTSTART:20250314T173000Z\r\n", "TRANSP:TRANSPARENT\r\n", "END:VEVENT\r\n", "END:VCALENDAR\r\n",
+		} {
+			if !strings.Contains(got, want) {
+				t.Errorf("calendar does not contain %q:\n%s", want, got)
+			}
+		}
+	})
+
+	t.Run("when a task is due at midnight UTC, Then it is an all-day to-do", func(t *testing.T) {
+		// Arrange
+		task := newTask("t1", time.Date(2025, 3, 14, 0, 0, 0, 0, time.UTC))
+		task.IsCompleted = true
+		task.RecurrenceRule = "RRULE:FREQ=WEEKLY"
+
+		// Act
+		got := write(t, ComponentTodo, task)
+
+		// Assert
+		for _, want := range []string{"BEGIN:VTODO\r\n", "DUE;VALUE=DATE:20250314\r\n", "STATUS:COMPLETED\r\n", "RRULE:FREQ=WEEKLY\r\n"} {
+			if !strings.Contains(got, want) {
+				t.Errorf("calendar does not contain %q:\n%s", want, got)
+			}
+		}
+	})
+
+	t.Run("when a task has no target date, Then it is left out", func(t *testing.T) {
+		// Act
+		got := write(t, ComponentEvent, newTask("t1", time.Time{}), newTask("t2", created))
+
+		// Assert
+		if strings.Contains(got, "UID:t1@tasks") || !strings.Contains(got, "UID:t2@tasks") {
+			t.Errorf("calendar should only contain t2:\n%s", got)
+		}
+	})
+
+	t.Run("when text has reserved characters, Then they are escaped", func(t *testing.T) {
+		// Arrange
+		task := newTask("t1", created)
+		task.TaskName = `Milk, eggs; bread\jam`
+		task.TaskDesc = "first line\nsecond line"
+
+		// Act
+		got := write(t, ComponentEvent, task)
+
+		// Assert
+		for _, want := range []string{`SUMMARY:Milk\, eggs\; bread\\jam`, `DESCRIPTION:first line\nsecond line`} {
+			if !strings.Contains(got, want) {
+				t.Errorf("calendar does not contain %q:\n%s", want, got)
+			}
+		}
+	})
+
+	t.Run("when a line is longer than 75 octets, Then it is folded between characters", func(t *testing.T) {
+		// Arrange
+		task := newTask("t1", created)
+		task.TaskName = strings.Repeat("é", 60)
+
+		// Act
+		got := write(t, ComponentEvent, task)
+
+		// Assert
+		for _, line := range strings.Split(got, "\r\n") {
+			if len(line) > 75 {
+				t.Errorf("line has %d octets: %q", len(line), line)
+			}
+			if !utf8.ValidString(line) {
+				t.Errorf("line splits a character: %q", line)
+			}
+		}
+		unfolded := strings.ReplaceAll(got, "\r\n ", "")
+		if !strings.Contains(unfolded, "SUMMARY:"+task.TaskName+"\r\n") {
+			t.Errorf("unfolded calendar does not contain the summary:\n%s", got)
+		}
+	})
+}
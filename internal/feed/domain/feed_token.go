@@ -0,0 +1,39 @@
+package domain
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+)
+
+var (
+	ErrFeedTokenNotFound = errors.New("user has no feed token")
+	ErrInvalidFeedToken  = errors.New("feed token is invalid or has been revoked")
+)
+
+// FeedToken lets calendar clients, which cannot send a JWT, read the task feeds of a user.
+// A user has at most one; issuing a new one revokes the previous. Only a hash of the token is stored.
+type FeedToken struct {
+	UserId    string    `json:"user_id"`
+	TokenHash string    `json:"-"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// NewFeedToken issues a feed token for the user and returns it with the token itself, which is shown only once
+func NewFeedToken(userId string) (*FeedToken, string, error) {
+	raw := make([]byte, 32)
+	if _, err := rand.Read(raw); err != nil {
+		return nil, "", fmt.Errorf("unable to generate feed token: %w", err)
+	}
+	token := hex.EncodeToString(raw)
+	return &FeedToken{UserId: userId, TokenHash: HashFeedToken(token), CreatedAt: time.Now().UTC()}, token, nil
+}
+
+// HashFeedToken returns the stored form of a token, under which it is looked up
+func HashFeedToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
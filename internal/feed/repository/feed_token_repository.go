@@ -0,0 +1,89 @@
+package repository
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/happYness-Project/taskManagementGolang/internal/feed/domain"
+	"github.com/happYness-Project/taskManagementGolang/pkg/dbs"
+)
+
+type FeedTokenRepository interface {
+	// SaveToken stores the token of its user, replacing the previous one
+	SaveToken(token domain.FeedToken) error
+	// GetByUserId returns nil when the user has no token
+	GetByUserId(userId string) (*domain.FeedToken, error)
+	// GetByHash returns nil when no active user holds a token with the hash
+	GetByHash(tokenHash string) (*domain.FeedToken, error)
+	DeleteToken(userId string) error
+	WithTx(tx dbs.DBTX) FeedTokenRepository
+}
+
+type FeedTokenRepo struct {
+	DB dbs.DBTX
+}
+
+func NewFeedTokenRepository(db dbs.DBTX) *FeedTokenRepo {
+	return &FeedTokenRepo{DB: db}
+}
+
+// WithTx returns a copy of the repository bound to the given transaction
+func (m *FeedTokenRepo) WithTx(tx dbs.DBTX) FeedTokenRepository {
+	return &FeedTokenRepo{DB: tx}
+}
+
+func (m *FeedTokenRepo) SaveToken(t domain.FeedToken) error {
+	result, err := m.DB.Exec(sqlSaveFeedToken, t.UserId, t.TokenHash, t.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("unable to save feed token : %w", err)
+	}
+	if rows, err := result.RowsAffected(); err == nil && rows == 0 {
+		return fmt.Errorf("unable to save feed token : user %s does not exist", t.UserId)
+	}
+	return nil
+}
+
+func (m *FeedTokenRepo) GetByUserId(userId string) (*domain.FeedToken, error) {
+	return m.getToken(sqlGetFeedTokenByUserId, userId)
+}
+
+func (m *FeedTokenRepo) GetByHash(tokenHash string) (*domain.FeedToken, error) {
+	return m.getToken(sqlGetFeedTokenByHash, tokenHash)
+}
+
+func (m *FeedTokenRepo) DeleteToken(userId string) error {
+	result, err := m.DB.Exec(sqlDeleteFeedToken, userId)
+	if err != nil {
+		return fmt.Errorf("unable to delete feed token : %w", err)
+	}
+	if rows, err := result.RowsAffected(); err == nil && rows == 0 {
+		return domain.ErrFeedTokenNotFound
+	}
+	return nil
+}
+
+func (m *FeedTokenRepo) getToken(query string, arg string) (*domain.FeedToken, error) {
+	rows, err := m.DB.Query(query, arg)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	if !rows.Next() {
+		return nil, rows.Err()
+	}
+	return scanRowsIntoFeedToken(rows)
+}
+
+func scanRowsIntoFeedToken(rows *sql.Rows) (*domain.FeedToken, error) {
+	token := new(domain.FeedToken)
+	err := rows.Scan(
+		&token.UserId,
+		&token.TokenHash,
+		&token.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return token, nil
+}
@@ -0,0 +1,16 @@
+package repository
+
+const (
+	// Tokens are stored by the user's internal id; issuing a token replaces the previous one of the user,
+	// and nothing is stored when the user does not exist
+	sqlSaveFeedToken = `INSERT INTO container.feed_token(user_id, token_hash, created_at)
+						SELECT u.id, $2, $3 FROM container.user u WHERE u.user_id = $1
+						ON CONFLICT (user_id) DO UPDATE SET token_hash = EXCLUDED.token_hash, created_at = EXCLUDED.created_at`
+	sqlGetFeedTokenByUserId = `SELECT u.user_id, f.token_hash, f.created_at FROM container.feed_token f
+						INNER JOIN container.user u ON u.id = f.user_id
+						WHERE u.user_id = $1`
+	sqlGetFeedTokenByHash = `SELECT u.user_id, f.token_hash, f.created_at FROM container.feed_token f
+						INNER JOIN container.user u ON u.id = f.user_id
+						WHERE f.token_hash = $1 AND u.is_active`
+	sqlDeleteFeedToken = `DELETE FROM container.feed_token f USING container.user u WHERE u.id = f.user_id AND u.user_id = $1`
+)
@@ -0,0 +1,12 @@
+package route
+
+const prefix = "feeds_"
+
+const (
+	FeedTokenNotFound     = prefix + "token_not_found"
+	FeedTokenServerError  = prefix + "token_server_error"
+	FeedInvalidToken      = prefix + "invalid_token"
+	FeedInvalidComponent  = prefix + "invalid_component"
+	FeedContainerNotFound = prefix + "container_not_found"
+	FeedServerError       = prefix + "server_error"
+)
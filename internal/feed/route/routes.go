@@ -0,0 +1,161 @@
+package route
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	auditApp "github.com/happYness-Project/taskManagementGolang/internal/audit/application"
+	"github.com/happYness-Project/taskManagementGolang/internal/authorization"
+	"github.com/happYness-Project/taskManagementGolang/internal/feed/application"
+	"github.com/happYness-Project/taskManagementGolang/internal/feed/application/command"
+	"github.com/happYness-Project/taskManagementGolang/internal/feed/application/query"
+	"github.com/happYness-Project/taskManagementGolang/internal/feed/domain"
+	"github.com/happYness-Project/taskManagementGolang/internal/feed/repository"
+	taskApp "github.com/happYness-Project/taskManagementGolang/internal/task/application"
+	taskRepo "github.com/happYness-Project/taskManagementGolang/internal/task/repository"
+	containerRepo "github.com/happYness-Project/taskManagementGolang/internal/taskcontainer/repository"
+	"github.com/happYness-Project/taskManagementGolang/pkg/constants"
+	"github.com/happYness-Project/taskManagementGolang/pkg/dbs"
+	"github.com/happYness-Project/taskManagementGolang/pkg/loggers"
+	"github.com/happYness-Project/taskManagementGolang/pkg/response"
+)
+
+const contentTypeCalendar = "text/calendar; charset=utf-8"
+
+type Handler struct {
+	logger     *loggers.AppLogger
+	commandBus *application.CommandBus
+	queryBus   *application.QueryBus
+}
+
+func NewHandler(logger *loggers.AppLogger, tokenRepo repository.FeedTokenRepository, taskRepo taskRepo.TaskRepository, containerRepo containerRepo.ContainerRepository, policy *authorization.Policy, uow dbs.UnitOfWork, recorder *auditApp.Recorder) *Handler {
+	return &Handler{
+		logger:     logger,
+		commandBus: application.NewCommandBus(tokenRepo, policy, uow, recorder),
+		queryBus:   application.NewQueryBus(tokenRepo, containerRepo, taskApp.NewQueryBus(taskRepo, containerRepo, policy)),
+	}
+}
+
+// RegisterRoutes registers the feed token endpoints, which need a JWT
+func (h *Handler) RegisterRoutes(router chi.Router) {
+	router.Post("/api/users/{userID}/feed-token", h.handleIssueFeedToken)
+	router.Delete("/api/users/{userID}/feed-token", h.handleRevokeFeedToken)
+}
+
+// RegisterFeedRoutes registers the calendar feeds. Calendar clients cannot send a JWT, so the feeds are
+// authenticated by the feed token in their URL and must be registered outside the JWT-protected routes.
+func (h *Handler) RegisterFeedRoutes(router chi.Router) {
+	router.Get("/api/task-containers/{containerID}/tasks.ics", h.handleGetContainerFeed)
+	router.Get("/api/users/{userID}/tasks.ics", h.handleGetUserFeed)
+}
+
+func (h *Handler) handleIssueFeedToken(w http.ResponseWriter, r *http.Request) {
+	// Use Command Bus
+	result, err := h.commandBus.Execute(r.Context(), command.IssueFeedTokenCommand{
+		UserId:      chi.URLParam(r, "userID"),
+		RequesterId: authorization.RequesterId(r),
+	})
+	if authorization.IsForbiddenError(err) {
+		h.logger.Error().Err(err).Str("ErrorCode", constants.PermissionDenied).Msg(err.Error())
+		response.Forbidden(w, constants.PermissionDenied, err.Error())
+		return
+	}
+	if err != nil {
+		h.logger.Error().Err(err).Str("ErrorCode", FeedTokenServerError).Msg(err.Error())
+		response.InternalServerError(w, "Error occurred during issuing feed token")
+		return
+	}
+	response.WriteJsonWithEncode(w, http.StatusCreated, result)
+}
+
+func (h *Handler) handleRevokeFeedToken(w http.ResponseWriter, r *http.Request) {
+	// Use Command Bus
+	_, err := h.commandBus.Execute(r.Context(), command.RevokeFeedTokenCommand{
+		UserId:      chi.URLParam(r, "userID"),
+		RequesterId: authorization.RequesterId(r),
+	})
+	if authorization.IsForbiddenError(err) {
+		h.logger.Error().Err(err).Str("ErrorCode", constants.PermissionDenied).Msg(err.Error())
+		response.Forbidden(w, constants.PermissionDenied, err.Error())
+		return
+	}
+	if errors.Is(err, domain.ErrFeedTokenNotFound) {
+		h.logger.Error().Err(err).Str("ErrorCode", FeedTokenNotFound).Msg(err.Error())
+		response.NotFound(w, FeedTokenNotFound, err.Error())
+		return
+	}
+	if err != nil {
+		h.logger.Error().Err(err).Str("ErrorCode", FeedTokenServerError).Msg(err.Error())
+		response.InternalServerError(w, "Error occurred during revoking feed token")
+		return
+	}
+	response.WriteJsonWithEncode(w, http.StatusNoContent, "feed token is revoked.")
+}
+
+func (h *Handler) handleGetContainerFeed(w http.ResponseWriter, r *http.Request) {
+	component, ok := h.component(w, r)
+	if !ok {
+		return
+	}
+	// Use Query Bus
+	result, err := h.queryBus.Execute(query.GetContainerFeedQuery{
+		ContainerId: chi.URLParam(r, "containerID"),
+		Token:       r.URL.Query().Get("token"),
+	})
+	h.writeFeed(w, result, component, err)
+}
+
+func (h *Handler) handleGetUserFeed(w http.ResponseWriter, r *http.Request) {
+	component, ok := h.component(w, r)
+	if !ok {
+		return
+	}
+	// Use Query Bus
+	result, err := h.queryBus.Execute(query.GetUserFeedQuery{
+		UserId: chi.URLParam(r, "userID"),
+		Token:  r.URL.Query().Get("token"),
+	})
+	h.writeFeed(w, result, component, err)
+}
+
+// component reads the ?component= parameter, answering 400 when it is unknown
+func (h *Handler) component(w http.ResponseWriter, r *http.Request) (domain.Component, bool) {
+	component, err := domain.NewComponent(r.URL.Query().Get("component"))
+	if err != nil {
+		h.logger.Error().Err(err).Str("ErrorCode", FeedInvalidComponent).Msg(err.Error())
+		response.ErrorResponse(w, http.StatusBadRequest, *(response.New(FeedInvalidComponent, "Invalid component", err.Error())))
+		return "", false
+	}
+	return component, true
+}
+
+// writeFeed answers the feed as an iCalendar document, or 401, 403 or 404 for the errors every feed shares
+func (h *Handler) writeFeed(w http.ResponseWriter, result interface{}, component domain.Component, err error) {
+	switch {
+	case errors.Is(err, domain.ErrInvalidFeedToken):
+		h.logger.Error().Err(err).Str("ErrorCode", FeedInvalidToken).Msg(err.Error())
+		response.ErrorResponse(w, http.StatusUnauthorized, *(response.New(FeedInvalidToken, "Invalid feed token", err.Error())))
+		return
+	case authorization.IsForbiddenError(err):
+		h.logger.Error().Err(err).Str("ErrorCode", constants.PermissionDenied).Msg(err.Error())
+		response.Forbidden(w, constants.PermissionDenied, err.Error())
+		return
+	case errors.Is(err, query.ErrContainerNotFound):
+		h.logger.Error().Err(err).Str("ErrorCode", FeedContainerNotFound).Msg(err.Error())
+		response.NotFound(w, FeedContainerNotFound, err.Error())
+		return
+	case err != nil:
+		h.logger.Error().Err(err).Str("ErrorCode", FeedServerError).Msg(err.Error())
+		response.InternalServerError(w, "Error occurred during getting feed")
+		return
+	}
+
+	feed := result.(*query.Feed)
+	w.Header().Set("Content-Type", contentTypeCalendar)
+	w.Header().Set("Cache-Control", "private, no-cache")
+	w.Header().Set("Content-Disposition", `inline; filename="tasks.ics"`)
+	if err := domain.WriteCalendar(w, feed.Name, component, feed.Tasks); err != nil {
+		h.logger.Error().Err(err).Str("ErrorCode", FeedServerError).Msg("Error occurred during writing feed")
+	}
+}
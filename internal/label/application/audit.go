@@ -0,0 +1,41 @@
+package application
+
+import (
+	auditApp "github.com/happYness-Project/taskManagementGolang/internal/audit/application"
+	auditDomain "github.com/happYness-Project/taskManagementGolang/internal/audit/domain"
+	cmd "github.com/happYness-Project/taskManagementGolang/internal/label/application/command"
+	"github.com/happYness-Project/taskManagementGolang/internal/label/domain"
+)
+
+// auditSubject describes the label a command mutates for the activity log
+func (bus *CommandBus) auditSubject(command interface{}) auditApp.Subject {
+	subject := auditApp.Subject{
+		Action:        auditApp.ActionOf(command),
+		AggregateType: auditDomain.AggregateLabel,
+		Load:          bus.loadLabel,
+	}
+
+	switch c := command.(type) {
+	case cmd.CreateLabelCommand:
+		subject.ActorId, subject.GroupId = c.RequesterId, c.GroupId
+		subject.Resolve = func(result interface{}) (string, int) {
+			if created, ok := result.(*domain.Label); ok {
+				return created.Id, c.GroupId
+			}
+			return "", c.GroupId
+		}
+	case cmd.UpdateLabelCommand:
+		subject.ActorId, subject.GroupId, subject.AggregateId = c.RequesterId, c.GroupId, c.LabelId
+	case cmd.DeleteLabelCommand:
+		subject.ActorId, subject.GroupId, subject.AggregateId = c.RequesterId, c.GroupId, c.LabelId
+	}
+	return subject
+}
+
+func (bus *CommandBus) loadLabel(labelId string) (interface{}, error) {
+	label, err := bus.labelRepo.GetById(labelId)
+	if err != nil || label == nil {
+		return nil, err
+	}
+	return label, nil
+}
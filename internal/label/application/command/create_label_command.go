@@ -0,0 +1,61 @@
+package command
+
+import (
+	"fmt"
+
+	"github.com/happYness-Project/taskManagementGolang/internal/label/domain"
+	"github.com/happYness-Project/taskManagementGolang/internal/label/repository"
+	"github.com/happYness-Project/taskManagementGolang/pkg/dbs"
+)
+
+type CreateLabelCommand struct {
+	GroupId     int
+	Name        string
+	Color       string
+	RequesterId string // UUID from JWT
+}
+
+type CreateLabelCommandHandler struct {
+	labelRepo repository.LabelRepository
+	uow       dbs.UnitOfWork
+}
+
+func NewCreateLabelCommandHandler(labelRepo repository.LabelRepository, uow dbs.UnitOfWork) *CreateLabelCommandHandler {
+	return &CreateLabelCommandHandler{labelRepo: labelRepo, uow: uow}
+}
+
+func (h *CreateLabelCommandHandler) Handle(cmd CreateLabelCommand) (*domain.Label, error) {
+	label, err := domain.NewLabel(cmd.GroupId, cmd.Name, cmd.Color)
+	if err != nil {
+		return nil, err
+	}
+
+	err = h.uow.Do(func(tx dbs.DBTX) error {
+		labelRepo := h.labelRepo.WithTx(tx)
+		if err := requireUniqueName(labelRepo, *label); err != nil {
+			return err
+		}
+		if err := labelRepo.CreateLabel(*label); err != nil {
+			return fmt.Errorf("failed to persist label: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return label, nil
+}
+
+// requireUniqueName checks that no other label of the group has the label's name
+func requireUniqueName(labelRepo repository.LabelRepository, label domain.Label) error {
+	labels, err := labelRepo.GetByGroupId(label.UsergroupId)
+	if err != nil {
+		return fmt.Errorf("failed to retrieve labels: %w", err)
+	}
+	for _, other := range labels {
+		if other.Id != label.Id && other.HasName(label.Name) {
+			return fmt.Errorf("%w: %q", domain.ErrDuplicateLabel, label.Name)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,33 @@
+package command
+
+import (
+	"github.com/happYness-Project/taskManagementGolang/internal/label/repository"
+	"github.com/happYness-Project/taskManagementGolang/pkg/dbs"
+)
+
+// DeleteLabelCommand removes a label from the group and from every task carrying it
+type DeleteLabelCommand struct {
+	GroupId     int
+	LabelId     string
+	RequesterId string // UUID from JWT
+}
+
+type DeleteLabelCommandHandler struct {
+	labelRepo repository.LabelRepository
+	uow       dbs.UnitOfWork
+}
+
+func NewDeleteLabelCommandHandler(labelRepo repository.LabelRepository, uow dbs.UnitOfWork) *DeleteLabelCommandHandler {
+	return &DeleteLabelCommandHandler{labelRepo: labelRepo, uow: uow}
+}
+
+func (h *DeleteLabelCommandHandler) Handle(cmd DeleteLabelCommand) error {
+	return h.uow.Do(func(tx dbs.DBTX) error {
+		labelRepo := h.labelRepo.WithTx(tx)
+
+		if _, err := getGroupLabel(labelRepo, cmd.GroupId, cmd.LabelId); err != nil {
+			return err
+		}
+		return labelRepo.DeleteLabel(cmd.LabelId)
+	})
+}
@@ -0,0 +1,63 @@
+package command
+
+import (
+	"fmt"
+
+	"github.com/happYness-Project/taskManagementGolang/internal/label/domain"
+	"github.com/happYness-Project/taskManagementGolang/internal/label/repository"
+	"github.com/happYness-Project/taskManagementGolang/pkg/dbs"
+)
+
+// UpdateLabelCommand renames and recolours a label; the tasks carrying it follow
+type UpdateLabelCommand struct {
+	GroupId     int
+	LabelId     string
+	Name        string
+	Color       string
+	RequesterId string // UUID from JWT
+}
+
+type UpdateLabelCommandHandler struct {
+	labelRepo repository.LabelRepository
+	uow       dbs.UnitOfWork
+}
+
+func NewUpdateLabelCommandHandler(labelRepo repository.LabelRepository, uow dbs.UnitOfWork) *UpdateLabelCommandHandler {
+	return &UpdateLabelCommandHandler{labelRepo: labelRepo, uow: uow}
+}
+
+func (h *UpdateLabelCommandHandler) Handle(cmd UpdateLabelCommand) (*domain.Label, error) {
+	var label *domain.Label
+	err := h.uow.Do(func(tx dbs.DBTX) error {
+		labelRepo := h.labelRepo.WithTx(tx)
+
+		var err error
+		label, err = getGroupLabel(labelRepo, cmd.GroupId, cmd.LabelId)
+		if err != nil {
+			return err
+		}
+		if err := label.Update(cmd.Name, cmd.Color); err != nil {
+			return err
+		}
+		if err := requireUniqueName(labelRepo, *label); err != nil {
+			return err
+		}
+		return labelRepo.UpdateLabel(*label)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return label, nil
+}
+
+// getGroupLabel hides labels of other groups as if they did not exist
+func getGroupLabel(labelRepo repository.LabelRepository, groupId int, labelId string) (*domain.Label, error) {
+	label, err := labelRepo.GetById(labelId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get label: %w", err)
+	}
+	if label == nil || !label.BelongsTo(groupId) {
+		return nil, domain.ErrLabelNotFound
+	}
+	return label, nil
+}
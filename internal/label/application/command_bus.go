@@ -0,0 +1,96 @@
+package application
+
+import (
+	"context"
+	"fmt"
+
+	auditApp "github.com/happYness-Project/taskManagementGolang/internal/audit/application"
+	"github.com/happYness-Project/taskManagementGolang/internal/authorization"
+	cmd "github.com/happYness-Project/taskManagementGolang/internal/label/application/command"
+	"github.com/happYness-Project/taskManagementGolang/internal/label/repository"
+	"github.com/happYness-Project/taskManagementGolang/pkg/dbs"
+)
+
+// CommandBus routes commands to their handlers
+type CommandBus struct {
+	createLabelHandler *cmd.CreateLabelCommandHandler
+	updateLabelHandler *cmd.UpdateLabelCommandHandler
+	deleteLabelHandler *cmd.DeleteLabelCommandHandler
+
+	labelRepo repository.LabelRepository
+	policy    *authorization.Policy
+	uow       dbs.UnitOfWork
+	recorder  *auditApp.Recorder
+}
+
+// NewCommandBus creates a new command bus with all handlers registered
+func NewCommandBus(
+	labelRepo repository.LabelRepository,
+	policy *authorization.Policy,
+	uow dbs.UnitOfWork,
+	recorder *auditApp.Recorder,
+) *CommandBus {
+	return &CommandBus{
+		createLabelHandler: cmd.NewCreateLabelCommandHandler(labelRepo, uow),
+		updateLabelHandler: cmd.NewUpdateLabelCommandHandler(labelRepo, uow),
+		deleteLabelHandler: cmd.NewDeleteLabelCommandHandler(labelRepo, uow),
+		labelRepo:          labelRepo,
+		policy:             policy,
+		uow:                uow,
+		recorder:           recorder,
+	}
+}
+
+// withTx returns a bus whose handlers and activity log run inside the given transaction.
+// The handlers' own units of work become savepoints of it.
+func (bus *CommandBus) withTx(tx dbs.DBTX) *CommandBus {
+	return NewCommandBus(bus.labelRepo.WithTx(tx), bus.policy, dbs.NewUnitOfWork(tx), bus.recorder.WithTx(tx))
+}
+
+// Execute authorizes the command, then dispatches it to the appropriate handler and records it in the activity log
+// in one transaction.
+// ctx carries the request id of the HTTP request that issued the command.
+func (bus *CommandBus) Execute(ctx context.Context, command interface{}) (interface{}, error) {
+	if err := bus.authorize(command); err != nil {
+		return nil, err
+	}
+
+	var result interface{}
+	err := bus.uow.Do(func(tx dbs.DBTX) error {
+		txBus := bus.withTx(tx)
+		var err error
+		result, err = txBus.recorder.Track(ctx, txBus.auditSubject(command), func() (interface{}, error) {
+			return txBus.dispatch(command)
+		})
+		return err
+	})
+	return result, err
+}
+
+// dispatch routes the command to its handler
+func (bus *CommandBus) dispatch(command interface{}) (interface{}, error) {
+	switch c := command.(type) {
+	case cmd.CreateLabelCommand:
+		return bus.createLabelHandler.Handle(c)
+	case cmd.UpdateLabelCommand:
+		return bus.updateLabelHandler.Handle(c)
+	case cmd.DeleteLabelCommand:
+		return nil, bus.deleteLabelHandler.Handle(c)
+	default:
+		return nil, fmt.Errorf("unknown command type: %T", command)
+	}
+}
+
+// authorize checks that the requester is a member of the group; every member manages its labels
+func (bus *CommandBus) authorize(command interface{}) error {
+	switch c := command.(type) {
+	case cmd.CreateLabelCommand:
+		return bus.policy.RequireMember(c.RequesterId, c.GroupId)
+	case cmd.UpdateLabelCommand:
+		return bus.policy.RequireMember(c.RequesterId, c.GroupId)
+	case cmd.DeleteLabelCommand:
+		return bus.policy.RequireMember(c.RequesterId, c.GroupId)
+	default:
+		return nil
+	}
+}
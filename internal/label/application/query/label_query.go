@@ -0,0 +1,49 @@
+package query
+
+import (
+	"fmt"
+
+	"github.com/happYness-Project/taskManagementGolang/internal/label/domain"
+	"github.com/happYness-Project/taskManagementGolang/internal/label/repository"
+)
+
+// GetGroupLabelsQuery lists the labels of a group by name
+type GetGroupLabelsQuery struct {
+	GroupId     int
+	RequesterId string // UUID from JWT
+}
+
+// GetLabelQuery reads one label of a group
+type GetLabelQuery struct {
+	GroupId     int
+	LabelId     string
+	RequesterId string // UUID from JWT
+}
+
+// LabelQueryHandler handles all read operations for labels
+type LabelQueryHandler struct {
+	labelRepo repository.LabelRepository
+}
+
+func NewLabelQueryHandler(labelRepo repository.LabelRepository) *LabelQueryHandler {
+	return &LabelQueryHandler{labelRepo: labelRepo}
+}
+
+func (h *LabelQueryHandler) HandleGetGroupLabels(query GetGroupLabelsQuery) ([]domain.Label, error) {
+	labels, err := h.labelRepo.GetByGroupId(query.GroupId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve labels: %w", err)
+	}
+	return labels, nil
+}
+
+func (h *LabelQueryHandler) HandleGetLabel(query GetLabelQuery) (*domain.Label, error) {
+	label, err := h.labelRepo.GetById(query.LabelId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve label: %w", err)
+	}
+	if label == nil || !label.BelongsTo(query.GroupId) {
+		return nil, domain.ErrLabelNotFound
+	}
+	return label, nil
+}
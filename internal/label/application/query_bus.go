@@ -0,0 +1,52 @@
+package application
+
+import (
+	"fmt"
+
+	"github.com/happYness-Project/taskManagementGolang/internal/authorization"
+	qry "github.com/happYness-Project/taskManagementGolang/internal/label/application/query"
+	"github.com/happYness-Project/taskManagementGolang/internal/label/repository"
+)
+
+// QueryBus routes queries to their handlers
+type QueryBus struct {
+	queryHandler *qry.LabelQueryHandler
+
+	policy *authorization.Policy
+}
+
+// NewQueryBus creates a new query bus with all handlers registered
+func NewQueryBus(labelRepo repository.LabelRepository, policy *authorization.Policy) *QueryBus {
+	return &QueryBus{
+		queryHandler: qry.NewLabelQueryHandler(labelRepo),
+		policy:       policy,
+	}
+}
+
+// Execute dispatches the query to the appropriate handler
+func (bus *QueryBus) Execute(query interface{}) (interface{}, error) {
+	if err := bus.authorize(query); err != nil {
+		return nil, err
+	}
+
+	switch q := query.(type) {
+	case qry.GetGroupLabelsQuery:
+		return bus.queryHandler.HandleGetGroupLabels(q)
+	case qry.GetLabelQuery:
+		return bus.queryHandler.HandleGetLabel(q)
+	default:
+		return nil, fmt.Errorf("unknown query type: %T", query)
+	}
+}
+
+// authorize checks that the requester is a member of the group owning the labels
+func (bus *QueryBus) authorize(query interface{}) error {
+	switch q := query.(type) {
+	case qry.GetGroupLabelsQuery:
+		return bus.policy.RequireMember(q.RequesterId, q.GroupId)
+	case qry.GetLabelQuery:
+		return bus.policy.RequireMember(q.RequesterId, q.GroupId)
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,93 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	taskDomain "github.com/happYness-Project/taskManagementGolang/internal/task/domain"
+)
+
+const (
+	maxLabelNameLength = 50
+	// DefaultColor is given to labels created without a colour
+	DefaultColor = "#808080"
+)
+
+var (
+	ErrInvalidLabel   = errors.New("invalid label")
+	ErrLabelNotFound  = errors.New("label not found")
+	ErrDuplicateLabel = errors.New("a label with this name already exists in the group")
+)
+
+var colorPattern = regexp.MustCompile(`^#[0-9a-f]{6}$`)
+
+// Label tags tasks of one group, e.g. "grocery" in green. Names are unique within the group regardless of case.
+type Label struct {
+	Id          string    `json:"id"`
+	UsergroupId int       `json:"usergroup_id"`
+	Name        string    `json:"name"`
+	Color       string    `json:"color"` // #rrggbb
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+func NewLabel(usergroupId int, name string, color string) (*Label, error) {
+	now := time.Now().UTC()
+	label := &Label{
+		Id:          uuid.New().String(),
+		UsergroupId: usergroupId,
+		CreatedAt:   now,
+	}
+	if err := label.Update(name, color); err != nil {
+		return nil, err
+	}
+	return label, nil
+}
+
+// Update renames and recolours the label; an empty colour falls back to DefaultColor
+func (l *Label) Update(name string, color string) error {
+	name = strings.TrimSpace(name)
+	if name == "" || len(name) > maxLabelNameLength {
+		return fmt.Errorf("%w: name must be between 1 and %d characters", ErrInvalidLabel, maxLabelNameLength)
+	}
+	color = strings.ToLower(strings.TrimSpace(color))
+	if color == "" {
+		color = DefaultColor
+	}
+	if !colorPattern.MatchString(color) {
+		return fmt.Errorf("%w: color must look like #4caf50, got %q", ErrInvalidLabel, color)
+	}
+
+	l.Name = name
+	l.Color = color
+	l.UpdatedAt = time.Now().UTC()
+	return nil
+}
+
+// BelongsTo reports whether the label is one of the group's
+func (l *Label) BelongsTo(usergroupId int) bool {
+	return l.UsergroupId == usergroupId
+}
+
+// HasName reports whether the label is named name, ignoring case
+func (l *Label) HasName(name string) bool {
+	return strings.EqualFold(l.Name, strings.TrimSpace(name))
+}
+
+// TaskLabels returns the labels as they are attached to tasks
+func TaskLabels(labels []Label) []taskDomain.Label {
+	taskLabels := make([]taskDomain.Label, len(labels))
+	for i, label := range labels {
+		taskLabels[i] = label.TaskLabel()
+	}
+	return taskLabels
+}
+
+// TaskLabel returns the label as it is attached to tasks
+func (l *Label) TaskLabel() taskDomain.Label {
+	return taskDomain.Label{Id: l.Id, Name: l.Name, Color: l.Color}
+}
@@ -0,0 +1,62 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestNewLabel(t *testing.T) {
+	t.Run("when name and colour are valid, Then label is trimmed and the colour lowercased", func(t *testing.T) {
+		label, err := NewLabel(3, "  Grocery ", "#4CAF50")
+		if err != nil {
+			t.Fatalf("NewLabel() unexpected error: %v", err)
+		}
+
+		if label.Name != "Grocery" || label.Color != "#4caf50" || !label.BelongsTo(3) {
+			t.Errorf("unexpected label: %+v", label)
+		}
+		if !label.HasName("grocery ") || label.HasName("groceries") {
+			t.Errorf("HasName() does not ignore case and whitespace only")
+		}
+	})
+
+	t.Run("when colour is empty, Then DefaultColor is used", func(t *testing.T) {
+		label, err := NewLabel(3, "Chores", "")
+		if err != nil {
+			t.Fatalf("NewLabel() unexpected error: %v", err)
+		}
+
+		if label.Color != DefaultColor {
+			t.Errorf("Color = %q, want %q", label.Color, DefaultColor)
+		}
+	})
+
+	invalid := []struct {
+		name      string
+		labelName string
+		color     string
+	}{
+		{"name is blank", "  ", "#ffffff"},
+		{"name is too long", strings.Repeat("a", maxLabelNameLength+1), "#ffffff"},
+		{"colour is a name", "Chores", "red"},
+		{"colour is short", "Chores", "#fff"},
+	}
+	for _, tt := range invalid {
+		t.Run("when "+tt.name+", Then ErrInvalidLabel is returned", func(t *testing.T) {
+			if _, err := NewLabel(3, tt.labelName, tt.color); !errors.Is(err, ErrInvalidLabel) {
+				t.Errorf("NewLabel() error = %v, want ErrInvalidLabel", err)
+			}
+		})
+	}
+}
+
+func TestTaskLabels(t *testing.T) {
+	labels := []Label{{Id: "label-1", UsergroupId: 3, Name: "Grocery", Color: "#4caf50"}}
+
+	taskLabels := TaskLabels(labels)
+
+	if len(taskLabels) != 1 || taskLabels[0].Id != "label-1" || taskLabels[0].Name != "Grocery" || taskLabels[0].Color != "#4caf50" {
+		t.Errorf("TaskLabels() = %+v, want the label's id, name and colour", taskLabels)
+	}
+}
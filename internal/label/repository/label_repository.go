@@ -0,0 +1,110 @@
+package repository
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/happYness-Project/taskManagementGolang/internal/label/domain"
+	"github.com/happYness-Project/taskManagementGolang/pkg/dbs"
+)
+
+type LabelRepository interface {
+	CreateLabel(label domain.Label) error
+	// GetById returns nil when the label does not exist
+	GetById(id string) (*domain.Label, error)
+	GetByGroupId(groupId int) ([]domain.Label, error)
+	UpdateLabel(label domain.Label) error
+	// DeleteLabel removes the label from every task carrying it
+	DeleteLabel(id string) error
+	WithTx(tx dbs.DBTX) LabelRepository
+}
+
+type LabelRepo struct {
+	DB dbs.DBTX
+}
+
+func NewLabelRepository(db dbs.DBTX) *LabelRepo {
+	return &LabelRepo{DB: db}
+}
+
+// WithTx returns a copy of the repository bound to the given transaction
+func (m *LabelRepo) WithTx(tx dbs.DBTX) LabelRepository {
+	return &LabelRepo{DB: tx}
+}
+
+func (m *LabelRepo) CreateLabel(l domain.Label) error {
+	_, err := m.DB.Exec(sqlCreateLabel, l.Id, l.UsergroupId, l.Name, l.Color, l.CreatedAt, l.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("unable to insert into label table : %w", err)
+	}
+	return nil
+}
+
+func (m *LabelRepo) GetById(id string) (*domain.Label, error) {
+	rows, err := m.DB.Query(sqlGetLabelById, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	if !rows.Next() {
+		return nil, rows.Err()
+	}
+	return scanRowsIntoLabel(rows)
+}
+
+func (m *LabelRepo) GetByGroupId(groupId int) ([]domain.Label, error) {
+	rows, err := m.DB.Query(sqlGetLabelsByGroupId, groupId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	labels := []domain.Label{}
+	for rows.Next() {
+		label, err := scanRowsIntoLabel(rows)
+		if err != nil {
+			return nil, err
+		}
+		labels = append(labels, *label)
+	}
+	return labels, rows.Err()
+}
+
+func (m *LabelRepo) UpdateLabel(l domain.Label) error {
+	result, err := m.DB.Exec(sqlUpdateLabel, l.Id, l.Name, l.Color, l.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("unable to update label : %w", err)
+	}
+	if rows, _ := result.RowsAffected(); rows == 0 {
+		return domain.ErrLabelNotFound
+	}
+	return nil
+}
+
+func (m *LabelRepo) DeleteLabel(id string) error {
+	result, err := m.DB.Exec(sqlDeleteLabel, id)
+	if err != nil {
+		return fmt.Errorf("unable to delete label : %w", err)
+	}
+	if rows, _ := result.RowsAffected(); rows == 0 {
+		return domain.ErrLabelNotFound
+	}
+	return nil
+}
+
+func scanRowsIntoLabel(rows *sql.Rows) (*domain.Label, error) {
+	label := new(domain.Label)
+	err := rows.Scan(
+		&label.Id,
+		&label.UsergroupId,
+		&label.Name,
+		&label.Color,
+		&label.CreatedAt,
+		&label.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return label, nil
+}
@@ -0,0 +1,12 @@
+package repository
+
+const (
+	sqlLabelColumns = `id, usergroup_id, name, color, created_at, updated_at`
+
+	sqlCreateLabel = `INSERT INTO container.label(` + sqlLabelColumns + `)
+		VALUES ($1,$2,$3,$4,$5,$6)`
+	sqlGetLabelById       = `SELECT ` + sqlLabelColumns + ` FROM container.label WHERE id = $1`
+	sqlGetLabelsByGroupId = `SELECT ` + sqlLabelColumns + ` FROM container.label WHERE usergroup_id = $1 ORDER BY LOWER(name)`
+	sqlUpdateLabel        = `UPDATE container.label SET name = $2, color = $3, updated_at = $4 WHERE id = $1`
+	sqlDeleteLabel        = `DELETE FROM container.label WHERE id = $1`
+)
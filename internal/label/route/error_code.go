@@ -0,0 +1,10 @@
+package route
+
+const prefix = "labels_"
+
+const (
+	LabelInvalidInput = prefix + "invalid_input"
+	LabelNotFound     = prefix + "not_found"
+	LabelDuplicate    = prefix + "duplicate"
+	LabelServerError  = prefix + "server_error"
+)
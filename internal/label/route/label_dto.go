@@ -0,0 +1,6 @@
+package route
+
+type LabelDto struct {
+	Name  string `json:"name"`
+	Color string `json:"color"` // #rrggbb, defaults to grey
+}
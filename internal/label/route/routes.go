@@ -0,0 +1,181 @@
+package route
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	auditApp "github.com/happYness-Project/taskManagementGolang/internal/audit/application"
+	"github.com/happYness-Project/taskManagementGolang/internal/authorization"
+	"github.com/happYness-Project/taskManagementGolang/internal/label/application"
+	"github.com/happYness-Project/taskManagementGolang/internal/label/application/command"
+	"github.com/happYness-Project/taskManagementGolang/internal/label/application/query"
+	"github.com/happYness-Project/taskManagementGolang/internal/label/domain"
+	"github.com/happYness-Project/taskManagementGolang/internal/label/repository"
+	"github.com/happYness-Project/taskManagementGolang/pkg/constants"
+	"github.com/happYness-Project/taskManagementGolang/pkg/dbs"
+	"github.com/happYness-Project/taskManagementGolang/pkg/loggers"
+	"github.com/happYness-Project/taskManagementGolang/pkg/response"
+)
+
+type Handler struct {
+	logger     *loggers.AppLogger
+	commandBus *application.CommandBus
+	queryBus   *application.QueryBus
+}
+
+func NewHandler(logger *loggers.AppLogger, labelRepo repository.LabelRepository, policy *authorization.Policy, uow dbs.UnitOfWork, recorder *auditApp.Recorder) *Handler {
+	return &Handler{
+		logger:     logger,
+		commandBus: application.NewCommandBus(labelRepo, policy, uow, recorder),
+		queryBus:   application.NewQueryBus(labelRepo, policy),
+	}
+}
+
+func (h *Handler) RegisterRoutes(router chi.Router) {
+	router.Route("/api/user-groups/{groupID}/labels", func(r chi.Router) {
+		r.Get("/", h.handleGetLabels)
+		r.Post("/", h.handleCreateLabel)
+		r.Get("/{labelID}", h.handleGetLabel)
+		r.Put("/{labelID}", h.handleUpdateLabel)
+		r.Delete("/{labelID}", h.handleDeleteLabel)
+	})
+}
+
+func (h *Handler) handleGetLabels(w http.ResponseWriter, r *http.Request) {
+	groupId, ok := h.groupId(w, r)
+	if !ok {
+		return
+	}
+
+	// Use Query Bus
+	result, err := h.queryBus.Execute(query.GetGroupLabelsQuery{GroupId: groupId, RequesterId: authorization.RequesterId(r)})
+	if err != nil {
+		h.labelError(w, err, "Failed to get labels")
+		return
+	}
+	response.WriteJsonWithEncode(w, http.StatusOK, result)
+}
+
+func (h *Handler) handleCreateLabel(w http.ResponseWriter, r *http.Request) {
+	groupId, ok := h.groupId(w, r)
+	if !ok {
+		return
+	}
+	var labelDto LabelDto
+	if err := response.ParseJson(r, &labelDto); err != nil {
+		h.logger.Error().Err(err).Str("ErrorCode", constants.RequestBodyError).Msg("Invalid JSON body for LabelDto")
+		response.ErrorResponse(w, http.StatusBadRequest, *(response.New(constants.RequestBodyError, "Invalid Json Body", err.Error())))
+		return
+	}
+
+	// Use Command Bus
+	result, err := h.commandBus.Execute(r.Context(), command.CreateLabelCommand{
+		GroupId:     groupId,
+		Name:        labelDto.Name,
+		Color:       labelDto.Color,
+		RequesterId: authorization.RequesterId(r),
+	})
+	if err != nil {
+		h.labelError(w, err, "Failed to create label")
+		return
+	}
+	response.WriteJsonWithEncode(w, http.StatusCreated, result)
+}
+
+func (h *Handler) handleGetLabel(w http.ResponseWriter, r *http.Request) {
+	groupId, ok := h.groupId(w, r)
+	if !ok {
+		return
+	}
+
+	// Use Query Bus
+	result, err := h.queryBus.Execute(query.GetLabelQuery{
+		GroupId:     groupId,
+		LabelId:     chi.URLParam(r, "labelID"),
+		RequesterId: authorization.RequesterId(r),
+	})
+	if err != nil {
+		h.labelError(w, err, "Failed to get label")
+		return
+	}
+	response.WriteJsonWithEncode(w, http.StatusOK, result)
+}
+
+func (h *Handler) handleUpdateLabel(w http.ResponseWriter, r *http.Request) {
+	groupId, ok := h.groupId(w, r)
+	if !ok {
+		return
+	}
+	var labelDto LabelDto
+	if err := response.ParseJson(r, &labelDto); err != nil {
+		h.logger.Error().Err(err).Str("ErrorCode", constants.RequestBodyError).Msg("Invalid JSON body for LabelDto")
+		response.ErrorResponse(w, http.StatusBadRequest, *(response.New(constants.RequestBodyError, "Invalid Json Body", err.Error())))
+		return
+	}
+
+	// Use Command Bus
+	result, err := h.commandBus.Execute(r.Context(), command.UpdateLabelCommand{
+		GroupId:     groupId,
+		LabelId:     chi.URLParam(r, "labelID"),
+		Name:        labelDto.Name,
+		Color:       labelDto.Color,
+		RequesterId: authorization.RequesterId(r),
+	})
+	if err != nil {
+		h.labelError(w, err, "Failed to update label")
+		return
+	}
+	response.WriteJsonWithEncode(w, http.StatusOK, result)
+}
+
+func (h *Handler) handleDeleteLabel(w http.ResponseWriter, r *http.Request) {
+	groupId, ok := h.groupId(w, r)
+	if !ok {
+		return
+	}
+
+	// Use Command Bus
+	_, err := h.commandBus.Execute(r.Context(), command.DeleteLabelCommand{
+		GroupId:     groupId,
+		LabelId:     chi.URLParam(r, "labelID"),
+		RequesterId: authorization.RequesterId(r),
+	})
+	if err != nil {
+		h.labelError(w, err, "Failed to delete label")
+		return
+	}
+	response.WriteJsonWithEncode(w, http.StatusNoContent, "label has been deleted.")
+}
+
+func (h *Handler) groupId(w http.ResponseWriter, r *http.Request) (int, bool) {
+	groupId, err := strconv.Atoi(chi.URLParam(r, "groupID"))
+	if err != nil {
+		h.logger.Error().Err(err).Msg("invalid Group ID")
+		response.ErrorResponse(w, http.StatusBadRequest, *(response.New(constants.InvalidParameter, "Invalid Group ID")))
+		return 0, false
+	}
+	return groupId, true
+}
+
+// labelError maps label command and query failures onto responses
+func (h *Handler) labelError(w http.ResponseWriter, err error, title string) {
+	switch {
+	case authorization.IsForbiddenError(err):
+		h.logger.Error().Err(err).Str("ErrorCode", constants.PermissionDenied).Msg(err.Error())
+		response.Forbidden(w, constants.PermissionDenied, err.Error())
+	case errors.Is(err, domain.ErrLabelNotFound):
+		h.logger.Error().Err(err).Str("ErrorCode", LabelNotFound).Msg(err.Error())
+		response.ErrorResponse(w, http.StatusNotFound, *(response.New(LabelNotFound, title, err.Error())))
+	case errors.Is(err, domain.ErrDuplicateLabel):
+		h.logger.Error().Err(err).Str("ErrorCode", LabelDuplicate).Msg(err.Error())
+		response.ErrorResponse(w, http.StatusConflict, *(response.New(LabelDuplicate, title, err.Error())))
+	case errors.Is(err, domain.ErrInvalidLabel):
+		h.logger.Error().Err(err).Str("ErrorCode", LabelInvalidInput).Msg(err.Error())
+		response.ErrorResponse(w, http.StatusBadRequest, *(response.New(LabelInvalidInput, title, err.Error())))
+	default:
+		h.logger.Error().Err(err).Str("ErrorCode", LabelServerError).Msg(err.Error())
+		response.ErrorResponse(w, http.StatusInternalServerError, *(response.New(LabelServerError, title, err.Error())))
+	}
+}
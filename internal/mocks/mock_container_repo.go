@@ -1,7 +1,11 @@
 package mocks
 
 import (
+	"time"
+
 	containerDomain "github.com/happYness-Project/taskManagementGolang/internal/taskcontainer/domain"
+	containerRepository "github.com/happYness-Project/taskManagementGolang/internal/taskcontainer/repository"
+	"github.com/happYness-Project/taskManagementGolang/pkg/dbs"
 	"github.com/stretchr/testify/mock"
 )
 
@@ -42,3 +46,69 @@ func (m *MockContainerRepo) RemoveContainerByUsergroupId(groupId int) error {
 	args := m.Called(groupId)
 	return args.Error(0)
 }
+
+// GetContainersByUserId implements repository.ContainerRepository.
+func (m *MockContainerRepo) GetContainersByUserId(userId string) ([]*containerDomain.TaskContainer, error) {
+	args := m.Called(userId)
+	return args.Get(0).([]*containerDomain.TaskContainer), args.Error(1)
+}
+
+// DeleteTasksByContainerId implements repository.ContainerRepository.
+func (m *MockContainerRepo) DeleteTasksByContainerId(id string) error {
+	args := m.Called(id)
+	return args.Error(0)
+}
+
+// SoftDeleteContainer implements repository.ContainerRepository.
+func (m *MockContainerRepo) SoftDeleteContainer(id string, deletedAt time.Time) error {
+	args := m.Called(id, deletedAt)
+	return args.Error(0)
+}
+
+// SoftDeleteTasksByContainerId implements repository.ContainerRepository.
+func (m *MockContainerRepo) SoftDeleteTasksByContainerId(id string, deletedAt time.Time) error {
+	args := m.Called(id, deletedAt)
+	return args.Error(0)
+}
+
+// GetDeletedContainerById implements repository.ContainerRepository.
+func (m *MockContainerRepo) GetDeletedContainerById(id string) (*containerDomain.TaskContainer, error) {
+	args := m.Called(id)
+	container, _ := args.Get(0).(*containerDomain.TaskContainer)
+	return container, args.Error(1)
+}
+
+// GetDeletedContainersByGroupId implements repository.ContainerRepository.
+func (m *MockContainerRepo) GetDeletedContainersByGroupId(groupId int) ([]containerDomain.TaskContainer, error) {
+	args := m.Called(groupId)
+	return args.Get(0).([]containerDomain.TaskContainer), args.Error(1)
+}
+
+// RestoreTasksByContainerId implements repository.ContainerRepository.
+func (m *MockContainerRepo) RestoreTasksByContainerId(id string) error {
+	args := m.Called(id)
+	return args.Error(0)
+}
+
+// RestoreContainer implements repository.ContainerRepository.
+func (m *MockContainerRepo) RestoreContainer(id string) error {
+	args := m.Called(id)
+	return args.Error(0)
+}
+
+// PurgeDeletedContainers implements repository.ContainerRepository.
+func (m *MockContainerRepo) PurgeDeletedContainers(before time.Time) (int64, error) {
+	args := m.Called(before)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+// IncrementVersion implements repository.ContainerRepository.
+func (m *MockContainerRepo) IncrementVersion(id string, expectedVersion int) (int, error) {
+	args := m.Called(id, expectedVersion)
+	return args.Int(0), args.Error(1)
+}
+
+// WithTx implements repository.ContainerRepository.
+func (m *MockContainerRepo) WithTx(tx dbs.DBTX) containerRepository.ContainerRepository {
+	return m
+}
@@ -2,6 +2,8 @@ package mocks
 
 import (
 	"github.com/happYness-Project/taskManagementGolang/internal/usergroup/domain"
+	userGroupRepository "github.com/happYness-Project/taskManagementGolang/internal/usergroup/repository"
+	"github.com/happYness-Project/taskManagementGolang/pkg/dbs"
 	"github.com/stretchr/testify/mock"
 )
 
@@ -43,7 +45,7 @@ func (m *MockUserGroupRepo) RemoveUserFromUserGroup(groupId int, userId int) err
 }
 func (m *MockUserGroupRepo) CreateGroupWithUsers(ug domain.UserGroup, userId int) (int, error) {
 	args := m.Called(ug, userId)
-	return args.Get(0).(int), args.Error(0)
+	return args.Get(0).(int), args.Error(1)
 }
 
 // UpdateUserRoleInGroup implements repository.UserGroupRepository.
@@ -51,3 +53,14 @@ func (m *MockUserGroupRepo) UpdateUserRoleInGroup(groupId int, userId int, role
 	args := m.Called(groupId, userId, role)
 	return args.Error(0)
 }
+
+// IncrementVersion implements repository.UserGroupRepository.
+func (m *MockUserGroupRepo) IncrementVersion(groupId int, expectedVersion int) (int, error) {
+	args := m.Called(groupId, expectedVersion)
+	return args.Int(0), args.Error(1)
+}
+
+// WithTx implements repository.UserGroupRepository.
+func (m *MockUserGroupRepo) WithTx(tx dbs.DBTX) userGroupRepository.UserGroupRepository {
+	return m
+}
@@ -0,0 +1,45 @@
+package mocks
+
+import (
+	labelDomain "github.com/happYness-Project/taskManagementGolang/internal/label/domain"
+	labelRepository "github.com/happYness-Project/taskManagementGolang/internal/label/repository"
+	"github.com/happYness-Project/taskManagementGolang/pkg/dbs"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockLabelRepo struct{ mock.Mock }
+
+// CreateLabel implements repository.LabelRepository.
+func (m *MockLabelRepo) CreateLabel(label labelDomain.Label) error {
+	args := m.Called(label)
+	return args.Error(0)
+}
+
+// GetById implements repository.LabelRepository.
+func (m *MockLabelRepo) GetById(id string) (*labelDomain.Label, error) {
+	args := m.Called(id)
+	return args.Get(0).(*labelDomain.Label), args.Error(1)
+}
+
+// GetByGroupId implements repository.LabelRepository.
+func (m *MockLabelRepo) GetByGroupId(groupId int) ([]labelDomain.Label, error) {
+	args := m.Called(groupId)
+	return args.Get(0).([]labelDomain.Label), args.Error(1)
+}
+
+// UpdateLabel implements repository.LabelRepository.
+func (m *MockLabelRepo) UpdateLabel(label labelDomain.Label) error {
+	args := m.Called(label)
+	return args.Error(0)
+}
+
+// DeleteLabel implements repository.LabelRepository.
+func (m *MockLabelRepo) DeleteLabel(id string) error {
+	args := m.Called(id)
+	return args.Error(0)
+}
+
+// WithTx implements repository.LabelRepository.
+func (m *MockLabelRepo) WithTx(tx dbs.DBTX) labelRepository.LabelRepository {
+	return m
+}
@@ -0,0 +1,56 @@
+package mocks
+
+import (
+	"time"
+
+	outboxDomain "github.com/happYness-Project/taskManagementGolang/internal/outbox/domain"
+	outboxRepository "github.com/happYness-Project/taskManagementGolang/internal/outbox/repository"
+	"github.com/happYness-Project/taskManagementGolang/pkg/dbs"
+	"github.com/happYness-Project/taskManagementGolang/pkg/events"
+)
+
+// MockOutboxRepo keeps appended events in memory, in order.
+// Handler tests inspect Events to check what a command raised.
+type MockOutboxRepo struct {
+	Events   []events.Event
+	GroupIds []int
+}
+
+// Append implements repository.OutboxRepository.
+func (m *MockOutboxRepo) Append(groupId int, evts ...events.Event) error {
+	for _, event := range evts {
+		m.Events = append(m.Events, event)
+		m.GroupIds = append(m.GroupIds, groupId)
+	}
+	return nil
+}
+
+// ClaimPending implements repository.OutboxRepository.
+func (m *MockOutboxRepo) ClaimPending(now time.Time, leaseUntil time.Time, limit int) ([]outboxDomain.Message, error) {
+	return []outboxDomain.Message{}, nil
+}
+
+// MarkDispatched implements repository.OutboxRepository.
+func (m *MockOutboxRepo) MarkDispatched(id int64, at time.Time) error {
+	return nil
+}
+
+// MarkFailed implements repository.OutboxRepository.
+func (m *MockOutboxRepo) MarkFailed(id int64, reason string, nextAttemptAt *time.Time) error {
+	return nil
+}
+
+// GetByGroupIdAfter implements repository.OutboxRepository.
+func (m *MockOutboxRepo) GetByGroupIdAfter(groupId int, after outboxDomain.Position, limit int) ([]outboxDomain.Message, error) {
+	return []outboxDomain.Message{}, nil
+}
+
+// GetCurrentPosition implements repository.OutboxRepository.
+func (m *MockOutboxRepo) GetCurrentPosition() (outboxDomain.Position, error) {
+	return outboxDomain.Position{}, nil
+}
+
+// WithTx implements repository.OutboxRepository.
+func (m *MockOutboxRepo) WithTx(tx dbs.DBTX) outboxRepository.OutboxRepository {
+	return m
+}
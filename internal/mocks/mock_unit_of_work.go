@@ -0,0 +1,12 @@
+package mocks
+
+import "github.com/happYness-Project/taskManagementGolang/pkg/dbs"
+
+// MockUnitOfWork runs the work directly without a transaction.
+// Mock repositories ignore the transaction passed to WithTx.
+type MockUnitOfWork struct{}
+
+// Do implements dbs.UnitOfWork.
+func (u *MockUnitOfWork) Do(fn func(tx dbs.DBTX) error) error {
+	return fn(nil)
+}
@@ -3,6 +3,8 @@ package mocks
 import (
 	taskDomain "github.com/happYness-Project/taskManagementGolang/internal/task/domain"
 	userModel "github.com/happYness-Project/taskManagementGolang/internal/user/domain"
+	userRepository "github.com/happYness-Project/taskManagementGolang/internal/user/repository"
+	"github.com/happYness-Project/taskManagementGolang/pkg/dbs"
 	"github.com/stretchr/testify/mock"
 )
 
@@ -79,3 +81,8 @@ func (m *TaskRepo) GetTaskById(id string) (*taskDomain.Task, error) {
 	args := m.Called(id)
 	return args.Get(0).(*taskDomain.Task), args.Error(1)
 }
+
+// WithTx implements repository.UserRepository.
+func (m *MockUserRepo) WithTx(tx dbs.DBTX) userRepository.UserRepository {
+	return m
+}
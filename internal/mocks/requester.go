@@ -0,0 +1,15 @@
+package mocks
+
+import (
+	"net/http"
+
+	"github.com/go-chi/jwtauth"
+)
+
+var testTokenAuth = jwtauth.New("HS512", []byte("test-secret"), nil)
+
+// WithRequester attaches a verified JWT carrying the given 'nameid' claim to the request
+func WithRequester(r *http.Request, userId string) *http.Request {
+	token, _, _ := testTokenAuth.Encode(map[string]interface{}{"nameid": userId})
+	return r.WithContext(jwtauth.NewContext(r.Context(), token, nil))
+}
@@ -0,0 +1,31 @@
+package application
+
+import (
+	auditApp "github.com/happYness-Project/taskManagementGolang/internal/audit/application"
+	auditDomain "github.com/happYness-Project/taskManagementGolang/internal/audit/domain"
+	cmd "github.com/happYness-Project/taskManagementGolang/internal/notification/application/command"
+)
+
+// auditSubject describes the reminder settings a command changes for the activity log.
+// Settings are personal, so their activities belong to no group.
+func (bus *CommandBus) auditSubject(command interface{}) (auditApp.Subject, bool) {
+	c, ok := command.(cmd.UpdateReminderSettingsCommand)
+	if !ok {
+		return auditApp.Subject{}, false
+	}
+	return auditApp.Subject{
+		ActorId:       c.RequesterId,
+		Action:        auditApp.ActionOf(command),
+		AggregateType: auditDomain.AggregateReminderSettings,
+		AggregateId:   c.UserId,
+		Load:          bus.loadSettings,
+	}, true
+}
+
+func (bus *CommandBus) loadSettings(userId string) (interface{}, error) {
+	settings, err := bus.reminderRepo.GetSettings(userId)
+	if err != nil || settings == nil {
+		return nil, err
+	}
+	return settings, nil
+}
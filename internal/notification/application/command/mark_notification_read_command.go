@@ -0,0 +1,44 @@
+package command
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/happYness-Project/taskManagementGolang/internal/notification/domain"
+	"github.com/happYness-Project/taskManagementGolang/internal/notification/repository"
+)
+
+// MarkNotificationReadCommand marks a notification of the user's inbox as read
+type MarkNotificationReadCommand struct {
+	UserId         string // UUID of the user owning the inbox
+	NotificationId string
+	RequesterId    string // UUID from JWT
+}
+
+// MarkAllNotificationsReadCommand marks every notification of the user's inbox as read
+type MarkAllNotificationsReadCommand struct {
+	UserId      string // UUID of the user owning the inbox
+	RequesterId string // UUID from JWT
+}
+
+// MarkNotificationReadCommandHandler handles marking notifications as read
+type MarkNotificationReadCommandHandler struct {
+	notificationRepo repository.NotificationRepository
+}
+
+func NewMarkNotificationReadCommandHandler(notificationRepo repository.NotificationRepository) *MarkNotificationReadCommandHandler {
+	return &MarkNotificationReadCommandHandler{notificationRepo: notificationRepo}
+}
+
+// Handle executes the mark notification read command; a notification already read keeps its read time
+func (h *MarkNotificationReadCommandHandler) Handle(cmd MarkNotificationReadCommand) error {
+	if _, err := uuid.Parse(cmd.NotificationId); err != nil {
+		return domain.ErrNotificationNotFound
+	}
+	return h.notificationRepo.MarkRead(cmd.UserId, cmd.NotificationId, time.Now().UTC())
+}
+
+// HandleAll executes the mark all notifications read command and returns how many were unread
+func (h *MarkNotificationReadCommandHandler) HandleAll(cmd MarkAllNotificationsReadCommand) (int64, error) {
+	return h.notificationRepo.MarkAllRead(cmd.UserId, time.Now().UTC())
+}
@@ -0,0 +1,36 @@
+package command
+
+import (
+	"github.com/happYness-Project/taskManagementGolang/internal/notification/domain"
+	"github.com/happYness-Project/taskManagementGolang/internal/notification/repository"
+)
+
+// UpdateReminderSettingsCommand replaces the reminder settings of a user
+type UpdateReminderSettingsCommand struct {
+	UserId         string // UUID of the user the settings belong to
+	OffsetsMinutes []int
+	NotifyOverdue  bool
+	EmailEnabled   bool
+	RequesterId    string // UUID from JWT
+}
+
+// UpdateReminderSettingsCommandHandler handles updating reminder settings
+type UpdateReminderSettingsCommandHandler struct {
+	reminderRepo repository.ReminderRepository
+}
+
+func NewUpdateReminderSettingsCommandHandler(reminderRepo repository.ReminderRepository) *UpdateReminderSettingsCommandHandler {
+	return &UpdateReminderSettingsCommandHandler{reminderRepo: reminderRepo}
+}
+
+// Handle executes the update reminder settings command
+func (h *UpdateReminderSettingsCommandHandler) Handle(cmd UpdateReminderSettingsCommand) (*domain.ReminderSettings, error) {
+	settings, err := domain.NewReminderSettings(cmd.UserId, cmd.OffsetsMinutes, cmd.NotifyOverdue, cmd.EmailEnabled)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.reminderRepo.SaveSettings(*settings); err != nil {
+		return nil, err
+	}
+	return settings, nil
+}
@@ -0,0 +1,100 @@
+package application
+
+import (
+	"context"
+	"fmt"
+
+	auditApp "github.com/happYness-Project/taskManagementGolang/internal/audit/application"
+	"github.com/happYness-Project/taskManagementGolang/internal/authorization"
+	cmd "github.com/happYness-Project/taskManagementGolang/internal/notification/application/command"
+	"github.com/happYness-Project/taskManagementGolang/internal/notification/repository"
+	"github.com/happYness-Project/taskManagementGolang/pkg/dbs"
+)
+
+// CommandBus routes commands to their handlers
+type CommandBus struct {
+	updateSettingsHandler *cmd.UpdateReminderSettingsCommandHandler
+	markReadHandler       *cmd.MarkNotificationReadCommandHandler
+
+	reminderRepo     repository.ReminderRepository
+	notificationRepo repository.NotificationRepository
+	policy           *authorization.Policy
+	uow              dbs.UnitOfWork
+	recorder         *auditApp.Recorder
+}
+
+// NewCommandBus creates a new command bus with all handlers registered
+func NewCommandBus(
+	reminderRepo repository.ReminderRepository,
+	notificationRepo repository.NotificationRepository,
+	policy *authorization.Policy,
+	uow dbs.UnitOfWork,
+	recorder *auditApp.Recorder,
+) *CommandBus {
+	return &CommandBus{
+		updateSettingsHandler: cmd.NewUpdateReminderSettingsCommandHandler(reminderRepo),
+		markReadHandler:       cmd.NewMarkNotificationReadCommandHandler(notificationRepo),
+		reminderRepo:          reminderRepo,
+		notificationRepo:      notificationRepo,
+		policy:                policy,
+		uow:                   uow,
+		recorder:              recorder,
+	}
+}
+
+// withTx returns a bus whose handlers and activity log run inside the given transaction
+func (bus *CommandBus) withTx(tx dbs.DBTX) *CommandBus {
+	return NewCommandBus(bus.reminderRepo.WithTx(tx), bus.notificationRepo.WithTx(tx), bus.policy, dbs.NewUnitOfWork(tx), bus.recorder.WithTx(tx))
+}
+
+// Execute authorizes the command and dispatches it to the appropriate handler. Settings changes are recorded in
+// the activity log, in the transaction of the change; reading notifications is not.
+// ctx carries the request id of the HTTP request that issued the command.
+func (bus *CommandBus) Execute(ctx context.Context, command interface{}) (interface{}, error) {
+	if err := bus.authorize(command); err != nil {
+		return nil, err
+	}
+
+	if _, ok := bus.auditSubject(command); !ok {
+		return bus.dispatch(command)
+	}
+	var result interface{}
+	err := bus.uow.Do(func(tx dbs.DBTX) error {
+		txBus := bus.withTx(tx)
+		subject, _ := txBus.auditSubject(command)
+		var err error
+		result, err = txBus.recorder.Track(ctx, subject, func() (interface{}, error) {
+			return txBus.dispatch(command)
+		})
+		return err
+	})
+	return result, err
+}
+
+// dispatch routes the command to its handler
+func (bus *CommandBus) dispatch(command interface{}) (interface{}, error) {
+	switch c := command.(type) {
+	case cmd.UpdateReminderSettingsCommand:
+		return bus.updateSettingsHandler.Handle(c)
+	case cmd.MarkNotificationReadCommand:
+		return nil, bus.markReadHandler.Handle(c)
+	case cmd.MarkAllNotificationsReadCommand:
+		return bus.markReadHandler.HandleAll(c)
+	default:
+		return nil, fmt.Errorf("unknown command type: %T", command)
+	}
+}
+
+// authorize checks that users only manage their own settings and inbox
+func (bus *CommandBus) authorize(command interface{}) error {
+	switch c := command.(type) {
+	case cmd.UpdateReminderSettingsCommand:
+		return bus.policy.RequireSelf(c.RequesterId, c.UserId)
+	case cmd.MarkNotificationReadCommand:
+		return bus.policy.RequireSelf(c.RequesterId, c.UserId)
+	case cmd.MarkAllNotificationsReadCommand:
+		return bus.policy.RequireSelf(c.RequesterId, c.UserId)
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,61 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/smtp"
+	"strings"
+	"time"
+)
+
+// Email is a plain text message to one recipient
+type Email struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// EmailSender sends emails, e.g. through an SMTP server or a provider's API
+type EmailSender interface {
+	Send(ctx context.Context, email Email) error
+}
+
+// SMTPSender sends emails through an SMTP server, authenticating with PLAIN when a username is set.
+// net/smtp upgrades the connection with STARTTLS whenever the server offers it.
+type SMTPSender struct {
+	addr string
+	from string
+	auth smtp.Auth
+}
+
+func NewSMTPSender(host string, port string, username string, password string, from string) *SMTPSender {
+	var auth smtp.Auth
+	if username != "" {
+		auth = smtp.PlainAuth("", username, password, host)
+	}
+	return &SMTPSender{addr: net.JoinHostPort(host, port), from: from, auth: auth}
+}
+
+func (s *SMTPSender) Send(ctx context.Context, email Email) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if strings.ContainsAny(email.To, "\r\n") || strings.ContainsAny(email.Subject, "\r\n") {
+		return fmt.Errorf("email headers cannot contain line breaks")
+	}
+	message := strings.Join([]string{
+		"From: " + s.from,
+		"To: " + email.To,
+		"Subject: " + email.Subject,
+		"Date: " + time.Now().UTC().Format(time.RFC1123Z),
+		"MIME-Version: 1.0",
+		"Content-Type: text/plain; charset=UTF-8",
+		"",
+		strings.ReplaceAll(email.Body, "\n", "\r\n"),
+	}, "\r\n")
+	if err := smtp.SendMail(s.addr, s.auth, s.from, []string{email.To}, []byte(message)); err != nil {
+		return fmt.Errorf("unable to send email to %s: %w", email.To, err)
+	}
+	return nil
+}
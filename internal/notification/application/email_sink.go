@@ -0,0 +1,53 @@
+package application
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/happYness-Project/taskManagementGolang/internal/notification/domain"
+	"github.com/happYness-Project/taskManagementGolang/internal/notification/repository"
+	outboxDomain "github.com/happYness-Project/taskManagementGolang/internal/outbox/domain"
+)
+
+// EmailSink is the outbox sink that queues an email for every reminder of a user who turned email on.
+// The EmailWorker sends them, so a slow or failing mail server never holds up the other sinks.
+type EmailSink struct {
+	reminderRepo repository.ReminderRepository
+	deliveryRepo repository.EmailDeliveryRepository
+	now          func() time.Time
+}
+
+func NewEmailSink(reminderRepo repository.ReminderRepository, deliveryRepo repository.EmailDeliveryRepository) *EmailSink {
+	return &EmailSink{
+		reminderRepo: reminderRepo,
+		deliveryRepo: deliveryRepo,
+		now:          func() time.Time { return time.Now().UTC() },
+	}
+}
+
+func (s *EmailSink) Name() string { return "email" }
+
+// Deliver queues the reminder's email once, however often the outbox dispatches its event
+func (s *EmailSink) Deliver(ctx context.Context, msg outboxDomain.Message) error {
+	if msg.EventType != domain.EventTaskDueSoon && msg.EventType != domain.EventTaskOverdue {
+		return nil
+	}
+	var reminder domain.TaskReminder
+	if err := json.Unmarshal(msg.Payload, &reminder); err != nil {
+		return fmt.Errorf("unable to read %s event: %w", msg.EventType, err)
+	}
+
+	settings, err := s.reminderRepo.GetSettings(reminder.UserId)
+	if err != nil {
+		return fmt.Errorf("failed to retrieve reminder settings: %w", err)
+	}
+	if settings == nil || !settings.EmailEnabled {
+		return nil
+	}
+	if err := s.deliveryRepo.Enqueue(domain.NewEmailDelivery(reminder.NotificationId, s.now())); err != nil {
+		return fmt.Errorf("failed to queue email: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,105 @@
+package application
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/happYness-Project/taskManagementGolang/internal/notification/domain"
+	"github.com/happYness-Project/taskManagementGolang/internal/notification/repository"
+	outboxDomain "github.com/happYness-Project/taskManagementGolang/internal/outbox/domain"
+	"github.com/happYness-Project/taskManagementGolang/pkg/dbs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubEmailDeliveryRepo keeps one delivery per notification, as the primary key does
+type stubEmailDeliveryRepo struct {
+	repository.EmailDeliveryRepository
+	deliveries map[string]*domain.EmailDelivery
+}
+
+func (s *stubEmailDeliveryRepo) Enqueue(delivery domain.EmailDelivery) error {
+	if s.deliveries == nil {
+		s.deliveries = map[string]*domain.EmailDelivery{}
+	}
+	if _, ok := s.deliveries[delivery.NotificationId]; !ok {
+		s.deliveries[delivery.NotificationId] = &delivery
+	}
+	return nil
+}
+
+func (s *stubEmailDeliveryRepo) ClaimDue(now time.Time, leaseUntil time.Time, limit int) ([]domain.EmailDelivery, error) {
+	claimed := []domain.EmailDelivery{}
+	for _, delivery := range s.deliveries {
+		if delivery.NextAttemptAt != nil && !delivery.NextAttemptAt.After(now) && len(claimed) < limit {
+			delivery.NextAttemptAt = &leaseUntil
+			claimed = append(claimed, *delivery)
+		}
+	}
+	return claimed, nil
+}
+
+func (s *stubEmailDeliveryRepo) UpdateState(delivery domain.EmailDelivery) error {
+	s.deliveries[delivery.NotificationId] = &delivery
+	return nil
+}
+
+func (s *stubEmailDeliveryRepo) WithTx(tx dbs.DBTX) repository.EmailDeliveryRepository { return s }
+
+func TestEmailSink_Deliver(t *testing.T) {
+	now := time.Date(2025, 3, 14, 12, 0, 0, 0, time.UTC)
+	reminder := domain.TaskReminder{NotificationId: "n1", TaskId: "t1", UserId: "u1", Kind: domain.KindDueSoon}
+	message := func(t *testing.T) outboxDomain.Message {
+		payload, err := json.Marshal(reminder)
+		require.NoError(t, err)
+		return outboxDomain.Message{EventType: domain.EventTaskDueSoon, Payload: payload}
+	}
+	newSink := func(emailEnabled bool) (*EmailSink, *stubEmailDeliveryRepo) {
+		settings := domain.DefaultReminderSettings("u1")
+		settings.EmailEnabled = emailEnabled
+		deliveryRepo := &stubEmailDeliveryRepo{}
+		sink := NewEmailSink(&stubReminderRepo{settings: map[string]*domain.ReminderSettings{"u1": settings}}, deliveryRepo)
+		sink.now = func() time.Time { return now }
+		return sink, deliveryRepo
+	}
+
+	t.Run("when the event is dispatched again, Then the email is queued once", func(t *testing.T) {
+		// Arrange
+		sink, deliveryRepo := newSink(true)
+
+		// Act
+		require.NoError(t, sink.Deliver(context.Background(), message(t)))
+		require.NoError(t, sink.Deliver(context.Background(), message(t)))
+
+		// Assert
+		require.Len(t, deliveryRepo.deliveries, 1)
+		assert.Equal(t, domain.EmailPending, deliveryRepo.deliveries["n1"].Status)
+		assert.Equal(t, now, *deliveryRepo.deliveries["n1"].NextAttemptAt)
+	})
+
+	t.Run("when the user left email off, Then nothing is queued", func(t *testing.T) {
+		// Arrange
+		sink, deliveryRepo := newSink(false)
+
+		// Act
+		err := sink.Deliver(context.Background(), message(t))
+
+		// Assert
+		require.NoError(t, err)
+		assert.Empty(t, deliveryRepo.deliveries)
+	})
+
+	t.Run("when the event is not a reminder, Then it is ignored", func(t *testing.T) {
+		// Arrange
+		sink, deliveryRepo := newSink(true)
+
+		// Act
+		err := sink.Deliver(context.Background(), outboxDomain.Message{EventType: "task.created", Payload: []byte(`{}`)})
+
+		// Assert
+		require.NoError(t, err)
+		assert.Empty(t, deliveryRepo.deliveries)
+	})
+}
@@ -0,0 +1,151 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/happYness-Project/taskManagementGolang/internal/notification/domain"
+	"github.com/happYness-Project/taskManagementGolang/internal/notification/repository"
+	userRepo "github.com/happYness-Project/taskManagementGolang/internal/user/repository"
+	"github.com/happYness-Project/taskManagementGolang/pkg/loggers"
+	"github.com/happYness-Project/taskManagementGolang/pkg/utils"
+)
+
+const (
+	DefaultEmailPollInterval = 10 * time.Second
+	DefaultEmailBatchSize    = 20
+	// MaxEmailAttempts is how often an email is tried before it is marked failed
+	MaxEmailAttempts = 6
+
+	// emailLease is how long a claimed email is kept from other workers while it is sent
+	emailLease          = 5 * time.Minute
+	baseEmailRetryDelay = time.Minute
+	maxEmailRetryDelay  = 2 * time.Hour
+)
+
+// EmailWorker sends the reminder emails queued by the EmailSink.
+// Failed sends are retried with exponential backoff; each email is claimed by one worker at a time.
+type EmailWorker struct {
+	deliveryRepo     repository.EmailDeliveryRepository
+	notificationRepo repository.NotificationRepository
+	reminderRepo     repository.ReminderRepository
+	userRepo         userRepo.UserRepository
+	sender           EmailSender
+	logger           *loggers.AppLogger
+
+	PollInterval time.Duration
+	BatchSize    int
+	now          func() time.Time
+}
+
+func NewEmailWorker(deliveryRepo repository.EmailDeliveryRepository, notificationRepo repository.NotificationRepository, reminderRepo repository.ReminderRepository, userRepo userRepo.UserRepository, sender EmailSender, logger *loggers.AppLogger) *EmailWorker {
+	return &EmailWorker{
+		deliveryRepo:     deliveryRepo,
+		notificationRepo: notificationRepo,
+		reminderRepo:     reminderRepo,
+		userRepo:         userRepo,
+		sender:           sender,
+		logger:           logger,
+		PollInterval:     DefaultEmailPollInterval,
+		BatchSize:        DefaultEmailBatchSize,
+		now:              func() time.Time { return time.Now().UTC() },
+	}
+}
+
+// Run sends due emails every PollInterval until ctx is cancelled
+func (w *EmailWorker) Run(ctx context.Context) {
+	ticker := time.NewTicker(w.PollInterval)
+	defer ticker.Stop()
+	for {
+		if _, err := w.SendDue(ctx); err != nil {
+			w.logger.Error().Err(err).Msg("failed to send reminder emails")
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// SendDue sends one batch of due emails and returns how many the mail server accepted.
+// The batch is claimed with a lease rather than a lock, so no transaction stays open while the mail server answers.
+func (w *EmailWorker) SendDue(ctx context.Context) (int, error) {
+	now := w.now()
+	deliveries, err := w.deliveryRepo.ClaimDue(now, now.Add(emailLease), w.BatchSize)
+	if err != nil {
+		return 0, fmt.Errorf("failed to read email deliveries: %w", err)
+	}
+
+	sent := 0
+	for _, delivery := range deliveries {
+		if ctx.Err() != nil {
+			break
+		}
+		email, skipReason, err := w.compose(delivery)
+		switch {
+		case err != nil:
+			return sent, err
+		case skipReason != "":
+			delivery.Skip(w.now(), skipReason)
+		default:
+			sendErr := w.sender.Send(ctx, email)
+			delivery.RecordAttempt(w.now(), sendErr, w.nextAttemptAt(delivery, sendErr))
+			if sendErr == nil {
+				sent++
+			}
+		}
+		if err := w.deliveryRepo.UpdateState(delivery); err != nil {
+			return sent, err
+		}
+	}
+	return sent, nil
+}
+
+// compose builds the notification's email, or explains why it can no longer be sent
+func (w *EmailWorker) compose(delivery domain.EmailDelivery) (Email, string, error) {
+	notification, err := w.notificationRepo.GetById(delivery.NotificationId)
+	if err != nil {
+		return Email{}, "", fmt.Errorf("failed to retrieve notification: %w", err)
+	}
+	if notification == nil {
+		return Email{}, "notification was deleted", nil
+	}
+	settings, err := w.reminderRepo.GetSettings(notification.UserId)
+	if err != nil {
+		return Email{}, "", fmt.Errorf("failed to retrieve reminder settings: %w", err)
+	}
+	if settings == nil || !settings.EmailEnabled {
+		return Email{}, "email was turned off", nil
+	}
+	user, err := w.userRepo.GetUserByUserId(notification.UserId)
+	if err != nil {
+		return Email{}, "", fmt.Errorf("failed to retrieve user: %w", err)
+	}
+	if user == nil || user.Email == "" || !user.IsActive {
+		return Email{}, "user cannot receive emails", nil
+	}
+
+	return Email{
+		To:      user.Email,
+		Subject: notification.Title,
+		Body:    fmt.Sprintf("%s.\n\nDue: %s\n", notification.Title, notification.DueAt.UTC().Format("Mon, 02 Jan 2006 15:04 MST")),
+	}, "", nil
+}
+
+// nextAttemptAt schedules the retry after a failed send, or returns nil once attempts are used up.
+// delivery.Attempts does not yet include the send being recorded.
+func (w *EmailWorker) nextAttemptAt(delivery domain.EmailDelivery, err error) *time.Time {
+	if err == nil {
+		return nil
+	}
+	attempts := delivery.Attempts + 1
+	if attempts >= MaxEmailAttempts {
+		w.logger.Error().Err(err).Str("NotificationId", delivery.NotificationId).
+			Int("Attempts", attempts).Msg("giving up on reminder email")
+		return nil
+	}
+	next := w.now().Add(utils.ExponentialBackoff(attempts, baseEmailRetryDelay, maxEmailRetryDelay))
+	return &next
+}
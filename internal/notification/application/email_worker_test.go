@@ -0,0 +1,122 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/happYness-Project/taskManagementGolang/internal/mocks"
+	"github.com/happYness-Project/taskManagementGolang/internal/notification/domain"
+	userDomain "github.com/happYness-Project/taskManagementGolang/internal/user/domain"
+	"github.com/happYness-Project/taskManagementGolang/pkg/configs"
+	"github.com/happYness-Project/taskManagementGolang/pkg/loggers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var errMailServerDown = errors.New("mail server down")
+
+// fakeEmailSender records the emails it accepts, or fails every send with err
+type fakeEmailSender struct {
+	sent []Email
+	err  error
+}
+
+func (f *fakeEmailSender) Send(ctx context.Context, email Email) error {
+	if f.err != nil {
+		return f.err
+	}
+	f.sent = append(f.sent, email)
+	return nil
+}
+
+func TestEmailWorker_SendDue(t *testing.T) {
+	logger := loggers.Setup(configs.Env{})
+	now := time.Date(2025, 3, 14, 12, 0, 0, 0, time.UTC)
+	newWorker := func(emailEnabled bool, sender *fakeEmailSender) (*EmailWorker, *stubEmailDeliveryRepo) {
+		notification := domain.Notification{Id: "n1", UserId: "u1", TaskId: "t1", Kind: domain.KindDueSoon,
+			DueAt: now.Add(12 * time.Hour), Title: "Pay rent is due in 12 hours"}
+		notificationRepo := &stubNotificationRepo{stored: map[string]*domain.Notification{"n1": &notification}}
+		settings := domain.DefaultReminderSettings("u1")
+		settings.EmailEnabled = emailEnabled
+		reminderRepo := &stubReminderRepo{settings: map[string]*domain.ReminderSettings{"u1": settings}}
+		userRepo := new(mocks.MockUserRepo)
+		userRepo.On("GetUserByUserId", "u1").Return(&userDomain.User{UserId: "u1", Email: "jane@example.com", IsActive: true}, nil)
+		deliveryRepo := &stubEmailDeliveryRepo{}
+		require.NoError(t, deliveryRepo.Enqueue(domain.NewEmailDelivery("n1", now)))
+
+		worker := NewEmailWorker(deliveryRepo, notificationRepo, reminderRepo, userRepo, sender, logger)
+		worker.now = func() time.Time { return now }
+		return worker, deliveryRepo
+	}
+
+	t.Run("when an email is due, Then it is sent once", func(t *testing.T) {
+		// Arrange
+		sender := &fakeEmailSender{}
+		worker, deliveryRepo := newWorker(true, sender)
+
+		// Act
+		sent, err := worker.SendDue(context.Background())
+		require.NoError(t, err)
+		again, err := worker.SendDue(context.Background())
+		require.NoError(t, err)
+
+		// Assert
+		assert.Equal(t, 1, sent)
+		assert.Zero(t, again)
+		require.Len(t, sender.sent, 1)
+		assert.Equal(t, "jane@example.com", sender.sent[0].To)
+		assert.Equal(t, "Pay rent is due in 12 hours", sender.sent[0].Subject)
+		delivery := deliveryRepo.deliveries["n1"]
+		assert.Equal(t, domain.EmailSent, delivery.Status)
+		assert.Nil(t, delivery.NextAttemptAt)
+	})
+
+	t.Run("when the mail server fails, Then the email is retried later", func(t *testing.T) {
+		// Arrange
+		worker, deliveryRepo := newWorker(true, &fakeEmailSender{err: errMailServerDown})
+
+		// Act
+		sent, err := worker.SendDue(context.Background())
+
+		// Assert
+		require.NoError(t, err)
+		assert.Zero(t, sent)
+		delivery := deliveryRepo.deliveries["n1"]
+		assert.Equal(t, domain.EmailPending, delivery.Status)
+		assert.Equal(t, 1, delivery.Attempts)
+		assert.Equal(t, errMailServerDown.Error(), delivery.LastError)
+		require.NotNil(t, delivery.NextAttemptAt)
+		assert.True(t, delivery.NextAttemptAt.After(now))
+	})
+
+	t.Run("when the mail server keeps failing, Then the email fails for good", func(t *testing.T) {
+		// Arrange
+		worker, deliveryRepo := newWorker(true, &fakeEmailSender{err: errMailServerDown})
+		deliveryRepo.deliveries["n1"].Attempts = MaxEmailAttempts - 1
+
+		// Act
+		_, err := worker.SendDue(context.Background())
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, domain.EmailFailed, deliveryRepo.deliveries["n1"].Status)
+		assert.Nil(t, deliveryRepo.deliveries["n1"].NextAttemptAt)
+	})
+
+	t.Run("when the user turned email off since, Then the email is skipped", func(t *testing.T) {
+		// Arrange
+		sender := &fakeEmailSender{}
+		worker, deliveryRepo := newWorker(false, sender)
+
+		// Act
+		_, err := worker.SendDue(context.Background())
+
+		// Assert
+		require.NoError(t, err)
+		assert.Empty(t, sender.sent)
+		assert.Equal(t, domain.EmailSkipped, deliveryRepo.deliveries["n1"].Status)
+		assert.Nil(t, deliveryRepo.deliveries["n1"].NextAttemptAt)
+	})
+}
@@ -0,0 +1,62 @@
+package query
+
+import (
+	"fmt"
+
+	"github.com/happYness-Project/taskManagementGolang/internal/notification/domain"
+	"github.com/happYness-Project/taskManagementGolang/internal/notification/repository"
+)
+
+const (
+	DefaultNotificationLimit = 50
+	MaxNotificationLimit     = 200
+)
+
+type GetReminderSettingsQuery struct {
+	UserId      string // UUID of the user the settings belong to
+	RequesterId string // UUID from JWT
+}
+
+// GetNotificationsQuery lists the latest notifications of a user's inbox, newest first
+type GetNotificationsQuery struct {
+	UserId      string // UUID of the user owning the inbox
+	UnreadOnly  bool
+	Limit       int
+	RequesterId string // UUID from JWT
+}
+
+// NotificationQueryHandler handles all read operations for reminder settings and inboxes
+type NotificationQueryHandler struct {
+	reminderRepo     repository.ReminderRepository
+	notificationRepo repository.NotificationRepository
+}
+
+func NewNotificationQueryHandler(reminderRepo repository.ReminderRepository, notificationRepo repository.NotificationRepository) *NotificationQueryHandler {
+	return &NotificationQueryHandler{reminderRepo: reminderRepo, notificationRepo: notificationRepo}
+}
+
+// HandleGetReminderSettings returns the settings of the user, or the defaults when they never changed them
+func (h *NotificationQueryHandler) HandleGetReminderSettings(query GetReminderSettingsQuery) (*domain.ReminderSettings, error) {
+	settings, err := h.reminderRepo.GetSettings(query.UserId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve reminder settings: %w", err)
+	}
+	if settings == nil {
+		return domain.DefaultReminderSettings(query.UserId), nil
+	}
+	return settings, nil
+}
+
+func (h *NotificationQueryHandler) HandleGetNotifications(query GetNotificationsQuery) ([]domain.Notification, error) {
+	limit := query.Limit
+	if limit <= 0 {
+		limit = DefaultNotificationLimit
+	}
+	limit = min(limit, MaxNotificationLimit)
+
+	notifications, err := h.notificationRepo.GetByUserId(query.UserId, query.UnreadOnly, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve notifications: %w", err)
+	}
+	return notifications, nil
+}
@@ -0,0 +1,52 @@
+package application
+
+import (
+	"fmt"
+
+	"github.com/happYness-Project/taskManagementGolang/internal/authorization"
+	qry "github.com/happYness-Project/taskManagementGolang/internal/notification/application/query"
+	"github.com/happYness-Project/taskManagementGolang/internal/notification/repository"
+)
+
+// QueryBus routes queries to their handlers
+type QueryBus struct {
+	queryHandler *qry.NotificationQueryHandler
+
+	policy *authorization.Policy
+}
+
+// NewQueryBus creates a new query bus with all handlers registered
+func NewQueryBus(reminderRepo repository.ReminderRepository, notificationRepo repository.NotificationRepository, policy *authorization.Policy) *QueryBus {
+	return &QueryBus{
+		queryHandler: qry.NewNotificationQueryHandler(reminderRepo, notificationRepo),
+		policy:       policy,
+	}
+}
+
+// Execute dispatches the query to the appropriate handler
+func (bus *QueryBus) Execute(query interface{}) (interface{}, error) {
+	if err := bus.authorize(query); err != nil {
+		return nil, err
+	}
+
+	switch q := query.(type) {
+	case qry.GetReminderSettingsQuery:
+		return bus.queryHandler.HandleGetReminderSettings(q)
+	case qry.GetNotificationsQuery:
+		return bus.queryHandler.HandleGetNotifications(q)
+	default:
+		return nil, fmt.Errorf("unknown query type: %T", query)
+	}
+}
+
+// authorize checks that users only read their own settings and inbox
+func (bus *QueryBus) authorize(query interface{}) error {
+	switch q := query.(type) {
+	case qry.GetReminderSettingsQuery:
+		return bus.policy.RequireSelf(q.RequesterId, q.UserId)
+	case qry.GetNotificationsQuery:
+		return bus.policy.RequireSelf(q.RequesterId, q.UserId)
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,137 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/happYness-Project/taskManagementGolang/internal/notification/domain"
+	"github.com/happYness-Project/taskManagementGolang/internal/notification/repository"
+	outboxRepo "github.com/happYness-Project/taskManagementGolang/internal/outbox/repository"
+	"github.com/happYness-Project/taskManagementGolang/pkg/dbs"
+	"github.com/happYness-Project/taskManagementGolang/pkg/events"
+	"github.com/happYness-Project/taskManagementGolang/pkg/loggers"
+)
+
+const (
+	DefaultSchedulerInterval = time.Minute
+	// DefaultLateness is how late a reminder is still sent, e.g. after the API was down
+	DefaultLateness = 24 * time.Hour
+)
+
+// Scheduler reminds assignees of their tasks as the due dates approach and pass. Every reminder lands in the
+// inbox and is published to the outbox as a task.due_soon or task.overdue event, which the webhook and email
+// sinks deliver.
+//
+// Replicas can all run a scheduler: each group is scheduled under a lock the others skip, and a reminder is
+// stored at most once, so it is published once.
+type Scheduler struct {
+	reminderRepo     repository.ReminderRepository
+	notificationRepo repository.NotificationRepository
+	outboxRepo       outboxRepo.OutboxRepository
+	uow              dbs.UnitOfWork
+	logger           *loggers.AppLogger
+
+	PollInterval time.Duration
+	Lateness     time.Duration
+	now          func() time.Time
+}
+
+func NewScheduler(reminderRepo repository.ReminderRepository, notificationRepo repository.NotificationRepository, outboxRepo outboxRepo.OutboxRepository, uow dbs.UnitOfWork, logger *loggers.AppLogger) *Scheduler {
+	return &Scheduler{
+		reminderRepo:     reminderRepo,
+		notificationRepo: notificationRepo,
+		outboxRepo:       outboxRepo,
+		uow:              uow,
+		logger:           logger,
+		PollInterval:     DefaultSchedulerInterval,
+		Lateness:         DefaultLateness,
+		now:              func() time.Time { return time.Now().UTC() },
+	}
+}
+
+// Run schedules reminders every PollInterval until ctx is cancelled
+func (s *Scheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.PollInterval)
+	defer ticker.Stop()
+	for {
+		if _, err := s.Schedule(ctx); err != nil {
+			s.logger.Error().Err(err).Msg("failed to schedule reminders")
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Schedule sends the reminders due now and returns how many it sent.
+// Groups are scheduled in a transaction each, so a failing group does not hold back the others.
+func (s *Scheduler) Schedule(ctx context.Context) (int, error) {
+	now := s.now()
+	from, to := now.Add(-s.Lateness), now.Add(domain.MaxOffsetMinutes*time.Minute)
+	groupIds, err := s.reminderRepo.GetGroupsWithDueTasks(from, to)
+	if err != nil {
+		return 0, fmt.Errorf("failed to read groups with due tasks: %w", err)
+	}
+
+	sent := 0
+	for _, groupId := range groupIds {
+		if ctx.Err() != nil {
+			break
+		}
+		n, err := s.scheduleGroup(groupId, now, from, to)
+		if err != nil {
+			s.logger.Error().Err(err).Int("GroupId", groupId).Msg("failed to schedule reminders of group")
+			continue
+		}
+		sent += n
+	}
+	if sent > 0 {
+		s.logger.Info().Int("reminders", sent).Msg("sent reminders")
+	}
+	return sent, nil
+}
+
+// scheduleGroup stores the group's due reminders and their events, unless another replica is scheduling the group
+func (s *Scheduler) scheduleGroup(groupId int, now time.Time, from time.Time, to time.Time) (int, error) {
+	sent := 0
+	err := s.uow.Do(func(tx dbs.DBTX) error {
+		reminderRepo := s.reminderRepo.WithTx(tx)
+		notificationRepo := s.notificationRepo.WithTx(tx)
+
+		locked, err := reminderRepo.TryLockGroup(groupId)
+		if err != nil || !locked {
+			return err
+		}
+		candidates, err := reminderRepo.GetCandidates(groupId, from, to)
+		if err != nil {
+			return fmt.Errorf("failed to read tasks due: %w", err)
+		}
+
+		evts := []events.Event{}
+		for _, candidate := range candidates {
+			notification, ok := candidate.DueReminder(now, s.Lateness)
+			if !ok {
+				continue
+			}
+			stored, err := notificationRepo.CreateNotification(notification)
+			if err != nil {
+				return err
+			}
+			if stored {
+				evts = append(evts, notification.Event())
+			}
+		}
+		if len(evts) == 0 {
+			return nil
+		}
+		if err := s.outboxRepo.WithTx(tx).Append(groupId, evts...); err != nil {
+			return fmt.Errorf("failed to store reminder events: %w", err)
+		}
+		sent = len(evts)
+		return nil
+	})
+	return sent, err
+}
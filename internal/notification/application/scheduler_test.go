@@ -0,0 +1,153 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/happYness-Project/taskManagementGolang/internal/mocks"
+	"github.com/happYness-Project/taskManagementGolang/internal/notification/domain"
+	"github.com/happYness-Project/taskManagementGolang/internal/notification/repository"
+	"github.com/happYness-Project/taskManagementGolang/pkg/configs"
+	"github.com/happYness-Project/taskManagementGolang/pkg/dbs"
+	"github.com/happYness-Project/taskManagementGolang/pkg/loggers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubReminderRepo holds the candidates of each group; groups in locked are held by another replica
+type stubReminderRepo struct {
+	repository.ReminderRepository
+	candidates map[int][]domain.ReminderCandidate
+	locked     map[int]bool
+	settings   map[string]*domain.ReminderSettings
+}
+
+func (s *stubReminderRepo) GetGroupsWithDueTasks(from time.Time, to time.Time) ([]int, error) {
+	groupIds := []int{}
+	for groupId := range s.candidates {
+		groupIds = append(groupIds, groupId)
+	}
+	return groupIds, nil
+}
+
+func (s *stubReminderRepo) GetCandidates(groupId int, from time.Time, to time.Time) ([]domain.ReminderCandidate, error) {
+	return s.candidates[groupId], nil
+}
+
+func (s *stubReminderRepo) TryLockGroup(groupId int) (bool, error) { return !s.locked[groupId], nil }
+
+func (s *stubReminderRepo) GetSettings(userId string) (*domain.ReminderSettings, error) {
+	return s.settings[userId], nil
+}
+
+func (s *stubReminderRepo) WithTx(tx dbs.DBTX) repository.ReminderRepository { return s }
+
+// stubNotificationRepo stores notifications once per reminder, as the unique index does
+type stubNotificationRepo struct {
+	repository.NotificationRepository
+	stored map[string]*domain.Notification
+}
+
+func reminderKey(n domain.Notification) string {
+	return fmt.Sprintf("%s|%s|%s|%d|%s", n.UserId, n.TaskId, n.Kind, n.OffsetMinutes, n.DueAt)
+}
+
+func (s *stubNotificationRepo) CreateNotification(n domain.Notification) (bool, error) {
+	if s.stored == nil {
+		s.stored = map[string]*domain.Notification{}
+	}
+	key := reminderKey(n)
+	if _, ok := s.stored[key]; ok {
+		return false, nil
+	}
+	s.stored[key] = &n
+	return true, nil
+}
+
+func (s *stubNotificationRepo) GetById(id string) (*domain.Notification, error) {
+	for _, n := range s.stored {
+		if n.Id == id {
+			return n, nil
+		}
+	}
+	return nil, nil
+}
+
+func (s *stubNotificationRepo) WithTx(tx dbs.DBTX) repository.NotificationRepository { return s }
+
+func TestScheduler_Schedule(t *testing.T) {
+	logger := loggers.Setup(configs.Env{})
+	now := time.Date(2025, 3, 14, 12, 0, 0, 0, time.UTC)
+	candidate := func(taskId string, groupId int, dueAt time.Time) domain.ReminderCandidate {
+		return domain.ReminderCandidate{
+			TaskId:   taskId,
+			TaskName: "Pay rent",
+			DueAt:    dueAt,
+			GroupId:  groupId,
+			Settings: *domain.DefaultReminderSettings("u1"),
+		}
+	}
+	newScheduler := func(reminderRepo *stubReminderRepo) (*Scheduler, *stubNotificationRepo, *mocks.MockOutboxRepo) {
+		notificationRepo := &stubNotificationRepo{}
+		outboxRepo := &mocks.MockOutboxRepo{}
+		scheduler := NewScheduler(reminderRepo, notificationRepo, outboxRepo, &mocks.MockUnitOfWork{}, logger)
+		scheduler.now = func() time.Time { return now }
+		return scheduler, notificationRepo, outboxRepo
+	}
+
+	t.Run("when reminders are due, Then each lands in the inbox and is published to its group", func(t *testing.T) {
+		// Arrange
+		scheduler, notificationRepo, outboxRepo := newScheduler(&stubReminderRepo{candidates: map[int][]domain.ReminderCandidate{
+			4: {candidate("t1", 4, now.Add(12*time.Hour)), candidate("t2", 4, now.Add(72*time.Hour))},
+			5: {candidate("t3", 5, now.Add(-time.Hour))},
+		}})
+
+		// Act
+		sent, err := scheduler.Schedule(context.Background())
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, 2, sent)
+		assert.Len(t, notificationRepo.stored, 2)
+		require.Len(t, outboxRepo.Events, 2)
+		assert.ElementsMatch(t, []string{domain.EventTaskDueSoon, domain.EventTaskOverdue},
+			[]string{outboxRepo.Events[0].EventType(), outboxRepo.Events[1].EventType()})
+		assert.ElementsMatch(t, []int{4, 5}, outboxRepo.GroupIds)
+	})
+
+	t.Run("when a reminder was already sent, Then it is not published again", func(t *testing.T) {
+		// Arrange
+		scheduler, _, outboxRepo := newScheduler(&stubReminderRepo{candidates: map[int][]domain.ReminderCandidate{
+			4: {candidate("t1", 4, now.Add(12*time.Hour))},
+		}})
+		_, err := scheduler.Schedule(context.Background())
+		require.NoError(t, err)
+
+		// Act
+		sent, err := scheduler.Schedule(context.Background())
+
+		// Assert
+		require.NoError(t, err)
+		assert.Zero(t, sent)
+		assert.Len(t, outboxRepo.Events, 1)
+	})
+
+	t.Run("when another replica holds the group, Then the group is skipped", func(t *testing.T) {
+		// Arrange
+		scheduler, notificationRepo, outboxRepo := newScheduler(&stubReminderRepo{
+			candidates: map[int][]domain.ReminderCandidate{4: {candidate("t1", 4, now.Add(12*time.Hour))}},
+			locked:     map[int]bool{4: true},
+		})
+
+		// Act
+		sent, err := scheduler.Schedule(context.Background())
+
+		// Assert
+		require.NoError(t, err)
+		assert.Zero(t, sent)
+		assert.Empty(t, notificationRepo.stored)
+		assert.Empty(t, outboxRepo.Events)
+	})
+}
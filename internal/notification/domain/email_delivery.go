@@ -0,0 +1,64 @@
+package domain
+
+import "time"
+
+// Email delivery statuses
+const (
+	EmailPending = "pending"
+	EmailSent    = "sent"
+	EmailFailed  = "failed"
+	// EmailSkipped is a reminder that could no longer be emailed, e.g. because the user turned email off
+	EmailSkipped = "skipped"
+)
+
+// EmailDelivery is the email of one notification, retried until the mail server accepts it or attempts run out.
+// There is at most one per notification.
+type EmailDelivery struct {
+	NotificationId string
+	Status         string
+	Attempts       int
+	LastError      string
+	NextAttemptAt  *time.Time
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// NewEmailDelivery queues the notification's email to be sent right away
+func NewEmailDelivery(notificationId string, now time.Time) EmailDelivery {
+	return EmailDelivery{
+		NotificationId: notificationId,
+		Status:         EmailPending,
+		NextAttemptAt:  &now,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+}
+
+// RecordAttempt applies the outcome of a send. A failed send is retried at nextAttemptAt;
+// without one the delivery has failed for good.
+func (d *EmailDelivery) RecordAttempt(at time.Time, err error, nextAttemptAt *time.Time) {
+	d.Attempts++
+	d.UpdatedAt = at
+	switch {
+	case err == nil:
+		d.Status = EmailSent
+		d.LastError = ""
+		d.NextAttemptAt = nil
+	case nextAttemptAt != nil:
+		d.Status = EmailPending
+		d.LastError = err.Error()
+		d.NextAttemptAt = nextAttemptAt
+	default:
+		d.Status = EmailFailed
+		d.LastError = err.Error()
+		d.NextAttemptAt = nil
+	}
+}
+
+// Skip gives up on the delivery without sending it
+func (d *EmailDelivery) Skip(at time.Time, reason string) {
+	d.Status = EmailSkipped
+	d.LastError = reason
+	d.NextAttemptAt = nil
+	d.UpdatedAt = at
+}
@@ -0,0 +1,30 @@
+package domain
+
+import "time"
+
+const (
+	EventTaskDueSoon = "task.due_soon"
+	EventTaskOverdue = "task.overdue"
+
+	aggregateTask = "task"
+)
+
+// TaskReminder is raised when a task reminds its assignee that it is due soon or overdue
+type TaskReminder struct {
+	NotificationId string    `json:"notification_id"`
+	TaskId         string    `json:"task_id"`
+	UserId         string    `json:"user_id"`
+	Kind           string    `json:"kind"`
+	OffsetMinutes  int       `json:"offset_minutes,omitempty"`
+	DueAt          time.Time `json:"due_at"`
+	Title          string    `json:"title"`
+}
+
+func (e TaskReminder) EventType() string {
+	if e.Kind == KindOverdue {
+		return EventTaskOverdue
+	}
+	return EventTaskDueSoon
+}
+func (e TaskReminder) AggregateType() string { return aggregateTask }
+func (e TaskReminder) AggregateId() string   { return e.TaskId }
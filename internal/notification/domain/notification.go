@@ -0,0 +1,58 @@
+package domain
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Kinds of notification
+const (
+	KindDueSoon = "due_soon"
+	KindOverdue = "overdue"
+)
+
+var ErrNotificationNotFound = errors.New("notification not found")
+
+// Notification is an entry of a user's in-app inbox, telling them a task is due soon or overdue.
+// A task reminds its assignee once per offset and due date; moving the due date brings new reminders.
+type Notification struct {
+	Id            string     `json:"id"`
+	UserId        string     `json:"user_id"`
+	GroupId       int        `json:"group_id"`
+	TaskId        string     `json:"task_id"`
+	Kind          string     `json:"kind"`
+	OffsetMinutes int        `json:"offset_minutes"` // 0 for overdue notifications
+	DueAt         time.Time  `json:"due_at"`
+	Title         string     `json:"title"`
+	CreatedAt     time.Time  `json:"created_at"`
+	ReadAt        *time.Time `json:"read_at,omitempty"`
+}
+
+func newNotification(userId string, groupId int, taskId string, kind string, offsetMinutes int, dueAt time.Time, title string, now time.Time) Notification {
+	return Notification{
+		Id:            uuid.NewString(),
+		UserId:        userId,
+		GroupId:       groupId,
+		TaskId:        taskId,
+		Kind:          kind,
+		OffsetMinutes: offsetMinutes,
+		DueAt:         dueAt,
+		Title:         title,
+		CreatedAt:     now,
+	}
+}
+
+// Event is the event published when the notification is created, for webhooks and the other outbox sinks
+func (n Notification) Event() TaskReminder {
+	return TaskReminder{
+		NotificationId: n.Id,
+		TaskId:         n.TaskId,
+		UserId:         n.UserId,
+		Kind:           n.Kind,
+		OffsetMinutes:  n.OffsetMinutes,
+		DueAt:          n.DueAt,
+		Title:          n.Title,
+	}
+}
@@ -0,0 +1,68 @@
+package domain
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// ReminderCandidate is an open task with a due date, together with the settings of its assignee
+type ReminderCandidate struct {
+	TaskId   string
+	TaskName string
+	DueAt    time.Time
+	GroupId  int
+	Settings ReminderSettings
+}
+
+// DueReminder returns the reminder the candidate owes at now, if any. Before the due date it is the one of the
+// latest offset already reached, so a task created close to its due date reminds once rather than once per offset;
+// after it, the overdue notification. Reminders more than lateness old are dropped, so a scheduler that was down
+// does not flood inboxes when it restarts.
+//
+// The same reminder is returned on every call until the next one falls due; storing it once is up to the caller.
+func (c ReminderCandidate) DueReminder(now time.Time, lateness time.Duration) (Notification, bool) {
+	if !now.Before(c.DueAt) {
+		if !c.Settings.NotifyOverdue || now.Sub(c.DueAt) > lateness {
+			return Notification{}, false
+		}
+		return newNotification(c.Settings.UserId, c.GroupId, c.TaskId, KindOverdue, 0, c.DueAt,
+			fmt.Sprintf("%s is overdue", c.TaskName), now), true
+	}
+
+	reached := 0
+	for _, offset := range c.Settings.OffsetsMinutes {
+		remindAt := c.DueAt.Add(-time.Duration(offset) * time.Minute)
+		if remindAt.After(now) || now.Sub(remindAt) > lateness {
+			continue
+		}
+		if reached == 0 || offset < reached {
+			reached = offset
+		}
+	}
+	if reached == 0 {
+		return Notification{}, false
+	}
+	return newNotification(c.Settings.UserId, c.GroupId, c.TaskId, KindDueSoon, reached, c.DueAt,
+		fmt.Sprintf("%s is due in %s", c.TaskName, humanizeDuration(c.DueAt.Sub(now))), now), true
+}
+
+// humanizeDuration rounds d to the nearest day, hour or minute, e.g. "2 days" or "45 minutes"
+func humanizeDuration(d time.Duration) string {
+	minutes := int(math.Round(d.Minutes()))
+	switch {
+	case minutes >= 24*60:
+		return plural(int(math.Round(float64(minutes)/(24*60))), "day")
+	case minutes >= 60:
+		return plural(int(math.Round(float64(minutes)/60)), "hour")
+	default:
+		return plural(max(minutes, 1), "minute")
+	}
+}
+
+func plural(n int, unit string) string {
+	if n == 1 {
+		return fmt.Sprintf("1 %s", unit)
+	}
+	return fmt.Sprintf("%d %ss", n, unit)
+}
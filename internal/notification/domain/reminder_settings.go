@@ -0,0 +1,66 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+)
+
+var ErrInvalidReminderSettings = errors.New("invalid reminder settings")
+
+const (
+	// MaxReminderOffsets is how many reminders a user can get before each due date
+	MaxReminderOffsets = 5
+	// MaxOffsetMinutes is the earliest a reminder can come: 30 days before the due date
+	MaxOffsetMinutes = 30 * 24 * 60
+)
+
+// DefaultOffsetsMinutes remind users one day before their tasks are due
+var DefaultOffsetsMinutes = []int{24 * 60}
+
+// ReminderSettings decide when a user is reminded of the tasks assigned to them.
+// Reminders always land in the in-app inbox; EmailEnabled sends them by email too.
+type ReminderSettings struct {
+	UserId         string    `json:"user_id"`
+	OffsetsMinutes []int     `json:"offsets_minutes"` // how long before the due date reminders come, earliest first
+	NotifyOverdue  bool      `json:"notify_overdue"`
+	EmailEnabled   bool      `json:"email_enabled"`
+	UpdatedAt      time.Time `json:"updated_at"`
+}
+
+// DefaultReminderSettings are the settings of users who never changed theirs
+func DefaultReminderSettings(userId string) *ReminderSettings {
+	return &ReminderSettings{
+		UserId:         userId,
+		OffsetsMinutes: slices.Clone(DefaultOffsetsMinutes),
+		NotifyOverdue:  true,
+	}
+}
+
+// NewReminderSettings validates the offsets, which must lie between 1 minute and 30 days, and sorts them.
+// No offsets turns off the reminders before due dates.
+func NewReminderSettings(userId string, offsetsMinutes []int, notifyOverdue bool, emailEnabled bool) (*ReminderSettings, error) {
+	if len(offsetsMinutes) > MaxReminderOffsets {
+		return nil, fmt.Errorf("%w: at most %d offsets are allowed", ErrInvalidReminderSettings, MaxReminderOffsets)
+	}
+	offsets := []int{}
+	for _, offset := range offsetsMinutes {
+		if offset < 1 || offset > MaxOffsetMinutes {
+			return nil, fmt.Errorf("%w: offsets must be between 1 and %d minutes, got %d", ErrInvalidReminderSettings, MaxOffsetMinutes, offset)
+		}
+		if !slices.Contains(offsets, offset) {
+			offsets = append(offsets, offset)
+		}
+	}
+	slices.Sort(offsets)
+	slices.Reverse(offsets)
+
+	return &ReminderSettings{
+		UserId:         userId,
+		OffsetsMinutes: offsets,
+		NotifyOverdue:  notifyOverdue,
+		EmailEnabled:   emailEnabled,
+		UpdatedAt:      time.Now().UTC(),
+	}, nil
+}
@@ -0,0 +1,110 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewReminderSettings(t *testing.T) {
+	t.Run("when offsets repeat, Then they are deduplicated and sorted earliest first", func(t *testing.T) {
+		settings, err := NewReminderSettings("u1", []int{60, 1440, 60, 10}, true, false)
+
+		require.NoError(t, err)
+		assert.Equal(t, []int{1440, 60, 10}, settings.OffsetsMinutes)
+	})
+
+	t.Run("when no offsets are given, Then only overdue reminders remain", func(t *testing.T) {
+		settings, err := NewReminderSettings("u1", nil, true, false)
+
+		require.NoError(t, err)
+		assert.Empty(t, settings.OffsetsMinutes)
+	})
+
+	tests := []struct {
+		name    string
+		offsets []int
+	}{
+		{name: "when an offset is zero, Then it is rejected", offsets: []int{0}},
+		{name: "when an offset is beyond 30 days, Then it is rejected", offsets: []int{MaxOffsetMinutes + 1}},
+		{name: "when there are too many offsets, Then they are rejected", offsets: []int{1, 2, 3, 4, 5, 6}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewReminderSettings("u1", tt.offsets, true, false)
+
+			assert.True(t, errors.Is(err, ErrInvalidReminderSettings), "got %v", err)
+		})
+	}
+}
+
+func TestReminderCandidate_DueReminder(t *testing.T) {
+	const lateness = 24 * time.Hour
+	due := time.Date(2025, 3, 14, 17, 0, 0, 0, time.UTC)
+	candidate := ReminderCandidate{
+		TaskId:   "t1",
+		TaskName: "Pay rent",
+		DueAt:    due,
+		GroupId:  4,
+		Settings: ReminderSettings{UserId: "u1", OffsetsMinutes: []int{1440, 60}, NotifyOverdue: true},
+	}
+
+	t.Run("when no offset is reached yet, Then nothing is due", func(t *testing.T) {
+		_, ok := candidate.DueReminder(due.Add(-48*time.Hour), lateness)
+
+		assert.False(t, ok)
+	})
+
+	t.Run("when an offset is reached, Then its reminder is due", func(t *testing.T) {
+		// Act
+		notification, ok := candidate.DueReminder(due.Add(-23*time.Hour), lateness)
+
+		// Assert
+		require.True(t, ok)
+		assert.Equal(t, KindDueSoon, notification.Kind)
+		assert.Equal(t, 1440, notification.OffsetMinutes)
+		assert.Equal(t, "u1", notification.UserId)
+		assert.Equal(t, 4, notification.GroupId)
+		assert.Equal(t, "Pay rent is due in 23 hours", notification.Title)
+	})
+
+	t.Run("when several offsets are reached, Then only the latest one is due", func(t *testing.T) {
+		// Act
+		notification, ok := candidate.DueReminder(due.Add(-30*time.Minute), lateness)
+
+		// Assert
+		require.True(t, ok)
+		assert.Equal(t, 60, notification.OffsetMinutes)
+		assert.Equal(t, "Pay rent is due in 30 minutes", notification.Title)
+	})
+
+	t.Run("when the due date has passed, Then the overdue reminder is due", func(t *testing.T) {
+		// Act
+		notification, ok := candidate.DueReminder(due.Add(time.Hour), lateness)
+
+		// Assert
+		require.True(t, ok)
+		assert.Equal(t, KindOverdue, notification.Kind)
+		assert.Zero(t, notification.OffsetMinutes)
+		assert.Equal(t, "Pay rent is overdue", notification.Title)
+		assert.Equal(t, EventTaskOverdue, notification.Event().EventType())
+	})
+
+	t.Run("when the user does not want overdue reminders, Then nothing is due after the due date", func(t *testing.T) {
+		quiet := candidate
+		quiet.Settings.NotifyOverdue = false
+
+		_, ok := quiet.DueReminder(due.Add(time.Hour), lateness)
+
+		assert.False(t, ok)
+	})
+
+	t.Run("when the reminder is older than the lateness, Then it is dropped", func(t *testing.T) {
+		_, ok := candidate.DueReminder(due.Add(25*time.Hour), lateness)
+
+		assert.False(t, ok)
+	})
+}
@@ -0,0 +1,97 @@
+package repository
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/happYness-Project/taskManagementGolang/internal/notification/domain"
+	"github.com/happYness-Project/taskManagementGolang/pkg/dbs"
+)
+
+type EmailDeliveryRepository interface {
+	// Enqueue stores the delivery unless the notification's email was already queued
+	Enqueue(delivery domain.EmailDelivery) error
+	// ClaimDue claims up to limit deliveries due at now until leaseUntil, so no other worker sends them meanwhile.
+	// A delivery whose worker stopped before recording the outcome is claimed again once the lease ends.
+	ClaimDue(now time.Time, leaseUntil time.Time, limit int) ([]domain.EmailDelivery, error)
+	UpdateState(delivery domain.EmailDelivery) error
+	WithTx(tx dbs.DBTX) EmailDeliveryRepository
+}
+
+type EmailDeliveryRepo struct {
+	DB dbs.DBTX
+}
+
+func NewEmailDeliveryRepository(db dbs.DBTX) *EmailDeliveryRepo {
+	return &EmailDeliveryRepo{DB: db}
+}
+
+// WithTx returns a copy of the repository bound to the given transaction
+func (m *EmailDeliveryRepo) WithTx(tx dbs.DBTX) EmailDeliveryRepository {
+	return &EmailDeliveryRepo{DB: tx}
+}
+
+func (m *EmailDeliveryRepo) Enqueue(d domain.EmailDelivery) error {
+	_, err := m.DB.Exec(sqlEnqueueEmailDelivery, d.NotificationId, d.Status, d.Attempts, d.NextAttemptAt, d.CreatedAt, d.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("unable to insert into email_delivery table : %w", err)
+	}
+	return nil
+}
+
+func (m *EmailDeliveryRepo) ClaimDue(now time.Time, leaseUntil time.Time, limit int) ([]domain.EmailDelivery, error) {
+	rows, err := m.DB.Query(sqlClaimDueEmailDeliveries, now, leaseUntil, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	deliveries := []domain.EmailDelivery{}
+	for rows.Next() {
+		delivery, err := scanRowsIntoEmailDelivery(rows)
+		if err != nil {
+			return nil, err
+		}
+		deliveries = append(deliveries, *delivery)
+	}
+	return deliveries, rows.Err()
+}
+
+func (m *EmailDeliveryRepo) UpdateState(d domain.EmailDelivery) error {
+	var lastError interface{}
+	if d.LastError != "" {
+		lastError = d.LastError
+	}
+	result, err := m.DB.Exec(sqlUpdateEmailDeliveryState, d.NotificationId, d.Status, d.Attempts, lastError, d.NextAttemptAt, d.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("unable to update email delivery : %w", err)
+	}
+	if rows, _ := result.RowsAffected(); rows == 0 {
+		return domain.ErrNotificationNotFound
+	}
+	return nil
+}
+
+func scanRowsIntoEmailDelivery(rows *sql.Rows) (*domain.EmailDelivery, error) {
+	delivery := new(domain.EmailDelivery)
+	var lastError sql.NullString
+	var nextAttemptAt sql.NullTime
+	err := rows.Scan(
+		&delivery.NotificationId,
+		&delivery.Status,
+		&delivery.Attempts,
+		&lastError,
+		&nextAttemptAt,
+		&delivery.CreatedAt,
+		&delivery.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	delivery.LastError = lastError.String
+	if nextAttemptAt.Valid {
+		delivery.NextAttemptAt = &nextAttemptAt.Time
+	}
+	return delivery, nil
+}
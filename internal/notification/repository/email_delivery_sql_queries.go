@@ -0,0 +1,18 @@
+package repository
+
+const (
+	// A notification is emailed at most once, however often its event is dispatched
+	sqlEnqueueEmailDelivery = `INSERT INTO container.email_delivery(notification_id, status, attempts, next_attempt_at, created_at, updated_at)
+						VALUES ($1,$2,$3,$4,$5,$6)
+						ON CONFLICT (notification_id) DO NOTHING`
+	// Claimed deliveries are leased until $2; SKIP LOCKED lets several workers claim side by side
+	sqlClaimDueEmailDeliveries = `UPDATE container.email_delivery d SET next_attempt_at = $2
+						FROM (SELECT notification_id FROM container.email_delivery
+							WHERE next_attempt_at <= $1
+							ORDER BY next_attempt_at LIMIT $3
+							FOR UPDATE SKIP LOCKED) due
+						WHERE d.notification_id = due.notification_id
+						RETURNING d.notification_id, d.status, d.attempts, d.last_error, d.next_attempt_at, d.created_at, d.updated_at`
+	sqlUpdateEmailDeliveryState = `UPDATE container.email_delivery SET status = $2, attempts = $3, last_error = $4, next_attempt_at = $5, updated_at = $6
+						WHERE notification_id = $1`
+)
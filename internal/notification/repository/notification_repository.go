@@ -0,0 +1,124 @@
+package repository
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/happYness-Project/taskManagementGolang/internal/notification/domain"
+	"github.com/happYness-Project/taskManagementGolang/pkg/dbs"
+)
+
+type NotificationRepository interface {
+	// CreateNotification stores the notification unless the same reminder was already stored, and reports
+	// whether it was
+	CreateNotification(notification domain.Notification) (bool, error)
+	// GetById returns nil when the notification does not exist
+	GetById(id string) (*domain.Notification, error)
+	// GetByUserId returns the latest notifications of the user, newest first
+	GetByUserId(userId string, unreadOnly bool, limit int) ([]domain.Notification, error)
+	MarkRead(userId string, id string, readAt time.Time) error
+	// MarkAllRead marks every unread notification of the user as read and returns how many there were
+	MarkAllRead(userId string, readAt time.Time) (int64, error)
+	WithTx(tx dbs.DBTX) NotificationRepository
+}
+
+type NotificationRepo struct {
+	DB dbs.DBTX
+}
+
+func NewNotificationRepository(db dbs.DBTX) *NotificationRepo {
+	return &NotificationRepo{DB: db}
+}
+
+// WithTx returns a copy of the repository bound to the given transaction
+func (m *NotificationRepo) WithTx(tx dbs.DBTX) NotificationRepository {
+	return &NotificationRepo{DB: tx}
+}
+
+func (m *NotificationRepo) CreateNotification(n domain.Notification) (bool, error) {
+	result, err := m.DB.Exec(sqlCreateNotification, n.Id, n.UserId, n.GroupId, n.TaskId, n.Kind, n.OffsetMinutes,
+		n.DueAt, n.Title, n.CreatedAt)
+	if err != nil {
+		return false, fmt.Errorf("unable to insert into notification table : %w", err)
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return rows > 0, nil
+}
+
+func (m *NotificationRepo) GetById(id string) (*domain.Notification, error) {
+	rows, err := m.DB.Query(sqlGetNotificationById, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	if !rows.Next() {
+		return nil, rows.Err()
+	}
+	return scanRowsIntoNotification(rows)
+}
+
+func (m *NotificationRepo) GetByUserId(userId string, unreadOnly bool, limit int) ([]domain.Notification, error) {
+	rows, err := m.DB.Query(sqlGetNotificationsByUserId, userId, unreadOnly, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	notifications := []domain.Notification{}
+	for rows.Next() {
+		notification, err := scanRowsIntoNotification(rows)
+		if err != nil {
+			return nil, err
+		}
+		notifications = append(notifications, *notification)
+	}
+	return notifications, rows.Err()
+}
+
+func (m *NotificationRepo) MarkRead(userId string, id string, readAt time.Time) error {
+	result, err := m.DB.Exec(sqlMarkNotificationRead, userId, id, readAt)
+	if err != nil {
+		return fmt.Errorf("unable to update notification : %w", err)
+	}
+	if rows, err := result.RowsAffected(); err == nil && rows == 0 {
+		return domain.ErrNotificationNotFound
+	}
+	return nil
+}
+
+func (m *NotificationRepo) MarkAllRead(userId string, readAt time.Time) (int64, error) {
+	result, err := m.DB.Exec(sqlMarkAllNotificationsRead, userId, readAt)
+	if err != nil {
+		return 0, fmt.Errorf("unable to update notifications : %w", err)
+	}
+	return result.RowsAffected()
+}
+
+func scanRowsIntoNotification(rows *sql.Rows) (*domain.Notification, error) {
+	notification := new(domain.Notification)
+	var readAt sql.NullTime
+	err := rows.Scan(
+		&notification.Id,
+		&notification.UserId,
+		&notification.GroupId,
+		&notification.TaskId,
+		&notification.Kind,
+		&notification.OffsetMinutes,
+		&notification.DueAt,
+		&notification.Title,
+		&notification.CreatedAt,
+		&readAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if readAt.Valid {
+		notification.ReadAt = &readAt.Time
+	}
+	return notification, nil
+}
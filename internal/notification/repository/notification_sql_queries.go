@@ -0,0 +1,22 @@
+package repository
+
+const (
+	sqlNotificationColumns = `n.id, u.user_id, n.usergroup_id, n.task_id, n.kind, n.offset_minutes, n.due_at, n.title, n.created_at, n.read_at`
+
+	// The recipient is stored by the user's internal id. A reminder already sent, by this process or another
+	// replica, conflicts with the unique index and is not inserted again.
+	sqlCreateNotification = `INSERT INTO container.notification(id, user_id, usergroup_id, task_id, kind, offset_minutes, due_at, title, created_at)
+						SELECT $1, u.id, $3, $4, $5, $6, $7, $8, $9 FROM container.user u WHERE u.user_id = $2
+						ON CONFLICT (user_id, task_id, kind, offset_minutes, due_at) DO NOTHING`
+	sqlGetNotificationById = `SELECT ` + sqlNotificationColumns + ` FROM container.notification n
+						INNER JOIN container.user u ON u.id = n.user_id
+						WHERE n.id = $1`
+	sqlGetNotificationsByUserId = `SELECT ` + sqlNotificationColumns + ` FROM container.notification n
+						INNER JOIN container.user u ON u.id = n.user_id
+						WHERE u.user_id = $1 AND (NOT $2 OR n.read_at IS NULL)
+						ORDER BY n.created_at DESC, n.id LIMIT $3`
+	sqlMarkNotificationRead = `UPDATE container.notification n SET read_at = COALESCE(n.read_at, $3)
+						FROM container.user u WHERE u.id = n.user_id AND u.user_id = $1 AND n.id = $2`
+	sqlMarkAllNotificationsRead = `UPDATE container.notification n SET read_at = $2
+						FROM container.user u WHERE u.id = n.user_id AND u.user_id = $1 AND n.read_at IS NULL`
+)
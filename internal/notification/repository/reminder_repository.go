@@ -0,0 +1,173 @@
+package repository
+
+import (
+	"database/sql"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/happYness-Project/taskManagementGolang/internal/notification/domain"
+	"github.com/happYness-Project/taskManagementGolang/pkg/dbs"
+)
+
+// reminderLockSpace keys the advisory locks of the scheduler, next to the id of the group they lock
+const reminderLockSpace = 7_342
+
+type ReminderRepository interface {
+	// GetSettings returns nil when the user never saved their settings
+	GetSettings(userId string) (*domain.ReminderSettings, error)
+	// SaveSettings stores the settings of their user, replacing the previous ones
+	SaveSettings(settings domain.ReminderSettings) error
+	// GetGroupsWithDueTasks returns the groups with open, assigned tasks due between from and to
+	GetGroupsWithDueTasks(from time.Time, to time.Time) ([]int, error)
+	// GetCandidates returns the open, assigned tasks of the group due between from and to
+	GetCandidates(groupId int, from time.Time, to time.Time) ([]domain.ReminderCandidate, error)
+	// TryLockGroup takes the scheduler's lock on the group until the surrounding transaction ends.
+	// It returns false without waiting when another process holds it.
+	TryLockGroup(groupId int) (bool, error)
+	WithTx(tx dbs.DBTX) ReminderRepository
+}
+
+type ReminderRepo struct {
+	DB dbs.DBTX
+}
+
+func NewReminderRepository(db dbs.DBTX) *ReminderRepo {
+	return &ReminderRepo{DB: db}
+}
+
+// WithTx returns a copy of the repository bound to the given transaction
+func (m *ReminderRepo) WithTx(tx dbs.DBTX) ReminderRepository {
+	return &ReminderRepo{DB: tx}
+}
+
+func (m *ReminderRepo) GetSettings(userId string) (*domain.ReminderSettings, error) {
+	rows, err := m.DB.Query(sqlGetReminderSettings, userId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	if !rows.Next() {
+		return nil, rows.Err()
+	}
+	settings := new(domain.ReminderSettings)
+	var offsets string
+	if err := rows.Scan(&settings.UserId, &offsets, &settings.NotifyOverdue, &settings.EmailEnabled, &settings.UpdatedAt); err != nil {
+		return nil, err
+	}
+	if settings.OffsetsMinutes, err = parseOffsets(offsets); err != nil {
+		return nil, err
+	}
+	return settings, nil
+}
+
+func (m *ReminderRepo) SaveSettings(s domain.ReminderSettings) error {
+	result, err := m.DB.Exec(sqlSaveReminderSettings, s.UserId, formatOffsets(s.OffsetsMinutes), s.NotifyOverdue, s.EmailEnabled, s.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("unable to save reminder settings : %w", err)
+	}
+	if rows, err := result.RowsAffected(); err == nil && rows == 0 {
+		return fmt.Errorf("unable to save reminder settings : user %s does not exist", s.UserId)
+	}
+	return nil
+}
+
+func (m *ReminderRepo) GetGroupsWithDueTasks(from time.Time, to time.Time) ([]int, error) {
+	rows, err := m.DB.Query(sqlGetGroupsWithDueTasks, from, to)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	groupIds := []int{}
+	for rows.Next() {
+		var groupId int
+		if err := rows.Scan(&groupId); err != nil {
+			return nil, err
+		}
+		groupIds = append(groupIds, groupId)
+	}
+	return groupIds, rows.Err()
+}
+
+func (m *ReminderRepo) GetCandidates(groupId int, from time.Time, to time.Time) ([]domain.ReminderCandidate, error) {
+	rows, err := m.DB.Query(sqlGetReminderCandidates, from, to, groupId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	candidates := []domain.ReminderCandidate{}
+	for rows.Next() {
+		candidate, err := scanRowsIntoCandidate(rows)
+		if err != nil {
+			return nil, err
+		}
+		candidates = append(candidates, *candidate)
+	}
+	return candidates, rows.Err()
+}
+
+func (m *ReminderRepo) TryLockGroup(groupId int) (bool, error) {
+	var locked bool
+	if err := m.DB.QueryRow(sqlTryLockGroup, reminderLockSpace, groupId).Scan(&locked); err != nil {
+		return false, fmt.Errorf("unable to lock group %d : %w", groupId, err)
+	}
+	return locked, nil
+}
+
+// scanRowsIntoCandidate reads a candidate; assignees without a settings row get the default settings
+func scanRowsIntoCandidate(rows *sql.Rows) (*domain.ReminderCandidate, error) {
+	candidate := new(domain.ReminderCandidate)
+	var userId string
+	var offsets sql.NullString
+	var notifyOverdue, emailEnabled sql.NullBool
+	err := rows.Scan(
+		&candidate.TaskId,
+		&candidate.TaskName,
+		&candidate.DueAt,
+		&candidate.GroupId,
+		&userId,
+		&offsets,
+		&notifyOverdue,
+		&emailEnabled,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	candidate.Settings = *domain.DefaultReminderSettings(userId)
+	if offsets.Valid {
+		if candidate.Settings.OffsetsMinutes, err = parseOffsets(offsets.String); err != nil {
+			return nil, err
+		}
+		candidate.Settings.NotifyOverdue = notifyOverdue.Bool
+		candidate.Settings.EmailEnabled = emailEnabled.Bool
+	}
+	return candidate, nil
+}
+
+func formatOffsets(offsets []int) string {
+	values := make([]string, len(offsets))
+	for i, offset := range offsets {
+		values[i] = strconv.Itoa(offset)
+	}
+	return strings.Join(values, ",")
+}
+
+func parseOffsets(value string) ([]int, error) {
+	offsets := []int{}
+	if value == "" {
+		return offsets, nil
+	}
+	for _, v := range strings.Split(value, ",") {
+		offset, err := strconv.Atoi(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid reminder offset %q : %w", v, err)
+		}
+		offsets = append(offsets, offset)
+	}
+	return offsets, nil
+}
@@ -0,0 +1,31 @@
+package repository
+
+const (
+	// Settings are stored by the user's internal id; offsets are comma separated minutes, earliest first
+	sqlSaveReminderSettings = `INSERT INTO container.reminder_setting(user_id, offsets_minutes, notify_overdue, email_enabled, updated_at)
+						SELECT u.id, $2, $3, $4, $5 FROM container.user u WHERE u.user_id = $1
+						ON CONFLICT (user_id) DO UPDATE SET offsets_minutes = EXCLUDED.offsets_minutes,
+							notify_overdue = EXCLUDED.notify_overdue, email_enabled = EXCLUDED.email_enabled, updated_at = EXCLUDED.updated_at`
+	sqlGetReminderSettings = `SELECT u.user_id, s.offsets_minutes, s.notify_overdue, s.email_enabled, s.updated_at
+						FROM container.reminder_setting s
+						INNER JOIN container.user u ON u.id = s.user_id
+						WHERE u.user_id = $1`
+
+	// Open tasks remind their assignee while they are still a member of the task's group, as their settings say
+	sqlReminderTasks = ` FROM container.task t
+						INNER JOIN container.taskcontainer_task ct ON ct.task_id = t.id
+						INNER JOIN container.taskcontainer c ON c.id = ct.taskcontainer_id
+						INNER JOIN container.user u ON u.user_id = t.assignee_id
+						INNER JOIN container.usergroup_user ug ON ug.usergroup_id = c.usergroup_id AND ug.user_id = u.id
+						LEFT JOIN container.reminder_setting s ON s.user_id = u.id
+						WHERE t.target_date BETWEEN $1 AND $2
+							AND NOT t.is_completed AND t.deleted_at IS NULL AND c.deleted_at IS NULL AND u.is_active`
+	sqlGetGroupsWithDueTasks = `SELECT DISTINCT c.usergroup_id` + sqlReminderTasks + ` ORDER BY c.usergroup_id`
+	sqlGetReminderCandidates = `SELECT t.id, t.name, t.target_date, c.usergroup_id, u.user_id,
+							s.offsets_minutes, s.notify_overdue, s.email_enabled` + sqlReminderTasks + `
+							AND c.usergroup_id = $3
+						ORDER BY t.target_date, t.id`
+
+	// The lock is released when the transaction ends; (reminderLockSpace, group id) keys it
+	sqlTryLockGroup = `SELECT pg_try_advisory_xact_lock($1::int, $2::int)`
+)
@@ -0,0 +1,10 @@
+package route
+
+const prefix = "notifications_"
+
+const (
+	ReminderSettingsInvalidInput = prefix + "reminder_settings_invalid_input"
+	NotificationInvalidParameter = prefix + "invalid_parameter"
+	NotificationNotFound         = prefix + "not_found"
+	NotificationServerError      = prefix + "server_error"
+)
@@ -0,0 +1,11 @@
+package route
+
+type ReminderSettingsDto struct {
+	OffsetsMinutes []int `json:"offsets_minutes"`
+	NotifyOverdue  bool  `json:"notify_overdue"`
+	EmailEnabled   bool  `json:"email_enabled"`
+}
+
+type MarkAllReadDto struct {
+	MarkedRead int64 `json:"marked_read"`
+}
@@ -0,0 +1,182 @@
+package route
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	auditApp "github.com/happYness-Project/taskManagementGolang/internal/audit/application"
+	"github.com/happYness-Project/taskManagementGolang/internal/authorization"
+	"github.com/happYness-Project/taskManagementGolang/internal/notification/application"
+	"github.com/happYness-Project/taskManagementGolang/internal/notification/application/command"
+	"github.com/happYness-Project/taskManagementGolang/internal/notification/application/query"
+	"github.com/happYness-Project/taskManagementGolang/internal/notification/domain"
+	"github.com/happYness-Project/taskManagementGolang/internal/notification/repository"
+	"github.com/happYness-Project/taskManagementGolang/pkg/constants"
+	"github.com/happYness-Project/taskManagementGolang/pkg/dbs"
+	"github.com/happYness-Project/taskManagementGolang/pkg/loggers"
+	"github.com/happYness-Project/taskManagementGolang/pkg/response"
+)
+
+type Handler struct {
+	logger     *loggers.AppLogger
+	commandBus *application.CommandBus
+	queryBus   *application.QueryBus
+}
+
+func NewHandler(logger *loggers.AppLogger, reminderRepo repository.ReminderRepository, notificationRepo repository.NotificationRepository, policy *authorization.Policy, uow dbs.UnitOfWork, recorder *auditApp.Recorder) *Handler {
+	return &Handler{
+		logger:     logger,
+		commandBus: application.NewCommandBus(reminderRepo, notificationRepo, policy, uow, recorder),
+		queryBus:   application.NewQueryBus(reminderRepo, notificationRepo, policy),
+	}
+}
+
+func (h *Handler) RegisterRoutes(router chi.Router) {
+	router.Get("/api/users/{userID}/reminder-settings", h.handleGetReminderSettings)
+	router.Put("/api/users/{userID}/reminder-settings", h.handleUpdateReminderSettings)
+	router.Route("/api/users/{userID}/notifications", func(r chi.Router) {
+		r.Get("/", h.handleGetNotifications)
+		r.Post("/read-all", h.handleMarkAllRead)
+		r.Post("/{notificationID}/read", h.handleMarkRead)
+	})
+}
+
+func (h *Handler) handleGetReminderSettings(w http.ResponseWriter, r *http.Request) {
+	// Use Query Bus
+	result, err := h.queryBus.Execute(query.GetReminderSettingsQuery{
+		UserId:      chi.URLParam(r, "userID"),
+		RequesterId: authorization.RequesterId(r),
+	})
+	if h.notificationError(w, err) {
+		return
+	}
+	if err != nil {
+		h.logger.Error().Err(err).Str("ErrorCode", NotificationServerError).Msg(err.Error())
+		response.InternalServerError(w, "Error occurred during getting reminder settings")
+		return
+	}
+	response.WriteJsonWithEncode(w, http.StatusOK, result)
+}
+
+func (h *Handler) handleUpdateReminderSettings(w http.ResponseWriter, r *http.Request) {
+	var settingsDto ReminderSettingsDto
+	if err := response.ParseJson(r, &settingsDto); err != nil {
+		h.logger.Error().Err(err).Str("ErrorCode", constants.RequestBodyError).Msg("Error occurred during parsing json of ReminderSettingsDto")
+		response.InvalidJsonBody(w, "Error occurred during parsing json of ReminderSettingsDto")
+		return
+	}
+
+	// Use Command Bus
+	result, err := h.commandBus.Execute(r.Context(), command.UpdateReminderSettingsCommand{
+		UserId:         chi.URLParam(r, "userID"),
+		OffsetsMinutes: settingsDto.OffsetsMinutes,
+		NotifyOverdue:  settingsDto.NotifyOverdue,
+		EmailEnabled:   settingsDto.EmailEnabled,
+		RequesterId:    authorization.RequesterId(r),
+	})
+	if h.notificationError(w, err) {
+		return
+	}
+	if err != nil {
+		h.logger.Error().Err(err).Str("ErrorCode", NotificationServerError).Msg(err.Error())
+		response.InternalServerError(w, "Error occurred during updating reminder settings")
+		return
+	}
+	response.WriteJsonWithEncode(w, http.StatusOK, result)
+}
+
+func (h *Handler) handleGetNotifications(w http.ResponseWriter, r *http.Request) {
+	notificationsQuery := query.GetNotificationsQuery{
+		UserId:      chi.URLParam(r, "userID"),
+		RequesterId: authorization.RequesterId(r),
+	}
+	if v := r.URL.Query().Get("unread"); v != "" {
+		unread, err := strconv.ParseBool(v)
+		if err != nil {
+			h.invalidParameter(w, fmt.Errorf("unread must be true or false"))
+			return
+		}
+		notificationsQuery.UnreadOnly = unread
+	}
+	if v := r.URL.Query().Get("limit"); v != "" {
+		limit, err := strconv.Atoi(v)
+		if err != nil || limit < 1 {
+			h.invalidParameter(w, fmt.Errorf("limit must be a positive integer"))
+			return
+		}
+		notificationsQuery.Limit = limit
+	}
+
+	// Use Query Bus
+	result, err := h.queryBus.Execute(notificationsQuery)
+	if h.notificationError(w, err) {
+		return
+	}
+	if err != nil {
+		h.logger.Error().Err(err).Str("ErrorCode", NotificationServerError).Msg(err.Error())
+		response.InternalServerError(w, "Error occurred during getting notifications")
+		return
+	}
+	response.WriteJsonWithEncode(w, http.StatusOK, result)
+}
+
+func (h *Handler) handleMarkRead(w http.ResponseWriter, r *http.Request) {
+	// Use Command Bus
+	_, err := h.commandBus.Execute(r.Context(), command.MarkNotificationReadCommand{
+		UserId:         chi.URLParam(r, "userID"),
+		NotificationId: chi.URLParam(r, "notificationID"),
+		RequesterId:    authorization.RequesterId(r),
+	})
+	if h.notificationError(w, err) {
+		return
+	}
+	if err != nil {
+		h.logger.Error().Err(err).Str("ErrorCode", NotificationServerError).Msg(err.Error())
+		response.InternalServerError(w, "Error occurred during marking notification as read")
+		return
+	}
+	response.WriteJsonWithEncode(w, http.StatusNoContent, "notification is read.")
+}
+
+func (h *Handler) handleMarkAllRead(w http.ResponseWriter, r *http.Request) {
+	// Use Command Bus
+	result, err := h.commandBus.Execute(r.Context(), command.MarkAllNotificationsReadCommand{
+		UserId:      chi.URLParam(r, "userID"),
+		RequesterId: authorization.RequesterId(r),
+	})
+	if h.notificationError(w, err) {
+		return
+	}
+	if err != nil {
+		h.logger.Error().Err(err).Str("ErrorCode", NotificationServerError).Msg(err.Error())
+		response.InternalServerError(w, "Error occurred during marking notifications as read")
+		return
+	}
+	response.WriteJsonWithEncode(w, http.StatusOK, MarkAllReadDto{MarkedRead: result.(int64)})
+}
+
+func (h *Handler) invalidParameter(w http.ResponseWriter, err error) {
+	h.logger.Error().Err(err).Str("ErrorCode", NotificationInvalidParameter).Msg(err.Error())
+	response.ErrorResponse(w, http.StatusBadRequest, *(response.New(NotificationInvalidParameter, "Invalid parameter", err.Error())))
+}
+
+// notificationError answers 403, 404 or 400 for the errors every notification endpoint shares
+func (h *Handler) notificationError(w http.ResponseWriter, err error) bool {
+	switch {
+	case authorization.IsForbiddenError(err):
+		h.logger.Error().Err(err).Str("ErrorCode", constants.PermissionDenied).Msg(err.Error())
+		response.Forbidden(w, constants.PermissionDenied, err.Error())
+	case errors.Is(err, domain.ErrNotificationNotFound):
+		h.logger.Error().Err(err).Str("ErrorCode", NotificationNotFound).Msg(err.Error())
+		response.NotFound(w, NotificationNotFound, err.Error())
+	case errors.Is(err, domain.ErrInvalidReminderSettings):
+		h.logger.Error().Err(err).Str("ErrorCode", ReminderSettingsInvalidInput).Msg(err.Error())
+		response.ErrorResponse(w, http.StatusBadRequest, *(response.New(ReminderSettingsInvalidInput, "Invalid reminder settings", err.Error())))
+	default:
+		return false
+	}
+	return true
+}
@@ -0,0 +1,126 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/happYness-Project/taskManagementGolang/internal/outbox/domain"
+	"github.com/happYness-Project/taskManagementGolang/internal/outbox/repository"
+	"github.com/happYness-Project/taskManagementGolang/pkg/loggers"
+	"github.com/happYness-Project/taskManagementGolang/pkg/utils"
+)
+
+const (
+	DefaultPollInterval = 2 * time.Second
+	DefaultBatchSize    = 50
+	// MaxDeliveryAttempts is how often a message is tried before the dispatcher gives up on it
+	MaxDeliveryAttempts = 10
+
+	// claimLease is how long a claimed batch is kept from other dispatchers while it is delivered
+	claimLease     = time.Minute
+	baseRetryDelay = 5 * time.Second
+	maxRetryDelay  = time.Hour
+)
+
+// Sink receives the events stored in the outbox, e.g. to publish them to a message broker.
+// A message is retried until every sink accepts it, so sinks may see it more than once
+// and should drop duplicates by Message.EventId.
+type Sink interface {
+	Name() string
+	Deliver(ctx context.Context, msg domain.Message) error
+}
+
+// Dispatcher polls the outbox and delivers pending messages to its sinks.
+// Failed deliveries are retried with exponential backoff.
+type Dispatcher struct {
+	outboxRepo repository.OutboxRepository
+	sinks      []Sink
+	logger     *loggers.AppLogger
+
+	PollInterval time.Duration
+	BatchSize    int
+	now          func() time.Time
+}
+
+func NewDispatcher(outboxRepo repository.OutboxRepository, logger *loggers.AppLogger, sinks ...Sink) *Dispatcher {
+	return &Dispatcher{
+		outboxRepo:   outboxRepo,
+		sinks:        sinks,
+		logger:       logger,
+		PollInterval: DefaultPollInterval,
+		BatchSize:    DefaultBatchSize,
+		now:          func() time.Time { return time.Now().UTC() },
+	}
+}
+
+// Run dispatches pending messages every PollInterval until ctx is cancelled
+func (d *Dispatcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(d.PollInterval)
+	defer ticker.Stop()
+	for {
+		if _, err := d.DispatchPending(ctx); err != nil {
+			d.logger.Error().Err(err).Msg("failed to dispatch outbox events")
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// DispatchPending delivers one batch of due messages and returns how many were delivered.
+// The batch is claimed with a lease and delivered outside any transaction, so slow sinks hold no row locks;
+// messages left unsettled, e.g. because the process stopped, are claimed again once the lease ends.
+func (d *Dispatcher) DispatchPending(ctx context.Context) (int, error) {
+	now := d.now()
+	messages, err := d.outboxRepo.ClaimPending(now, now.Add(claimLease), d.BatchSize)
+	if err != nil {
+		return 0, fmt.Errorf("failed to read outbox: %w", err)
+	}
+
+	delivered := 0
+	for _, msg := range messages {
+		if ctx.Err() != nil {
+			break
+		}
+		if err := d.deliver(ctx, msg); err != nil {
+			if err := d.outboxRepo.MarkFailed(msg.Id, err.Error(), d.nextAttemptAt(msg, err)); err != nil {
+				return delivered, err
+			}
+			continue
+		}
+		if err := d.outboxRepo.MarkDispatched(msg.Id, d.now()); err != nil {
+			return delivered, err
+		}
+		delivered++
+	}
+	return delivered, nil
+}
+
+// deliver hands the message to every sink, collecting the failures
+func (d *Dispatcher) deliver(ctx context.Context, msg domain.Message) error {
+	var errs []error
+	for _, sink := range d.sinks {
+		if err := sink.Deliver(ctx, msg); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", sink.Name(), err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// nextAttemptAt schedules the retry of a failed message, or returns nil once it has used up its attempts
+func (d *Dispatcher) nextAttemptAt(msg domain.Message, err error) *time.Time {
+	attempts := msg.Attempts + 1
+	if attempts >= MaxDeliveryAttempts {
+		d.logger.Error().Err(err).Str("EventId", msg.EventId).Str("EventType", msg.EventType).
+			Int("Attempts", attempts).Msg("giving up on outbox event")
+		return nil
+	}
+	d.logger.Info().Str("EventId", msg.EventId).Str("EventType", msg.EventType).
+		Int("Attempts", attempts).Str("Reason", err.Error()).Msg("outbox event delivery failed, will retry")
+	next := d.now().Add(utils.ExponentialBackoff(attempts, baseRetryDelay, maxRetryDelay))
+	return &next
+}
@@ -0,0 +1,115 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/happYness-Project/taskManagementGolang/internal/mocks"
+	"github.com/happYness-Project/taskManagementGolang/internal/outbox/domain"
+	"github.com/happYness-Project/taskManagementGolang/pkg/configs"
+	"github.com/happYness-Project/taskManagementGolang/pkg/loggers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubOutboxRepo hands out the pending messages once and records how they were settled
+type stubOutboxRepo struct {
+	mocks.MockOutboxRepo
+	pending    []domain.Message
+	dispatched []int64
+	failed     map[int64]*time.Time
+	leaseUntil time.Time
+}
+
+func (s *stubOutboxRepo) ClaimPending(now time.Time, leaseUntil time.Time, limit int) ([]domain.Message, error) {
+	s.leaseUntil = leaseUntil
+	pending := s.pending
+	s.pending = nil
+	return pending, nil
+}
+
+func (s *stubOutboxRepo) MarkDispatched(id int64, at time.Time) error {
+	s.dispatched = append(s.dispatched, id)
+	return nil
+}
+
+func (s *stubOutboxRepo) MarkFailed(id int64, reason string, nextAttemptAt *time.Time) error {
+	s.failed[id] = nextAttemptAt
+	return nil
+}
+
+type stubSink struct {
+	name      string
+	failOn    string
+	delivered []string
+}
+
+func (s *stubSink) Name() string { return s.name }
+
+func (s *stubSink) Deliver(ctx context.Context, msg domain.Message) error {
+	if msg.EventType == s.failOn {
+		return errors.New("unavailable")
+	}
+	s.delivered = append(s.delivered, msg.EventId)
+	return nil
+}
+
+func TestDispatcher_DispatchPending(t *testing.T) {
+	logger := loggers.Setup(configs.Env{})
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	newDispatcher := func(repo *stubOutboxRepo, sinks ...Sink) *Dispatcher {
+		d := NewDispatcher(repo, logger, sinks...)
+		d.now = func() time.Time { return now }
+		return d
+	}
+
+	t.Run("when every sink accepts the messages, Then they are marked dispatched", func(t *testing.T) {
+		// Arrange
+		repo := &stubOutboxRepo{failed: map[int64]*time.Time{}, pending: []domain.Message{
+			{Id: 1, EventId: "e1", EventType: "task.created"},
+			{Id: 2, EventId: "e2", EventType: "task.completed"},
+		}}
+		first, second := &stubSink{name: "first"}, &stubSink{name: "second"}
+
+		// Act
+		delivered, err := newDispatcher(repo, first, second).DispatchPending(context.Background())
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, 2, delivered)
+		assert.Equal(t, []int64{1, 2}, repo.dispatched)
+		assert.Equal(t, []string{"e1", "e2"}, first.delivered)
+		assert.Equal(t, []string{"e1", "e2"}, second.delivered)
+		assert.Equal(t, now.Add(claimLease), repo.leaseUntil)
+	})
+
+	t.Run("when a sink fails, Then the message is retried with backoff", func(t *testing.T) {
+		repo := &stubOutboxRepo{failed: map[int64]*time.Time{}, pending: []domain.Message{
+			{Id: 1, EventId: "e1", EventType: "task.created", Attempts: 2},
+			{Id: 2, EventId: "e2", EventType: "task.completed"},
+		}}
+
+		delivered, err := newDispatcher(repo, &stubSink{name: "flaky", failOn: "task.created"}).DispatchPending(context.Background())
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, delivered)
+		assert.Equal(t, []int64{2}, repo.dispatched)
+		require.NotNil(t, repo.failed[1])
+		assert.Equal(t, now.Add(4*baseRetryDelay), *repo.failed[1])
+	})
+
+	t.Run("when a message has used up its attempts, Then the dispatcher gives up on it", func(t *testing.T) {
+		repo := &stubOutboxRepo{failed: map[int64]*time.Time{}, pending: []domain.Message{
+			{Id: 1, EventId: "e1", EventType: "task.created", Attempts: MaxDeliveryAttempts - 1},
+		}}
+
+		_, err := newDispatcher(repo, &stubSink{name: "down", failOn: "task.created"}).DispatchPending(context.Background())
+
+		require.NoError(t, err)
+		assert.Contains(t, repo.failed, int64(1))
+		assert.Nil(t, repo.failed[1])
+	})
+}
@@ -0,0 +1,31 @@
+package application
+
+import (
+	"context"
+
+	"github.com/happYness-Project/taskManagementGolang/internal/outbox/domain"
+	"github.com/happYness-Project/taskManagementGolang/pkg/loggers"
+)
+
+// LogSink writes every event to the application log.
+// It is the default sink, so events are visible before any integration is configured.
+type LogSink struct {
+	logger *loggers.AppLogger
+}
+
+func NewLogSink(logger *loggers.AppLogger) *LogSink {
+	return &LogSink{logger: logger}
+}
+
+func (s *LogSink) Name() string { return "log" }
+
+func (s *LogSink) Deliver(ctx context.Context, msg domain.Message) error {
+	s.logger.Info().
+		Str("EventId", msg.EventId).
+		Str("EventType", msg.EventType).
+		Str("AggregateId", msg.AggregateId).
+		Int("GroupId", msg.GroupId).
+		RawJSON("Payload", msg.Payload).
+		Msg("domain event")
+	return nil
+}
@@ -0,0 +1,47 @@
+package domain
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/happYness-Project/taskManagementGolang/pkg/events"
+)
+
+// Message is a domain event stored in the outbox, waiting to be delivered to the sinks.
+// EventId is unique per event, so consumers can drop the duplicates at-least-once delivery may produce.
+type Message struct {
+	Id            int64           `json:"-"`
+	EventId       string          `json:"event_id"`
+	EventType     string          `json:"event_type"`
+	AggregateType string          `json:"aggregate_type"`
+	AggregateId   string          `json:"aggregate_id"`
+	GroupId       int             `json:"group_id,omitempty"`
+	Payload       json.RawMessage `json:"payload"`
+	OccurredAt    time.Time       `json:"occurred_at"`
+	Attempts      int             `json:"-"`
+	TxId          uint64          `json:"-"` // transaction that stored the message
+}
+
+// NewMessage wraps an event raised in the given group (0 when it belongs to none)
+func NewMessage(event events.Event, groupId int, occurredAt time.Time) (Message, error) {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return Message{}, fmt.Errorf("unable to serialize %s event: %w", event.EventType(), err)
+	}
+	return Message{
+		EventId:       uuid.New().String(),
+		EventType:     event.EventType(),
+		AggregateType: event.AggregateType(),
+		AggregateId:   event.AggregateId(),
+		GroupId:       groupId,
+		Payload:       payload,
+		OccurredAt:    occurredAt,
+	}, nil
+}
+
+// Position returns where the message sits in the outbox
+func (m Message) Position() Position {
+	return Position{TxId: m.TxId, Id: m.Id}
+}
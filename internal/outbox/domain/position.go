@@ -0,0 +1,53 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+var ErrInvalidPosition = errors.New("invalid outbox position")
+
+// Position orders the messages of the outbox for readers that follow it.
+// Ids are taken when a message is inserted, not when its transaction commits, so ids alone can be read out of order;
+// messages are ordered by the id of the transaction that stored them first, then by id.
+// The zero Position comes before every message.
+type Position struct {
+	TxId uint64
+	Id   int64
+}
+
+// After reports whether p comes after other
+func (p Position) After(other Position) bool {
+	if p.TxId != other.TxId {
+		return p.TxId > other.TxId
+	}
+	return p.Id > other.Id
+}
+
+// IsZero reports whether p is the zero Position
+func (p Position) IsZero() bool {
+	return p == Position{}
+}
+
+// String formats p as "<txid>-<id>", the form read by ParsePosition
+func (p Position) String() string {
+	return fmt.Sprintf("%d-%d", p.TxId, p.Id)
+}
+
+func ParsePosition(s string) (Position, error) {
+	txId, id, found := strings.Cut(s, "-")
+	if !found {
+		return Position{}, fmt.Errorf("%w: '%s'", ErrInvalidPosition, s)
+	}
+	var p Position
+	var err error
+	if p.TxId, err = strconv.ParseUint(txId, 10, 64); err != nil {
+		return Position{}, fmt.Errorf("%w: '%s'", ErrInvalidPosition, s)
+	}
+	if p.Id, err = strconv.ParseInt(id, 10, 64); err != nil || p.Id < 0 {
+		return Position{}, fmt.Errorf("%w: '%s'", ErrInvalidPosition, s)
+	}
+	return p, nil
+}
@@ -0,0 +1,162 @@
+package repository
+
+import (
+	"cmp"
+	"database/sql"
+	"fmt"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/happYness-Project/taskManagementGolang/internal/outbox/domain"
+	"github.com/happYness-Project/taskManagementGolang/pkg/dbs"
+	"github.com/happYness-Project/taskManagementGolang/pkg/events"
+)
+
+type OutboxRepository interface {
+	// Append stores events raised in a group (0 for none).
+	// Call it with the transaction that persisted the aggregate, so events are stored exactly when the change is.
+	Append(groupId int, evts ...events.Event) error
+	// ClaimPending claims up to limit messages due for delivery at now, oldest first, until leaseUntil.
+	// Other dispatchers skip them meanwhile; a message that is neither dispatched nor failed by then is claimed again.
+	ClaimPending(now time.Time, leaseUntil time.Time, limit int) ([]domain.Message, error)
+	MarkDispatched(id int64, at time.Time) error
+	// MarkFailed records a failed delivery. A nil nextAttemptAt gives up on the message.
+	MarkFailed(id int64, reason string, nextAttemptAt *time.Time) error
+	// GetByGroupIdAfter returns up to limit messages of the group past the given position, in position order,
+	// whether or not they have been dispatched yet. Messages are returned once no message can be committed before them.
+	GetByGroupIdAfter(groupId int, after domain.Position, limit int) ([]domain.Message, error)
+	// GetCurrentPosition returns a position every message stored from now on comes after.
+	// A few messages committed just before may come after it as well.
+	GetCurrentPosition() (domain.Position, error)
+	WithTx(tx dbs.DBTX) OutboxRepository
+}
+type OutboxRepo struct {
+	DB dbs.DBTX
+}
+
+func NewOutboxRepository(db dbs.DBTX) *OutboxRepo {
+	return &OutboxRepo{DB: db}
+}
+
+// WithTx returns a copy of the repository bound to the given transaction
+func (m *OutboxRepo) WithTx(tx dbs.DBTX) OutboxRepository {
+	return &OutboxRepo{DB: tx}
+}
+
+func (m *OutboxRepo) Append(groupId int, evts ...events.Event) error {
+	now := time.Now().UTC()
+	for _, event := range evts {
+		msg, err := domain.NewMessage(event, groupId, now)
+		if err != nil {
+			return err
+		}
+		var group interface{}
+		if msg.GroupId > 0 {
+			group = msg.GroupId
+		}
+		_, err = m.DB.Exec(sqlAppendOutboxEvent, msg.EventId, msg.EventType, msg.AggregateType, msg.AggregateId, group, string(msg.Payload), msg.OccurredAt)
+		if err != nil {
+			return fmt.Errorf("unable to insert into outbox_event table : %w", err)
+		}
+	}
+	return nil
+}
+
+func (m *OutboxRepo) ClaimPending(now time.Time, leaseUntil time.Time, limit int) ([]domain.Message, error) {
+	rows, err := m.DB.Query(sqlClaimPendingOutboxEvents, now, leaseUntil, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	messages := []domain.Message{}
+	for rows.Next() {
+		msg, err := scanRowsIntoMessage(rows)
+		if err != nil {
+			return nil, err
+		}
+		messages = append(messages, *msg)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	// RETURNING does not keep the order of the claim
+	slices.SortFunc(messages, func(a, b domain.Message) int { return cmp.Compare(a.Id, b.Id) })
+	return messages, nil
+}
+
+func (m *OutboxRepo) GetByGroupIdAfter(groupId int, after domain.Position, limit int) ([]domain.Message, error) {
+	rows, err := m.DB.Query(sqlGetOutboxEventsByGroupIdAfter, groupId, strconv.FormatUint(after.TxId, 10), after.Id, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	messages := []domain.Message{}
+	for rows.Next() {
+		msg, err := scanRowsIntoMessage(rows)
+		if err != nil {
+			return nil, err
+		}
+		messages = append(messages, *msg)
+	}
+	return messages, rows.Err()
+}
+
+func (m *OutboxRepo) GetCurrentPosition() (domain.Position, error) {
+	var txId string
+	if err := m.DB.QueryRow(sqlGetCurrentOutboxTxId).Scan(&txId); err != nil {
+		return domain.Position{}, err
+	}
+	oldestRunning, err := strconv.ParseUint(txId, 10, 64)
+	if err != nil {
+		return domain.Position{}, fmt.Errorf("unexpected transaction id '%s' : %w", txId, err)
+	}
+	// Messages of transactions older than the oldest running one are all committed
+	return domain.Position{TxId: oldestRunning}, nil
+}
+
+func (m *OutboxRepo) MarkDispatched(id int64, at time.Time) error {
+	_, err := m.DB.Exec(sqlMarkOutboxEventDispatched, id, at)
+	if err != nil {
+		return fmt.Errorf("unable to mark outbox event %d dispatched : %w", id, err)
+	}
+	return nil
+}
+
+func (m *OutboxRepo) MarkFailed(id int64, reason string, nextAttemptAt *time.Time) error {
+	_, err := m.DB.Exec(sqlMarkOutboxEventFailed, id, reason, nextAttemptAt)
+	if err != nil {
+		return fmt.Errorf("unable to mark outbox event %d failed : %w", id, err)
+	}
+	return nil
+}
+
+func scanRowsIntoMessage(rows *sql.Rows) (*domain.Message, error) {
+	msg := new(domain.Message)
+	var groupId sql.NullInt64
+	var payload []byte
+	var txId string
+	err := rows.Scan(
+		&msg.Id,
+		&msg.EventId,
+		&msg.EventType,
+		&msg.AggregateType,
+		&msg.AggregateId,
+		&groupId,
+		&payload,
+		&msg.OccurredAt,
+		&msg.Attempts,
+		&txId,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if msg.TxId, err = strconv.ParseUint(txId, 10, 64); err != nil {
+		return nil, fmt.Errorf("unexpected transaction id '%s' : %w", txId, err)
+	}
+	msg.GroupId = int(groupId.Int64)
+	msg.Payload = payload
+	return msg, nil
+}
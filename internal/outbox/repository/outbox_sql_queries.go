@@ -0,0 +1,26 @@
+package repository
+
+const (
+	sqlAppendOutboxEvent = `INSERT INTO container.outbox_event(event_id, event_type, aggregate_type, aggregate_id, usergroup_id, payload, occurred_at, next_attempt_at)
+		VALUES ($1,$2,$3,$4,$5,$6,$7,$7)`
+
+	// Claimed messages are leased until $2 by moving their next attempt; SKIP LOCKED lets several dispatchers
+	// claim side by side without handing out a message twice
+	sqlClaimPendingOutboxEvents = `UPDATE container.outbox_event e SET next_attempt_at = $2
+								FROM (SELECT id FROM container.outbox_event
+									WHERE dispatched_at IS NULL AND next_attempt_at <= $1
+									ORDER BY id LIMIT $3
+									FOR UPDATE SKIP LOCKED) due
+								WHERE e.id = due.id
+								RETURNING e.id, e.event_id, e.event_type, e.aggregate_type, e.aggregate_id, e.usergroup_id, e.payload, e.occurred_at, e.attempts, e.tx_id::text`
+	// Events of transactions at or after the oldest one still running are held back: an older transaction may
+	// still commit events that come before them
+	sqlGetOutboxEventsByGroupIdAfter = `SELECT id, event_id, event_type, aggregate_type, aggregate_id, usergroup_id, payload, occurred_at, attempts, tx_id::text
+								FROM container.outbox_event
+								WHERE usergroup_id = $1 AND (tx_id, id) > ($2::text::xid8, $3)
+									AND tx_id < pg_snapshot_xmin(pg_current_snapshot())
+								ORDER BY tx_id, id LIMIT $4`
+	sqlGetCurrentOutboxTxId      = `SELECT pg_snapshot_xmin(pg_current_snapshot())::text`
+	sqlMarkOutboxEventDispatched = `UPDATE container.outbox_event SET dispatched_at = $2, attempts = attempts + 1, last_error = NULL WHERE id = $1`
+	sqlMarkOutboxEventFailed     = `UPDATE container.outbox_event SET attempts = attempts + 1, last_error = $2, next_attempt_at = $3 WHERE id = $1`
+)
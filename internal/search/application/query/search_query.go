@@ -0,0 +1,74 @@
+package query
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/happYness-Project/taskManagementGolang/internal/search/domain"
+	"github.com/happYness-Project/taskManagementGolang/internal/search/repository"
+)
+
+const (
+	DefaultSearchLimit = 20
+	MaxSearchLimit     = 100
+	MaxSearchLength    = 200
+)
+
+var ErrInvalidSearchParams = errors.New("invalid search parameters")
+
+// SearchQuery searches tasks, containers and groups of the requester's groups, best match first
+type SearchQuery struct {
+	Text        string   // web search syntax: quoted phrases, "or", and -word to exclude
+	Types       []string // task, container or group; every type when empty
+	GroupId     int      // 0 searches every group of the requester
+	Limit       int
+	RequesterId string // UUID from JWT
+}
+
+// SearchQueryHandler handles full-text search
+type SearchQueryHandler struct {
+	searchRepo repository.SearchRepository
+}
+
+func NewSearchQueryHandler(searchRepo repository.SearchRepository) *SearchQueryHandler {
+	return &SearchQueryHandler{searchRepo: searchRepo}
+}
+
+func invalidParams(format string, args ...interface{}) error {
+	return fmt.Errorf("%w: %s", ErrInvalidSearchParams, fmt.Sprintf(format, args...))
+}
+
+// HandleSearch returns the ranked matches of the query with highlighted snippets
+func (h *SearchQueryHandler) HandleSearch(query SearchQuery) ([]domain.Result, error) {
+	criteria := repository.SearchCriteria{
+		RequesterId: query.RequesterId,
+		Text:        strings.TrimSpace(query.Text),
+		GroupId:     query.GroupId,
+		Limit:       DefaultSearchLimit,
+	}
+	if criteria.Text == "" {
+		return nil, invalidParams("q is required")
+	}
+	if len(criteria.Text) > MaxSearchLength {
+		return nil, invalidParams("q cannot exceed %d characters", MaxSearchLength)
+	}
+	for _, t := range query.Types {
+		if !domain.IsValidResultType(t) {
+			return nil, invalidParams("unsupported type '%s'", t)
+		}
+	}
+	criteria.Types = query.Types
+	if query.Limit < 0 || query.Limit > MaxSearchLimit {
+		return nil, invalidParams("limit must be between 1 and %d", MaxSearchLimit)
+	}
+	if query.Limit > 0 {
+		criteria.Limit = query.Limit
+	}
+
+	results, err := h.searchRepo.Search(criteria)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search: %w", err)
+	}
+	return results, nil
+}
@@ -0,0 +1,70 @@
+package query
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/happYness-Project/taskManagementGolang/internal/search/domain"
+	"github.com/happYness-Project/taskManagementGolang/internal/search/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubSearchRepo records the criteria it was searched with
+type stubSearchRepo struct {
+	repository.SearchRepository
+	results      []domain.Result
+	lastCriteria repository.SearchCriteria
+}
+
+func (s *stubSearchRepo) Search(criteria repository.SearchCriteria) ([]domain.Result, error) {
+	s.lastCriteria = criteria
+	return s.results, nil
+}
+
+func TestHandleSearch(t *testing.T) {
+	repo := &stubSearchRepo{results: []domain.Result{{Type: domain.ResultTask, Id: "task-1", Title: "Apple pie"}}}
+	handler := NewSearchQueryHandler(repo)
+
+	t.Run("when search has filters, Then trimmed text, types and group reach the repository", func(t *testing.T) {
+		// Act
+		results, err := handler.HandleSearch(SearchQuery{Text: "  apple pie ", Types: []string{"task", "container"}, GroupId: 3, RequesterId: "requester-id"})
+
+		// Assert
+		require.NoError(t, err)
+		assert.Len(t, results, 1)
+		assert.Equal(t, "apple pie", repo.lastCriteria.Text)
+		assert.Equal(t, []string{"task", "container"}, repo.lastCriteria.Types)
+		assert.Equal(t, 3, repo.lastCriteria.GroupId)
+		assert.Equal(t, "requester-id", repo.lastCriteria.RequesterId)
+		assert.Equal(t, DefaultSearchLimit, repo.lastCriteria.Limit)
+	})
+
+	t.Run("when search parameters are invalid, Then ErrInvalidSearchParams is returned", func(t *testing.T) {
+		invalid := []SearchQuery{
+			{Text: "   "},
+			{Text: strings.Repeat("a", MaxSearchLength+1)},
+			{Text: "apple", Types: []string{"user"}},
+			{Text: "apple", Limit: MaxSearchLimit + 1},
+		}
+
+		for _, q := range invalid {
+			// Act
+			_, err := handler.HandleSearch(q)
+
+			// Assert
+			assert.True(t, errors.Is(err, ErrInvalidSearchParams), "query %+v: error = %v", q, err)
+		}
+	})
+}
+
+func TestHighlightSnippet(t *testing.T) {
+	t.Run("when snippet has markup, Then it is escaped and matches are wrapped in mark", func(t *testing.T) {
+		// Act
+		snippet := domain.HighlightSnippet("Bake <b>" + domain.HighlightStart + "apple" + domain.HighlightStop + "</b> pie")
+
+		// Assert
+		assert.Equal(t, "Bake &lt;b&gt;<mark>apple</mark>&lt;/b&gt; pie", snippet)
+	})
+}
@@ -0,0 +1,52 @@
+package application
+
+import (
+	"fmt"
+
+	"github.com/happYness-Project/taskManagementGolang/internal/authorization"
+	qry "github.com/happYness-Project/taskManagementGolang/internal/search/application/query"
+	"github.com/happYness-Project/taskManagementGolang/internal/search/repository"
+)
+
+// QueryBus routes queries to their handlers
+type QueryBus struct {
+	queryHandler *qry.SearchQueryHandler
+
+	policy *authorization.Policy
+}
+
+// NewQueryBus creates a new query bus with all handlers registered
+func NewQueryBus(searchRepo repository.SearchRepository, policy *authorization.Policy) *QueryBus {
+	return &QueryBus{
+		queryHandler: qry.NewSearchQueryHandler(searchRepo),
+		policy:       policy,
+	}
+}
+
+// Execute dispatches the query to the appropriate handler
+func (bus *QueryBus) Execute(query interface{}) (interface{}, error) {
+	if err := bus.authorize(query); err != nil {
+		return nil, err
+	}
+
+	switch q := query.(type) {
+	case qry.SearchQuery:
+		return bus.queryHandler.HandleSearch(q)
+	default:
+		return nil, fmt.Errorf("unknown query type: %T", query)
+	}
+}
+
+// authorize checks that the requester is a member of the group a search is narrowed to.
+// A search over every group needs no check because it only reads the requester's groups.
+func (bus *QueryBus) authorize(query interface{}) error {
+	switch q := query.(type) {
+	case qry.SearchQuery:
+		if q.GroupId == 0 {
+			return nil
+		}
+		return bus.policy.RequireMember(q.RequesterId, q.GroupId)
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,47 @@
+package domain
+
+import (
+	"html"
+	"strings"
+)
+
+// Kinds of results a search returns
+const (
+	ResultTask      = "task"
+	ResultContainer = "container"
+	ResultGroup     = "group"
+)
+
+// Markers the database puts around matched words in snippets, replaced by <mark> once the snippet is escaped
+const (
+	HighlightStart = "\x01"
+	HighlightStop  = "\x02"
+)
+
+// Result is one ranked match of a search
+type Result struct {
+	Type        string  `json:"type"`
+	Id          string  `json:"id"`
+	GroupId     int     `json:"group_id"`
+	GroupName   string  `json:"group_name"`
+	ContainerId string  `json:"container_id,omitempty"` // container of a task result
+	Title       string  `json:"title"`
+	Snippet     string  `json:"snippet"` // HTML-escaped, matched words wrapped in <mark>
+	Rank        float64 `json:"rank"`
+}
+
+// IsValidResultType reports whether t is one of the searchable types
+func IsValidResultType(t string) bool {
+	switch t {
+	case ResultTask, ResultContainer, ResultGroup:
+		return true
+	}
+	return false
+}
+
+// HighlightSnippet escapes a snippet read from the database and turns its highlight markers into <mark> tags
+func HighlightSnippet(raw string) string {
+	snippet := html.EscapeString(raw)
+	snippet = strings.ReplaceAll(snippet, HighlightStart, "<mark>")
+	return strings.ReplaceAll(snippet, HighlightStop, "</mark>")
+}
@@ -0,0 +1,111 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/happYness-Project/taskManagementGolang/internal/search/domain"
+	"github.com/happYness-Project/taskManagementGolang/pkg/dbs"
+)
+
+const dbTimeout = time.Second * 5
+
+// SearchCriteria selects the best matches of a search in the requester's groups.
+// Empty Types searches every type; GroupId 0 searches every group.
+type SearchCriteria struct {
+	RequesterId string // UUID from JWT
+	Text        string
+	Types       []string
+	GroupId     int
+	Limit       int
+}
+
+type SearchRepository interface {
+	Search(criteria SearchCriteria) ([]domain.Result, error)
+}
+
+type SearchRepo struct {
+	DB dbs.DBTX
+}
+
+func NewSearchRepository(db dbs.DBTX) *SearchRepo {
+	return &SearchRepo{DB: db}
+}
+
+func (m *SearchRepo) Search(criteria SearchCriteria) ([]domain.Result, error) {
+	query, args := buildSearchQuery(criteria)
+
+	ctx, cancel := context.WithTimeout(context.Background(), dbTimeout)
+	defer cancel()
+
+	rows, err := m.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	results := []domain.Result{}
+	for rows.Next() {
+		result, err := scanRowsIntoResult(rows)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, *result)
+	}
+	return results, rows.Err()
+}
+
+func buildSearchQuery(c SearchCriteria) (string, []interface{}) {
+	args := []interface{}{c.RequesterId, c.Text, searchHeadlineOptions}
+	arg := func(v interface{}) int {
+		args = append(args, v)
+		return len(args)
+	}
+
+	types := c.Types
+	if len(types) == 0 {
+		types = []string{domain.ResultTask, domain.ResultContainer, domain.ResultGroup}
+	}
+	groupFilter := ""
+	if c.GroupId > 0 {
+		groupFilter = fmt.Sprintf(sqlSearchFilterGroup, arg(c.GroupId))
+	}
+
+	branches := make([]string, 0, len(types))
+	for _, t := range types {
+		switch t {
+		case domain.ResultTask:
+			branches = append(branches, sqlSearchTasks+groupFilter)
+		case domain.ResultContainer:
+			branches = append(branches, sqlSearchContainers+groupFilter)
+		case domain.ResultGroup:
+			branches = append(branches, sqlSearchGroups+groupFilter)
+		}
+	}
+
+	query := sqlSearchWith + strings.Join(branches, sqlSearchUnion) + fmt.Sprintf(sqlSearchOrderLimit, arg(c.Limit))
+	return query, args
+}
+
+func scanRowsIntoResult(rows *sql.Rows) (*domain.Result, error) {
+	result := new(domain.Result)
+	var snippet string
+	err := rows.Scan(
+		&result.Type,
+		&result.Id,
+		&result.GroupId,
+		&result.GroupName,
+		&result.ContainerId,
+		&result.Title,
+		&snippet,
+		&result.Rank,
+	)
+	if err != nil {
+		return nil, err
+	}
+	result.Snippet = domain.HighlightSnippet(snippet)
+	return result, nil
+}
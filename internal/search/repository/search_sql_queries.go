@@ -0,0 +1,42 @@
+package repository
+
+const (
+	// $1 is the requester's UUID, $2 the search text and $3 the ts_headline options
+	sqlSearchWith = `WITH q AS (SELECT websearch_to_tsquery('english', $2) AS query),
+		member_groups AS (SELECT ugu.usergroup_id FROM container.usergroup_user ugu
+							INNER JOIN container.user u ON u.id = ugu.user_id
+							WHERE u.user_id = $1)
+		SELECT type, id, group_id, group_name, container_id, title, snippet, rank FROM (`
+
+	sqlSearchTasks = `SELECT 'task' AS type, t.id::text AS id, ug.id AS group_id, ug.name AS group_name, tc.id::text AS container_id, t.name AS title,
+			ts_headline('english', t.name || ' ' || coalesce(t.description, ''), q.query, $3) AS snippet,
+			ts_rank(t.search_vector, q.query) AS rank
+		FROM q CROSS JOIN container.task t
+			INNER JOIN container.taskcontainer_task tct ON tct.task_id = t.id
+			INNER JOIN container.taskcontainer tc ON tc.id = tct.taskcontainer_id
+			INNER JOIN container.usergroup ug ON ug.id = tc.usergroup_id
+		WHERE t.search_vector @@ q.query AND t.deleted_at IS NULL AND tc.deleted_at IS NULL
+			AND ug.id IN (SELECT usergroup_id FROM member_groups)`
+
+	sqlSearchContainers = `SELECT 'container' AS type, tc.id::text AS id, ug.id AS group_id, ug.name AS group_name, '' AS container_id, tc.name AS title,
+			ts_headline('english', tc.name || ' ' || coalesce(tc.description, ''), q.query, $3) AS snippet,
+			ts_rank(tc.search_vector, q.query) AS rank
+		FROM q CROSS JOIN container.taskcontainer tc
+			INNER JOIN container.usergroup ug ON ug.id = tc.usergroup_id
+		WHERE tc.search_vector @@ q.query AND tc.deleted_at IS NULL
+			AND ug.id IN (SELECT usergroup_id FROM member_groups)`
+
+	sqlSearchGroups = `SELECT 'group' AS type, ug.id::text AS id, ug.id AS group_id, ug.name AS group_name, '' AS container_id, ug.name AS title,
+			ts_headline('english', ug.name || ' ' || coalesce(ug.description, ''), q.query, $3) AS snippet,
+			ts_rank(ug.search_vector, q.query) AS rank
+		FROM q CROSS JOIN container.usergroup ug
+		WHERE ug.search_vector @@ q.query
+			AND ug.id IN (SELECT usergroup_id FROM member_groups)`
+
+	sqlSearchFilterGroup = ` AND ug.id = $%d`
+	sqlSearchUnion       = ` UNION ALL `
+	sqlSearchOrderLimit  = `) results ORDER BY rank DESC, title LIMIT $%d`
+
+	// Snippets keep a few words around the matches, with the markers of domain.HighlightStart and HighlightStop
+	searchHeadlineOptions = "StartSel=\x01, StopSel=\x02, MaxWords=25, MinWords=8, MaxFragments=2"
+)
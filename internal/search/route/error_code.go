@@ -0,0 +1,8 @@
+package route
+
+const prefix = "search_"
+
+const (
+	SearchInvalidParameter = prefix + "invalid_parameter"
+	SearchServerError      = prefix + "server_error"
+)
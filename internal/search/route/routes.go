@@ -0,0 +1,90 @@
+package route
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/happYness-Project/taskManagementGolang/internal/authorization"
+	"github.com/happYness-Project/taskManagementGolang/internal/search/application"
+	"github.com/happYness-Project/taskManagementGolang/internal/search/application/query"
+	"github.com/happYness-Project/taskManagementGolang/internal/search/repository"
+	"github.com/happYness-Project/taskManagementGolang/pkg/constants"
+	"github.com/happYness-Project/taskManagementGolang/pkg/loggers"
+	"github.com/happYness-Project/taskManagementGolang/pkg/response"
+)
+
+type Handler struct {
+	logger   *loggers.AppLogger
+	queryBus *application.QueryBus
+}
+
+func NewHandler(logger *loggers.AppLogger, searchRepo repository.SearchRepository, policy *authorization.Policy) *Handler {
+	return &Handler{
+		logger:   logger,
+		queryBus: application.NewQueryBus(searchRepo, policy),
+	}
+}
+
+func (h *Handler) RegisterRoutes(router chi.Router) {
+	router.Get("/api/search", h.handleSearch)
+}
+
+func (h *Handler) handleSearch(w http.ResponseWriter, r *http.Request) {
+	searchQuery, err := parseSearchQuery(r)
+	if err != nil {
+		h.logger.Error().Err(err).Str("ErrorCode", SearchInvalidParameter).Msg(err.Error())
+		response.ErrorResponse(w, http.StatusBadRequest, *(response.New(SearchInvalidParameter, "Invalid parameter", err.Error())))
+		return
+	}
+	searchQuery.RequesterId = authorization.RequesterId(r)
+
+	// Use Query Bus
+	result, err := h.queryBus.Execute(searchQuery)
+	if authorization.IsForbiddenError(err) {
+		h.logger.Error().Err(err).Str("ErrorCode", constants.PermissionDenied).Msg(err.Error())
+		response.Forbidden(w, constants.PermissionDenied, err.Error())
+		return
+	}
+	if errors.Is(err, query.ErrInvalidSearchParams) {
+		h.logger.Error().Err(err).Str("ErrorCode", SearchInvalidParameter).Msg(err.Error())
+		response.ErrorResponse(w, http.StatusBadRequest, *(response.New(SearchInvalidParameter, "Invalid parameter", err.Error())))
+		return
+	}
+	if err != nil {
+		h.logger.Error().Err(err).Str("ErrorCode", SearchServerError).Msg("Error occurred during Search")
+		response.ErrorResponse(w, http.StatusInternalServerError, *(response.New(SearchServerError, "Failed to search", err.Error())))
+		return
+	}
+	response.WriteJsonWithEncode(w, http.StatusOK, result)
+}
+
+// parseSearchQuery reads the query string: q, type (comma-separated), group and limit
+func parseSearchQuery(r *http.Request) (query.SearchQuery, error) {
+	values := r.URL.Query()
+	q := query.SearchQuery{Text: values.Get("q")}
+
+	for _, t := range strings.Split(values.Get("type"), ",") {
+		if t = strings.TrimSpace(t); t != "" {
+			q.Types = append(q.Types, t)
+		}
+	}
+	if v := values.Get("group"); v != "" {
+		groupId, err := strconv.Atoi(v)
+		if err != nil || groupId < 1 {
+			return q, fmt.Errorf("group must be a positive integer")
+		}
+		q.GroupId = groupId
+	}
+	if v := values.Get("limit"); v != "" {
+		limit, err := strconv.Atoi(v)
+		if err != nil || limit < 1 {
+			return q, fmt.Errorf("limit must be a positive integer")
+		}
+		q.Limit = limit
+	}
+	return q, nil
+}
@@ -0,0 +1,213 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/happYness-Project/taskManagementGolang/internal/outbox/domain"
+	"github.com/happYness-Project/taskManagementGolang/internal/outbox/repository"
+	taskDomain "github.com/happYness-Project/taskManagementGolang/internal/task/domain"
+	containerDomain "github.com/happYness-Project/taskManagementGolang/internal/taskcontainer/domain"
+	"github.com/happYness-Project/taskManagementGolang/pkg/loggers"
+)
+
+const (
+	DefaultPollInterval = time.Second
+	// SubscriptionBuffer is how many events may wait for a subscriber; the rest stay in the outbox until it catches up
+	SubscriptionBuffer = 64
+
+	pollPageSize = 200
+)
+
+// StreamedEventTypes lists the events pushed to the members of a group
+var StreamedEventTypes = []string{
+	taskDomain.EventTaskCreated,
+	taskDomain.EventTaskUpdated,
+	taskDomain.EventTaskCompleted,
+	taskDomain.EventTaskReopened,
+	taskDomain.EventTaskDeleted,
+	taskDomain.EventTaskRestored,
+	taskDomain.EventTaskMoved,
+	containerDomain.EventContainerCreated,
+	containerDomain.EventContainerDeleted,
+	containerDomain.EventContainerRestored,
+}
+
+// Hub streams the task and container events of a group to its connected members.
+// It reads them from the outbox, so subscribers see every committed change of their group,
+// whichever API instance made it.
+type Hub struct {
+	outboxRepo repository.OutboxRepository
+	logger     *loggers.AppLogger
+
+	PollInterval time.Duration
+
+	mu          sync.Mutex
+	subscribers map[int]map[*Subscription]struct{}
+}
+
+func NewHub(outboxRepo repository.OutboxRepository, logger *loggers.AppLogger) *Hub {
+	return &Hub{
+		outboxRepo:   outboxRepo,
+		logger:       logger,
+		PollInterval: DefaultPollInterval,
+		subscribers:  map[int]map[*Subscription]struct{}{},
+	}
+}
+
+// Subscription receives the streamed events of one group, each once and in order, until it is closed
+type Subscription struct {
+	hub     *Hub
+	groupId int
+	events  chan domain.Message
+	// last is the position of the last event queued to the subscriber, guarded by the hub
+	last domain.Position
+}
+
+// Run reads the new events of the subscribed groups every PollInterval until ctx is cancelled
+func (h *Hub) Run(ctx context.Context) {
+	ticker := time.NewTicker(h.PollInterval)
+	defer ticker.Stop()
+	for {
+		if err := h.Poll(); err != nil {
+			h.logger.Error().Err(err).Msg("failed to stream outbox events")
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Subscribe starts receiving the events of the group past the given position.
+// The zero position starts with the events stored from now on.
+func (h *Hub) Subscribe(groupId int, after domain.Position) (*Subscription, error) {
+	if after.IsZero() {
+		current, err := h.outboxRepo.GetCurrentPosition()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read the current outbox position: %w", err)
+		}
+		after = current
+	}
+	sub := &Subscription{hub: h, groupId: groupId, events: make(chan domain.Message, SubscriptionBuffer), last: after}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.subscribers[groupId] == nil {
+		h.subscribers[groupId] = map[*Subscription]struct{}{}
+	}
+	h.subscribers[groupId][sub] = struct{}{}
+	return sub, nil
+}
+
+// Poll queues the events stored since the last poll to the subscribers of every group
+func (h *Hub) Poll() error {
+	h.mu.Lock()
+	groupIds := make([]int, 0, len(h.subscribers))
+	for groupId := range h.subscribers {
+		groupIds = append(groupIds, groupId)
+	}
+	h.mu.Unlock()
+
+	for _, groupId := range groupIds {
+		if err := h.pollGroup(groupId); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Subscribers returns how many subscribers are connected to the group
+func (h *Hub) Subscribers(groupId int) int {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return len(h.subscribers[groupId])
+}
+
+// pollGroup reads the group's events from the subscriber furthest behind, page by page,
+// until every subscriber is caught up or has a full buffer
+func (h *Hub) pollGroup(groupId int) error {
+	for {
+		after, ok := h.oldestPosition(groupId)
+		if !ok {
+			return nil
+		}
+		page, err := h.outboxRepo.GetByGroupIdAfter(groupId, after, pollPageSize)
+		if err != nil {
+			return fmt.Errorf("failed to read events of group %d: %w", groupId, err)
+		}
+		if !h.offer(groupId, page) || len(page) < pollPageSize {
+			return nil
+		}
+	}
+}
+
+func (h *Hub) oldestPosition(groupId int) (domain.Position, bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	oldest, found := domain.Position{}, false
+	for sub := range h.subscribers[groupId] {
+		if !found || oldest.After(sub.last) {
+			oldest, found = sub.last, true
+		}
+	}
+	return oldest, found
+}
+
+// offer queues the page to the group's subscribers and reports whether any of them moved forward
+func (h *Hub) offer(groupId int, page []domain.Message) bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	progressed := false
+	for sub := range h.subscribers[groupId] {
+		for _, msg := range page {
+			if !msg.Position().After(sub.last) {
+				continue
+			}
+			if !sub.offer(msg) {
+				break
+			}
+			progressed = true
+		}
+	}
+	return progressed
+}
+
+func (h *Hub) unsubscribe(sub *Subscription) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	delete(h.subscribers[sub.groupId], sub)
+	if len(h.subscribers[sub.groupId]) == 0 {
+		delete(h.subscribers, sub.groupId)
+	}
+}
+
+// Events delivers the group's streamed events in position order
+func (s *Subscription) Events() <-chan domain.Message {
+	return s.events
+}
+
+// Close stops the subscription. It is safe to call more than once.
+func (s *Subscription) Close() {
+	s.hub.unsubscribe(s)
+}
+
+// offer queues a streamed event, or skips any other one, and moves past it.
+// It returns false when the buffer is full; the event is offered again on the next poll.
+func (s *Subscription) offer(msg domain.Message) bool {
+	if !slices.Contains(StreamedEventTypes, msg.EventType) {
+		s.last = msg.Position()
+		return true
+	}
+	select {
+	case s.events <- msg:
+		s.last = msg.Position()
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,278 @@
+package application
+
+import (
+	"cmp"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/happYness-Project/taskManagementGolang/internal/mocks"
+	"github.com/happYness-Project/taskManagementGolang/internal/outbox/domain"
+	"github.com/happYness-Project/taskManagementGolang/pkg/configs"
+	"github.com/happYness-Project/taskManagementGolang/pkg/loggers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubOutboxRepo serves stored messages of every group in position order. Like the outbox repository,
+// it holds back messages of transactions at or after the oldest one still running.
+type stubOutboxRepo struct {
+	mocks.MockOutboxRepo
+	mu      sync.Mutex
+	stored  []domain.Message
+	running []uint64
+	reads   int
+}
+
+// event is a message stored alone in its transaction, whose id follows the message id
+func event(id int64, groupId int, eventType string) domain.Message {
+	return domain.Message{Id: id, TxId: uint64(id), GroupId: groupId, EventType: eventType}
+}
+
+func (s *stubOutboxRepo) store(msgs ...domain.Message) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.stored = append(s.stored, msgs...)
+}
+
+func (s *stubOutboxRepo) begin(txId uint64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.running = append(s.running, txId)
+}
+
+func (s *stubOutboxRepo) commit(txId uint64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.running = slices.DeleteFunc(s.running, func(running uint64) bool { return running == txId })
+}
+
+// oldestRunning is the snapshot xmin: every transaction before it has finished
+func (s *stubOutboxRepo) oldestRunning() uint64 {
+	if len(s.running) > 0 {
+		return slices.Min(s.running)
+	}
+	var next uint64 = 1
+	for _, msg := range s.stored {
+		next = max(next, msg.TxId+1)
+	}
+	return next
+}
+
+func (s *stubOutboxRepo) GetByGroupIdAfter(groupId int, after domain.Position, limit int) ([]domain.Message, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.reads++
+	visible := []domain.Message{}
+	for _, msg := range s.stored {
+		if msg.GroupId == groupId && msg.Position().After(after) && msg.TxId < s.oldestRunning() {
+			visible = append(visible, msg)
+		}
+	}
+	slices.SortFunc(visible, func(a, b domain.Message) int {
+		return cmp.Or(cmp.Compare(a.TxId, b.TxId), cmp.Compare(a.Id, b.Id))
+	})
+	return visible[:min(len(visible), limit)], nil
+}
+
+func (s *stubOutboxRepo) GetCurrentPosition() (domain.Position, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return domain.Position{TxId: s.oldestRunning()}, nil
+}
+
+func received(sub *Subscription) []int64 {
+	ids := []int64{}
+	for {
+		select {
+		case msg := <-sub.Events():
+			ids = append(ids, msg.Id)
+		default:
+			return ids
+		}
+	}
+}
+
+func TestHub(t *testing.T) {
+	logger := loggers.Setup(configs.Env{})
+
+	t.Run("when an event is stored, Then only subscribers of its group receive it", func(t *testing.T) {
+		// Arrange
+		repo := &stubOutboxRepo{}
+		hub := NewHub(repo, logger)
+		member, err := hub.Subscribe(1, domain.Position{})
+		require.NoError(t, err)
+		other, err := hub.Subscribe(2, domain.Position{})
+		require.NoError(t, err)
+		defer member.Close()
+		defer other.Close()
+		repo.store(event(10, 1, "task.created"))
+
+		// Act
+		require.NoError(t, hub.Poll())
+
+		// Assert
+		assert.Equal(t, []int64{10}, received(member))
+		assert.Empty(t, received(other))
+	})
+
+	t.Run("when a subscriber connects without Last-Event-ID, Then earlier events are not sent", func(t *testing.T) {
+		// Arrange
+		repo := &stubOutboxRepo{stored: []domain.Message{event(1, 1, "task.created")}}
+		hub := NewHub(repo, logger)
+		sub, err := hub.Subscribe(1, domain.Position{})
+		require.NoError(t, err)
+		defer sub.Close()
+		repo.store(event(2, 1, "task.updated"))
+
+		// Act
+		require.NoError(t, hub.Poll())
+
+		// Assert
+		assert.Equal(t, []int64{2}, received(sub))
+	})
+
+	t.Run("when events are polled again, Then each is sent once per subscriber", func(t *testing.T) {
+		// Arrange
+		repo := &stubOutboxRepo{stored: []domain.Message{
+			event(3, 1, "task.created"),
+			event(4, 1, "task.updated"),
+			event(5, 1, "task.completed"),
+		}}
+		hub := NewHub(repo, logger)
+		resumed, err := hub.Subscribe(1, domain.Position{TxId: 3, Id: 3})
+		require.NoError(t, err)
+		defer resumed.Close()
+
+		// Act
+		require.NoError(t, hub.Poll())
+		require.NoError(t, hub.Poll())
+
+		// Assert
+		assert.Equal(t, []int64{4, 5}, received(resumed))
+	})
+
+	t.Run("when transactions commit out of id order, Then no event is skipped", func(t *testing.T) {
+		// Arrange
+		repo := &stubOutboxRepo{}
+		hub := NewHub(repo, logger)
+		sub, err := hub.Subscribe(1, domain.Position{})
+		require.NoError(t, err)
+		defer sub.Close()
+		repo.begin(20)
+		repo.begin(21)
+		repo.store(
+			domain.Message{Id: 1, TxId: 21, GroupId: 1, EventType: "task.created"},
+			domain.Message{Id: 2, TxId: 20, GroupId: 1, EventType: "task.updated"},
+			domain.Message{Id: 3, TxId: 21, GroupId: 1, EventType: "task.completed"},
+		)
+
+		// Act
+		repo.commit(21)
+		require.NoError(t, hub.Poll())
+		beforeOldestCommits := received(sub)
+		repo.commit(20)
+		require.NoError(t, hub.Poll())
+		afterOldestCommits := received(sub)
+
+		// Assert
+		assert.Empty(t, beforeOldestCommits)
+		assert.Equal(t, []int64{2, 1, 3}, afterOldestCommits)
+	})
+
+	t.Run("when a later transaction commits first, Then its events are sent and the earlier ones follow", func(t *testing.T) {
+		// Arrange
+		repo := &stubOutboxRepo{}
+		hub := NewHub(repo, logger)
+		sub, err := hub.Subscribe(1, domain.Position{})
+		require.NoError(t, err)
+		defer sub.Close()
+		repo.begin(30)
+		repo.begin(31)
+		repo.store(
+			domain.Message{Id: 5, TxId: 31, GroupId: 1, EventType: "task.created"},
+			domain.Message{Id: 6, TxId: 30, GroupId: 1, EventType: "task.updated"},
+		)
+
+		// Act
+		repo.commit(30)
+		require.NoError(t, hub.Poll())
+		first := received(sub)
+		repo.commit(31)
+		require.NoError(t, hub.Poll())
+		second := received(sub)
+
+		// Assert
+		assert.Equal(t, []int64{6}, first)
+		assert.Equal(t, []int64{5}, second)
+	})
+
+	t.Run("when group events are not about tasks or containers, Then they are not streamed", func(t *testing.T) {
+		// Arrange
+		repo := &stubOutboxRepo{}
+		hub := NewHub(repo, logger)
+		sub, err := hub.Subscribe(1, domain.Position{})
+		require.NoError(t, err)
+		defer sub.Close()
+		repo.store(
+			event(1, 1, "usergroup.member_added"),
+			event(2, 1, "usergroup.role_changed"),
+			event(3, 1, "task.due_soon"),
+			event(4, 1, "task.overdue"),
+			event(5, 1, "task_container.created"),
+			event(6, 1, "task.moved"),
+		)
+
+		// Act
+		require.NoError(t, hub.Poll())
+
+		// Assert
+		assert.Equal(t, []int64{5, 6}, received(sub))
+	})
+
+	t.Run("when a subscriber falls behind, Then the rest of its events wait in the outbox", func(t *testing.T) {
+		// Arrange
+		repo := &stubOutboxRepo{}
+		hub := NewHub(repo, logger)
+		slow, err := hub.Subscribe(1, domain.Position{})
+		require.NoError(t, err)
+		for i := 1; i <= SubscriptionBuffer+5; i++ {
+			repo.store(event(int64(i), 1, "task.updated"))
+		}
+
+		// Act
+		require.NoError(t, hub.Poll())
+		first := received(slow)
+		require.NoError(t, hub.Poll())
+		rest := received(slow)
+
+		// Assert
+		require.Len(t, first, SubscriptionBuffer)
+		assert.Equal(t, int64(1), first[0])
+		require.Len(t, rest, 5)
+		assert.Equal(t, int64(SubscriptionBuffer+1), rest[0])
+		slow.Close()
+		slow.Close()
+		assert.Zero(t, hub.Subscribers(1))
+	})
+
+	t.Run("when a subscriber is far behind, Then events are read page by page", func(t *testing.T) {
+		// Arrange
+		repo := &stubOutboxRepo{}
+		for i := 1; i <= pollPageSize+5; i++ {
+			repo.store(event(int64(i), 1, "usergroup.member_added"))
+		}
+		repo.store(event(999, 1, "task.created"))
+		hub := NewHub(repo, logger)
+		sub, err := hub.Subscribe(1, domain.Position{TxId: 3, Id: 3})
+		require.NoError(t, err)
+		defer sub.Close()
+
+		// Act
+		require.NoError(t, hub.Poll())
+
+		// Assert
+		assert.Equal(t, []int64{999}, received(sub))
+		assert.Equal(t, 2, repo.reads)
+	})
+}
@@ -0,0 +1,9 @@
+package route
+
+const prefix = "stream_"
+
+const (
+	StreamInvalidLastEventId = prefix + "invalid_last_event_id"
+	StreamUnsupported        = prefix + "unsupported"
+	StreamServerError        = prefix + "server_error"
+)
@@ -0,0 +1,137 @@
+package route
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/happYness-Project/taskManagementGolang/internal/authorization"
+	outboxDomain "github.com/happYness-Project/taskManagementGolang/internal/outbox/domain"
+	"github.com/happYness-Project/taskManagementGolang/internal/stream/application"
+	"github.com/happYness-Project/taskManagementGolang/pkg/constants"
+	"github.com/happYness-Project/taskManagementGolang/pkg/loggers"
+	"github.com/happYness-Project/taskManagementGolang/pkg/response"
+)
+
+const (
+	DefaultHeartbeatInterval = 15 * time.Second
+	// reconnectDelay is the retry hint sent to clients, in milliseconds
+	reconnectDelay = 3000
+)
+
+type Handler struct {
+	logger *loggers.AppLogger
+	hub    *application.Hub
+	policy *authorization.Policy
+
+	heartbeat time.Duration
+}
+
+func NewHandler(logger *loggers.AppLogger, hub *application.Hub, policy *authorization.Policy) *Handler {
+	return &Handler{
+		logger:    logger,
+		hub:       hub,
+		policy:    policy,
+		heartbeat: DefaultHeartbeatInterval,
+	}
+}
+
+func (h *Handler) RegisterRoutes(router chi.Router) {
+	router.Get("/api/user-groups/{groupID}/stream", h.handleStream)
+}
+
+// handleStream pushes the group's task and container events to a member as Server-Sent Events.
+// Each event carries its outbox position, so a client reconnecting with Last-Event-ID receives what it missed.
+// Membership is checked again on every heartbeat; the stream ends once the requester has left the group.
+// Browsers cannot set headers on an EventSource; they authenticate with the jwt cookie instead.
+func (h *Handler) handleStream(w http.ResponseWriter, r *http.Request) {
+	groupId, err := strconv.Atoi(chi.URLParam(r, "groupID"))
+	if err != nil {
+		h.logger.Error().Err(err).Msg("invalid Group ID")
+		response.ErrorResponse(w, http.StatusBadRequest, *(response.New(constants.InvalidParameter, "Invalid Group ID")))
+		return
+	}
+	requesterId := authorization.RequesterId(r)
+	if err := h.policy.RequireMember(requesterId, groupId); err != nil {
+		if authorization.IsForbiddenError(err) {
+			h.logger.Error().Err(err).Str("ErrorCode", constants.PermissionDenied).Msg(err.Error())
+			response.Forbidden(w, constants.PermissionDenied, err.Error())
+			return
+		}
+		h.logger.Error().Err(err).Str("ErrorCode", StreamServerError).Msg(err.Error())
+		response.ErrorResponse(w, http.StatusInternalServerError, *(response.New(StreamServerError, "Failed to open stream", err.Error())))
+		return
+	}
+
+	var lastEvent outboxDomain.Position
+	if value := r.Header.Get("Last-Event-ID"); value != "" {
+		lastEvent, err = outboxDomain.ParsePosition(value)
+		if err != nil {
+			h.logger.Error().Str("ErrorCode", StreamInvalidLastEventId).Msg("invalid Last-Event-ID")
+			response.ErrorResponse(w, http.StatusBadRequest, *(response.New(StreamInvalidLastEventId, "Invalid Last-Event-ID", "Last-Event-ID must be an event id sent by this stream")))
+			return
+		}
+	}
+
+	// A reconnecting client resumes after the last event it received
+	sub, err := h.hub.Subscribe(groupId, lastEvent)
+	if err != nil {
+		h.logger.Error().Err(err).Str("ErrorCode", StreamServerError).Msg(err.Error())
+		response.ErrorResponse(w, http.StatusInternalServerError, *(response.New(StreamServerError, "Failed to open stream", err.Error())))
+		return
+	}
+	defer sub.Close()
+
+	rc := http.NewResponseController(w)
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no")
+	if err := rc.Flush(); err != nil {
+		h.logger.Error().Err(err).Str("ErrorCode", StreamUnsupported).Msg("response does not support streaming")
+		response.ErrorResponse(w, http.StatusInternalServerError, *(response.New(StreamUnsupported, "Streaming unsupported")))
+		return
+	}
+
+	fmt.Fprintf(w, "retry: %d\n\n", reconnectDelay)
+	if err := rc.Flush(); err != nil {
+		return
+	}
+
+	heartbeat := time.NewTicker(h.heartbeat)
+	defer heartbeat.Stop()
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case <-heartbeat.C:
+			if err := h.policy.RequireMember(requesterId, groupId); err != nil {
+				h.logger.Info().Err(err).Str("RequesterId", requesterId).Int("GroupId", groupId).Msg("closing group stream")
+				return
+			}
+			if _, err := io.WriteString(w, ": heartbeat\n\n"); err != nil {
+				return
+			}
+		case msg := <-sub.Events():
+			if err := writeEvent(w, msg); err != nil {
+				return
+			}
+		}
+		if err := rc.Flush(); err != nil {
+			return
+		}
+	}
+}
+
+func writeEvent(w io.Writer, msg outboxDomain.Message) error {
+	data, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "id: %s\nevent: %s\ndata: %s\n\n", msg.Position(), msg.EventType, data)
+	return err
+}
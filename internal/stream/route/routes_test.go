@@ -0,0 +1,186 @@
+package route
+
+import (
+	"bufio"
+	"context"
+	"database/sql"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/happYness-Project/taskManagementGolang/internal/authorization"
+	"github.com/happYness-Project/taskManagementGolang/internal/mocks"
+	outboxDomain "github.com/happYness-Project/taskManagementGolang/internal/outbox/domain"
+	"github.com/happYness-Project/taskManagementGolang/internal/stream/application"
+	"github.com/happYness-Project/taskManagementGolang/pkg/configs"
+	"github.com/happYness-Project/taskManagementGolang/pkg/loggers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type stubOutboxRepo struct {
+	mocks.MockOutboxRepo
+	mu     sync.Mutex
+	stored []outboxDomain.Message
+}
+
+func (s *stubOutboxRepo) store(msgs ...outboxDomain.Message) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.stored = append(s.stored, msgs...)
+}
+
+// GetByGroupIdAfter serves messages stored in id order, each in its own committed transaction
+func (s *stubOutboxRepo) GetByGroupIdAfter(groupId int, after outboxDomain.Position, limit int) ([]outboxDomain.Message, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	page := []outboxDomain.Message{}
+	for _, msg := range s.stored {
+		if msg.GroupId == groupId && msg.Position().After(after) && len(page) < limit {
+			page = append(page, msg)
+		}
+	}
+	return page, nil
+}
+
+func (s *stubOutboxRepo) GetCurrentPosition() (outboxDomain.Position, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var next uint64 = 1
+	for _, msg := range s.stored {
+		next = max(next, msg.TxId+1)
+	}
+	return outboxDomain.Position{TxId: next}, nil
+}
+
+func event(id int64, eventType string) outboxDomain.Message {
+	return outboxDomain.Message{Id: id, TxId: uint64(id), GroupId: 1, EventType: eventType}
+}
+
+func TestStreamHandler(t *testing.T) {
+	logger := loggers.Setup(configs.Env{})
+	mockUserRepo := new(mocks.MockUserRepo)
+	mockUserRepo.On("GetUserRoleInGroup", "member-id", 1).Return("member", nil)
+	mockUserRepo.On("GetUserRoleInGroup", "stranger-id", 1).Return("", sql.ErrNoRows)
+	mockUserRepo.On("GetUserRoleInGroup", "leaving-id", 1).Return("member", nil).Once()
+	mockUserRepo.On("GetUserRoleInGroup", "leaving-id", 1).Return("", sql.ErrNoRows)
+
+	newServer := func(t *testing.T, hub *application.Hub, requesterId string) *httptest.Server {
+		t.Helper()
+		handler := NewHandler(logger, hub, authorization.NewPolicy(mockUserRepo))
+		handler.heartbeat = 20 * time.Millisecond
+		router := chi.NewRouter()
+		router.Use(func(next http.Handler) http.Handler {
+			return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				next.ServeHTTP(w, mocks.WithRequester(r, requesterId))
+			})
+		})
+		handler.RegisterRoutes(router)
+		server := httptest.NewServer(router)
+		t.Cleanup(server.Close)
+		return server
+	}
+
+	newHub := func(t *testing.T, repo *stubOutboxRepo) *application.Hub {
+		t.Helper()
+		hub := application.NewHub(repo, logger)
+		hub.PollInterval = 10 * time.Millisecond
+		ctx, cancel := context.WithCancel(context.Background())
+		t.Cleanup(cancel)
+		go hub.Run(ctx)
+		return hub
+	}
+
+	t.Run("when a member reconnects with Last-Event-ID, Then missed and live events are streamed once with heartbeats", func(t *testing.T) {
+		// Arrange
+		repo := &stubOutboxRepo{stored: []outboxDomain.Message{
+			event(3, "task.created"),
+			event(4, "task.updated"),
+			event(5, "task.completed"),
+		}}
+		server := newServer(t, newHub(t, repo), "member-id")
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		req, _ := http.NewRequestWithContext(ctx, http.MethodGet, server.URL+"/api/user-groups/1/stream", nil)
+		req.Header.Set("Last-Event-ID", "3-3")
+
+		// Act
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		lines := bufio.NewScanner(resp.Body)
+		ids := []string{}
+		heartbeats := 0
+		for lines.Scan() && (len(ids) < 3 || heartbeats == 0) {
+			line := lines.Text()
+			switch {
+			case strings.HasPrefix(line, "id: "):
+				ids = append(ids, strings.TrimPrefix(line, "id: "))
+				if len(ids) == 2 {
+					// Membership changes are not streamed
+					repo.store(
+						event(6, "usergroup.member_added"),
+						event(7, "task.deleted"),
+					)
+				}
+			case line == ": heartbeat":
+				heartbeats++
+			}
+		}
+
+		// Assert
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+		assert.Equal(t, []string{"4-4", "5-5", "7-7"}, ids)
+		assert.NotZero(t, heartbeats)
+	})
+
+	t.Run("when the requester leaves the group, Then the stream is closed at the next heartbeat", func(t *testing.T) {
+		// Arrange
+		repo := &stubOutboxRepo{}
+		server := newServer(t, newHub(t, repo), "leaving-id")
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		req, _ := http.NewRequestWithContext(ctx, http.MethodGet, server.URL+"/api/user-groups/1/stream", nil)
+
+		// Act
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		repo.store(event(1, "task.created"))
+		body, err := io.ReadAll(resp.Body)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.NotContains(t, string(body), ": heartbeat")
+		assert.NoError(t, ctx.Err())
+	})
+
+	t.Run("when requester is not a member, Then returns 403", func(t *testing.T) {
+		server := newServer(t, newHub(t, &stubOutboxRepo{}), "stranger-id")
+
+		resp, err := http.Get(server.URL + "/api/user-groups/1/stream")
+
+		require.NoError(t, err)
+		resp.Body.Close()
+		assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+	})
+
+	t.Run("when Last-Event-ID is not an event id, Then returns 400", func(t *testing.T) {
+		server := newServer(t, newHub(t, &stubOutboxRepo{}), "member-id")
+		req, _ := http.NewRequest(http.MethodGet, server.URL+"/api/user-groups/1/stream", nil)
+		req.Header.Set("Last-Event-ID", "3")
+
+		resp, err := http.DefaultClient.Do(req)
+
+		require.NoError(t, err)
+		resp.Body.Close()
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+}
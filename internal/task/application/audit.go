@@ -0,0 +1,84 @@
+package application
+
+import (
+	auditApp "github.com/happYness-Project/taskManagementGolang/internal/audit/application"
+	auditDomain "github.com/happYness-Project/taskManagementGolang/internal/audit/domain"
+	cmd "github.com/happYness-Project/taskManagementGolang/internal/task/application/command"
+	"github.com/happYness-Project/taskManagementGolang/internal/task/domain"
+)
+
+// auditSubject describes the task a command mutates for the activity log
+func (bus *CommandBus) auditSubject(command interface{}) auditApp.Subject {
+	subject := auditApp.Subject{
+		Action:        auditApp.ActionOf(command),
+		AggregateType: auditDomain.AggregateTask,
+		Load:          bus.loadTask,
+	}
+
+	switch c := command.(type) {
+	case cmd.CreateTaskCommand:
+		subject.ActorId = c.RequesterId
+		if container, err := bus.containerRepo.GetById(c.ContainerId); err == nil && container != nil {
+			subject.GroupId = container.UsergroupId
+		}
+		subject.Resolve = func(result interface{}) (string, int) {
+			task, _ := result.(domain.Task)
+			return task.TaskId, 0
+		}
+		return subject
+	case cmd.CopyTaskCommand:
+		subject.ActorId = c.RequesterId
+		if container, err := bus.containerRepo.GetById(c.TargetContainerId); err == nil && container != nil {
+			subject.GroupId = container.UsergroupId
+		}
+		subject.Resolve = func(result interface{}) (string, int) {
+			task, _ := result.(domain.Task)
+			return task.TaskId, 0
+		}
+		return subject
+	case cmd.UpdateTaskCommand:
+		subject.ActorId, subject.AggregateId = c.RequesterId, c.TaskId
+	case cmd.PatchTaskCommand:
+		subject.ActorId, subject.AggregateId = c.RequesterId, c.TaskId
+	case cmd.DeleteTaskCommand:
+		subject.ActorId, subject.AggregateId = c.RequesterId, c.TaskId
+	case cmd.ToggleCompletionCommand:
+		subject.ActorId, subject.AggregateId = c.RequesterId, c.TaskId
+	case cmd.ToggleImportantCommand:
+		subject.ActorId, subject.AggregateId = c.RequesterId, c.TaskId
+	case cmd.RestoreTaskCommand:
+		subject.ActorId, subject.AggregateId = c.RequesterId, c.TaskId
+	case cmd.MoveTaskCommand:
+		subject.ActorId, subject.AggregateId = c.RequesterId, c.TaskId
+	case cmd.AddChecklistItemCommand:
+		subject.ActorId, subject.AggregateId = c.RequesterId, c.TaskId
+	case cmd.ToggleChecklistItemCommand:
+		subject.ActorId, subject.AggregateId = c.RequesterId, c.TaskId
+	case cmd.RemoveChecklistItemCommand:
+		subject.ActorId, subject.AggregateId = c.RequesterId, c.TaskId
+	case cmd.ReorderChecklistCommand:
+		subject.ActorId, subject.AggregateId = c.RequesterId, c.TaskId
+	case cmd.AssignTaskCommand:
+		subject.ActorId, subject.AggregateId = c.RequesterId, c.TaskId
+	case cmd.UnassignTaskCommand:
+		subject.ActorId, subject.AggregateId = c.RequesterId, c.TaskId
+	case cmd.AddTaskLabelCommand:
+		subject.ActorId, subject.AggregateId = c.RequesterId, c.TaskId
+	case cmd.RemoveTaskLabelCommand:
+		subject.ActorId, subject.AggregateId = c.RequesterId, c.TaskId
+	}
+
+	// Resolved before the command runs, so deleted tasks keep their group
+	if groupId, err := bus.taskRepo.GetGroupIdByTaskId(subject.AggregateId); err == nil {
+		subject.GroupId = groupId
+	}
+	return subject
+}
+
+func (bus *CommandBus) loadTask(taskId string) (interface{}, error) {
+	task, err := bus.taskRepo.GetTaskById(taskId)
+	if err != nil || task == nil {
+		return nil, err
+	}
+	return task, nil
+}
@@ -0,0 +1,41 @@
+package application
+
+import (
+	"fmt"
+
+	"github.com/happYness-Project/taskManagementGolang/internal/authorization"
+	cmd "github.com/happYness-Project/taskManagementGolang/internal/task/application/command"
+	"github.com/happYness-Project/taskManagementGolang/internal/task/repository"
+	containerRepo "github.com/happYness-Project/taskManagementGolang/internal/taskcontainer/repository"
+)
+
+// requireTaskMember resolves the group owning the task and checks the requester belongs to it
+func requireTaskMember(policy *authorization.Policy, taskRepo repository.TaskRepository, requesterId string, taskId string) error {
+	groupId, err := taskRepo.GetGroupIdByTaskId(taskId)
+	if err != nil {
+		return fmt.Errorf("task not found: %w", err)
+	}
+	return policy.RequireMember(requesterId, groupId)
+}
+
+// requireContainerMember resolves the group owning the container and checks the requester belongs to it
+func requireContainerMember(policy *authorization.Policy, containerRepo containerRepo.ContainerRepository, requesterId string, containerId string) error {
+	container, err := containerRepo.GetById(containerId)
+	if err != nil || container == nil {
+		return fmt.Errorf("task container not found: %w", err)
+	}
+	return policy.RequireMember(requesterId, container.UsergroupId)
+}
+
+// requireTaskAndTargetMember checks the requester belongs both to the group owning the task and to the group owning
+// the container it is moved or copied to
+func requireTaskAndTargetMember(policy *authorization.Policy, taskRepo repository.TaskRepository, containerRepo containerRepo.ContainerRepository, requesterId string, taskId string, targetContainerId string) error {
+	if err := requireTaskMember(policy, taskRepo, requesterId, taskId); err != nil {
+		return err
+	}
+	target, err := containerRepo.GetById(targetContainerId)
+	if err != nil || target == nil || target.Id == "" {
+		return cmd.ErrTargetContainerNotFound
+	}
+	return policy.RequireMember(requesterId, target.UsergroupId)
+}
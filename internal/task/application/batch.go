@@ -0,0 +1,113 @@
+package application
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	cmd "github.com/happYness-Project/taskManagementGolang/internal/task/application/command"
+	"github.com/happYness-Project/taskManagementGolang/pkg/dbs"
+)
+
+// MaxBatchSize caps the number of operations of a single batch
+const MaxBatchSize = 100
+
+var (
+	ErrTaskNotInContainer = errors.New("task not found in the container")
+	// ErrBatchAborted marks the operations of an atomic batch that were rolled back, or never ran, because another one failed
+	ErrBatchAborted = errors.New("operation not applied because another operation of the batch failed")
+)
+
+// BatchResult is the outcome of one batch operation: the command's result, or the error it failed with
+type BatchResult struct {
+	Result interface{}
+	Err    error
+}
+
+// ExecuteBatch runs commands aimed at the tasks of one container, in order, and returns one result per command.
+// An atomic batch runs in a single transaction and stops at the first failure, rolling back everything before it.
+// Otherwise every command runs in its own transaction and a failure does not stop the rest.
+func (bus *CommandBus) ExecuteBatch(ctx context.Context, containerId string, commands []interface{}, atomic bool) []BatchResult {
+	results := make([]BatchResult, len(commands))
+	if !atomic {
+		for i, command := range commands {
+			results[i] = bus.executeInContainer(ctx, containerId, command)
+		}
+		return results
+	}
+
+	failed := -1
+	err := bus.uow.Do(func(tx dbs.DBTX) error {
+		txBus := bus.withTx(tx)
+		for i, command := range commands {
+			results[i] = txBus.executeInContainer(ctx, containerId, command)
+			if results[i].Err != nil {
+				failed = i
+				return results[i].Err
+			}
+		}
+		return nil
+	})
+	if err == nil {
+		return results
+	}
+	for i := range results {
+		switch {
+		case i == failed:
+		case failed < 0:
+			// Every operation went through but the transaction did not commit
+			results[i] = BatchResult{Err: fmt.Errorf("failed to commit batch: %w", err)}
+		default:
+			results[i] = BatchResult{Err: ErrBatchAborted}
+		}
+	}
+	return results
+}
+
+// executeInContainer executes the command once its task is known to live in the container
+func (bus *CommandBus) executeInContainer(ctx context.Context, containerId string, command interface{}) BatchResult {
+	if taskId := batchTaskId(command); taskId != "" {
+		if err := bus.requireTaskInContainer(taskId, containerId); err != nil {
+			return BatchResult{Err: err}
+		}
+	}
+	result, err := bus.Execute(ctx, command)
+	return BatchResult{Result: result, Err: err}
+}
+
+func (bus *CommandBus) requireTaskInContainer(taskId string, containerId string) error {
+	taskContainerId, err := bus.taskRepo.GetContainerIdByTaskId(taskId)
+	if errors.Is(err, sql.ErrNoRows) || (err == nil && taskContainerId != containerId) {
+		return ErrTaskNotInContainer
+	}
+	if err != nil {
+		return fmt.Errorf("failed to find task container: %w", err)
+	}
+
+	// Tasks in the trash keep their container link
+	task, err := bus.taskRepo.GetTaskById(taskId)
+	if err != nil {
+		return fmt.Errorf("failed to find task: %w", err)
+	}
+	if task == nil {
+		return ErrTaskNotInContainer
+	}
+	return nil
+}
+
+// batchTaskId returns the existing task a batch command targets, or an empty string for commands creating one
+func batchTaskId(command interface{}) string {
+	switch c := command.(type) {
+	case cmd.UpdateTaskCommand:
+		return c.TaskId
+	case cmd.ToggleCompletionCommand:
+		return c.TaskId
+	case cmd.DeleteTaskCommand:
+		return c.TaskId
+	case cmd.MoveTaskCommand:
+		return c.TaskId
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,130 @@
+package application
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/happYness-Project/taskManagementGolang/internal/authorization"
+	"github.com/happYness-Project/taskManagementGolang/internal/mocks"
+	cmd "github.com/happYness-Project/taskManagementGolang/internal/task/application/command"
+	"github.com/happYness-Project/taskManagementGolang/internal/task/domain"
+	"github.com/happYness-Project/taskManagementGolang/internal/task/repository"
+	"github.com/happYness-Project/taskManagementGolang/pkg/dbs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	batchContainerId = "container-1"
+	batchGroupId     = 7
+	batchRequesterId = "requester"
+)
+
+// stubBatchTaskRepo keeps tasks and their containers in memory and records the completions it persisted
+type stubBatchTaskRepo struct {
+	repository.TaskRepository
+	tasks      map[string]*domain.Task
+	containers map[string]string
+	completed  []string
+}
+
+func (s *stubBatchTaskRepo) GetTaskById(id string) (*domain.Task, error) { return s.tasks[id], nil }
+
+func (s *stubBatchTaskRepo) GetContainerIdByTaskId(taskId string) (string, error) {
+	containerId, ok := s.containers[taskId]
+	if !ok {
+		return "", sql.ErrNoRows
+	}
+	return containerId, nil
+}
+
+func (s *stubBatchTaskRepo) GetGroupIdByTaskId(taskId string) (int, error) { return batchGroupId, nil }
+
+func (s *stubBatchTaskRepo) IncrementVersion(id string, expectedVersion int) (int, error) {
+	task := s.tasks[id]
+	if expectedVersion != 0 && expectedVersion != task.Version {
+		return 0, dbs.ErrVersionConflict
+	}
+	task.Version++
+	return task.Version, nil
+}
+
+func (s *stubBatchTaskRepo) DoneTask(id string, isDone bool) error {
+	s.completed = append(s.completed, id)
+	return nil
+}
+
+func (s *stubBatchTaskRepo) WithTx(tx dbs.DBTX) repository.TaskRepository { return s }
+
+func TestCommandBus_ExecuteBatch(t *testing.T) {
+	newFixture := func(t *testing.T, taskIds ...string) (*CommandBus, *stubBatchTaskRepo) {
+		t.Helper()
+		taskRepo := &stubBatchTaskRepo{tasks: map[string]*domain.Task{}, containers: map[string]string{}}
+		for _, taskId := range taskIds {
+			task, err := domain.CreateTask("Groceries", "", time.Now(), "")
+			require.NoError(t, err)
+			task.TaskId, task.Version = taskId, 1
+			taskRepo.tasks[taskId] = task
+			taskRepo.containers[taskId] = batchContainerId
+		}
+		taskRepo.containers["elsewhere"] = "container-2"
+
+		userRepo := new(mocks.MockUserRepo)
+		userRepo.On("GetUserRoleInGroup", batchRequesterId, batchGroupId).Return("member", nil)
+		bus := NewCommandBus(taskRepo, new(mocks.MockContainerRepo), new(mocks.MockUserGroupRepo), userRepo, new(mocks.MockLabelRepo),
+			authorization.NewPolicy(userRepo), &mocks.MockUnitOfWork{}, nil, &mocks.MockOutboxRepo{})
+		return bus, taskRepo
+	}
+	complete := func(taskId string) interface{} {
+		return cmd.ToggleCompletionCommand{TaskId: taskId, IsCompleted: true, RequesterId: batchRequesterId}
+	}
+
+	t.Run("when best effort batch has a failing operation, Then the other operations still run", func(t *testing.T) {
+		// Arrange
+		bus, taskRepo := newFixture(t, "task-1", "task-2")
+		commands := []interface{}{complete("task-1"), complete("elsewhere"), complete("task-2")}
+
+		// Act
+		results := bus.ExecuteBatch(context.Background(), batchContainerId, commands, false)
+
+		// Assert
+		require.Len(t, results, 3)
+		assert.NoError(t, results[0].Err)
+		assert.ErrorIs(t, results[1].Err, ErrTaskNotInContainer)
+		assert.NoError(t, results[2].Err)
+		assert.Equal(t, []string{"task-1", "task-2"}, taskRepo.completed)
+	})
+
+	t.Run("when atomic batch has a failing operation, Then it stops and the other operations are aborted", func(t *testing.T) {
+		// Arrange
+		bus, taskRepo := newFixture(t, "task-1", "task-2")
+		commands := []interface{}{complete("task-1"), complete("missing"), complete("task-2")}
+
+		// Act
+		results := bus.ExecuteBatch(context.Background(), batchContainerId, commands, true)
+
+		// Assert
+		require.Len(t, results, 3)
+		assert.ErrorIs(t, results[0].Err, ErrBatchAborted)
+		assert.ErrorIs(t, results[1].Err, ErrTaskNotInContainer)
+		assert.ErrorIs(t, results[2].Err, ErrBatchAborted)
+		assert.Equal(t, []string{"task-1"}, taskRepo.completed)
+	})
+
+	t.Run("when atomic batch succeeds, Then every operation is applied", func(t *testing.T) {
+		// Arrange
+		bus, taskRepo := newFixture(t, "task-1", "task-2")
+		versioned := cmd.ToggleCompletionCommand{TaskId: "task-2", IsCompleted: true, ExpectedVersion: 1, RequesterId: batchRequesterId}
+
+		// Act
+		results := bus.ExecuteBatch(context.Background(), batchContainerId, []interface{}{complete("task-1"), versioned}, true)
+
+		// Assert
+		require.Len(t, results, 2)
+		assert.NoError(t, results[0].Err)
+		assert.NoError(t, results[1].Err)
+		assert.Equal(t, 2, taskRepo.tasks["task-2"].Version)
+	})
+}
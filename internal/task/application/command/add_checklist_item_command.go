@@ -0,0 +1,37 @@
+package command
+
+import (
+	outboxRepo "github.com/happYness-Project/taskManagementGolang/internal/outbox/repository"
+	"github.com/happYness-Project/taskManagementGolang/internal/task/domain"
+	"github.com/happYness-Project/taskManagementGolang/internal/task/repository"
+	"github.com/happYness-Project/taskManagementGolang/pkg/dbs"
+)
+
+type AddChecklistItemCommand struct {
+	TaskId      string
+	Title       string
+	RequesterId string // UUID from JWT
+}
+
+type AddChecklistItemCommandHandler struct {
+	taskRepo   repository.TaskRepository
+	outboxRepo outboxRepo.OutboxRepository
+	uow        dbs.UnitOfWork
+}
+
+func NewAddChecklistItemCommandHandler(taskRepo repository.TaskRepository, outboxRepo outboxRepo.OutboxRepository, uow dbs.UnitOfWork) *AddChecklistItemCommandHandler {
+	return &AddChecklistItemCommandHandler{taskRepo: taskRepo, outboxRepo: outboxRepo, uow: uow}
+}
+
+func (h *AddChecklistItemCommandHandler) Handle(cmd AddChecklistItemCommand) (*domain.ChecklistItem, error) {
+	var item *domain.ChecklistItem
+	err := changeChecklist(h.uow, h.taskRepo, h.outboxRepo, cmd.TaskId, 0, func(task *domain.Task) error {
+		var err error
+		item, err = task.AddChecklistItem(cmd.Title)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return item, nil
+}
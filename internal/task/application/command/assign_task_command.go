@@ -0,0 +1,87 @@
+package command
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+
+	outboxRepo "github.com/happYness-Project/taskManagementGolang/internal/outbox/repository"
+	"github.com/happYness-Project/taskManagementGolang/internal/task/repository"
+	userRepo "github.com/happYness-Project/taskManagementGolang/internal/user/repository"
+	usergroupRepo "github.com/happYness-Project/taskManagementGolang/internal/usergroup/repository"
+	"github.com/happYness-Project/taskManagementGolang/pkg/dbs"
+)
+
+var ErrAssigneeNotMember = errors.New("assignee is not a member of the task's user group")
+
+type AssignTaskCommand struct {
+	TaskId          string
+	AssigneeId      string // UUID of the user to assign
+	ExpectedVersion int    // from If-Match; 0 skips the version check
+	RequesterId     string // UUID from JWT
+}
+
+type AssignTaskCommandHandler struct {
+	taskRepo   repository.TaskRepository
+	groupRepo  usergroupRepo.UserGroupRepository
+	userRepo   userRepo.UserRepository
+	outboxRepo outboxRepo.OutboxRepository
+	uow        dbs.UnitOfWork
+}
+
+func NewAssignTaskCommandHandler(taskRepo repository.TaskRepository, groupRepo usergroupRepo.UserGroupRepository, userRepo userRepo.UserRepository, outboxRepo outboxRepo.OutboxRepository, uow dbs.UnitOfWork) *AssignTaskCommandHandler {
+	return &AssignTaskCommandHandler{taskRepo: taskRepo, groupRepo: groupRepo, userRepo: userRepo, outboxRepo: outboxRepo, uow: uow}
+}
+
+func (h *AssignTaskCommandHandler) Handle(cmd AssignTaskCommand) error {
+	return h.uow.Do(func(tx dbs.DBTX) error {
+		taskRepo := h.taskRepo.WithTx(tx)
+
+		task, err := taskRepo.GetTaskById(cmd.TaskId)
+		if err != nil || task == nil {
+			return fmt.Errorf("task not found: %w", err)
+		}
+		if err := bumpVersion(taskRepo, task, cmd.ExpectedVersion); err != nil {
+			return err
+		}
+
+		// Domain validation
+		if err := task.AssignTo(cmd.AssigneeId); err != nil {
+			return err
+		}
+
+		groupId, err := taskRepo.GetGroupIdByTaskId(task.TaskId)
+		if err != nil {
+			return fmt.Errorf("failed to find task group: %w", err)
+		}
+		if err := requireGroupMember(h.groupRepo.WithTx(tx), h.userRepo.WithTx(tx), groupId, task.AssigneeId); err != nil {
+			return err
+		}
+
+		err = taskRepo.UpdateAssignee(task.TaskId, task.AssigneeId, task.UpdatedAt)
+		if err != nil {
+			return fmt.Errorf("failed to assign task: %w", err)
+		}
+		if err := h.outboxRepo.WithTx(tx).Append(groupId, task.PullEvents()...); err != nil {
+			return fmt.Errorf("failed to store task events: %w", err)
+		}
+		return nil
+	})
+}
+
+// requireGroupMember checks that the user belongs to the user group owning the task's container
+func requireGroupMember(groupRepo usergroupRepo.UserGroupRepository, userRepo userRepo.UserRepository, groupId int, userId string) error {
+	group, err := groupRepo.GetById(groupId)
+	if err != nil || group == nil {
+		return fmt.Errorf("user group not found: %w", err)
+	}
+
+	_, err = userRepo.GetUserRoleInGroup(userId, group.GroupId)
+	if errors.Is(err, sql.ErrNoRows) {
+		return fmt.Errorf("%w: %s", ErrAssigneeNotMember, userId)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to resolve group membership: %w", err)
+	}
+	return nil
+}
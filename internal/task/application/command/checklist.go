@@ -0,0 +1,53 @@
+package command
+
+import (
+	"fmt"
+
+	outboxRepo "github.com/happYness-Project/taskManagementGolang/internal/outbox/repository"
+	"github.com/happYness-Project/taskManagementGolang/internal/task/domain"
+	"github.com/happYness-Project/taskManagementGolang/internal/task/repository"
+	"github.com/happYness-Project/taskManagementGolang/pkg/dbs"
+)
+
+// changeChecklist loads a task with its checklist, applies change and persists the checklist
+// together with any completion roll-up and the events it raises, all inside one unit of work
+func changeChecklist(uow dbs.UnitOfWork, repo repository.TaskRepository, outbox outboxRepo.OutboxRepository, taskId string, expectedVersion int, change func(task *domain.Task) error) error {
+	return uow.Do(func(tx dbs.DBTX) error {
+		taskRepo := repo.WithTx(tx)
+
+		task, err := taskRepo.GetTaskById(taskId)
+		if err != nil || task == nil {
+			return fmt.Errorf("task not found: %w", err)
+		}
+		if err := bumpVersion(taskRepo, task, expectedVersion); err != nil {
+			return err
+		}
+		wasCompleted := task.IsCompleted
+
+		if err := change(task); err != nil {
+			return err
+		}
+
+		err = taskRepo.SaveChecklist(task.TaskId, task.Checklist)
+		if err != nil {
+			return fmt.Errorf("failed to save checklist: %w", err)
+		}
+		if task.IsCompleted == wasCompleted {
+			return nil
+		}
+
+		// The checklist rolled the task's completion up or down
+		err = taskRepo.DoneTask(task.TaskId, task.IsCompleted)
+		if err != nil {
+			return fmt.Errorf("failed to toggle completion: %w", err)
+		}
+		outboxRepo := outbox.WithTx(tx)
+		if err := publishEvents(outboxRepo, taskRepo, task); err != nil {
+			return err
+		}
+		if !task.IsCompleted || !task.IsRecurring() {
+			return nil
+		}
+		return scheduleNextOccurrence(taskRepo, outboxRepo, task)
+	})
+}
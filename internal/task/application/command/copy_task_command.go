@@ -0,0 +1,81 @@
+package command
+
+import (
+	"fmt"
+
+	labelRepo "github.com/happYness-Project/taskManagementGolang/internal/label/repository"
+	outboxRepo "github.com/happYness-Project/taskManagementGolang/internal/outbox/repository"
+	"github.com/happYness-Project/taskManagementGolang/internal/task/domain"
+	"github.com/happYness-Project/taskManagementGolang/internal/task/repository"
+	containerRepo "github.com/happYness-Project/taskManagementGolang/internal/taskcontainer/repository"
+	userRepo "github.com/happYness-Project/taskManagementGolang/internal/user/repository"
+	usergroupRepo "github.com/happYness-Project/taskManagementGolang/internal/usergroup/repository"
+	"github.com/happYness-Project/taskManagementGolang/pkg/dbs"
+)
+
+type CopyTaskCommand struct {
+	TaskId            string
+	TargetContainerId string
+	RequesterId       string // UUID from JWT
+}
+
+type CopyTaskCommandHandler struct {
+	taskRepo      repository.TaskRepository
+	containerRepo containerRepo.ContainerRepository
+	groupRepo     usergroupRepo.UserGroupRepository
+	userRepo      userRepo.UserRepository
+	labelRepo     labelRepo.LabelRepository
+	outboxRepo    outboxRepo.OutboxRepository
+	uow           dbs.UnitOfWork
+}
+
+func NewCopyTaskCommandHandler(taskRepo repository.TaskRepository, containerRepo containerRepo.ContainerRepository, groupRepo usergroupRepo.UserGroupRepository, userRepo userRepo.UserRepository, labelRepo labelRepo.LabelRepository, outboxRepo outboxRepo.OutboxRepository, uow dbs.UnitOfWork) *CopyTaskCommandHandler {
+	return &CopyTaskCommandHandler{taskRepo: taskRepo, containerRepo: containerRepo, groupRepo: groupRepo, userRepo: userRepo, labelRepo: labelRepo, outboxRepo: outboxRepo, uow: uow}
+}
+
+// Handle creates an open copy of the task in the target container, which may belong to another group.
+// A copy in another group carries that group's labels of the same names as the task's.
+func (h *CopyTaskCommandHandler) Handle(cmd CopyTaskCommand) (domain.Task, error) {
+	var copied domain.Task
+	err := h.uow.Do(func(tx dbs.DBTX) error {
+		taskRepo := h.taskRepo.WithTx(tx)
+
+		target, err := h.containerRepo.WithTx(tx).GetById(cmd.TargetContainerId)
+		if err != nil || target == nil || target.Id == "" {
+			return ErrTargetContainerNotFound
+		}
+
+		task, err := taskRepo.GetTaskById(cmd.TaskId)
+		if err != nil || task == nil {
+			return fmt.Errorf("task not found: %w", err)
+		}
+		keepAssignee, err := canKeepAssignee(h.groupRepo.WithTx(tx), h.userRepo.WithTx(tx), target.UsergroupId, task.AssigneeId)
+		if err != nil {
+			return err
+		}
+
+		duplicate := task.Copy(keepAssignee)
+		if len(duplicate.Labels) > 0 {
+			sourceGroupId, err := taskRepo.GetGroupIdByTaskId(task.TaskId)
+			if err != nil {
+				return fmt.Errorf("failed to find task group: %w", err)
+			}
+			if sourceGroupId != target.UsergroupId {
+				groupLabels, err := groupTaskLabels(h.labelRepo.WithTx(tx), target.UsergroupId)
+				if err != nil {
+					return err
+				}
+				duplicate.MatchLabels(groupLabels)
+			}
+		}
+		copied, err = taskRepo.CreateTask(target.Id, *duplicate)
+		if err != nil {
+			return fmt.Errorf("failed to persist task copy: %w", err)
+		}
+		return publishEvents(h.outboxRepo.WithTx(tx), taskRepo, duplicate)
+	})
+	if err != nil {
+		return domain.Task{}, fmt.Errorf("failed to copy task: %w", err)
+	}
+	return copied, nil
+}
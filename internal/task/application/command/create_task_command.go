@@ -4,8 +4,10 @@ import (
 	"fmt"
 	"time"
 
+	outboxRepo "github.com/happYness-Project/taskManagementGolang/internal/outbox/repository"
 	"github.com/happYness-Project/taskManagementGolang/internal/task/domain"
 	"github.com/happYness-Project/taskManagementGolang/internal/task/repository"
+	"github.com/happYness-Project/taskManagementGolang/pkg/dbs"
 )
 
 type CreateTaskCommand struct {
@@ -14,15 +16,19 @@ type CreateTaskCommand struct {
 	TaskDesc    string
 	TargetDate  time.Time
 	Priority    string
-	Category    string
+	// RecurrenceRule is an optional RRULE, e.g. "FREQ=WEEKLY;BYDAY=MO,TH"
+	RecurrenceRule string
+	RequesterId    string // UUID from JWT
 }
 
 type CreateTaskCommandHandler struct {
-	taskRepo repository.TaskRepository
+	taskRepo   repository.TaskRepository
+	outboxRepo outboxRepo.OutboxRepository
+	uow        dbs.UnitOfWork
 }
 
-func NewCreateTaskCommandHandler(taskRepo repository.TaskRepository) *CreateTaskCommandHandler {
-	return &CreateTaskCommandHandler{taskRepo: taskRepo}
+func NewCreateTaskCommandHandler(taskRepo repository.TaskRepository, outboxRepo outboxRepo.OutboxRepository, uow dbs.UnitOfWork) *CreateTaskCommandHandler {
+	return &CreateTaskCommandHandler{taskRepo: taskRepo, outboxRepo: outboxRepo, uow: uow}
 }
 
 func (h *CreateTaskCommandHandler) Handle(cmd CreateTaskCommand) (domain.Task, error) {
@@ -32,14 +38,24 @@ func (h *CreateTaskCommandHandler) Handle(cmd CreateTaskCommand) (domain.Task, e
 		cmd.TaskDesc,
 		cmd.TargetDate,
 		cmd.Priority,
-		cmd.Category,
 	)
 	if err != nil {
 		return domain.Task{}, fmt.Errorf("failed to create task: %w", err)
 	}
+	if err := task.SetRecurrence(cmd.RecurrenceRule); err != nil {
+		return domain.Task{}, fmt.Errorf("failed to create task: %w", err)
+	}
 
-	// Persist task
-	newTask, err := h.taskRepo.CreateTask(cmd.ContainerId, *task)
+	// Persist task and its container link in one transaction
+	var newTask domain.Task
+	err = h.uow.Do(func(tx dbs.DBTX) error {
+		taskRepo := h.taskRepo.WithTx(tx)
+		newTask, err = taskRepo.CreateTask(cmd.ContainerId, *task)
+		if err != nil {
+			return err
+		}
+		return publishEvents(h.outboxRepo.WithTx(tx), taskRepo, task)
+	})
 	if err != nil {
 		return domain.Task{}, fmt.Errorf("failed to persist task: %w", err)
 	}
@@ -3,23 +3,46 @@ package command
 import (
 	"fmt"
 
+	outboxRepo "github.com/happYness-Project/taskManagementGolang/internal/outbox/repository"
 	"github.com/happYness-Project/taskManagementGolang/internal/task/repository"
+	"github.com/happYness-Project/taskManagementGolang/pkg/dbs"
 )
 
 type DeleteTaskCommand struct {
-	TaskId string
+	TaskId          string
+	ExpectedVersion int    // from If-Match; 0 skips the version check
+	RequesterId     string // UUID from JWT
 }
 
 type DeleteTaskCommandHandler struct {
-	taskRepo repository.TaskRepository
+	taskRepo   repository.TaskRepository
+	outboxRepo outboxRepo.OutboxRepository
+	uow        dbs.UnitOfWork
 }
 
-func NewDeleteTaskCommandHandler(taskRepo repository.TaskRepository) *DeleteTaskCommandHandler {
-	return &DeleteTaskCommandHandler{taskRepo: taskRepo}
+func NewDeleteTaskCommandHandler(taskRepo repository.TaskRepository, outboxRepo outboxRepo.OutboxRepository, uow dbs.UnitOfWork) *DeleteTaskCommandHandler {
+	return &DeleteTaskCommandHandler{taskRepo: taskRepo, outboxRepo: outboxRepo, uow: uow}
 }
 
 func (h *DeleteTaskCommandHandler) Handle(cmd DeleteTaskCommand) error {
-	err := h.taskRepo.DeleteTask(cmd.TaskId)
+	err := h.uow.Do(func(tx dbs.DBTX) error {
+		taskRepo := h.taskRepo.WithTx(tx)
+
+		task, err := taskRepo.GetTaskById(cmd.TaskId)
+		if err != nil || task == nil {
+			return fmt.Errorf("task not found: %w", err)
+		}
+		if err := bumpVersion(taskRepo, task, cmd.ExpectedVersion); err != nil {
+			return err
+		}
+		task.Delete()
+
+		// The task stays in the trash, restorable, until the purge removes it for good
+		if err := taskRepo.SoftDeleteTask(cmd.TaskId, *task.DeletedAt); err != nil {
+			return err
+		}
+		return publishEvents(h.outboxRepo.WithTx(tx), taskRepo, task)
+	})
 	if err != nil {
 		return fmt.Errorf("failed to delete task: %w", err)
 	}
@@ -0,0 +1,26 @@
+package command
+
+import (
+	"fmt"
+
+	outboxRepo "github.com/happYness-Project/taskManagementGolang/internal/outbox/repository"
+	"github.com/happYness-Project/taskManagementGolang/internal/task/domain"
+	"github.com/happYness-Project/taskManagementGolang/internal/task/repository"
+)
+
+// publishEvents moves the events raised by a persisted task into the outbox, under the group owning its container.
+// Both repositories must be bound to the transaction that persisted the task.
+func publishEvents(outbox outboxRepo.OutboxRepository, taskRepo repository.TaskRepository, task *domain.Task) error {
+	events := task.PullEvents()
+	if len(events) == 0 {
+		return nil
+	}
+	groupId, err := taskRepo.GetGroupIdByTaskId(task.TaskId)
+	if err != nil {
+		return fmt.Errorf("failed to find task group: %w", err)
+	}
+	if err := outbox.Append(groupId, events...); err != nil {
+		return fmt.Errorf("failed to store task events: %w", err)
+	}
+	return nil
+}
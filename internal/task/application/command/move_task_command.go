@@ -0,0 +1,135 @@
+package command
+
+import (
+	"errors"
+	"fmt"
+
+	labelDomain "github.com/happYness-Project/taskManagementGolang/internal/label/domain"
+	labelRepo "github.com/happYness-Project/taskManagementGolang/internal/label/repository"
+	outboxRepo "github.com/happYness-Project/taskManagementGolang/internal/outbox/repository"
+	"github.com/happYness-Project/taskManagementGolang/internal/task/domain"
+	"github.com/happYness-Project/taskManagementGolang/internal/task/repository"
+	containerRepo "github.com/happYness-Project/taskManagementGolang/internal/taskcontainer/repository"
+	userRepo "github.com/happYness-Project/taskManagementGolang/internal/user/repository"
+	usergroupRepo "github.com/happYness-Project/taskManagementGolang/internal/usergroup/repository"
+	"github.com/happYness-Project/taskManagementGolang/pkg/dbs"
+)
+
+var ErrTargetContainerNotFound = errors.New("target task container not found")
+
+type MoveTaskCommand struct {
+	TaskId            string
+	TargetContainerId string
+	ExpectedVersion   int    // from If-Match; 0 skips the version check
+	RequesterId       string // UUID from JWT
+}
+
+type MoveTaskCommandHandler struct {
+	taskRepo      repository.TaskRepository
+	containerRepo containerRepo.ContainerRepository
+	groupRepo     usergroupRepo.UserGroupRepository
+	userRepo      userRepo.UserRepository
+	labelRepo     labelRepo.LabelRepository
+	outboxRepo    outboxRepo.OutboxRepository
+	uow           dbs.UnitOfWork
+}
+
+func NewMoveTaskCommandHandler(taskRepo repository.TaskRepository, containerRepo containerRepo.ContainerRepository, groupRepo usergroupRepo.UserGroupRepository, userRepo userRepo.UserRepository, labelRepo labelRepo.LabelRepository, outboxRepo outboxRepo.OutboxRepository, uow dbs.UnitOfWork) *MoveTaskCommandHandler {
+	return &MoveTaskCommandHandler{taskRepo: taskRepo, containerRepo: containerRepo, groupRepo: groupRepo, userRepo: userRepo, labelRepo: labelRepo, outboxRepo: outboxRepo, uow: uow}
+}
+
+// Handle relinks the task to the target container. Moving a task to the container it is already in changes nothing.
+// A task moved to another group loses an assignee who is not a member of it, swaps its labels for that group's
+// labels of the same names, and both groups hear of the move.
+func (h *MoveTaskCommandHandler) Handle(cmd MoveTaskCommand) error {
+	return h.uow.Do(func(tx dbs.DBTX) error {
+		taskRepo := h.taskRepo.WithTx(tx)
+		containerRepo := h.containerRepo.WithTx(tx)
+
+		target, err := containerRepo.GetById(cmd.TargetContainerId)
+		if err != nil || target == nil || target.Id == "" {
+			return ErrTargetContainerNotFound
+		}
+
+		task, err := taskRepo.GetTaskById(cmd.TaskId)
+		if err != nil || task == nil {
+			return fmt.Errorf("task not found: %w", err)
+		}
+		sourceContainerId, err := taskRepo.GetContainerIdByTaskId(cmd.TaskId)
+		if err != nil {
+			return fmt.Errorf("failed to find task container: %w", err)
+		}
+		if sourceContainerId == target.Id {
+			return nil
+		}
+		sourceGroupId, err := taskRepo.GetGroupIdByTaskId(cmd.TaskId)
+		if err != nil {
+			return fmt.Errorf("failed to find task group: %w", err)
+		}
+
+		if err := bumpVersion(taskRepo, task, cmd.ExpectedVersion); err != nil {
+			return err
+		}
+		task.MoveTo(sourceContainerId, target.Id)
+		if err := taskRepo.MoveTask(cmd.TaskId, target.Id); err != nil {
+			return fmt.Errorf("failed to move task: %w", err)
+		}
+
+		if sourceGroupId == target.UsergroupId {
+			return publishEvents(h.outboxRepo.WithTx(tx), taskRepo, task)
+		}
+
+		keepAssignee, err := canKeepAssignee(h.groupRepo.WithTx(tx), h.userRepo.WithTx(tx), target.UsergroupId, task.AssigneeId)
+		if err != nil {
+			return err
+		}
+		if !keepAssignee {
+			task.Unassign()
+			if err := taskRepo.UpdateAssignee(task.TaskId, "", task.UpdatedAt); err != nil {
+				return fmt.Errorf("failed to unassign task: %w", err)
+			}
+		}
+		if len(task.Labels) > 0 {
+			groupLabels, err := groupTaskLabels(h.labelRepo.WithTx(tx), target.UsergroupId)
+			if err != nil {
+				return err
+			}
+			task.MatchLabels(groupLabels)
+			if err := taskRepo.SaveLabels(task.TaskId, task.Labels); err != nil {
+				return fmt.Errorf("failed to save task labels: %w", err)
+			}
+		}
+
+		// The source group only learns that the task left; everything else belongs to the target group now
+		events := task.PullEvents()
+		outboxRepo := h.outboxRepo.WithTx(tx)
+		if err := outboxRepo.Append(sourceGroupId, events[0]); err != nil {
+			return fmt.Errorf("failed to store task events: %w", err)
+		}
+		if err := outboxRepo.Append(target.UsergroupId, events...); err != nil {
+			return fmt.Errorf("failed to store task events: %w", err)
+		}
+		return nil
+	})
+}
+
+// groupTaskLabels returns the labels of the group a task is moved or copied to
+func groupTaskLabels(labelRepo labelRepo.LabelRepository, groupId int) ([]domain.Label, error) {
+	labels, err := labelRepo.GetByGroupId(groupId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve group labels: %w", err)
+	}
+	return labelDomain.TaskLabels(labels), nil
+}
+
+// canKeepAssignee reports whether the task's assignee, if any, is a member of the group it is moved or copied to
+func canKeepAssignee(groupRepo usergroupRepo.UserGroupRepository, userRepo userRepo.UserRepository, groupId int, assigneeId string) (bool, error) {
+	if assigneeId == "" {
+		return true, nil
+	}
+	err := requireGroupMember(groupRepo, userRepo, groupId, assigneeId)
+	if errors.Is(err, ErrAssigneeNotMember) {
+		return false, nil
+	}
+	return err == nil, err
+}
@@ -0,0 +1,135 @@
+package command
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	labelDomain "github.com/happYness-Project/taskManagementGolang/internal/label/domain"
+	"github.com/happYness-Project/taskManagementGolang/internal/mocks"
+	"github.com/happYness-Project/taskManagementGolang/internal/task/domain"
+	"github.com/happYness-Project/taskManagementGolang/internal/task/repository"
+	containerDomain "github.com/happYness-Project/taskManagementGolang/internal/taskcontainer/domain"
+	usergroupDomain "github.com/happYness-Project/taskManagementGolang/internal/usergroup/domain"
+	"github.com/happYness-Project/taskManagementGolang/pkg/dbs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubMoveTaskRepo holds a single task linked to a container of sourceGroupId
+type stubMoveTaskRepo struct {
+	repository.TaskRepository
+	task          *domain.Task
+	containerId   string
+	sourceGroupId int
+	unassigned    bool
+	savedLabels   []domain.Label
+}
+
+func (s *stubMoveTaskRepo) GetTaskById(id string) (*domain.Task, error) { return s.task, nil }
+
+func (s *stubMoveTaskRepo) GetContainerIdByTaskId(taskId string) (string, error) {
+	return s.containerId, nil
+}
+
+func (s *stubMoveTaskRepo) GetGroupIdByTaskId(taskId string) (int, error) {
+	return s.sourceGroupId, nil
+}
+
+func (s *stubMoveTaskRepo) IncrementVersion(id string, expectedVersion int) (int, error) {
+	return s.task.Version + 1, nil
+}
+
+func (s *stubMoveTaskRepo) MoveTask(id string, containerId string) error {
+	s.containerId = containerId
+	return nil
+}
+
+func (s *stubMoveTaskRepo) UpdateAssignee(id string, assigneeId string, updatedAt time.Time) error {
+	s.unassigned = assigneeId == ""
+	return nil
+}
+
+func (s *stubMoveTaskRepo) SaveLabels(taskId string, labels []domain.Label) error {
+	s.savedLabels = labels
+	return nil
+}
+
+func (s *stubMoveTaskRepo) WithTx(tx dbs.DBTX) repository.TaskRepository { return s }
+
+func TestMoveTaskCommandHandler_Handle(t *testing.T) {
+	const sourceGroupId, targetGroupId = 1, 9
+
+	newFixture := func(t *testing.T, targetGroup int) (*MoveTaskCommandHandler, *stubMoveTaskRepo, *mocks.MockOutboxRepo) {
+		t.Helper()
+		task, err := domain.CreateTask("Milk", "", time.Now(), "")
+		require.NoError(t, err)
+		task.PullEvents()
+		task.AssigneeId = "assignee"
+		task.Labels = []domain.Label{{Id: "source-grocery", Name: "Grocery"}, {Id: "source-garden", Name: "Garden"}}
+		taskRepo := &stubMoveTaskRepo{task: task, containerId: "source", sourceGroupId: sourceGroupId}
+
+		containerRepo := new(mocks.MockContainerRepo)
+		containerRepo.On("GetById", "target").Return(&containerDomain.TaskContainer{Id: "target", UsergroupId: targetGroup}, nil)
+		groupRepo := new(mocks.MockUserGroupRepo)
+		groupRepo.On("GetById", targetGroup).Return(&usergroupDomain.UserGroup{GroupId: targetGroup}, nil)
+		userRepo := new(mocks.MockUserRepo)
+		userRepo.On("GetUserRoleInGroup", "assignee", targetGroup).Return("", sql.ErrNoRows)
+		labelRepo := new(mocks.MockLabelRepo)
+		labelRepo.On("GetByGroupId", targetGroup).Return([]labelDomain.Label{{Id: "target-grocery", UsergroupId: targetGroup, Name: "grocery", Color: "#00ff00"}}, nil)
+		outbox := &mocks.MockOutboxRepo{}
+
+		handler := NewMoveTaskCommandHandler(taskRepo, containerRepo, groupRepo, userRepo, labelRepo, outbox, &mocks.MockUnitOfWork{})
+		return handler, taskRepo, outbox
+	}
+
+	t.Run("when task moves within its group, Then it keeps its assignee and labels and the group hears of the move", func(t *testing.T) {
+		// Arrange
+		handler, taskRepo, outbox := newFixture(t, sourceGroupId)
+
+		// Act
+		err := handler.Handle(MoveTaskCommand{TaskId: taskRepo.task.TaskId, TargetContainerId: "target"})
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, "target", taskRepo.containerId)
+		assert.False(t, taskRepo.unassigned)
+		assert.Nil(t, taskRepo.savedLabels)
+		require.Len(t, outbox.Events, 1)
+		assert.Equal(t, domain.EventTaskMoved, outbox.Events[0].EventType())
+		assert.Equal(t, []int{sourceGroupId}, outbox.GroupIds)
+	})
+
+	t.Run("when task moves to a group its assignee is not in, Then it is unassigned, takes that group's labels and both groups hear of the move", func(t *testing.T) {
+		// Arrange
+		handler, taskRepo, outbox := newFixture(t, targetGroupId)
+
+		// Act
+		err := handler.Handle(MoveTaskCommand{TaskId: taskRepo.task.TaskId, TargetContainerId: "target"})
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, "target", taskRepo.containerId)
+		assert.True(t, taskRepo.unassigned)
+		require.Len(t, taskRepo.savedLabels, 1)
+		assert.Equal(t, "target-grocery", taskRepo.savedLabels[0].Id)
+		require.Len(t, outbox.Events, 3)
+		assert.Equal(t, domain.EventTaskMoved, outbox.Events[0].EventType())
+		assert.Equal(t, domain.EventTaskMoved, outbox.Events[1].EventType())
+		assert.Equal(t, domain.EventTaskUpdated, outbox.Events[2].EventType())
+		assert.Equal(t, []int{sourceGroupId, targetGroupId, targetGroupId}, outbox.GroupIds)
+	})
+
+	t.Run("when target container does not exist, Then ErrTargetContainerNotFound is returned", func(t *testing.T) {
+		// Arrange
+		handler, taskRepo, _ := newFixture(t, sourceGroupId)
+		handler.containerRepo.(*mocks.MockContainerRepo).On("GetById", "missing").Return(&containerDomain.TaskContainer{}, nil)
+
+		// Act
+		err := handler.Handle(MoveTaskCommand{TaskId: taskRepo.task.TaskId, TargetContainerId: "missing"})
+
+		// Assert
+		assert.ErrorIs(t, err, ErrTargetContainerNotFound)
+		assert.Equal(t, "source", taskRepo.containerId)
+	})
+}
@@ -0,0 +1,59 @@
+package command
+
+import (
+	"fmt"
+
+	outboxRepo "github.com/happYness-Project/taskManagementGolang/internal/outbox/repository"
+	"github.com/happYness-Project/taskManagementGolang/internal/task/domain"
+	"github.com/happYness-Project/taskManagementGolang/internal/task/repository"
+	"github.com/happYness-Project/taskManagementGolang/pkg/dbs"
+)
+
+// PatchTaskCommand changes only the task fields present in a merge patch
+type PatchTaskCommand struct {
+	TaskId          string
+	Patch           domain.TaskPatch
+	ExpectedVersion int    // from If-Match; 0 skips the version check
+	RequesterId     string // UUID from JWT
+}
+
+type PatchTaskCommandHandler struct {
+	taskRepo   repository.TaskRepository
+	outboxRepo outboxRepo.OutboxRepository
+	uow        dbs.UnitOfWork
+}
+
+func NewPatchTaskCommandHandler(taskRepo repository.TaskRepository, outboxRepo outboxRepo.OutboxRepository, uow dbs.UnitOfWork) *PatchTaskCommandHandler {
+	return &PatchTaskCommandHandler{taskRepo: taskRepo, outboxRepo: outboxRepo, uow: uow}
+}
+
+// Handle applies the patch and returns the patched task. Rejected fields are reported as a *domain.ValidationError.
+func (h *PatchTaskCommandHandler) Handle(cmd PatchTaskCommand) (domain.Task, error) {
+	var patched domain.Task
+	err := h.uow.Do(func(tx dbs.DBTX) error {
+		taskRepo := h.taskRepo.WithTx(tx)
+
+		task, err := taskRepo.GetTaskById(cmd.TaskId)
+		if err != nil || task == nil {
+			return fmt.Errorf("task not found: %w", err)
+		}
+		if err := bumpVersion(taskRepo, task, cmd.ExpectedVersion); err != nil {
+			return err
+		}
+
+		// Domain method validates every field before changing any
+		err = task.ApplyPatch(cmd.Patch)
+		if err != nil {
+			return fmt.Errorf("failed to patch task: %w", err)
+		}
+
+		err = taskRepo.UpdateTask(*task)
+		if err != nil {
+			return fmt.Errorf("failed to persist task patch: %w", err)
+		}
+
+		patched = *task
+		return publishEvents(h.outboxRepo.WithTx(tx), taskRepo, task)
+	})
+	return patched, err
+}
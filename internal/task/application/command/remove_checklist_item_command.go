@@ -0,0 +1,31 @@
+package command
+
+import (
+	outboxRepo "github.com/happYness-Project/taskManagementGolang/internal/outbox/repository"
+	"github.com/happYness-Project/taskManagementGolang/internal/task/domain"
+	"github.com/happYness-Project/taskManagementGolang/internal/task/repository"
+	"github.com/happYness-Project/taskManagementGolang/pkg/dbs"
+)
+
+type RemoveChecklistItemCommand struct {
+	TaskId          string
+	ItemId          string
+	ExpectedVersion int    // from If-Match; 0 skips the version check
+	RequesterId     string // UUID from JWT
+}
+
+type RemoveChecklistItemCommandHandler struct {
+	taskRepo   repository.TaskRepository
+	outboxRepo outboxRepo.OutboxRepository
+	uow        dbs.UnitOfWork
+}
+
+func NewRemoveChecklistItemCommandHandler(taskRepo repository.TaskRepository, outboxRepo outboxRepo.OutboxRepository, uow dbs.UnitOfWork) *RemoveChecklistItemCommandHandler {
+	return &RemoveChecklistItemCommandHandler{taskRepo: taskRepo, outboxRepo: outboxRepo, uow: uow}
+}
+
+func (h *RemoveChecklistItemCommandHandler) Handle(cmd RemoveChecklistItemCommand) error {
+	return changeChecklist(h.uow, h.taskRepo, h.outboxRepo, cmd.TaskId, cmd.ExpectedVersion, func(task *domain.Task) error {
+		return task.RemoveChecklistItem(cmd.ItemId)
+	})
+}
@@ -0,0 +1,31 @@
+package command
+
+import (
+	outboxRepo "github.com/happYness-Project/taskManagementGolang/internal/outbox/repository"
+	"github.com/happYness-Project/taskManagementGolang/internal/task/domain"
+	"github.com/happYness-Project/taskManagementGolang/internal/task/repository"
+	"github.com/happYness-Project/taskManagementGolang/pkg/dbs"
+)
+
+type ReorderChecklistCommand struct {
+	TaskId          string
+	ItemIds         []string // every item of the checklist, in the new order
+	ExpectedVersion int      // from If-Match; 0 skips the version check
+	RequesterId     string   // UUID from JWT
+}
+
+type ReorderChecklistCommandHandler struct {
+	taskRepo   repository.TaskRepository
+	outboxRepo outboxRepo.OutboxRepository
+	uow        dbs.UnitOfWork
+}
+
+func NewReorderChecklistCommandHandler(taskRepo repository.TaskRepository, outboxRepo outboxRepo.OutboxRepository, uow dbs.UnitOfWork) *ReorderChecklistCommandHandler {
+	return &ReorderChecklistCommandHandler{taskRepo: taskRepo, outboxRepo: outboxRepo, uow: uow}
+}
+
+func (h *ReorderChecklistCommandHandler) Handle(cmd ReorderChecklistCommand) error {
+	return changeChecklist(h.uow, h.taskRepo, h.outboxRepo, cmd.TaskId, cmd.ExpectedVersion, func(task *domain.Task) error {
+		return task.ReorderChecklist(cmd.ItemIds)
+	})
+}
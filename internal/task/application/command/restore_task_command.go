@@ -0,0 +1,65 @@
+package command
+
+import (
+	"errors"
+	"fmt"
+
+	outboxRepo "github.com/happYness-Project/taskManagementGolang/internal/outbox/repository"
+	"github.com/happYness-Project/taskManagementGolang/internal/task/domain"
+	"github.com/happYness-Project/taskManagementGolang/internal/task/repository"
+	containerRepo "github.com/happYness-Project/taskManagementGolang/internal/taskcontainer/repository"
+	"github.com/happYness-Project/taskManagementGolang/pkg/dbs"
+)
+
+var ErrContainerInTrash = errors.New("the task's container is in the trash; restore the container instead")
+
+type RestoreTaskCommand struct {
+	TaskId      string
+	RequesterId string // UUID from JWT
+}
+
+type RestoreTaskCommandHandler struct {
+	taskRepo      repository.TaskRepository
+	containerRepo containerRepo.ContainerRepository
+	outboxRepo    outboxRepo.OutboxRepository
+	uow           dbs.UnitOfWork
+}
+
+func NewRestoreTaskCommandHandler(taskRepo repository.TaskRepository, containerRepo containerRepo.ContainerRepository, outboxRepo outboxRepo.OutboxRepository, uow dbs.UnitOfWork) *RestoreTaskCommandHandler {
+	return &RestoreTaskCommandHandler{taskRepo: taskRepo, containerRepo: containerRepo, outboxRepo: outboxRepo, uow: uow}
+}
+
+// Handle takes a task back out of the trash. A task trashed with its container comes back with the container.
+func (h *RestoreTaskCommandHandler) Handle(cmd RestoreTaskCommand) error {
+	return h.uow.Do(func(tx dbs.DBTX) error {
+		taskRepo := h.taskRepo.WithTx(tx)
+
+		task, err := taskRepo.GetDeletedTaskById(cmd.TaskId)
+		if err != nil {
+			return fmt.Errorf("failed to find deleted task: %w", err)
+		}
+		if task == nil {
+			return domain.ErrNotInTrash
+		}
+
+		containerId, err := taskRepo.GetContainerIdByTaskId(cmd.TaskId)
+		if err != nil {
+			return fmt.Errorf("failed to find task container: %w", err)
+		}
+		container, err := h.containerRepo.WithTx(tx).GetById(containerId)
+		if err != nil || container == nil || container.Id == "" {
+			return ErrContainerInTrash
+		}
+
+		if err := task.Restore(); err != nil {
+			return err
+		}
+		if err := taskRepo.RestoreTask(cmd.TaskId); err != nil {
+			return err
+		}
+		if err := bumpVersion(taskRepo, task, 0); err != nil {
+			return err
+		}
+		return publishEvents(h.outboxRepo.WithTx(tx), taskRepo, task)
+	})
+}
@@ -0,0 +1,105 @@
+package command
+
+import (
+	"errors"
+	"fmt"
+
+	labelDomain "github.com/happYness-Project/taskManagementGolang/internal/label/domain"
+	labelRepo "github.com/happYness-Project/taskManagementGolang/internal/label/repository"
+	outboxRepo "github.com/happYness-Project/taskManagementGolang/internal/outbox/repository"
+	"github.com/happYness-Project/taskManagementGolang/internal/task/domain"
+	"github.com/happYness-Project/taskManagementGolang/internal/task/repository"
+	"github.com/happYness-Project/taskManagementGolang/pkg/dbs"
+)
+
+var ErrLabelNotInGroup = errors.New("label does not belong to the task's user group")
+
+type AddTaskLabelCommand struct {
+	TaskId          string
+	LabelId         string
+	ExpectedVersion int    // from If-Match; 0 skips the version check
+	RequesterId     string // UUID from JWT
+}
+
+type RemoveTaskLabelCommand struct {
+	TaskId          string
+	LabelId         string
+	ExpectedVersion int    // from If-Match; 0 skips the version check
+	RequesterId     string // UUID from JWT
+}
+
+type AddTaskLabelCommandHandler struct {
+	taskRepo   repository.TaskRepository
+	labelRepo  labelRepo.LabelRepository
+	outboxRepo outboxRepo.OutboxRepository
+	uow        dbs.UnitOfWork
+}
+
+type RemoveTaskLabelCommandHandler struct {
+	taskRepo   repository.TaskRepository
+	outboxRepo outboxRepo.OutboxRepository
+	uow        dbs.UnitOfWork
+}
+
+func NewAddTaskLabelCommandHandler(taskRepo repository.TaskRepository, labelRepo labelRepo.LabelRepository, outboxRepo outboxRepo.OutboxRepository, uow dbs.UnitOfWork) *AddTaskLabelCommandHandler {
+	return &AddTaskLabelCommandHandler{taskRepo: taskRepo, labelRepo: labelRepo, outboxRepo: outboxRepo, uow: uow}
+}
+
+func NewRemoveTaskLabelCommandHandler(taskRepo repository.TaskRepository, outboxRepo outboxRepo.OutboxRepository, uow dbs.UnitOfWork) *RemoveTaskLabelCommandHandler {
+	return &RemoveTaskLabelCommandHandler{taskRepo: taskRepo, outboxRepo: outboxRepo, uow: uow}
+}
+
+// Handle attaches a label of the task's group to the task. Adding a label the task already carries changes nothing.
+func (h *AddTaskLabelCommandHandler) Handle(cmd AddTaskLabelCommand) error {
+	return h.uow.Do(func(tx dbs.DBTX) error {
+		taskRepo := h.taskRepo.WithTx(tx)
+
+		label, err := h.labelRepo.WithTx(tx).GetById(cmd.LabelId)
+		if err != nil {
+			return fmt.Errorf("failed to retrieve label: %w", err)
+		}
+		if label == nil {
+			return fmt.Errorf("%w: %s", labelDomain.ErrLabelNotFound, cmd.LabelId)
+		}
+		groupId, err := taskRepo.GetGroupIdByTaskId(cmd.TaskId)
+		if err != nil {
+			return fmt.Errorf("failed to find task group: %w", err)
+		}
+		if !label.BelongsTo(groupId) {
+			return fmt.Errorf("%w: %s", ErrLabelNotInGroup, cmd.LabelId)
+		}
+
+		return changeLabels(taskRepo, h.outboxRepo.WithTx(tx), cmd.TaskId, cmd.ExpectedVersion, func(task *domain.Task) (bool, error) {
+			return task.AddLabel(label.TaskLabel())
+		})
+	})
+}
+
+// Handle detaches the label from the task. Removing a label the task does not carry changes nothing.
+func (h *RemoveTaskLabelCommandHandler) Handle(cmd RemoveTaskLabelCommand) error {
+	return h.uow.Do(func(tx dbs.DBTX) error {
+		return changeLabels(h.taskRepo.WithTx(tx), h.outboxRepo.WithTx(tx), cmd.TaskId, cmd.ExpectedVersion, func(task *domain.Task) (bool, error) {
+			return task.RemoveLabel(cmd.LabelId), nil
+		})
+	})
+}
+
+// changeLabels loads the task, applies change and, when it changed anything, persists the labels and their events
+func changeLabels(taskRepo repository.TaskRepository, outbox outboxRepo.OutboxRepository, taskId string, expectedVersion int, change func(task *domain.Task) (bool, error)) error {
+	task, err := taskRepo.GetTaskById(taskId)
+	if err != nil || task == nil {
+		return fmt.Errorf("task not found: %w", err)
+	}
+	if err := bumpVersion(taskRepo, task, expectedVersion); err != nil {
+		return err
+	}
+
+	changed, err := change(task)
+	if err != nil || !changed {
+		return err
+	}
+	if err := taskRepo.SaveLabels(task.TaskId, task.Labels); err != nil {
+		return fmt.Errorf("failed to save task labels: %w", err)
+	}
+	return publishEvents(outbox, taskRepo, task)
+}
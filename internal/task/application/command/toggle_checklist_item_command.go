@@ -0,0 +1,32 @@
+package command
+
+import (
+	outboxRepo "github.com/happYness-Project/taskManagementGolang/internal/outbox/repository"
+	"github.com/happYness-Project/taskManagementGolang/internal/task/domain"
+	"github.com/happYness-Project/taskManagementGolang/internal/task/repository"
+	"github.com/happYness-Project/taskManagementGolang/pkg/dbs"
+)
+
+type ToggleChecklistItemCommand struct {
+	TaskId          string
+	ItemId          string
+	IsChecked       bool
+	ExpectedVersion int    // from If-Match; 0 skips the version check
+	RequesterId     string // UUID from JWT
+}
+
+type ToggleChecklistItemCommandHandler struct {
+	taskRepo   repository.TaskRepository
+	outboxRepo outboxRepo.OutboxRepository
+	uow        dbs.UnitOfWork
+}
+
+func NewToggleChecklistItemCommandHandler(taskRepo repository.TaskRepository, outboxRepo outboxRepo.OutboxRepository, uow dbs.UnitOfWork) *ToggleChecklistItemCommandHandler {
+	return &ToggleChecklistItemCommandHandler{taskRepo: taskRepo, outboxRepo: outboxRepo, uow: uow}
+}
+
+func (h *ToggleChecklistItemCommandHandler) Handle(cmd ToggleChecklistItemCommand) error {
+	return changeChecklist(h.uow, h.taskRepo, h.outboxRepo, cmd.TaskId, cmd.ExpectedVersion, func(task *domain.Task) error {
+		return task.ToggleChecklistItem(cmd.ItemId, cmd.IsChecked)
+	})
+}
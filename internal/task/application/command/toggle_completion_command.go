@@ -3,37 +3,88 @@ package command
 import (
 	"fmt"
 
+	outboxRepo "github.com/happYness-Project/taskManagementGolang/internal/outbox/repository"
+	"github.com/happYness-Project/taskManagementGolang/internal/task/domain"
 	"github.com/happYness-Project/taskManagementGolang/internal/task/repository"
+	"github.com/happYness-Project/taskManagementGolang/pkg/dbs"
 )
 
 type ToggleCompletionCommand struct {
-	TaskId      string
-	IsCompleted bool
+	TaskId          string
+	IsCompleted     bool
+	ExpectedVersion int    // from If-Match; 0 skips the version check
+	RequesterId     string // UUID from JWT
 }
 
 type ToggleCompletionCommandHandler struct {
-	taskRepo repository.TaskRepository
+	taskRepo   repository.TaskRepository
+	outboxRepo outboxRepo.OutboxRepository
+	uow        dbs.UnitOfWork
 }
 
-func NewToggleCompletionCommandHandler(taskRepo repository.TaskRepository) *ToggleCompletionCommandHandler {
-	return &ToggleCompletionCommandHandler{taskRepo: taskRepo}
+func NewToggleCompletionCommandHandler(taskRepo repository.TaskRepository, outboxRepo outboxRepo.OutboxRepository, uow dbs.UnitOfWork) *ToggleCompletionCommandHandler {
+	return &ToggleCompletionCommandHandler{taskRepo: taskRepo, outboxRepo: outboxRepo, uow: uow}
 }
 
 func (h *ToggleCompletionCommandHandler) Handle(cmd ToggleCompletionCommand) error {
-	// Get existing task
-	task, err := h.taskRepo.GetTaskById(cmd.TaskId)
-	if err != nil || task == nil {
-		return fmt.Errorf("task not found: %w", err)
-	}
+	return h.uow.Do(func(tx dbs.DBTX) error {
+		taskRepo := h.taskRepo.WithTx(tx)
+
+		// Get existing task
+		task, err := taskRepo.GetTaskById(cmd.TaskId)
+		if err != nil || task == nil {
+			return fmt.Errorf("task not found: %w", err)
+		}
+		if err := bumpVersion(taskRepo, task, cmd.ExpectedVersion); err != nil {
+			return err
+		}
+
+		wasCompleted := task.IsCompleted
+
+		// Toggle completion using domain method
+		task.ToggleCompletion(cmd.IsCompleted)
 
-	// Toggle completion using domain method
-	task.ToggleCompletion(cmd.IsCompleted)
+		// Persist changes
+		err = taskRepo.DoneTask(cmd.TaskId, cmd.IsCompleted)
+		if err != nil {
+			return fmt.Errorf("failed to toggle completion: %w", err)
+		}
 
-	// Persist changes
-	err = h.taskRepo.DoneTask(cmd.TaskId, cmd.IsCompleted)
+		outboxRepo := h.outboxRepo.WithTx(tx)
+		if err := publishEvents(outboxRepo, taskRepo, task); err != nil {
+			return err
+		}
+
+		if wasCompleted || !cmd.IsCompleted || !task.IsRecurring() {
+			return nil
+		}
+		return scheduleNextOccurrence(taskRepo, outboxRepo, task)
+	})
+}
+
+// scheduleNextOccurrence creates the follow-up instance of a completed recurring task in the same container
+func scheduleNextOccurrence(taskRepo repository.TaskRepository, outboxRepo outboxRepo.OutboxRepository, task *domain.Task) error {
+	next, err := task.NextOccurrence()
 	if err != nil {
-		return fmt.Errorf("failed to toggle completion: %w", err)
+		return fmt.Errorf("failed to compute next occurrence: %w", err)
 	}
 
-	return nil
+	// The rule has moved to the next instance (or the series has ended)
+	err = taskRepo.UpdateTask(*task)
+	if err != nil {
+		return fmt.Errorf("failed to persist task update: %w", err)
+	}
+	if next == nil {
+		return nil
+	}
+
+	containerId, err := taskRepo.GetContainerIdByTaskId(task.TaskId)
+	if err != nil {
+		return fmt.Errorf("failed to find task container: %w", err)
+	}
+	_, err = taskRepo.CreateTask(containerId, *next)
+	if err != nil {
+		return fmt.Errorf("failed to create next occurrence: %w", err)
+	}
+	return publishEvents(outboxRepo, taskRepo, next)
 }
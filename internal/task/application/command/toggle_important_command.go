@@ -3,37 +3,50 @@ package command
 import (
 	"fmt"
 
+	outboxRepo "github.com/happYness-Project/taskManagementGolang/internal/outbox/repository"
 	"github.com/happYness-Project/taskManagementGolang/internal/task/repository"
+	"github.com/happYness-Project/taskManagementGolang/pkg/dbs"
 )
 
 type ToggleImportantCommand struct {
-	TaskId      string
-	IsImportant bool
+	TaskId          string
+	IsImportant     bool
+	ExpectedVersion int    // from If-Match; 0 skips the version check
+	RequesterId     string // UUID from JWT
 }
 
 type ToggleImportantCommandHandler struct {
-	taskRepo repository.TaskRepository
+	taskRepo   repository.TaskRepository
+	outboxRepo outboxRepo.OutboxRepository
+	uow        dbs.UnitOfWork
 }
 
-func NewToggleImportantCommandHandler(taskRepo repository.TaskRepository) *ToggleImportantCommandHandler {
-	return &ToggleImportantCommandHandler{taskRepo: taskRepo}
+func NewToggleImportantCommandHandler(taskRepo repository.TaskRepository, outboxRepo outboxRepo.OutboxRepository, uow dbs.UnitOfWork) *ToggleImportantCommandHandler {
+	return &ToggleImportantCommandHandler{taskRepo: taskRepo, outboxRepo: outboxRepo, uow: uow}
 }
 
 func (h *ToggleImportantCommandHandler) Handle(cmd ToggleImportantCommand) error {
-	// Get existing task
-	task, err := h.taskRepo.GetTaskById(cmd.TaskId)
-	if err != nil || task == nil {
-		return fmt.Errorf("task not found: %w", err)
-	}
-
-	// Toggle important using domain method
-	task.ToggleImportant(cmd.IsImportant)
-
-	// Persist changes
-	err = h.taskRepo.UpdateImportantTask(cmd.TaskId, cmd.IsImportant)
-	if err != nil {
-		return fmt.Errorf("failed to toggle important: %w", err)
-	}
-
-	return nil
+	return h.uow.Do(func(tx dbs.DBTX) error {
+		taskRepo := h.taskRepo.WithTx(tx)
+
+		// Get existing task
+		task, err := taskRepo.GetTaskById(cmd.TaskId)
+		if err != nil || task == nil {
+			return fmt.Errorf("task not found: %w", err)
+		}
+		if err := bumpVersion(taskRepo, task, cmd.ExpectedVersion); err != nil {
+			return err
+		}
+
+		// Toggle important using domain method
+		task.ToggleImportant(cmd.IsImportant)
+
+		// Persist changes
+		err = taskRepo.UpdateImportantTask(cmd.TaskId, cmd.IsImportant)
+		if err != nil {
+			return fmt.Errorf("failed to toggle important: %w", err)
+		}
+
+		return publishEvents(h.outboxRepo.WithTx(tx), taskRepo, task)
+	})
 }
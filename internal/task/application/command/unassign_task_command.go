@@ -0,0 +1,47 @@
+package command
+
+import (
+	"fmt"
+
+	outboxRepo "github.com/happYness-Project/taskManagementGolang/internal/outbox/repository"
+	"github.com/happYness-Project/taskManagementGolang/internal/task/repository"
+	"github.com/happYness-Project/taskManagementGolang/pkg/dbs"
+)
+
+type UnassignTaskCommand struct {
+	TaskId          string
+	ExpectedVersion int    // from If-Match; 0 skips the version check
+	RequesterId     string // UUID from JWT
+}
+
+type UnassignTaskCommandHandler struct {
+	taskRepo   repository.TaskRepository
+	outboxRepo outboxRepo.OutboxRepository
+	uow        dbs.UnitOfWork
+}
+
+func NewUnassignTaskCommandHandler(taskRepo repository.TaskRepository, outboxRepo outboxRepo.OutboxRepository, uow dbs.UnitOfWork) *UnassignTaskCommandHandler {
+	return &UnassignTaskCommandHandler{taskRepo: taskRepo, outboxRepo: outboxRepo, uow: uow}
+}
+
+func (h *UnassignTaskCommandHandler) Handle(cmd UnassignTaskCommand) error {
+	return h.uow.Do(func(tx dbs.DBTX) error {
+		taskRepo := h.taskRepo.WithTx(tx)
+
+		task, err := taskRepo.GetTaskById(cmd.TaskId)
+		if err != nil || task == nil {
+			return fmt.Errorf("task not found: %w", err)
+		}
+		if err := bumpVersion(taskRepo, task, cmd.ExpectedVersion); err != nil {
+			return err
+		}
+
+		task.Unassign()
+
+		err = taskRepo.UpdateAssignee(task.TaskId, task.AssigneeId, task.UpdatedAt)
+		if err != nil {
+			return fmt.Errorf("failed to unassign task: %w", err)
+		}
+		return publishEvents(h.outboxRepo.WithTx(tx), taskRepo, task)
+	})
+}
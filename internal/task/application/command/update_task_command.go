@@ -4,7 +4,9 @@ import (
 	"fmt"
 	"time"
 
+	outboxRepo "github.com/happYness-Project/taskManagementGolang/internal/outbox/repository"
 	"github.com/happYness-Project/taskManagementGolang/internal/task/repository"
+	"github.com/happYness-Project/taskManagementGolang/pkg/dbs"
 )
 
 type UpdateTaskCommand struct {
@@ -13,35 +15,51 @@ type UpdateTaskCommand struct {
 	TaskDesc   string
 	TargetDate time.Time
 	Priority   string
-	Category   string
+	// RecurrenceRule is an optional RRULE, e.g. "FREQ=WEEKLY;BYDAY=MO,TH"
+	RecurrenceRule  string
+	ExpectedVersion int    // from If-Match; 0 skips the version check
+	RequesterId     string // UUID from JWT
 }
 
 type UpdateTaskCommandHandler struct {
-	taskRepo repository.TaskRepository
+	taskRepo   repository.TaskRepository
+	outboxRepo outboxRepo.OutboxRepository
+	uow        dbs.UnitOfWork
 }
 
-func NewUpdateTaskCommandHandler(taskRepo repository.TaskRepository) *UpdateTaskCommandHandler {
-	return &UpdateTaskCommandHandler{taskRepo: taskRepo}
+func NewUpdateTaskCommandHandler(taskRepo repository.TaskRepository, outboxRepo outboxRepo.OutboxRepository, uow dbs.UnitOfWork) *UpdateTaskCommandHandler {
+	return &UpdateTaskCommandHandler{taskRepo: taskRepo, outboxRepo: outboxRepo, uow: uow}
 }
 
 func (h *UpdateTaskCommandHandler) Handle(cmd UpdateTaskCommand) error {
-	// Get existing task
-	task, err := h.taskRepo.GetTaskById(cmd.TaskId)
-	if err != nil || task == nil {
-		return fmt.Errorf("task not found: %w", err)
-	}
-
-	// Update task using domain method (enforces validation)
-	err = task.UpdateTask(cmd.TaskName, cmd.TaskDesc, cmd.TargetDate, cmd.Priority, cmd.Category)
-	if err != nil {
-		return fmt.Errorf("failed to update task: %w", err)
-	}
-
-	// Persist changes
-	err = h.taskRepo.UpdateTask(*task)
-	if err != nil {
-		return fmt.Errorf("failed to persist task update: %w", err)
-	}
-
-	return nil
+	return h.uow.Do(func(tx dbs.DBTX) error {
+		taskRepo := h.taskRepo.WithTx(tx)
+
+		// Get existing task
+		task, err := taskRepo.GetTaskById(cmd.TaskId)
+		if err != nil || task == nil {
+			return fmt.Errorf("task not found: %w", err)
+		}
+		if err := bumpVersion(taskRepo, task, cmd.ExpectedVersion); err != nil {
+			return err
+		}
+
+		// Update task using domain method (enforces validation)
+		err = task.UpdateTask(cmd.TaskName, cmd.TaskDesc, cmd.TargetDate, cmd.Priority)
+		if err != nil {
+			return fmt.Errorf("failed to update task: %w", err)
+		}
+		err = task.SetRecurrence(cmd.RecurrenceRule)
+		if err != nil {
+			return fmt.Errorf("failed to update task: %w", err)
+		}
+
+		// Persist changes
+		err = taskRepo.UpdateTask(*task)
+		if err != nil {
+			return fmt.Errorf("failed to persist task update: %w", err)
+		}
+
+		return publishEvents(h.outboxRepo.WithTx(tx), taskRepo, task)
+	})
 }
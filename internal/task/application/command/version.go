@@ -0,0 +1,17 @@
+package command
+
+import (
+	"github.com/happYness-Project/taskManagementGolang/internal/task/domain"
+	"github.com/happYness-Project/taskManagementGolang/internal/task/repository"
+)
+
+// bumpVersion moves a loaded task to its next version. It fails with dbs.ErrVersionConflict when expectedVersion
+// (0 for none) is stale. Call it before the task's other writes, so concurrent writers queue behind the row lock.
+func bumpVersion(taskRepo repository.TaskRepository, task *domain.Task, expectedVersion int) error {
+	version, err := taskRepo.IncrementVersion(task.TaskId, expectedVersion)
+	if err != nil {
+		return err
+	}
+	task.Version = version
+	return nil
+}
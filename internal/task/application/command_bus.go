@@ -1,46 +1,216 @@
 package application
 
 import (
+	"context"
 	"fmt"
 
+	auditApp "github.com/happYness-Project/taskManagementGolang/internal/audit/application"
+	"github.com/happYness-Project/taskManagementGolang/internal/authorization"
+	labelRepo "github.com/happYness-Project/taskManagementGolang/internal/label/repository"
+	outboxRepo "github.com/happYness-Project/taskManagementGolang/internal/outbox/repository"
 	cmd "github.com/happYness-Project/taskManagementGolang/internal/task/application/command"
 	"github.com/happYness-Project/taskManagementGolang/internal/task/repository"
+	containerRepo "github.com/happYness-Project/taskManagementGolang/internal/taskcontainer/repository"
+	userRepo "github.com/happYness-Project/taskManagementGolang/internal/user/repository"
+	usergroupRepo "github.com/happYness-Project/taskManagementGolang/internal/usergroup/repository"
+	"github.com/happYness-Project/taskManagementGolang/pkg/dbs"
 )
 
 // CommandBus routes commands to their handlers
 type CommandBus struct {
-	createTaskHandler         *cmd.CreateTaskCommandHandler
-	updateTaskHandler         *cmd.UpdateTaskCommandHandler
-	deleteTaskHandler         *cmd.DeleteTaskCommandHandler
-	toggleCompletionHandler   *cmd.ToggleCompletionCommandHandler
-	toggleImportantHandler    *cmd.ToggleImportantCommandHandler
+	createTaskHandler       *cmd.CreateTaskCommandHandler
+	updateTaskHandler       *cmd.UpdateTaskCommandHandler
+	patchTaskHandler        *cmd.PatchTaskCommandHandler
+	deleteTaskHandler       *cmd.DeleteTaskCommandHandler
+	toggleCompletionHandler *cmd.ToggleCompletionCommandHandler
+	toggleImportantHandler  *cmd.ToggleImportantCommandHandler
+	restoreTaskHandler      *cmd.RestoreTaskCommandHandler
+	moveTaskHandler         *cmd.MoveTaskCommandHandler
+	copyTaskHandler         *cmd.CopyTaskCommandHandler
+
+	addChecklistItemHandler    *cmd.AddChecklistItemCommandHandler
+	toggleChecklistItemHandler *cmd.ToggleChecklistItemCommandHandler
+	removeChecklistItemHandler *cmd.RemoveChecklistItemCommandHandler
+	reorderChecklistHandler    *cmd.ReorderChecklistCommandHandler
+
+	assignTaskHandler   *cmd.AssignTaskCommandHandler
+	unassignTaskHandler *cmd.UnassignTaskCommandHandler
+
+	addTaskLabelHandler    *cmd.AddTaskLabelCommandHandler
+	removeTaskLabelHandler *cmd.RemoveTaskLabelCommandHandler
+
+	taskRepo      repository.TaskRepository
+	containerRepo containerRepo.ContainerRepository
+	groupRepo     usergroupRepo.UserGroupRepository
+	userRepo      userRepo.UserRepository
+	labelRepo     labelRepo.LabelRepository
+	outboxRepo    outboxRepo.OutboxRepository
+	policy        *authorization.Policy
+	uow           dbs.UnitOfWork
+	recorder      *auditApp.Recorder
 }
 
 // NewCommandBus creates a new command bus with all handlers registered
-func NewCommandBus(taskRepo repository.TaskRepository) *CommandBus {
+func NewCommandBus(
+	taskRepo repository.TaskRepository,
+	containerRepo containerRepo.ContainerRepository,
+	groupRepo usergroupRepo.UserGroupRepository,
+	userRepo userRepo.UserRepository,
+	labelRepo labelRepo.LabelRepository,
+	policy *authorization.Policy,
+	uow dbs.UnitOfWork,
+	recorder *auditApp.Recorder,
+	outboxRepo outboxRepo.OutboxRepository,
+) *CommandBus {
 	return &CommandBus{
-		createTaskHandler:       cmd.NewCreateTaskCommandHandler(taskRepo),
-		updateTaskHandler:       cmd.NewUpdateTaskCommandHandler(taskRepo),
-		deleteTaskHandler:       cmd.NewDeleteTaskCommandHandler(taskRepo),
-		toggleCompletionHandler: cmd.NewToggleCompletionCommandHandler(taskRepo),
-		toggleImportantHandler:  cmd.NewToggleImportantCommandHandler(taskRepo),
+		createTaskHandler:       cmd.NewCreateTaskCommandHandler(taskRepo, outboxRepo, uow),
+		updateTaskHandler:       cmd.NewUpdateTaskCommandHandler(taskRepo, outboxRepo, uow),
+		patchTaskHandler:        cmd.NewPatchTaskCommandHandler(taskRepo, outboxRepo, uow),
+		deleteTaskHandler:       cmd.NewDeleteTaskCommandHandler(taskRepo, outboxRepo, uow),
+		toggleCompletionHandler: cmd.NewToggleCompletionCommandHandler(taskRepo, outboxRepo, uow),
+		toggleImportantHandler:  cmd.NewToggleImportantCommandHandler(taskRepo, outboxRepo, uow),
+		restoreTaskHandler:      cmd.NewRestoreTaskCommandHandler(taskRepo, containerRepo, outboxRepo, uow),
+		moveTaskHandler:         cmd.NewMoveTaskCommandHandler(taskRepo, containerRepo, groupRepo, userRepo, labelRepo, outboxRepo, uow),
+		copyTaskHandler:         cmd.NewCopyTaskCommandHandler(taskRepo, containerRepo, groupRepo, userRepo, labelRepo, outboxRepo, uow),
+
+		addChecklistItemHandler:    cmd.NewAddChecklistItemCommandHandler(taskRepo, outboxRepo, uow),
+		toggleChecklistItemHandler: cmd.NewToggleChecklistItemCommandHandler(taskRepo, outboxRepo, uow),
+		removeChecklistItemHandler: cmd.NewRemoveChecklistItemCommandHandler(taskRepo, outboxRepo, uow),
+		reorderChecklistHandler:    cmd.NewReorderChecklistCommandHandler(taskRepo, outboxRepo, uow),
+
+		assignTaskHandler:   cmd.NewAssignTaskCommandHandler(taskRepo, groupRepo, userRepo, outboxRepo, uow),
+		unassignTaskHandler: cmd.NewUnassignTaskCommandHandler(taskRepo, outboxRepo, uow),
+
+		addTaskLabelHandler:    cmd.NewAddTaskLabelCommandHandler(taskRepo, labelRepo, outboxRepo, uow),
+		removeTaskLabelHandler: cmd.NewRemoveTaskLabelCommandHandler(taskRepo, outboxRepo, uow),
+
+		taskRepo:      taskRepo,
+		containerRepo: containerRepo,
+		groupRepo:     groupRepo,
+		userRepo:      userRepo,
+		labelRepo:     labelRepo,
+		outboxRepo:    outboxRepo,
+		policy:        policy,
+		uow:           uow,
+		recorder:      recorder,
+	}
+}
+
+// withTx returns a bus whose handlers, authorization checks and activity log all run inside the given transaction.
+// The handlers' own units of work become savepoints of it.
+func (bus *CommandBus) withTx(tx dbs.DBTX) *CommandBus {
+	return NewCommandBus(
+		bus.taskRepo.WithTx(tx),
+		bus.containerRepo.WithTx(tx),
+		bus.groupRepo.WithTx(tx),
+		bus.userRepo.WithTx(tx),
+		bus.labelRepo.WithTx(tx),
+		bus.policy,
+		dbs.NewUnitOfWork(tx),
+		bus.recorder.WithTx(tx),
+		bus.outboxRepo.WithTx(tx),
+	)
+}
+
+// Execute authorizes the command, then dispatches it to the appropriate handler and records it in the activity log
+// in one transaction. ctx carries the request id of the HTTP request that issued the command.
+func (bus *CommandBus) Execute(ctx context.Context, command interface{}) (interface{}, error) {
+	if err := bus.authorize(command); err != nil {
+		return nil, err
 	}
+
+	var result interface{}
+	err := bus.uow.Do(func(tx dbs.DBTX) error {
+		txBus := bus.withTx(tx)
+		var err error
+		result, err = txBus.recorder.Track(ctx, txBus.auditSubject(command), func() (interface{}, error) {
+			return txBus.dispatch(command)
+		})
+		return err
+	})
+	return result, err
 }
 
-// Execute dispatches the command to the appropriate handler
-func (bus *CommandBus) Execute(command interface{}) (interface{}, error) {
+// dispatch routes the command to its handler
+func (bus *CommandBus) dispatch(command interface{}) (interface{}, error) {
 	switch c := command.(type) {
 	case cmd.CreateTaskCommand:
 		return bus.createTaskHandler.Handle(c)
 	case cmd.UpdateTaskCommand:
 		return nil, bus.updateTaskHandler.Handle(c)
+	case cmd.PatchTaskCommand:
+		return bus.patchTaskHandler.Handle(c)
 	case cmd.DeleteTaskCommand:
 		return nil, bus.deleteTaskHandler.Handle(c)
 	case cmd.ToggleCompletionCommand:
 		return nil, bus.toggleCompletionHandler.Handle(c)
 	case cmd.ToggleImportantCommand:
 		return nil, bus.toggleImportantHandler.Handle(c)
+	case cmd.RestoreTaskCommand:
+		return nil, bus.restoreTaskHandler.Handle(c)
+	case cmd.MoveTaskCommand:
+		return nil, bus.moveTaskHandler.Handle(c)
+	case cmd.CopyTaskCommand:
+		return bus.copyTaskHandler.Handle(c)
+	case cmd.AddChecklistItemCommand:
+		return bus.addChecklistItemHandler.Handle(c)
+	case cmd.ToggleChecklistItemCommand:
+		return nil, bus.toggleChecklistItemHandler.Handle(c)
+	case cmd.RemoveChecklistItemCommand:
+		return nil, bus.removeChecklistItemHandler.Handle(c)
+	case cmd.ReorderChecklistCommand:
+		return nil, bus.reorderChecklistHandler.Handle(c)
+	case cmd.AssignTaskCommand:
+		return nil, bus.assignTaskHandler.Handle(c)
+	case cmd.UnassignTaskCommand:
+		return nil, bus.unassignTaskHandler.Handle(c)
+	case cmd.AddTaskLabelCommand:
+		return nil, bus.addTaskLabelHandler.Handle(c)
+	case cmd.RemoveTaskLabelCommand:
+		return nil, bus.removeTaskLabelHandler.Handle(c)
 	default:
 		return nil, fmt.Errorf("unknown command type: %T", command)
 	}
 }
+
+// authorize checks that the requester is a member of the group owning the task
+func (bus *CommandBus) authorize(command interface{}) error {
+	switch c := command.(type) {
+	case cmd.CreateTaskCommand:
+		return requireContainerMember(bus.policy, bus.containerRepo, c.RequesterId, c.ContainerId)
+	case cmd.UpdateTaskCommand:
+		return requireTaskMember(bus.policy, bus.taskRepo, c.RequesterId, c.TaskId)
+	case cmd.PatchTaskCommand:
+		return requireTaskMember(bus.policy, bus.taskRepo, c.RequesterId, c.TaskId)
+	case cmd.DeleteTaskCommand:
+		return requireTaskMember(bus.policy, bus.taskRepo, c.RequesterId, c.TaskId)
+	case cmd.ToggleCompletionCommand:
+		return requireTaskMember(bus.policy, bus.taskRepo, c.RequesterId, c.TaskId)
+	case cmd.ToggleImportantCommand:
+		return requireTaskMember(bus.policy, bus.taskRepo, c.RequesterId, c.TaskId)
+	case cmd.RestoreTaskCommand:
+		return requireTaskMember(bus.policy, bus.taskRepo, c.RequesterId, c.TaskId)
+	case cmd.MoveTaskCommand:
+		return requireTaskAndTargetMember(bus.policy, bus.taskRepo, bus.containerRepo, c.RequesterId, c.TaskId, c.TargetContainerId)
+	case cmd.CopyTaskCommand:
+		return requireTaskAndTargetMember(bus.policy, bus.taskRepo, bus.containerRepo, c.RequesterId, c.TaskId, c.TargetContainerId)
+	case cmd.AddChecklistItemCommand:
+		return requireTaskMember(bus.policy, bus.taskRepo, c.RequesterId, c.TaskId)
+	case cmd.ToggleChecklistItemCommand:
+		return requireTaskMember(bus.policy, bus.taskRepo, c.RequesterId, c.TaskId)
+	case cmd.RemoveChecklistItemCommand:
+		return requireTaskMember(bus.policy, bus.taskRepo, c.RequesterId, c.TaskId)
+	case cmd.ReorderChecklistCommand:
+		return requireTaskMember(bus.policy, bus.taskRepo, c.RequesterId, c.TaskId)
+	case cmd.AssignTaskCommand:
+		return requireTaskMember(bus.policy, bus.taskRepo, c.RequesterId, c.TaskId)
+	case cmd.UnassignTaskCommand:
+		return requireTaskMember(bus.policy, bus.taskRepo, c.RequesterId, c.TaskId)
+	case cmd.AddTaskLabelCommand:
+		return requireTaskMember(bus.policy, bus.taskRepo, c.RequesterId, c.TaskId)
+	case cmd.RemoveTaskLabelCommand:
+		return requireTaskMember(bus.policy, bus.taskRepo, c.RequesterId, c.TaskId)
+	default:
+		return nil
+	}
+}
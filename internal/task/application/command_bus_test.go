@@ -0,0 +1,106 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	auditApp "github.com/happYness-Project/taskManagementGolang/internal/audit/application"
+	auditDomain "github.com/happYness-Project/taskManagementGolang/internal/audit/domain"
+	auditRepo "github.com/happYness-Project/taskManagementGolang/internal/audit/repository"
+	"github.com/happYness-Project/taskManagementGolang/internal/authorization"
+	"github.com/happYness-Project/taskManagementGolang/internal/mocks"
+	cmd "github.com/happYness-Project/taskManagementGolang/internal/task/application/command"
+	"github.com/happYness-Project/taskManagementGolang/internal/task/domain"
+	"github.com/happYness-Project/taskManagementGolang/pkg/configs"
+	"github.com/happYness-Project/taskManagementGolang/pkg/dbs"
+	"github.com/happYness-Project/taskManagementGolang/pkg/loggers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubTx stands for the transaction opened by stubUnitOfWork
+type stubTx struct {
+	dbs.DBTX
+}
+
+// stubUnitOfWork hands its transaction to the work and keeps the error the work returned, which would roll it back
+type stubUnitOfWork struct {
+	tx  *stubTx
+	err error
+}
+
+func (u *stubUnitOfWork) Do(fn func(tx dbs.DBTX) error) error {
+	u.err = fn(u.tx)
+	return u.err
+}
+
+// stubTxActivityRepo records the transaction each activity was appended in
+type stubTxActivityRepo struct {
+	auditRepo.ActivityRepository
+	tx        dbs.DBTX
+	appended  *[]dbs.DBTX
+	appendErr error
+}
+
+func (s *stubTxActivityRepo) Append(activity auditDomain.Activity) (int64, error) {
+	if s.appendErr != nil {
+		return 0, s.appendErr
+	}
+	*s.appended = append(*s.appended, s.tx)
+	return int64(len(*s.appended)), nil
+}
+
+func (s *stubTxActivityRepo) WithTx(tx dbs.DBTX) auditRepo.ActivityRepository {
+	return &stubTxActivityRepo{tx: tx, appended: s.appended, appendErr: s.appendErr}
+}
+
+func TestCommandBus_Execute(t *testing.T) {
+	logger := loggers.Setup(configs.Env{})
+	newFixture := func(t *testing.T, activityRepo *stubTxActivityRepo) (*CommandBus, *stubUnitOfWork, *stubBatchTaskRepo) {
+		t.Helper()
+		task, err := domain.CreateTask("Groceries", "", time.Now(), "")
+		require.NoError(t, err)
+		task.TaskId, task.Version = "task-1", 1
+		taskRepo := &stubBatchTaskRepo{tasks: map[string]*domain.Task{"task-1": task}, containers: map[string]string{"task-1": batchContainerId}}
+
+		userRepo := new(mocks.MockUserRepo)
+		userRepo.On("GetUserRoleInGroup", batchRequesterId, batchGroupId).Return("member", nil)
+		uow := &stubUnitOfWork{tx: &stubTx{}}
+		bus := NewCommandBus(taskRepo, new(mocks.MockContainerRepo), new(mocks.MockUserGroupRepo), userRepo, new(mocks.MockLabelRepo),
+			authorization.NewPolicy(userRepo), uow, auditApp.NewRecorder(activityRepo, logger), &mocks.MockOutboxRepo{})
+		return bus, uow, taskRepo
+	}
+	complete := cmd.ToggleCompletionCommand{TaskId: "task-1", IsCompleted: true, RequesterId: batchRequesterId}
+
+	t.Run("when command succeeds, Then its activity is appended in the command's transaction", func(t *testing.T) {
+		// Arrange
+		appended := []dbs.DBTX{}
+		bus, uow, taskRepo := newFixture(t, &stubTxActivityRepo{appended: &appended})
+
+		// Act
+		_, err := bus.Execute(context.Background(), complete)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, []string{"task-1"}, taskRepo.completed)
+		require.Len(t, appended, 1)
+		assert.Same(t, uow.tx, appended[0])
+	})
+
+	t.Run("when activity cannot be appended, Then command fails and its transaction rolls back", func(t *testing.T) {
+		// Arrange
+		appended := []dbs.DBTX{}
+		appendErr := errors.New("activity log unavailable")
+		bus, uow, _ := newFixture(t, &stubTxActivityRepo{appended: &appended, appendErr: appendErr})
+
+		// Act
+		_, err := bus.Execute(context.Background(), complete)
+
+		// Assert
+		assert.ErrorIs(t, err, appendErr)
+		assert.ErrorIs(t, uow.err, appendErr)
+		assert.Empty(t, appended)
+	})
+}
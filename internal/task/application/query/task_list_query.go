@@ -0,0 +1,188 @@
+package query
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/happYness-Project/taskManagementGolang/internal/task/domain"
+	"github.com/happYness-Project/taskManagementGolang/internal/task/repository"
+)
+
+const (
+	DefaultTaskPageLimit = 50
+	MaxTaskPageLimit     = 200
+)
+
+var ErrInvalidListParams = errors.New("invalid task list parameters")
+
+// TaskListParams is the paging, sorting and filtering shared by every task listing.
+// Sort is a field name optionally prefixed with '-' for descending order, e.g. "-priority".
+// Without Cursor and Limit the listing is not paged; a Cursor alone pages by DefaultTaskPageLimit.
+type TaskListParams struct {
+	Cursor string
+	Limit  int
+	Sort   string
+
+	IsCompleted *bool
+	IsImportant *bool
+	Priority    string
+	LabelIds    []string // tasks carrying every one of the labels
+	TargetFrom  *time.Time
+	TargetTo    *time.Time
+}
+
+// TaskPage is one page of a task listing. NextCursor is empty on the last page.
+type TaskPage struct {
+	Tasks      []domain.Task `json:"tasks"`
+	NextCursor string        `json:"next_cursor,omitempty"`
+}
+
+// taskCursor is the decoded form of the opaque cursor handed to clients
+type taskCursor struct {
+	SortBy     string `json:"s"`
+	Descending bool   `json:"d,omitempty"`
+	SortValue  string `json:"v"`
+	TaskId     string `json:"id"`
+}
+
+func invalidParams(format string, args ...interface{}) error {
+	return fmt.Errorf("%w: %s", ErrInvalidListParams, fmt.Sprintf(format, args...))
+}
+
+// toCriteria validates the params and converts them into repository criteria.
+// The scope (user, container or group) is set by the caller.
+func (p TaskListParams) toCriteria() (repository.TaskListCriteria, error) {
+	criteria := repository.TaskListCriteria{
+		SortBy: repository.SortByTargetDate,
+		Filter: repository.TaskFilter{
+			IsCompleted: p.IsCompleted,
+			IsImportant: p.IsImportant,
+			TargetFrom:  p.TargetFrom,
+			TargetTo:    p.TargetTo,
+		},
+	}
+
+	if p.Sort != "" {
+		field := strings.TrimPrefix(p.Sort, "-")
+		criteria.Descending = strings.HasPrefix(p.Sort, "-")
+		criteria.SortBy = repository.TaskSortField(field)
+		if !criteria.SortBy.IsValid() {
+			return criteria, invalidParams("unsupported sort field '%s'", field)
+		}
+	}
+
+	if p.Limit < 0 || p.Limit > MaxTaskPageLimit {
+		return criteria, invalidParams("limit must be between 1 and %d", MaxTaskPageLimit)
+	}
+	if p.Limit > 0 {
+		criteria.Limit = p.Limit
+	} else if p.Cursor != "" {
+		criteria.Limit = DefaultTaskPageLimit
+	}
+
+	if strings.TrimSpace(p.Priority) != "" {
+		priority, err := domain.NewPriority(p.Priority)
+		if err != nil {
+			return criteria, invalidParams("unsupported priority '%s'", p.Priority)
+		}
+		criteria.Filter.Priority = priority.String()
+	}
+
+	for _, labelId := range p.LabelIds {
+		if _, err := uuid.Parse(labelId); err != nil {
+			return criteria, invalidParams("label '%s' is not a valid label id", labelId)
+		}
+		if !slices.Contains(criteria.Filter.LabelIds, labelId) {
+			criteria.Filter.LabelIds = append(criteria.Filter.LabelIds, labelId)
+		}
+	}
+
+	if p.TargetFrom != nil && p.TargetTo != nil && p.TargetTo.Before(*p.TargetFrom) {
+		return criteria, invalidParams("target date range end is before its start")
+	}
+
+	if p.Cursor != "" {
+		cursor, err := decodeTaskCursor(p.Cursor)
+		if err != nil {
+			return criteria, err
+		}
+		if cursor.SortBy != string(criteria.SortBy) || cursor.Descending != criteria.Descending {
+			return criteria, invalidParams("cursor does not match the requested sort")
+		}
+		if !validCursorValue(criteria.SortBy, cursor.SortValue) {
+			return criteria, invalidParams("malformed cursor")
+		}
+		criteria.After = &repository.TaskKey{SortValue: cursor.SortValue, TaskId: cursor.TaskId}
+	}
+	return criteria, nil
+}
+
+// listTasks fetches one page using a look-ahead row to decide whether another page exists.
+// Without a limit every task is on the page.
+func listTasks(repo repository.TaskRepository, criteria repository.TaskListCriteria) (*TaskPage, error) {
+	limit := criteria.Limit
+	if limit > 0 {
+		criteria.Limit = limit + 1
+	}
+
+	tasks, err := repo.ListTasks(criteria)
+	if err != nil {
+		return nil, err
+	}
+	if tasks == nil {
+		tasks = []domain.Task{}
+	}
+
+	page := &TaskPage{Tasks: tasks}
+	if limit > 0 && len(tasks) > limit {
+		page.Tasks = tasks[:limit]
+		key := repository.KeyOf(page.Tasks[limit-1], criteria.SortBy)
+		page.NextCursor = encodeTaskCursor(taskCursor{
+			SortBy:     string(criteria.SortBy),
+			Descending: criteria.Descending,
+			SortValue:  key.SortValue,
+			TaskId:     key.TaskId,
+		})
+	}
+	return page, nil
+}
+
+func encodeTaskCursor(c taskCursor) string {
+	raw, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+func decodeTaskCursor(s string) (taskCursor, error) {
+	var c taskCursor
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return c, invalidParams("malformed cursor")
+	}
+	if err := json.Unmarshal(raw, &c); err != nil {
+		return c, invalidParams("malformed cursor")
+	}
+	if _, err := uuid.Parse(c.TaskId); err != nil {
+		return c, invalidParams("malformed cursor")
+	}
+	return c, nil
+}
+
+// validCursorValue guards the SQL casts applied to the cursor's sort value
+func validCursorValue(sortBy repository.TaskSortField, value string) bool {
+	if sortBy == repository.SortByPriority {
+		_, err := strconv.Atoi(value)
+		return err == nil
+	}
+	if value == "infinity" || value == "-infinity" {
+		return true
+	}
+	_, err := time.Parse(time.RFC3339Nano, value)
+	return err == nil
+}
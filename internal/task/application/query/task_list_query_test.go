@@ -0,0 +1,153 @@
+package query
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/happYness-Project/taskManagementGolang/internal/task/domain"
+	"github.com/happYness-Project/taskManagementGolang/internal/task/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubTaskRepo serves ListTasks from memory; other methods are not used by these tests
+type stubTaskRepo struct {
+	repository.TaskRepository
+	tasks    []domain.Task
+	criteria repository.TaskListCriteria
+}
+
+func (s *stubTaskRepo) ListTasks(criteria repository.TaskListCriteria) ([]domain.Task, error) {
+	s.criteria = criteria
+	if criteria.Limit > 0 && len(s.tasks) > criteria.Limit {
+		return s.tasks[:criteria.Limit], nil
+	}
+	return s.tasks, nil
+}
+
+func TestTaskListParams(t *testing.T) {
+	t.Run("when params are empty, Then default sort is applied and the listing is not paged", func(t *testing.T) {
+		criteria, err := TaskListParams{}.toCriteria()
+
+		require.NoError(t, err)
+		assert.Equal(t, repository.SortByTargetDate, criteria.SortBy)
+		assert.False(t, criteria.Descending)
+		assert.Zero(t, criteria.Limit)
+	})
+
+	t.Run("when only a cursor is given, Then default limit is applied", func(t *testing.T) {
+		cursor := encodeTaskCursor(taskCursor{SortBy: "target_date", SortValue: "infinity", TaskId: uuid.New().String()})
+
+		criteria, err := TaskListParams{Cursor: cursor}.toCriteria()
+
+		require.NoError(t, err)
+		assert.Equal(t, DefaultTaskPageLimit, criteria.Limit)
+	})
+
+	t.Run("when sort field is unsupported, Then return invalid params error", func(t *testing.T) {
+		_, err := TaskListParams{Sort: "name"}.toCriteria()
+
+		assert.ErrorIs(t, err, ErrInvalidListParams)
+	})
+
+	t.Run("when priority is given, Then it is normalized, and an unknown one is rejected", func(t *testing.T) {
+		criteria, err := TaskListParams{Priority: " HIGH"}.toCriteria()
+		_, invalidErr := TaskListParams{Priority: "normal"}.toCriteria()
+
+		require.NoError(t, err)
+		assert.Equal(t, "high", criteria.Filter.Priority)
+		assert.ErrorIs(t, invalidErr, ErrInvalidListParams)
+	})
+
+	t.Run("when limit exceeds maximum, Then return invalid params error", func(t *testing.T) {
+		_, err := TaskListParams{Limit: MaxTaskPageLimit + 1}.toCriteria()
+
+		assert.ErrorIs(t, err, ErrInvalidListParams)
+	})
+
+	t.Run("when cursor is malformed, Then return invalid params error", func(t *testing.T) {
+		_, err := TaskListParams{Cursor: "not-a-cursor"}.toCriteria()
+
+		assert.ErrorIs(t, err, ErrInvalidListParams)
+	})
+
+	t.Run("when cursor was issued for another sort, Then return invalid params error", func(t *testing.T) {
+		cursor := encodeTaskCursor(taskCursor{SortBy: "priority", SortValue: "2", TaskId: uuid.New().String()})
+
+		_, err := TaskListParams{Cursor: cursor, Sort: "-created_at"}.toCriteria()
+
+		assert.ErrorIs(t, err, ErrInvalidListParams)
+	})
+}
+
+func TestListTasks(t *testing.T) {
+	tasks := []domain.Task{
+		{TaskId: uuid.New().String(), Priority: "low"},
+		{TaskId: uuid.New().String(), Priority: "medium"},
+		{TaskId: uuid.New().String(), Priority: "high"},
+	}
+	repo := &stubTaskRepo{tasks: tasks}
+	handler := NewTaskQueryHandler(repo)
+
+	t.Run("when more tasks exist than the limit, Then return a cursor for the next page", func(t *testing.T) {
+		page, err := handler.HandleGetAllTasksByGroupId(GetAllTasksByGroupIdQuery{GroupId: 1, List: TaskListParams{Limit: 2, Sort: "priority"}})
+
+		require.NoError(t, err)
+		assert.Len(t, page.Tasks, 2)
+		assert.Equal(t, 3, repo.criteria.Limit)
+		require.NotEmpty(t, page.NextCursor)
+
+		criteria, err := TaskListParams{Cursor: page.NextCursor, Sort: "priority"}.toCriteria()
+		require.NoError(t, err)
+		assert.Equal(t, &repository.TaskKey{SortValue: "2", TaskId: tasks[1].TaskId}, criteria.After)
+	})
+
+	t.Run("when all tasks fit on the page, Then no cursor is returned", func(t *testing.T) {
+		page, err := handler.HandleGetTasksByContainerId(GetTasksByContainerIdQuery{ContainerId: "container-id", List: TaskListParams{Limit: 5}})
+
+		require.NoError(t, err)
+		assert.Len(t, page.Tasks, 3)
+		assert.Empty(t, page.NextCursor)
+		assert.Equal(t, "container-id", repo.criteria.ContainerId)
+	})
+
+	t.Run("when neither cursor nor limit is given, Then every task is returned without a cursor", func(t *testing.T) {
+		many := make([]domain.Task, DefaultTaskPageLimit+1)
+		for i := range many {
+			many[i] = domain.Task{TaskId: uuid.New().String()}
+		}
+		repo := &stubTaskRepo{tasks: many}
+
+		page, err := NewTaskQueryHandler(repo).HandleGetAllTasksByGroupId(GetAllTasksByGroupIdQuery{GroupId: 1})
+
+		require.NoError(t, err)
+		assert.Len(t, page.Tasks, DefaultTaskPageLimit+1)
+		assert.Empty(t, page.NextCursor)
+		assert.Zero(t, repo.criteria.Limit)
+	})
+}
+
+func TestHandleGetTasksByAssignee(t *testing.T) {
+	t.Run("when assignee is given, Then list is scoped to the requester's groups and filtered by assignee", func(t *testing.T) {
+		// Arrange
+		repo := &stubTaskRepo{}
+		handler := NewTaskQueryHandler(repo)
+		assigneeId := uuid.New().String()
+
+		// Act
+		_, err := handler.HandleGetTasksByAssignee(GetTasksByAssigneeQuery{AssigneeId: assigneeId, RequesterId: "requester-id"})
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, "requester-id", repo.criteria.UserId)
+		assert.Equal(t, assigneeId, repo.criteria.Filter.AssigneeId)
+	})
+
+	t.Run("when assignee is not a UUID, Then return invalid params error", func(t *testing.T) {
+		handler := NewTaskQueryHandler(&stubTaskRepo{})
+
+		_, err := handler.HandleGetTasksByAssignee(GetTasksByAssigneeQuery{AssigneeId: "abc", RequesterId: "requester-id"})
+
+		assert.ErrorIs(t, err, ErrInvalidListParams)
+	})
+}
@@ -0,0 +1,124 @@
+package query
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/happYness-Project/taskManagementGolang/internal/task/domain"
+)
+
+const (
+	DefaultOccurrenceWindow = 30 * 24 * time.Hour
+	MaxOccurrenceWindow     = 366 * 24 * time.Hour
+	MaxOccurrences          = 500
+)
+
+var ErrInvalidOccurrenceWindow = errors.New("invalid occurrence window")
+
+// GetTaskOccurrencesQuery lists the occurrences of one task within [From, To]
+type GetTaskOccurrencesQuery struct {
+	TaskId      string
+	From        time.Time
+	To          time.Time
+	RequesterId string // UUID from JWT
+}
+
+// GetContainerOccurrencesQuery lists the occurrences of every open task in a container within [From, To]
+type GetContainerOccurrencesQuery struct {
+	ContainerId string
+	From        time.Time
+	To          time.Time
+	RequesterId string // UUID from JWT
+}
+
+// TaskOccurrence is a computed, not persisted, occurrence of a task
+type TaskOccurrence struct {
+	TaskId      string    `json:"task_id"`
+	TaskName    string    `json:"name"`
+	Date        time.Time `json:"date"`
+	IsRecurring bool      `json:"is_recurring"`
+}
+
+// occurrenceWindow applies the default window and validates its bounds
+func occurrenceWindow(from, to time.Time) (time.Time, time.Time, error) {
+	if from.IsZero() {
+		from = time.Now().UTC()
+	}
+	if to.IsZero() {
+		to = from.Add(DefaultOccurrenceWindow)
+	}
+	if to.Before(from) {
+		return from, to, fmt.Errorf("%w: end is before start", ErrInvalidOccurrenceWindow)
+	}
+	if to.Sub(from) > MaxOccurrenceWindow {
+		return from, to, fmt.Errorf("%w: window cannot exceed %d days", ErrInvalidOccurrenceWindow, int(MaxOccurrenceWindow.Hours()/24))
+	}
+	return from, to, nil
+}
+
+func taskOccurrences(task domain.Task, from, to time.Time, limit int) ([]TaskOccurrence, error) {
+	dates, err := task.Occurrences(from, to, limit)
+	if err != nil {
+		return nil, err
+	}
+	occurrences := make([]TaskOccurrence, 0, len(dates))
+	for _, date := range dates {
+		occurrences = append(occurrences, TaskOccurrence{
+			TaskId:      task.TaskId,
+			TaskName:    task.TaskName,
+			Date:        date,
+			IsRecurring: task.IsRecurring(),
+		})
+	}
+	return occurrences, nil
+}
+
+// HandleGetTaskOccurrences computes the occurrences of a single task
+func (h *TaskQueryHandler) HandleGetTaskOccurrences(query GetTaskOccurrencesQuery) ([]TaskOccurrence, error) {
+	from, to, err := occurrenceWindow(query.From, query.To)
+	if err != nil {
+		return nil, err
+	}
+	task, err := h.taskRepo.GetTaskById(query.TaskId)
+	if err != nil || task == nil {
+		return nil, fmt.Errorf("task not found: %w", err)
+	}
+
+	occurrences, err := taskOccurrences(*task, from, to, MaxOccurrences)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute occurrences: %w", err)
+	}
+	return occurrences, nil
+}
+
+// HandleGetContainerOccurrences computes the occurrences of the open tasks in a container, ordered by date
+func (h *TaskQueryHandler) HandleGetContainerOccurrences(query GetContainerOccurrencesQuery) ([]TaskOccurrence, error) {
+	from, to, err := occurrenceWindow(query.From, query.To)
+	if err != nil {
+		return nil, err
+	}
+	tasks, err := h.taskRepo.GetTasksByContainerId(query.ContainerId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve tasks by container: %w", err)
+	}
+
+	occurrences := []TaskOccurrence{}
+	for _, task := range tasks {
+		if task.IsCompleted {
+			continue
+		}
+		taskOccurrences, err := taskOccurrences(task, from, to, MaxOccurrences)
+		if err != nil {
+			return nil, fmt.Errorf("failed to compute occurrences of task %s: %w", task.TaskId, err)
+		}
+		occurrences = append(occurrences, taskOccurrences...)
+	}
+
+	sort.SliceStable(occurrences, func(i, j int) bool { return occurrences[i].Date.Before(occurrences[j].Date) })
+	if len(occurrences) > MaxOccurrences {
+		occurrences = occurrences[:MaxOccurrences]
+	}
+	return occurrences, nil
+}
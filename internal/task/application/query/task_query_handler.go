@@ -3,24 +3,39 @@ package query
 import (
 	"fmt"
 
+	"github.com/google/uuid"
 	"github.com/happYness-Project/taskManagementGolang/internal/task/domain"
 	"github.com/happYness-Project/taskManagementGolang/internal/task/repository"
 )
 
 // Queries define the read operations
-type GetAllTasksQuery struct{}
+type GetAllTasksQuery struct {
+	List        TaskListParams
+	RequesterId string // UUID from JWT
+}
 
 type GetTaskByIdQuery struct {
-	TaskId string
+	TaskId      string
+	RequesterId string // UUID from JWT
 }
 
 type GetTasksByContainerIdQuery struct {
 	ContainerId string
+	List        TaskListParams
+	RequesterId string // UUID from JWT
 }
 
 type GetAllTasksByGroupIdQuery struct {
-	GroupId       int
-	OnlyImportant bool
+	GroupId     int
+	List        TaskListParams
+	RequesterId string // UUID from JWT
+}
+
+// GetTasksByAssigneeQuery lists the tasks assigned to a user, limited to the groups the requester shares with them
+type GetTasksByAssigneeQuery struct {
+	AssigneeId  string
+	List        TaskListParams
+	RequesterId string // UUID from JWT
 }
 
 // TaskQueryHandler handles all read operations for Task
@@ -32,13 +47,19 @@ func NewTaskQueryHandler(taskRepo repository.TaskRepository) *TaskQueryHandler {
 	return &TaskQueryHandler{taskRepo: taskRepo}
 }
 
-// HandleGetAllTasks retrieves all tasks
-func (h *TaskQueryHandler) HandleGetAllTasks(query GetAllTasksQuery) ([]domain.Task, error) {
-	tasks, err := h.taskRepo.GetAllTasks()
+// HandleGetAllTasks retrieves a page of tasks in the groups the requester belongs to
+func (h *TaskQueryHandler) HandleGetAllTasks(query GetAllTasksQuery) (*TaskPage, error) {
+	criteria, err := query.List.toCriteria()
+	if err != nil {
+		return nil, err
+	}
+	criteria.UserId = query.RequesterId
+
+	page, err := listTasks(h.taskRepo, criteria)
 	if err != nil {
 		return nil, fmt.Errorf("failed to retrieve tasks: %w", err)
 	}
-	return tasks, nil
+	return page, nil
 }
 
 // HandleGetTaskById retrieves a single task by ID
@@ -50,28 +71,52 @@ func (h *TaskQueryHandler) HandleGetTaskById(query GetTaskByIdQuery) (*domain.Ta
 	return task, nil
 }
 
-// HandleGetTasksByContainerId retrieves tasks for a specific container
-func (h *TaskQueryHandler) HandleGetTasksByContainerId(query GetTasksByContainerIdQuery) ([]domain.Task, error) {
-	tasks, err := h.taskRepo.GetTasksByContainerId(query.ContainerId)
+// HandleGetTasksByContainerId retrieves a page of tasks for a specific container
+func (h *TaskQueryHandler) HandleGetTasksByContainerId(query GetTasksByContainerIdQuery) (*TaskPage, error) {
+	criteria, err := query.List.toCriteria()
+	if err != nil {
+		return nil, err
+	}
+	criteria.ContainerId = query.ContainerId
+
+	page, err := listTasks(h.taskRepo, criteria)
 	if err != nil {
 		return nil, fmt.Errorf("failed to retrieve tasks by container: %w", err)
 	}
-	return tasks, nil
+	return page, nil
 }
 
-// HandleGetAllTasksByGroupId retrieves tasks for a specific group
-func (h *TaskQueryHandler) HandleGetAllTasksByGroupId(query GetAllTasksByGroupIdQuery) ([]domain.Task, error) {
-	var tasks []domain.Task
-	var err error
-
-	if query.OnlyImportant {
-		tasks, err = h.taskRepo.GetAllTasksByGroupIdOnlyImportant(query.GroupId)
-	} else {
-		tasks, err = h.taskRepo.GetAllTasksByGroupId(query.GroupId)
+// HandleGetAllTasksByGroupId retrieves a page of tasks for a specific group
+func (h *TaskQueryHandler) HandleGetAllTasksByGroupId(query GetAllTasksByGroupIdQuery) (*TaskPage, error) {
+	criteria, err := query.List.toCriteria()
+	if err != nil {
+		return nil, err
 	}
+	criteria.GroupId = query.GroupId
 
+	page, err := listTasks(h.taskRepo, criteria)
 	if err != nil {
 		return nil, fmt.Errorf("failed to retrieve tasks by group: %w", err)
 	}
-	return tasks, nil
+	return page, nil
+}
+
+// HandleGetTasksByAssignee retrieves a page of tasks assigned to a user across the requester's groups.
+// When users look up their own tasks this covers all of their groups.
+func (h *TaskQueryHandler) HandleGetTasksByAssignee(query GetTasksByAssigneeQuery) (*TaskPage, error) {
+	criteria, err := query.List.toCriteria()
+	if err != nil {
+		return nil, err
+	}
+	if _, err := uuid.Parse(query.AssigneeId); err != nil {
+		return nil, invalidParams("user id must be a UUID")
+	}
+	criteria.UserId = query.RequesterId
+	criteria.Filter.AssigneeId = query.AssigneeId
+
+	page, err := listTasks(h.taskRepo, criteria)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve tasks by assignee: %w", err)
+	}
+	return page, nil
 }
@@ -3,24 +3,41 @@ package application
 import (
 	"fmt"
 
+	"github.com/happYness-Project/taskManagementGolang/internal/authorization"
 	qry "github.com/happYness-Project/taskManagementGolang/internal/task/application/query"
 	"github.com/happYness-Project/taskManagementGolang/internal/task/repository"
+	containerRepo "github.com/happYness-Project/taskManagementGolang/internal/taskcontainer/repository"
 )
 
 // QueryBus routes queries to their handlers
 type QueryBus struct {
 	queryHandler *qry.TaskQueryHandler
+
+	taskRepo      repository.TaskRepository
+	containerRepo containerRepo.ContainerRepository
+	policy        *authorization.Policy
 }
 
 // NewQueryBus creates a new query bus with all handlers registered
-func NewQueryBus(taskRepo repository.TaskRepository) *QueryBus {
+func NewQueryBus(
+	taskRepo repository.TaskRepository,
+	containerRepo containerRepo.ContainerRepository,
+	policy *authorization.Policy,
+) *QueryBus {
 	return &QueryBus{
-		queryHandler: qry.NewTaskQueryHandler(taskRepo),
+		queryHandler:  qry.NewTaskQueryHandler(taskRepo),
+		taskRepo:      taskRepo,
+		containerRepo: containerRepo,
+		policy:        policy,
 	}
 }
 
 // Execute dispatches the query to the appropriate handler
 func (bus *QueryBus) Execute(query interface{}) (interface{}, error) {
+	if err := bus.authorize(query); err != nil {
+		return nil, err
+	}
+
 	switch q := query.(type) {
 	case qry.GetAllTasksQuery:
 		return bus.queryHandler.HandleGetAllTasks(q)
@@ -30,7 +47,32 @@ func (bus *QueryBus) Execute(query interface{}) (interface{}, error) {
 		return bus.queryHandler.HandleGetTasksByContainerId(q)
 	case qry.GetAllTasksByGroupIdQuery:
 		return bus.queryHandler.HandleGetAllTasksByGroupId(q)
+	case qry.GetTasksByAssigneeQuery:
+		return bus.queryHandler.HandleGetTasksByAssignee(q)
+	case qry.GetTaskOccurrencesQuery:
+		return bus.queryHandler.HandleGetTaskOccurrences(q)
+	case qry.GetContainerOccurrencesQuery:
+		return bus.queryHandler.HandleGetContainerOccurrences(q)
 	default:
 		return nil, fmt.Errorf("unknown query type: %T", query)
 	}
 }
+
+// authorize checks that the requester is a member of the group being read.
+// GetAllTasksQuery and GetTasksByAssigneeQuery need no check because they are already scoped to the requester's groups.
+func (bus *QueryBus) authorize(query interface{}) error {
+	switch q := query.(type) {
+	case qry.GetTaskByIdQuery:
+		return requireTaskMember(bus.policy, bus.taskRepo, q.RequesterId, q.TaskId)
+	case qry.GetTasksByContainerIdQuery:
+		return requireContainerMember(bus.policy, bus.containerRepo, q.RequesterId, q.ContainerId)
+	case qry.GetAllTasksByGroupIdQuery:
+		return bus.policy.RequireMember(q.RequesterId, q.GroupId)
+	case qry.GetTaskOccurrencesQuery:
+		return requireTaskMember(bus.policy, bus.taskRepo, q.RequesterId, q.TaskId)
+	case qry.GetContainerOccurrencesQuery:
+		return requireContainerMember(bus.policy, bus.containerRepo, q.RequesterId, q.ContainerId)
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,160 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const MaxChecklistItems = 100
+
+var (
+	ErrInvalidChecklist      = errors.New("invalid checklist")
+	ErrChecklistItemNotFound = errors.New("checklist item not found")
+)
+
+// ChecklistItem is a checkable sub-item of a task, ordered by Position
+type ChecklistItem struct {
+	ItemId    string    `json:"id"`
+	TaskId    string    `json:"task_id"`
+	Title     string    `json:"title"`
+	IsChecked bool      `json:"is_checked"`
+	Position  int       `json:"position"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// AddChecklistItem appends an unchecked item to the end of the checklist
+func (t *Task) AddChecklistItem(title string) (*ChecklistItem, error) {
+	title = strings.TrimSpace(title)
+	if title == "" {
+		return nil, fmt.Errorf("%w: item title cannot be empty", ErrInvalidChecklist)
+	}
+	if len(title) > 255 {
+		return nil, fmt.Errorf("%w: item title cannot exceed 255 characters", ErrInvalidChecklist)
+	}
+	if len(t.Checklist) >= MaxChecklistItems {
+		return nil, fmt.Errorf("%w: a task cannot have more than %d items", ErrInvalidChecklist, MaxChecklistItems)
+	}
+
+	now := time.Now().UTC()
+	item := ChecklistItem{
+		ItemId:    uuid.New().String(),
+		TaskId:    t.TaskId,
+		Title:     title,
+		IsChecked: false,
+		Position:  len(t.Checklist),
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	t.Checklist = append(t.Checklist, item)
+	t.rollUpCompletion()
+	return &item, nil
+}
+
+// ToggleChecklistItem checks or unchecks an item
+func (t *Task) ToggleChecklistItem(itemId string, isChecked bool) error {
+	i := t.checklistIndex(itemId)
+	if i < 0 {
+		return ErrChecklistItemNotFound
+	}
+	t.Checklist[i].IsChecked = isChecked
+	t.Checklist[i].UpdatedAt = time.Now().UTC()
+	t.rollUpCompletion()
+	return nil
+}
+
+// RemoveChecklistItem deletes an item and closes the gap in the ordering
+func (t *Task) RemoveChecklistItem(itemId string) error {
+	i := t.checklistIndex(itemId)
+	if i < 0 {
+		return ErrChecklistItemNotFound
+	}
+	t.Checklist = append(t.Checklist[:i], t.Checklist[i+1:]...)
+	t.renumberChecklist()
+	t.rollUpCompletion()
+	return nil
+}
+
+// ReorderChecklist orders the checklist as given. itemIds must list every item exactly once.
+func (t *Task) ReorderChecklist(itemIds []string) error {
+	if len(itemIds) != len(t.Checklist) {
+		return fmt.Errorf("%w: order must list all %d items", ErrInvalidChecklist, len(t.Checklist))
+	}
+
+	reordered := make([]ChecklistItem, 0, len(itemIds))
+	seen := make(map[string]bool, len(itemIds))
+	for _, id := range itemIds {
+		i := t.checklistIndex(id)
+		if i < 0 {
+			return fmt.Errorf("%w: %s", ErrChecklistItemNotFound, id)
+		}
+		if seen[id] {
+			return fmt.Errorf("%w: item %s is listed twice", ErrInvalidChecklist, id)
+		}
+		seen[id] = true
+		reordered = append(reordered, t.Checklist[i])
+	}
+	t.Checklist = reordered
+	t.renumberChecklist()
+	return nil
+}
+
+// rollUpCompletion keeps a task with a checklist completed exactly when all of its items are checked
+func (t *Task) rollUpCompletion() {
+	if len(t.Checklist) == 0 {
+		return
+	}
+	allChecked := true
+	for _, item := range t.Checklist {
+		if !item.IsChecked {
+			allChecked = false
+			break
+		}
+	}
+	if t.IsCompleted != allChecked {
+		t.ToggleCompletion(allChecked)
+	}
+}
+
+func (t *Task) renumberChecklist() {
+	now := time.Now().UTC()
+	for i := range t.Checklist {
+		if t.Checklist[i].Position != i {
+			t.Checklist[i].Position = i
+			t.Checklist[i].UpdatedAt = now
+		}
+	}
+}
+
+func (t *Task) checklistIndex(itemId string) int {
+	for i, item := range t.Checklist {
+		if item.ItemId == itemId {
+			return i
+		}
+	}
+	return -1
+}
+
+// copyChecklist returns unchecked copies of the checklist for a new task
+func (t *Task) copyChecklist(taskId string, now time.Time) []ChecklistItem {
+	if len(t.Checklist) == 0 {
+		return nil
+	}
+	items := make([]ChecklistItem, 0, len(t.Checklist))
+	for _, item := range t.Checklist {
+		items = append(items, ChecklistItem{
+			ItemId:    uuid.New().String(),
+			TaskId:    taskId,
+			Title:     item.Title,
+			IsChecked: false,
+			Position:  item.Position,
+			CreatedAt: now,
+			UpdatedAt: now,
+		})
+	}
+	return items
+}
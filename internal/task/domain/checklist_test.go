@@ -0,0 +1,190 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func newChecklistTask(t *testing.T, titles ...string) *Task {
+	t.Helper()
+	task, err := CreateTask("Apple pie ingredients", "", time.Now().Add(24*time.Hour), "medium")
+	if err != nil {
+		t.Fatalf("CreateTask() unexpected error: %v", err)
+	}
+	for _, title := range titles {
+		if _, err := task.AddChecklistItem(title); err != nil {
+			t.Fatalf("AddChecklistItem(%q) unexpected error: %v", title, err)
+		}
+	}
+	return task
+}
+
+func TestChecklist(t *testing.T) {
+	t.Run("when items are added, Then they are appended in order", func(t *testing.T) {
+		task := newChecklistTask(t, "Apples", "Flour", "Butter")
+
+		if len(task.Checklist) != 3 {
+			t.Fatalf("len(Checklist) = %d, want 3", len(task.Checklist))
+		}
+		for i, item := range task.Checklist {
+			if item.Position != i || item.TaskId != task.TaskId || item.IsChecked {
+				t.Errorf("unexpected item %d: %+v", i, item)
+			}
+		}
+	})
+
+	t.Run("when item title is empty, Then returns error", func(t *testing.T) {
+		task := newChecklistTask(t)
+		if _, err := task.AddChecklistItem("  "); !errors.Is(err, ErrInvalidChecklist) {
+			t.Errorf("AddChecklistItem() error = %v, want ErrInvalidChecklist", err)
+		}
+	})
+
+	t.Run("when all items are checked, Then task rolls up to completed", func(t *testing.T) {
+		// Arrange
+		task := newChecklistTask(t, "Apples", "Flour")
+
+		// Act
+		task.ToggleChecklistItem(task.Checklist[0].ItemId, true)
+		completedAfterFirst := task.IsCompleted
+		task.ToggleChecklistItem(task.Checklist[1].ItemId, true)
+
+		// Assert
+		if completedAfterFirst {
+			t.Errorf("task should stay open while an item is unchecked")
+		}
+		if !task.IsCompleted {
+			t.Errorf("task should be completed once every item is checked")
+		}
+	})
+
+	t.Run("when item is unchecked or added to a completed task, Then task reopens", func(t *testing.T) {
+		task := newChecklistTask(t, "Apples")
+		task.ToggleChecklistItem(task.Checklist[0].ItemId, true)
+
+		task.ToggleChecklistItem(task.Checklist[0].ItemId, false)
+		if task.IsCompleted {
+			t.Errorf("unchecking an item should reopen the task")
+		}
+
+		task.ToggleChecklistItem(task.Checklist[0].ItemId, true)
+		task.AddChecklistItem("Cinnamon")
+		if task.IsCompleted {
+			t.Errorf("adding an unchecked item should reopen the task")
+		}
+	})
+
+	t.Run("when the only unchecked item is removed, Then task completes and positions are compacted", func(t *testing.T) {
+		task := newChecklistTask(t, "Apples", "Flour", "Butter")
+		task.ToggleChecklistItem(task.Checklist[0].ItemId, true)
+		task.ToggleChecklistItem(task.Checklist[2].ItemId, true)
+
+		if err := task.RemoveChecklistItem(task.Checklist[1].ItemId); err != nil {
+			t.Fatalf("RemoveChecklistItem() unexpected error: %v", err)
+		}
+
+		if !task.IsCompleted {
+			t.Errorf("task should be completed")
+		}
+		if task.Checklist[1].Title != "Butter" || task.Checklist[1].Position != 1 {
+			t.Errorf("unexpected second item: %+v", task.Checklist[1])
+		}
+	})
+
+	t.Run("when item does not exist, Then returns not found", func(t *testing.T) {
+		task := newChecklistTask(t, "Apples")
+		if err := task.ToggleChecklistItem("missing", true); !errors.Is(err, ErrChecklistItemNotFound) {
+			t.Errorf("ToggleChecklistItem() error = %v, want ErrChecklistItemNotFound", err)
+		}
+		if err := task.RemoveChecklistItem("missing"); !errors.Is(err, ErrChecklistItemNotFound) {
+			t.Errorf("RemoveChecklistItem() error = %v, want ErrChecklistItemNotFound", err)
+		}
+	})
+
+	t.Run("when checklist is reordered, Then positions follow the given order", func(t *testing.T) {
+		task := newChecklistTask(t, "Apples", "Flour", "Butter")
+		ids := []string{task.Checklist[2].ItemId, task.Checklist[0].ItemId, task.Checklist[1].ItemId}
+
+		if err := task.ReorderChecklist(ids); err != nil {
+			t.Fatalf("ReorderChecklist() unexpected error: %v", err)
+		}
+		for i, want := range []string{"Butter", "Apples", "Flour"} {
+			if task.Checklist[i].Title != want || task.Checklist[i].Position != i {
+				t.Errorf("item %d = %+v, want %s", i, task.Checklist[i], want)
+			}
+		}
+	})
+
+	t.Run("when reorder is incomplete or repeats an item, Then returns error", func(t *testing.T) {
+		task := newChecklistTask(t, "Apples", "Flour")
+		first := task.Checklist[0].ItemId
+
+		if err := task.ReorderChecklist([]string{first}); !errors.Is(err, ErrInvalidChecklist) {
+			t.Errorf("ReorderChecklist() error = %v, want ErrInvalidChecklist", err)
+		}
+		if err := task.ReorderChecklist([]string{first, first}); !errors.Is(err, ErrInvalidChecklist) {
+			t.Errorf("ReorderChecklist() error = %v, want ErrInvalidChecklist", err)
+		}
+	})
+
+	t.Run("when recurring task moves to its next occurrence, Then checklist is copied unchecked", func(t *testing.T) {
+		task := newChecklistTask(t, "Apples")
+		task.SetRecurrence("FREQ=WEEKLY")
+		task.ToggleChecklistItem(task.Checklist[0].ItemId, true)
+
+		next, err := task.NextOccurrence()
+		if err != nil || next == nil {
+			t.Fatalf("NextOccurrence() = %v, %v", next, err)
+		}
+		if len(next.Checklist) != 1 {
+			t.Fatalf("len(next.Checklist) = %d, want 1", len(next.Checklist))
+		}
+		item := next.Checklist[0]
+		if item.IsChecked || item.TaskId != next.TaskId || item.ItemId == task.Checklist[0].ItemId {
+			t.Errorf("unexpected copied item: %+v", item)
+		}
+	})
+}
+
+func TestCopy(t *testing.T) {
+	t.Run("when completed task is copied, Then the copy is open with an unchecked checklist", func(t *testing.T) {
+		task := newChecklistTask(t, "Apples", "Flour")
+		task.AssigneeId = "assignee"
+		task.ToggleChecklistItem(task.Checklist[0].ItemId, true)
+		task.ToggleCompletion(true)
+		task.PullEvents()
+
+		copied := task.Copy(true)
+
+		if copied.TaskId == task.TaskId || copied.IsCompleted {
+			t.Errorf("Copy() = %+v, want a new open task", copied)
+		}
+		if copied.AssigneeId != "assignee" {
+			t.Errorf("AssigneeId = %q, want assignee", copied.AssigneeId)
+		}
+		if len(copied.Checklist) != 2 {
+			t.Fatalf("Checklist has %d items, want 2", len(copied.Checklist))
+		}
+		for _, item := range copied.Checklist {
+			if item.IsChecked || item.TaskId != copied.TaskId {
+				t.Errorf("checklist item %+v, want unchecked and owned by the copy", item)
+			}
+		}
+		events := copied.PullEvents()
+		if len(events) != 1 || events[0].EventType() != EventTaskCreated {
+			t.Errorf("events = %+v, want TaskCreated", events)
+		}
+	})
+
+	t.Run("when assignee is not kept, Then the copy is unassigned", func(t *testing.T) {
+		task := newChecklistTask(t)
+		task.AssigneeId = "assignee"
+
+		copied := task.Copy(false)
+
+		if copied.AssigneeId != "" {
+			t.Errorf("AssigneeId = %q, want empty", copied.AssigneeId)
+		}
+	})
+}
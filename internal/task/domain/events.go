@@ -0,0 +1,104 @@
+package domain
+
+import "time"
+
+const (
+	EventTaskCreated   = "task.created"
+	EventTaskUpdated   = "task.updated"
+	EventTaskCompleted = "task.completed"
+	EventTaskReopened  = "task.reopened"
+	EventTaskDeleted   = "task.deleted"
+	EventTaskRestored  = "task.restored"
+	EventTaskMoved     = "task.moved"
+
+	aggregateTask = "task"
+)
+
+// TaskCreated is raised when a task is created, including the follow-up instance of a recurring task
+type TaskCreated struct {
+	TaskId     string    `json:"task_id"`
+	TaskName   string    `json:"task_name"`
+	TargetDate time.Time `json:"target_date"`
+	Priority   string    `json:"priority"`
+	AssigneeId string    `json:"assignee_id,omitempty"`
+}
+
+func (e TaskCreated) EventType() string     { return EventTaskCreated }
+func (e TaskCreated) AggregateType() string { return aggregateTask }
+func (e TaskCreated) AggregateId() string   { return e.TaskId }
+
+// TaskCompleted is raised when a task becomes completed, directly or through its checklist
+type TaskCompleted struct {
+	TaskId     string `json:"task_id"`
+	TaskName   string `json:"task_name"`
+	AssigneeId string `json:"assignee_id,omitempty"`
+}
+
+func (e TaskCompleted) EventType() string     { return EventTaskCompleted }
+func (e TaskCompleted) AggregateType() string { return aggregateTask }
+func (e TaskCompleted) AggregateId() string   { return e.TaskId }
+
+// TaskUpdated is raised when the details, importance, assignee or labels of a task change
+type TaskUpdated struct {
+	TaskId      string    `json:"task_id"`
+	TaskName    string    `json:"task_name"`
+	TargetDate  time.Time `json:"target_date"`
+	Priority    string    `json:"priority"`
+	IsImportant bool      `json:"is_important"`
+	AssigneeId  string    `json:"assignee_id,omitempty"`
+	Labels      []string  `json:"labels,omitempty"`
+}
+
+func (e TaskUpdated) EventType() string     { return EventTaskUpdated }
+func (e TaskUpdated) AggregateType() string { return aggregateTask }
+func (e TaskUpdated) AggregateId() string   { return e.TaskId }
+
+// TaskReopened is raised when a completed task is marked as not completed, directly or through its checklist
+type TaskReopened struct {
+	TaskId   string `json:"task_id"`
+	TaskName string `json:"task_name"`
+}
+
+func (e TaskReopened) EventType() string     { return EventTaskReopened }
+func (e TaskReopened) AggregateType() string { return aggregateTask }
+func (e TaskReopened) AggregateId() string   { return e.TaskId }
+
+// TaskDeleted is raised when a task is moved to the trash
+type TaskDeleted struct {
+	TaskId   string `json:"task_id"`
+	TaskName string `json:"task_name"`
+}
+
+func (e TaskDeleted) EventType() string     { return EventTaskDeleted }
+func (e TaskDeleted) AggregateType() string { return aggregateTask }
+func (e TaskDeleted) AggregateId() string   { return e.TaskId }
+
+// TaskRestored is raised when a task is taken back out of the trash
+type TaskRestored struct {
+	TaskId   string `json:"task_id"`
+	TaskName string `json:"task_name"`
+}
+
+func (e TaskRestored) EventType() string     { return EventTaskRestored }
+func (e TaskRestored) AggregateType() string { return aggregateTask }
+func (e TaskRestored) AggregateId() string   { return e.TaskId }
+
+// TaskMoved is raised when a task is relinked to another container
+type TaskMoved struct {
+	TaskId          string `json:"task_id"`
+	TaskName        string `json:"task_name"`
+	FromContainerId string `json:"from_container_id"`
+	ToContainerId   string `json:"to_container_id"`
+}
+
+func (e TaskMoved) EventType() string     { return EventTaskMoved }
+func (e TaskMoved) AggregateType() string { return aggregateTask }
+func (e TaskMoved) AggregateId() string   { return e.TaskId }
+
+func (t *Task) raiseCreated() {
+	t.Raise(TaskCreated{TaskId: t.TaskId, TaskName: t.TaskName, TargetDate: t.TargetDate, Priority: t.Priority.String(), AssigneeId: t.AssigneeId})
+}
+
+func (t *Task) raiseUpdated() {
+	t.Raise(TaskUpdated{TaskId: t.TaskId, TaskName: t.TaskName, TargetDate: t.TargetDate, Priority: t.Priority.String(), IsImportant: t.IsImportant, AssigneeId: t.AssigneeId, Labels: t.LabelNames()})
+}
@@ -0,0 +1,145 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestTaskEvents(t *testing.T) {
+	t.Run("when task is created, Then TaskCreated is raised", func(t *testing.T) {
+		task, err := CreateTask("Laundry", "", time.Now(), "high")
+		if err != nil {
+			t.Fatalf("CreateTask() unexpected error: %v", err)
+		}
+
+		events := task.PullEvents()
+		if len(events) != 1 {
+			t.Fatalf("len(events) = %d, want 1", len(events))
+		}
+		created, ok := events[0].(TaskCreated)
+		if !ok || created.TaskId != task.TaskId || created.Priority != "high" {
+			t.Errorf("unexpected event: %+v", events[0])
+		}
+	})
+
+	t.Run("when task is completed twice and reopened, Then TaskCompleted and TaskReopened are raised once", func(t *testing.T) {
+		task := newChecklistTask(t)
+		task.PullEvents()
+
+		task.ToggleCompletion(true)
+		task.ToggleCompletion(true)
+		task.ToggleCompletion(false)
+		task.ToggleCompletion(false)
+
+		events := task.PullEvents()
+		if len(events) != 2 || events[0].EventType() != EventTaskCompleted || events[1].EventType() != EventTaskReopened {
+			t.Errorf("events = %+v, want TaskCompleted then TaskReopened", events)
+		}
+	})
+
+	t.Run("when task details, importance or assignee change, Then TaskUpdated is raised", func(t *testing.T) {
+		task := newChecklistTask(t)
+		task.PullEvents()
+
+		task.UpdateTask("Cherry pie ingredients", "", task.TargetDate, "high")
+		task.ToggleImportant(true)
+		task.ToggleImportant(true)
+		task.AssignTo("01959b38-b3f9-7ec5-8ac8-e353bfe08a2d")
+		task.Unassign()
+
+		events := task.PullEvents()
+		if len(events) != 4 {
+			t.Fatalf("len(events) = %d, want 4", len(events))
+		}
+		updated, ok := events[2].(TaskUpdated)
+		if !ok || updated.TaskName != "Cherry pie ingredients" || !updated.IsImportant || updated.AssigneeId == "" {
+			t.Errorf("unexpected event: %+v", events[2])
+		}
+	})
+
+	t.Run("when task is deleted, Then TaskDeleted is raised", func(t *testing.T) {
+		task := newChecklistTask(t)
+		task.PullEvents()
+
+		task.Delete()
+
+		events := task.PullEvents()
+		if len(events) != 1 || events[0].EventType() != EventTaskDeleted || events[0].AggregateId() != task.TaskId {
+			t.Errorf("events = %+v, want TaskDeleted", events)
+		}
+	})
+
+	t.Run("when deleted task is restored, Then TaskRestored is raised and DeletedAt is cleared", func(t *testing.T) {
+		task := newChecklistTask(t)
+		task.Delete()
+		task.PullEvents()
+
+		if err := task.Restore(); err != nil {
+			t.Fatalf("Restore() error = %v", err)
+		}
+
+		events := task.PullEvents()
+		if len(events) != 1 || events[0].EventType() != EventTaskRestored {
+			t.Errorf("events = %+v, want TaskRestored", events)
+		}
+		if task.DeletedAt != nil {
+			t.Errorf("DeletedAt = %v, want nil", task.DeletedAt)
+		}
+	})
+
+	t.Run("when live task is restored, Then ErrNotInTrash is returned", func(t *testing.T) {
+		task := newChecklistTask(t)
+		task.PullEvents()
+
+		if err := task.Restore(); !errors.Is(err, ErrNotInTrash) {
+			t.Errorf("Restore() error = %v, want ErrNotInTrash", err)
+		}
+		if events := task.PullEvents(); len(events) != 0 {
+			t.Errorf("events = %+v, want none", events)
+		}
+	})
+
+	t.Run("when task is moved, Then TaskMoved names both containers", func(t *testing.T) {
+		task := newChecklistTask(t)
+		task.PullEvents()
+
+		task.MoveTo("container-1", "container-2")
+
+		events := task.PullEvents()
+		if len(events) != 1 || events[0].EventType() != EventTaskMoved {
+			t.Fatalf("events = %+v, want TaskMoved", events)
+		}
+		moved := events[0].(TaskMoved)
+		if moved.FromContainerId != "container-1" || moved.ToContainerId != "container-2" {
+			t.Errorf("TaskMoved = %+v, want container-1 to container-2", moved)
+		}
+	})
+
+	t.Run("when last checklist item is checked, Then TaskCompleted is raised", func(t *testing.T) {
+		task := newChecklistTask(t, "Apples")
+		task.PullEvents()
+
+		task.ToggleChecklistItem(task.Checklist[0].ItemId, true)
+
+		events := task.PullEvents()
+		if len(events) != 1 || events[0].EventType() != EventTaskCompleted {
+			t.Errorf("events = %+v, want a single TaskCompleted", events)
+		}
+	})
+
+	t.Run("when recurring task moves to its next occurrence, Then the new instance raises TaskCreated", func(t *testing.T) {
+		task := newChecklistTask(t)
+		task.SetRecurrence("FREQ=DAILY")
+
+		next, err := task.NextOccurrence()
+		if err != nil || next == nil {
+			t.Fatalf("NextOccurrence() = %v, %v", next, err)
+		}
+
+		events := next.PullEvents()
+		if len(events) != 1 || events[0].AggregateId() != next.TaskId || events[0].EventType() != EventTaskCreated {
+			t.Errorf("events = %+v, want TaskCreated for %s", events, next.TaskId)
+		}
+	})
+}
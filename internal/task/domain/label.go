@@ -0,0 +1,81 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+// MaxTaskLabels caps the labels of a single task
+const MaxTaskLabels = 20
+
+var ErrInvalidLabels = errors.New("invalid task labels")
+
+// Label is one of the labels of the task's group, attached to the task
+type Label struct {
+	Id    string `json:"id"`
+	Name  string `json:"name"`
+	Color string `json:"color"`
+}
+
+// AddLabel attaches the label, raising TaskUpdated. It returns false when the task already carries the label.
+func (t *Task) AddLabel(label Label) (bool, error) {
+	if t.hasLabel(label.Id) {
+		return false, nil
+	}
+	if len(t.Labels) >= MaxTaskLabels {
+		return false, fmt.Errorf("%w: a task cannot have more than %d labels", ErrInvalidLabels, MaxTaskLabels)
+	}
+	t.Labels = append(t.Labels, label)
+	t.UpdatedAt = time.Now().UTC()
+	t.raiseUpdated()
+	return true, nil
+}
+
+// RemoveLabel detaches the label, raising TaskUpdated. It returns false when the task does not carry the label.
+func (t *Task) RemoveLabel(labelId string) bool {
+	index := slices.IndexFunc(t.Labels, func(l Label) bool { return l.Id == labelId })
+	if index < 0 {
+		return false
+	}
+	t.Labels = slices.Delete(t.Labels, index, index+1)
+	t.UpdatedAt = time.Now().UTC()
+	t.raiseUpdated()
+	return true
+}
+
+// MatchLabels swaps the task's labels for the labels of another group with the same names, ignoring case.
+// Labels without a namesake there are dropped. Used when the task, or a copy of it, joins another group.
+func (t *Task) MatchLabels(available []Label) {
+	t.Labels = LabelsNamed(t.LabelNames(), available)
+}
+
+// LabelNames returns the names of the task's labels
+func (t *Task) LabelNames() []string {
+	if len(t.Labels) == 0 {
+		return nil
+	}
+	names := make([]string, len(t.Labels))
+	for i, label := range t.Labels {
+		names[i] = label.Name
+	}
+	return names
+}
+
+// LabelsNamed picks, in order, the available labels named after one of names, ignoring case
+func LabelsNamed(names []string, available []Label) []Label {
+	var matched []Label
+	for _, name := range names {
+		index := slices.IndexFunc(available, func(l Label) bool { return strings.EqualFold(l.Name, strings.TrimSpace(name)) })
+		if index >= 0 && !slices.ContainsFunc(matched, func(l Label) bool { return l.Id == available[index].Id }) {
+			matched = append(matched, available[index])
+		}
+	}
+	return matched
+}
+
+func (t *Task) hasLabel(labelId string) bool {
+	return slices.ContainsFunc(t.Labels, func(l Label) bool { return l.Id == labelId })
+}
@@ -0,0 +1,57 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestTaskLabels(t *testing.T) {
+	grocery := Label{Id: "label-1", Name: "Grocery", Color: "#4caf50"}
+
+	t.Run("when label is added twice and removed, Then TaskUpdated is raised once for each change", func(t *testing.T) {
+		task := newChecklistTask(t)
+		task.PullEvents()
+
+		added, err := task.AddLabel(grocery)
+		addedAgain, _ := task.AddLabel(grocery)
+		removed := task.RemoveLabel(grocery.Id)
+		removedAgain := task.RemoveLabel(grocery.Id)
+
+		if err != nil || !added || addedAgain || !removed || removedAgain {
+			t.Errorf("AddLabel/RemoveLabel = %v, %v, %v, %v (%v)", added, addedAgain, removed, removedAgain, err)
+		}
+		events := task.PullEvents()
+		if len(events) != 2 || events[0].EventType() != EventTaskUpdated || events[1].EventType() != EventTaskUpdated {
+			t.Errorf("events = %+v, want two TaskUpdated", events)
+		}
+		if len(task.Labels) != 0 {
+			t.Errorf("Labels = %+v, want none", task.Labels)
+		}
+	})
+
+	t.Run("when task carries MaxTaskLabels labels, Then another one is rejected", func(t *testing.T) {
+		task := newChecklistTask(t)
+		for i := range MaxTaskLabels {
+			if _, err := task.AddLabel(Label{Id: fmt.Sprintf("label-%d", i), Name: fmt.Sprintf("label %d", i)}); err != nil {
+				t.Fatalf("AddLabel() unexpected error: %v", err)
+			}
+		}
+
+		if _, err := task.AddLabel(Label{Id: "one-too-many", Name: "one too many"}); !errors.Is(err, ErrInvalidLabels) {
+			t.Errorf("AddLabel() error = %v, want ErrInvalidLabels", err)
+		}
+	})
+
+	t.Run("when task joins another group, Then its labels become that group's labels of the same names", func(t *testing.T) {
+		task := newChecklistTask(t)
+		task.Labels = []Label{grocery, {Id: "label-2", Name: "Garden"}}
+		available := []Label{{Id: "other-1", Name: "chores"}, {Id: "other-2", Name: "GROCERY"}}
+
+		task.MatchLabels(available)
+
+		if len(task.Labels) != 1 || task.Labels[0].Id != "other-2" {
+			t.Errorf("Labels = %+v, want the other group's grocery label only", task.Labels)
+		}
+	})
+}
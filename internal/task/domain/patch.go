@@ -0,0 +1,72 @@
+package domain
+
+import (
+	"strings"
+	"time"
+)
+
+// TaskPatch is a partial change to a task, as carried by an RFC 7396 merge patch.
+// A nil field is left as it is; a pointer to the zero value clears the field, as JSON null does.
+type TaskPatch struct {
+	Name           *string
+	Description    *string
+	TargetDate     *time.Time
+	Priority       *string
+	RecurrenceRule *string
+}
+
+// ApplyPatch validates every field of the patch before changing anything, so a rejected patch leaves the
+// task untouched. The returned *ValidationError lists all rejected fields.
+func (t *Task) ApplyPatch(patch TaskPatch) error {
+	var fields []FieldError
+	reject := func(field string, err error) {
+		fields = append(fields, FieldError{Field: field, Message: err.Error(), Err: err})
+	}
+
+	name, description, targetDate := t.TaskName, t.TaskDesc, t.TargetDate
+	priority, rule := t.Priority, t.RecurrenceRule
+
+	if patch.Name != nil {
+		if err := validateTaskName(*patch.Name); err != nil {
+			reject("name", err)
+		}
+		name = strings.TrimSpace(*patch.Name)
+	}
+	if patch.Description != nil {
+		description = strings.TrimSpace(*patch.Description)
+	}
+	if patch.TargetDate != nil {
+		targetDate = *patch.TargetDate
+	}
+	if patch.Priority != nil {
+		parsed, err := NewPriority(*patch.Priority)
+		if err != nil {
+			reject("priority", err)
+		}
+		priority = parsed
+	}
+	if patch.RecurrenceRule != nil {
+		rule = *patch.RecurrenceRule
+	}
+	if patch.RecurrenceRule != nil || patch.TargetDate != nil {
+		normalized, err := normalizeRecurrence(rule, targetDate)
+		switch {
+		case err != nil && patch.RecurrenceRule == nil:
+			// Only the target date moved, so it is the field that broke the existing rule
+			reject("target_date", err)
+		case err != nil:
+			reject("recurrence_rule", err)
+		}
+		rule = normalized
+	}
+
+	if len(fields) > 0 {
+		return &ValidationError{Fields: fields}
+	}
+
+	t.TaskName, t.TaskDesc, t.TargetDate = name, description, targetDate
+	t.Priority, t.RecurrenceRule = priority, rule
+	t.UpdatedAt = time.Now().UTC()
+	t.raiseUpdated()
+	return nil
+}
@@ -0,0 +1,98 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func ptr[T any](v T) *T { return &v }
+
+func newPatchTask(t *testing.T) *Task {
+	t.Helper()
+	task, err := CreateTask("Water plants", "Balcony", time.Date(2026, 5, 4, 9, 0, 0, 0, time.UTC), "high")
+	if err != nil {
+		t.Fatalf("CreateTask() unexpected error: %v", err)
+	}
+	if err := task.SetRecurrence("FREQ=WEEKLY"); err != nil {
+		t.Fatalf("SetRecurrence() unexpected error: %v", err)
+	}
+	task.PullEvents()
+	return task
+}
+
+func TestApplyPatch(t *testing.T) {
+	t.Run("when only some fields are present, Then the others are kept", func(t *testing.T) {
+		// Arrange
+		task := newPatchTask(t)
+		before := *task
+
+		// Act
+		err := task.ApplyPatch(TaskPatch{Name: ptr("  Water all plants "), Priority: ptr("URGENT")})
+
+		// Assert
+		if err != nil {
+			t.Fatalf("ApplyPatch() unexpected error: %v", err)
+		}
+		if task.TaskName != "Water all plants" || task.Priority != "urgent" {
+			t.Errorf("patched fields = %q/%q, want trimmed name and normalized priority", task.TaskName, task.Priority)
+		}
+		if task.TaskDesc != before.TaskDesc || !task.TargetDate.Equal(before.TargetDate) || task.RecurrenceRule != before.RecurrenceRule {
+			t.Errorf("ApplyPatch() changed fields missing from the patch: %+v", task)
+		}
+		if events := task.PullEvents(); len(events) != 1 || events[0].EventType() != EventTaskUpdated {
+			t.Errorf("events = %v, want a single %s", events, EventTaskUpdated)
+		}
+	})
+
+	t.Run("when fields are cleared, Then they are reset", func(t *testing.T) {
+		task := newPatchTask(t)
+
+		err := task.ApplyPatch(TaskPatch{Description: ptr(""), RecurrenceRule: ptr(""), TargetDate: &time.Time{}, Priority: ptr("")})
+
+		if err != nil {
+			t.Fatalf("ApplyPatch() unexpected error: %v", err)
+		}
+		if task.TaskDesc != "" || task.RecurrenceRule != "" || !task.TargetDate.IsZero() || task.Priority != "medium" {
+			t.Errorf("ApplyPatch() did not clear fields: %+v", task)
+		}
+	})
+
+	t.Run("when fields are invalid, Then every one is reported and the task is untouched", func(t *testing.T) {
+		// Arrange
+		task := newPatchTask(t)
+		before := *task
+
+		// Act
+		err := task.ApplyPatch(TaskPatch{Name: ptr(" "), Priority: ptr("someday"), Description: ptr("Garden")})
+
+		// Assert
+		var invalid *ValidationError
+		if !errors.As(err, &invalid) || !errors.Is(err, ErrInvalidTask) {
+			t.Fatalf("ApplyPatch() error = %v, want a ValidationError", err)
+		}
+		if len(invalid.Fields) != 2 || invalid.Fields[0].Field != "name" || invalid.Fields[1].Field != "priority" {
+			t.Errorf("Fields = %+v, want name and priority", invalid.Fields)
+		}
+		if !errors.Is(err, ErrInvalidPriority) {
+			t.Errorf("ApplyPatch() error = %v, want it to wrap ErrInvalidPriority", err)
+		}
+		if task.TaskName != before.TaskName || task.Priority != before.Priority || task.TaskDesc != before.TaskDesc {
+			t.Errorf("rejected patch changed the task: %+v", task)
+		}
+		if events := task.PullEvents(); len(events) != 0 {
+			t.Errorf("events = %v, want none", events)
+		}
+	})
+
+	t.Run("when the target date of a recurring task is cleared, Then target_date is rejected", func(t *testing.T) {
+		task := newPatchTask(t)
+
+		err := task.ApplyPatch(TaskPatch{TargetDate: &time.Time{}})
+
+		var invalid *ValidationError
+		if !errors.As(err, &invalid) || len(invalid.Fields) != 1 || invalid.Fields[0].Field != "target_date" {
+			t.Errorf("ApplyPatch() error = %v, want target_date rejected", err)
+		}
+	})
+}
@@ -0,0 +1,62 @@
+package domain
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Priority represents how pressing a task is
+type Priority string
+
+const (
+	PriorityLow    Priority = "low"
+	PriorityMedium Priority = "medium"
+	PriorityHigh   Priority = "high"
+	PriorityUrgent Priority = "urgent"
+)
+
+var ErrInvalidPriority = errors.New("invalid priority")
+
+var priorityRanks = map[Priority]int{
+	PriorityLow:    1,
+	PriorityMedium: 2,
+	PriorityHigh:   3,
+	PriorityUrgent: 4,
+}
+
+// NewPriority creates a new Priority value object with validation, ignoring case.
+// An empty value is medium.
+func NewPriority(value string) (Priority, error) {
+	normalized := strings.ToLower(strings.TrimSpace(value))
+	if normalized == "" {
+		return PriorityMedium, nil
+	}
+	priority := Priority(normalized)
+	if !priority.IsValid() {
+		return "", fmt.Errorf("%w: must be one of low, medium, high or urgent, got %q", ErrInvalidPriority, value)
+	}
+	return priority, nil
+}
+
+// IsValid checks if the priority is a valid value
+func (p Priority) IsValid() bool {
+	_, ok := priorityRanks[p]
+	return ok
+}
+
+// String returns the string representation
+func (p Priority) String() string {
+	return string(p)
+}
+
+// Rank orders priorities from low (1) to urgent (4). An invalid priority ranks 0, below low.
+func (p Priority) Rank() int {
+	return priorityRanks[p]
+}
+
+// Compare returns -1, 0 or +1 as p is less pressing than, as pressing as, or more pressing than other
+func (p Priority) Compare(other Priority) int {
+	return cmp.Compare(p.Rank(), other.Rank())
+}
@@ -0,0 +1,53 @@
+package domain
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestNewPriority(t *testing.T) {
+	valid := []struct {
+		value string
+		want  Priority
+	}{
+		{"low", PriorityLow},
+		{" High ", PriorityHigh},
+		{"URGENT", PriorityUrgent},
+		{"", PriorityMedium},
+	}
+	for _, tt := range valid {
+		t.Run("when value is "+tt.value+", Then it is normalized", func(t *testing.T) {
+			priority, err := NewPriority(tt.value)
+			if err != nil || priority != tt.want {
+				t.Errorf("NewPriority(%q) = %q, %v, want %q", tt.value, priority, err, tt.want)
+			}
+		})
+	}
+
+	for _, value := range []string{"normal", "someday", "1"} {
+		t.Run("when value is "+value+", Then ErrInvalidPriority is returned", func(t *testing.T) {
+			if _, err := NewPriority(value); !errors.Is(err, ErrInvalidPriority) {
+				t.Errorf("NewPriority(%q) error = %v, want ErrInvalidPriority", value, err)
+			}
+		})
+	}
+}
+
+func TestPriorityCompare(t *testing.T) {
+	t.Run("when priorities are sorted, Then they run from low to urgent", func(t *testing.T) {
+		priorities := []Priority{PriorityUrgent, PriorityLow, PriorityHigh, PriorityMedium}
+
+		slices.SortFunc(priorities, Priority.Compare)
+
+		if want := []Priority{PriorityLow, PriorityMedium, PriorityHigh, PriorityUrgent}; !slices.Equal(priorities, want) {
+			t.Errorf("sorted = %v, want %v", priorities, want)
+		}
+	})
+
+	t.Run("when priority is invalid, Then it ranks below low", func(t *testing.T) {
+		if Priority("normal").Rank() != 0 || Priority("normal").Compare(PriorityLow) >= 0 {
+			t.Errorf("invalid priority does not rank below low")
+		}
+	})
+}
@@ -0,0 +1,399 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var ErrInvalidRecurrence = errors.New("invalid recurrence rule")
+
+// Frequency is the RRULE FREQ part supported for recurring tasks
+type Frequency string
+
+const (
+	FrequencyDaily   Frequency = "DAILY"
+	FrequencyWeekly  Frequency = "WEEKLY"
+	FrequencyMonthly Frequency = "MONTHLY"
+)
+
+// maxRecurrencePeriods bounds iteration for rules that rarely or never match (e.g. BYMONTHDAY=31 every 12 months from February)
+const maxRecurrencePeriods = 100000
+
+var weekdayCodes = map[string]time.Weekday{
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+	"SU": time.Sunday,
+}
+
+// WeekdayNum is a BYDAY entry. N is the ordinal within the month (e.g. 2 for "2MO", -1 for "-1FR");
+// it is 0 for plain weekdays.
+type WeekdayNum struct {
+	N   int
+	Day time.Weekday
+}
+
+func (w WeekdayNum) String() string {
+	code := strings.ToUpper(w.Day.String()[:2])
+	if w.N == 0 {
+		return code
+	}
+	return strconv.Itoa(w.N) + code
+}
+
+// Recurrence is the subset of an iCalendar RRULE supported for tasks:
+// DAILY, WEEKLY on given weekdays, and MONTHLY on the nth day or nth weekday, bounded by COUNT or UNTIL.
+// The series start (DTSTART) is the task's target date and is always the first occurrence.
+type Recurrence struct {
+	Frequency  Frequency
+	Interval   int
+	ByDay      []WeekdayNum
+	ByMonthDay []int
+	Count      int
+	Until      *time.Time
+}
+
+func invalidRecurrence(format string, args ...interface{}) error {
+	return fmt.Errorf("%w: %s", ErrInvalidRecurrence, fmt.Sprintf(format, args...))
+}
+
+// ParseRecurrence parses an RRULE such as "FREQ=WEEKLY;BYDAY=MO,TH;COUNT=10".
+// A leading "RRULE:" is accepted.
+func ParseRecurrence(rule string) (*Recurrence, error) {
+	rule = strings.TrimPrefix(strings.TrimSpace(rule), "RRULE:")
+	if rule == "" {
+		return nil, invalidRecurrence("rule cannot be empty")
+	}
+
+	r := &Recurrence{Interval: 1}
+	seen := map[string]bool{}
+	for _, part := range strings.Split(rule, ";") {
+		key, value, ok := strings.Cut(part, "=")
+		key = strings.ToUpper(strings.TrimSpace(key))
+		value = strings.ToUpper(strings.TrimSpace(value))
+		if !ok || key == "" || value == "" {
+			return nil, invalidRecurrence("malformed part '%s'", part)
+		}
+		if seen[key] {
+			return nil, invalidRecurrence("%s is given more than once", key)
+		}
+		seen[key] = true
+
+		var err error
+		switch key {
+		case "FREQ":
+			r.Frequency = Frequency(value)
+		case "INTERVAL":
+			r.Interval, err = parsePositive(key, value)
+		case "COUNT":
+			r.Count, err = parsePositive(key, value)
+		case "UNTIL":
+			r.Until, err = parseUntil(value)
+		case "BYDAY":
+			r.ByDay, err = parseByDay(value)
+		case "BYMONTHDAY":
+			r.ByMonthDay, err = parseByMonthDay(value)
+		default:
+			return nil, invalidRecurrence("%s is not supported", key)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := r.validate(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (r *Recurrence) validate() error {
+	switch r.Frequency {
+	case FrequencyDaily:
+		if len(r.ByDay) > 0 || len(r.ByMonthDay) > 0 {
+			return invalidRecurrence("BYDAY and BYMONTHDAY are not supported with FREQ=DAILY")
+		}
+	case FrequencyWeekly:
+		if len(r.ByMonthDay) > 0 {
+			return invalidRecurrence("BYMONTHDAY is not supported with FREQ=WEEKLY")
+		}
+		for _, d := range r.ByDay {
+			if d.N != 0 {
+				return invalidRecurrence("ordinal weekdays are only supported with FREQ=MONTHLY")
+			}
+		}
+	case FrequencyMonthly:
+		if len(r.ByDay) > 0 && len(r.ByMonthDay) > 0 {
+			return invalidRecurrence("BYDAY and BYMONTHDAY cannot be combined")
+		}
+		for _, d := range r.ByDay {
+			if d.N == 0 {
+				return invalidRecurrence("BYDAY with FREQ=MONTHLY needs an ordinal, e.g. 2MO or -1FR")
+			}
+		}
+	case "":
+		return invalidRecurrence("FREQ is required")
+	default:
+		return invalidRecurrence("FREQ=%s is not supported", r.Frequency)
+	}
+	if r.Count > 0 && r.Until != nil {
+		return invalidRecurrence("COUNT and UNTIL cannot be combined")
+	}
+	return nil
+}
+
+func parsePositive(key, value string) (int, error) {
+	n, err := strconv.Atoi(value)
+	if err != nil || n < 1 {
+		return 0, invalidRecurrence("%s must be a positive integer", key)
+	}
+	return n, nil
+}
+
+func parseUntil(value string) (*time.Time, error) {
+	for _, layout := range []string{"20060102T150405Z", "20060102"} {
+		if t, err := time.Parse(layout, value); err == nil {
+			if layout == "20060102" {
+				// A date-only UNTIL includes the whole day
+				t = t.Add(24*time.Hour - time.Second)
+			}
+			return &t, nil
+		}
+	}
+	return nil, invalidRecurrence("UNTIL must be YYYYMMDD or YYYYMMDDTHHMMSSZ")
+}
+
+func parseByDay(value string) ([]WeekdayNum, error) {
+	var days []WeekdayNum
+	for _, item := range strings.Split(value, ",") {
+		if len(item) < 2 {
+			return nil, invalidRecurrence("invalid BYDAY '%s'", item)
+		}
+		day, ok := weekdayCodes[item[len(item)-2:]]
+		if !ok {
+			return nil, invalidRecurrence("invalid BYDAY '%s'", item)
+		}
+		n := 0
+		if prefix := item[:len(item)-2]; prefix != "" {
+			var err error
+			n, err = strconv.Atoi(prefix)
+			if err != nil || n == 0 || n < -5 || n > 5 {
+				return nil, invalidRecurrence("invalid BYDAY '%s'", item)
+			}
+		}
+		days = append(days, WeekdayNum{N: n, Day: day})
+	}
+	return days, nil
+}
+
+func parseByMonthDay(value string) ([]int, error) {
+	var days []int
+	for _, item := range strings.Split(value, ",") {
+		n, err := strconv.Atoi(item)
+		if err != nil || n == 0 || n < -31 || n > 31 {
+			return nil, invalidRecurrence("invalid BYMONTHDAY '%s'", item)
+		}
+		days = append(days, n)
+	}
+	return days, nil
+}
+
+// String returns the rule in canonical RRULE form (without the "RRULE:" prefix)
+func (r Recurrence) String() string {
+	parts := []string{"FREQ=" + string(r.Frequency)}
+	if r.Interval > 1 {
+		parts = append(parts, "INTERVAL="+strconv.Itoa(r.Interval))
+	}
+	if len(r.ByDay) > 0 {
+		days := make([]string, len(r.ByDay))
+		for i, d := range r.ByDay {
+			days[i] = d.String()
+		}
+		parts = append(parts, "BYDAY="+strings.Join(days, ","))
+	}
+	if len(r.ByMonthDay) > 0 {
+		days := make([]string, len(r.ByMonthDay))
+		for i, d := range r.ByMonthDay {
+			days[i] = strconv.Itoa(d)
+		}
+		parts = append(parts, "BYMONTHDAY="+strings.Join(days, ","))
+	}
+	if r.Count > 0 {
+		parts = append(parts, "COUNT="+strconv.Itoa(r.Count))
+	}
+	if r.Until != nil {
+		parts = append(parts, "UNTIL="+r.Until.UTC().Format("20060102T150405Z"))
+	}
+	return strings.Join(parts, ";")
+}
+
+// Each calls fn for every occurrence of the series starting at start, in order, until fn returns false
+// or the series ends. The start itself is always the first occurrence.
+func (r Recurrence) Each(start time.Time, fn func(occurrence time.Time) bool) {
+	emitted := 0
+	emit := func(t time.Time) bool {
+		if r.Until != nil && t.After(*r.Until) {
+			return false
+		}
+		if r.Count > 0 && emitted >= r.Count {
+			return false
+		}
+		emitted++
+		return fn(t)
+	}
+
+	if !emit(start) {
+		return
+	}
+	for period := 0; period < maxRecurrencePeriods; period++ {
+		for _, candidate := range r.candidates(start, period*r.interval()) {
+			if !candidate.After(start) {
+				continue
+			}
+			if !emit(candidate) {
+				return
+			}
+		}
+	}
+}
+
+// After returns the first occurrence strictly after t
+func (r Recurrence) After(start, t time.Time) (time.Time, bool) {
+	var next time.Time
+	found := false
+	r.Each(start, func(occurrence time.Time) bool {
+		if occurrence.After(t) {
+			next, found = occurrence, true
+			return false
+		}
+		return true
+	})
+	return next, found
+}
+
+// Between returns at most limit occurrences within [from, to]
+func (r Recurrence) Between(start, from, to time.Time, limit int) []time.Time {
+	occurrences := []time.Time{}
+	r.Each(start, func(occurrence time.Time) bool {
+		if occurrence.After(to) || len(occurrences) >= limit {
+			return false
+		}
+		if !occurrence.Before(from) {
+			occurrences = append(occurrences, occurrence)
+		}
+		return true
+	})
+	return occurrences
+}
+
+// Advance returns the rule for the series continuing after its first occurrence,
+// so that a follow-up task can be anchored on the next occurrence.
+// It returns false when the first occurrence was the last one.
+func (r Recurrence) Advance() (Recurrence, bool) {
+	if r.Count == 1 {
+		return r, false
+	}
+	if r.Count > 1 {
+		r.Count--
+	}
+	return r, true
+}
+
+func (r Recurrence) interval() int {
+	if r.Interval < 1 {
+		return 1
+	}
+	return r.Interval
+}
+
+// candidates returns the sorted occurrences generated by the rule in the period offset from start's period
+func (r Recurrence) candidates(start time.Time, offset int) []time.Time {
+	hour, min, sec := start.Clock()
+	at := func(y int, m time.Month, d int) time.Time {
+		return time.Date(y, m, d, hour, min, sec, start.Nanosecond(), start.Location())
+	}
+
+	switch r.Frequency {
+	case FrequencyDaily:
+		return []time.Time{start.AddDate(0, 0, offset)}
+
+	case FrequencyWeekly:
+		// Weeks start on Monday (RRULE default WKST=MO)
+		weekStart := start.AddDate(0, 0, -((int(start.Weekday())+6)%7)+7*offset)
+		if len(r.ByDay) == 0 {
+			return []time.Time{start.AddDate(0, 0, 7*offset)}
+		}
+		var result []time.Time
+		for _, d := range r.ByDay {
+			result = append(result, weekStart.AddDate(0, 0, (int(d.Day)+6)%7))
+		}
+		return sortUnique(result)
+
+	case FrequencyMonthly:
+		first := time.Date(start.Year(), start.Month()+time.Month(offset), 1, 0, 0, 0, 0, start.Location())
+		year, month := first.Year(), first.Month()
+		daysInMonth := time.Date(year, month+1, 0, 0, 0, 0, 0, start.Location()).Day()
+
+		var result []time.Time
+		switch {
+		case len(r.ByDay) > 0:
+			for _, d := range r.ByDay {
+				if day := nthWeekdayOfMonth(year, month, daysInMonth, d, start.Location()); day > 0 {
+					result = append(result, at(year, month, day))
+				}
+			}
+		case len(r.ByMonthDay) > 0:
+			for _, d := range r.ByMonthDay {
+				if d < 0 {
+					d = daysInMonth + d + 1
+				}
+				if d >= 1 && d <= daysInMonth {
+					result = append(result, at(year, month, d))
+				}
+			}
+		default:
+			// Months without the start's day (e.g. the 31st) are skipped
+			if start.Day() <= daysInMonth {
+				result = append(result, at(year, month, start.Day()))
+			}
+		}
+		return sortUnique(result)
+	}
+	return nil
+}
+
+// nthWeekdayOfMonth returns the day of month of the nth weekday, or 0 if the month has no such day
+func nthWeekdayOfMonth(year int, month time.Month, daysInMonth int, d WeekdayNum, loc *time.Location) int {
+	if d.N > 0 {
+		firstWeekday := time.Date(year, month, 1, 0, 0, 0, 0, loc).Weekday()
+		day := 1 + (int(d.Day)-int(firstWeekday)+7)%7 + 7*(d.N-1)
+		if day > daysInMonth {
+			return 0
+		}
+		return day
+	}
+	lastWeekday := time.Date(year, month, daysInMonth, 0, 0, 0, 0, loc).Weekday()
+	day := daysInMonth - (int(lastWeekday)-int(d.Day)+7)%7 + 7*(d.N+1)
+	if day < 1 {
+		return 0
+	}
+	return day
+}
+
+func sortUnique(times []time.Time) []time.Time {
+	sort.Slice(times, func(i, j int) bool { return times[i].Before(times[j]) })
+	result := times[:0]
+	for i, t := range times {
+		if i == 0 || !t.Equal(times[i-1]) {
+			result = append(result, t)
+		}
+	}
+	return result
+}
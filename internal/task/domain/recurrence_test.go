@@ -0,0 +1,188 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 9, 0, 0, 0, time.UTC)
+}
+
+func TestParseRecurrence(t *testing.T) {
+	tests := []struct {
+		name    string
+		rule    string
+		want    string
+		wantErr bool
+	}{
+		{name: "daily", rule: "FREQ=DAILY", want: "FREQ=DAILY"},
+		{name: "rrule prefix and lower case", rule: "RRULE:freq=weekly;byday=mo,we", want: "FREQ=WEEKLY;BYDAY=MO,WE"},
+		{name: "monthly with count", rule: "FREQ=MONTHLY;BYMONTHDAY=-1;COUNT=3", want: "FREQ=MONTHLY;BYMONTHDAY=-1;COUNT=3"},
+		{name: "until date", rule: "FREQ=DAILY;UNTIL=20250110", want: "FREQ=DAILY;UNTIL=20250110T235959Z"},
+		{name: "missing freq", rule: "INTERVAL=2", wantErr: true},
+		{name: "unsupported freq", rule: "FREQ=YEARLY", wantErr: true},
+		{name: "count and until together", rule: "FREQ=DAILY;COUNT=2;UNTIL=20250110", wantErr: true},
+		{name: "ordinal weekday on weekly rule", rule: "FREQ=WEEKLY;BYDAY=2MO", wantErr: true},
+		{name: "invalid month day", rule: "FREQ=MONTHLY;BYMONTHDAY=32", wantErr: true},
+		{name: "zero interval", rule: "FREQ=DAILY;INTERVAL=0", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := ParseRecurrence(tt.rule)
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidRecurrence) {
+					t.Fatalf("ParseRecurrence(%q) error = %v, want ErrInvalidRecurrence", tt.rule, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseRecurrence(%q) unexpected error: %v", tt.rule, err)
+			}
+			if got := r.String(); got != tt.want {
+				t.Errorf("String() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRecurrenceBetween(t *testing.T) {
+	tests := []struct {
+		name  string
+		rule  string
+		start time.Time
+		from  time.Time
+		to    time.Time
+		want  []time.Time
+	}{
+		{
+			name:  "every other day",
+			rule:  "FREQ=DAILY;INTERVAL=2",
+			start: date(2025, 1, 1),
+			from:  date(2025, 1, 1),
+			to:    date(2025, 1, 7),
+			want:  []time.Time{date(2025, 1, 1), date(2025, 1, 3), date(2025, 1, 5), date(2025, 1, 7)},
+		},
+		{
+			name:  "weekly on monday and thursday",
+			rule:  "FREQ=WEEKLY;BYDAY=MO,TH",
+			start: date(2025, 1, 6), // Monday
+			from:  date(2025, 1, 6),
+			to:    date(2025, 1, 16),
+			want:  []time.Time{date(2025, 1, 6), date(2025, 1, 9), date(2025, 1, 13), date(2025, 1, 16)},
+		},
+		{
+			name:  "last day of the month",
+			rule:  "FREQ=MONTHLY;BYMONTHDAY=-1",
+			start: date(2025, 1, 31),
+			from:  date(2025, 1, 1),
+			to:    date(2025, 4, 30),
+			want:  []time.Time{date(2025, 1, 31), date(2025, 2, 28), date(2025, 3, 31), date(2025, 4, 30)},
+		},
+		{
+			name:  "second monday of the month",
+			rule:  "FREQ=MONTHLY;BYDAY=2MO",
+			start: date(2025, 1, 13),
+			from:  date(2025, 1, 1),
+			to:    date(2025, 3, 31),
+			want:  []time.Time{date(2025, 1, 13), date(2025, 2, 10), date(2025, 3, 10)},
+		},
+		{
+			name:  "count limits the series",
+			rule:  "FREQ=DAILY;COUNT=3",
+			start: date(2025, 1, 1),
+			from:  date(2025, 1, 1),
+			to:    date(2025, 1, 31),
+			want:  []time.Time{date(2025, 1, 1), date(2025, 1, 2), date(2025, 1, 3)},
+		},
+		{
+			name:  "until limits the series",
+			rule:  "FREQ=WEEKLY;UNTIL=20250114",
+			start: date(2025, 1, 1),
+			from:  date(2025, 1, 1),
+			to:    date(2025, 2, 28),
+			want:  []time.Time{date(2025, 1, 1), date(2025, 1, 8)},
+		},
+		{
+			name:  "window later in the series",
+			rule:  "FREQ=DAILY",
+			start: date(2025, 1, 1),
+			from:  date(2025, 6, 1),
+			to:    date(2025, 6, 2),
+			want:  []time.Time{date(2025, 6, 1), date(2025, 6, 2)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := ParseRecurrence(tt.rule)
+			if err != nil {
+				t.Fatalf("ParseRecurrence(%q) unexpected error: %v", tt.rule, err)
+			}
+			got := r.Between(tt.start, tt.from, tt.to, 100)
+			if len(got) != len(tt.want) {
+				t.Fatalf("Between() = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if !got[i].Equal(tt.want[i]) {
+					t.Errorf("Between()[%d] = %v, want %v", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestTaskRecurrence(t *testing.T) {
+	t.Run("when rule is set without target date, Then returns error", func(t *testing.T) {
+		task := &Task{TaskName: "Dish Wash"}
+		if err := task.SetRecurrence("FREQ=DAILY"); !errors.Is(err, ErrInvalidRecurrence) {
+			t.Errorf("SetRecurrence() error = %v, want ErrInvalidRecurrence", err)
+		}
+	})
+
+	t.Run("when occurrence is completed, Then next instance carries the advanced rule", func(t *testing.T) {
+		// Arrange
+		task := &Task{TaskId: "task-1", TaskName: "Dish Wash", TargetDate: date(2025, 1, 1)}
+		if err := task.SetRecurrence("FREQ=DAILY;INTERVAL=2;COUNT=3"); err != nil {
+			t.Fatalf("SetRecurrence() unexpected error: %v", err)
+		}
+
+		// Act
+		next, err := task.NextOccurrence()
+
+		// Assert
+		if err != nil || next == nil {
+			t.Fatalf("NextOccurrence() = %v, %v", next, err)
+		}
+		if task.IsRecurring() {
+			t.Errorf("completed instance should no longer carry the rule")
+		}
+		if next.TaskId == task.TaskId || next.TaskName != task.TaskName || next.IsCompleted {
+			t.Errorf("unexpected next instance: %+v", next)
+		}
+		if !next.TargetDate.Equal(date(2025, 1, 3)) {
+			t.Errorf("next target date = %v, want %v", next.TargetDate, date(2025, 1, 3))
+		}
+		if next.RecurrenceRule != "FREQ=DAILY;INTERVAL=2;COUNT=2" {
+			t.Errorf("next rule = %q", next.RecurrenceRule)
+		}
+	})
+
+	t.Run("when last occurrence of the series is completed, Then no next instance", func(t *testing.T) {
+		task := &Task{TaskName: "Dish Wash", TargetDate: date(2025, 1, 1), RecurrenceRule: "FREQ=DAILY;COUNT=1"}
+		next, err := task.NextOccurrence()
+		if err != nil || next != nil {
+			t.Errorf("NextOccurrence() = %v, %v, want nil, nil", next, err)
+		}
+	})
+
+	t.Run("when task is one-off, Then occurrences contain only its target date", func(t *testing.T) {
+		task := &Task{TaskName: "Dish Wash", TargetDate: date(2025, 1, 5)}
+		got, err := task.Occurrences(date(2025, 1, 1), date(2025, 1, 31), 10)
+		if err != nil || len(got) != 1 || !got[0].Equal(task.TargetDate) {
+			t.Errorf("Occurrences() = %v, %v", got, err)
+		}
+	})
+}
@@ -2,13 +2,23 @@ package domain
 
 import (
 	"errors"
+	"fmt"
+	"slices"
 	"strings"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/happYness-Project/taskManagementGolang/pkg/events"
+)
+
+var (
+	ErrInvalidAssignee = errors.New("assignee must be a valid user id")
+	ErrNotInTrash      = errors.New("task is not in the trash")
 )
 
 type Task struct {
+	events.Aggregate `json:"-"`
+
 	TaskId      string    `json:"id"`
 	TaskName    string    `json:"name"`
 	TaskDesc    string    `json:"description"`
@@ -16,34 +26,30 @@ type Task struct {
 	CreatedAt   time.Time `json:"created_at"`
 	UpdatedAt   time.Time `json:"updated_at"`
 	TargetDate  time.Time `json:"target_date"`
-	Priority    string    `json:"priority"`
-	Category    string    `json:"category"`
+	Priority    Priority  `json:"priority"`
 	IsCompleted bool      `json:"is_completed"`
 	IsImportant bool      `json:"is_important"`
-}
 
-func CreateTask(name, description string, targetDate time.Time, priority, category string) (*Task, error) {
-	// Domain validation
-	if strings.TrimSpace(name) == "" {
-		return nil, errors.New("task name cannot be empty")
-	}
+	RecurrenceRule string          `json:"recurrence_rule,omitempty"`
+	AssigneeId     string          `json:"assignee_id,omitempty"` // UUID of the assigned user, empty when unassigned
+	Checklist      []ChecklistItem `json:"checklist,omitempty"`
+	Labels         []Label         `json:"labels,omitempty"`
 
-	if len(name) > 255 {
-		return nil, errors.New("task name cannot exceed 255 characters")
-	}
+	// Version counts the changes to the task; it is the task's ETag
+	Version int `json:"version"`
+	// DeletedAt is set while the task is in the trash
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+}
 
-	// Validate priority
-	validPriorities := map[string]bool{
-		"low":    true,
-		"medium": true,
-		"high":   true,
-		"urgent": true,
+func CreateTask(name, description string, targetDate time.Time, priority string) (*Task, error) {
+	// Domain validation
+	if err := validateTaskName(name); err != nil {
+		return nil, err
 	}
 
-	if priority == "" || !validPriorities[strings.ToLower(priority)] {
-		priority = "medium" // Default to medium if empty or invalid
-	} else {
-		priority = strings.ToLower(priority)
+	parsedPriority, err := NewPriority(priority)
+	if err != nil {
+		return nil, err
 	}
 
 	now := time.Now().UTC()
@@ -56,60 +62,226 @@ func CreateTask(name, description string, targetDate time.Time, priority, catego
 		CreatedAt:   now,
 		UpdatedAt:   now,
 		TargetDate:  targetDate,
-		Priority:    priority,
-		Category:    category,
+		Priority:    parsedPriority,
 		IsCompleted: false,
 		IsImportant: false,
+		Version:     1,
 	}
+	task.raiseCreated()
 
 	return task, nil
 }
 
 // UpdateTask updates task details with domain validation
-func (t *Task) UpdateTask(name, description string, targetDate time.Time, priority, category string) error {
+func (t *Task) UpdateTask(name, description string, targetDate time.Time, priority string) error {
 	// Validate name
-	if strings.TrimSpace(name) == "" {
-		return errors.New("task name cannot be empty")
-	}
-
-	if len(name) > 255 {
-		return errors.New("task name cannot exceed 255 characters")
+	if err := validateTaskName(name); err != nil {
+		return err
 	}
 
 	// Validate and normalize priority
-	validPriorities := map[string]bool{
-		"low":    true,
-		"medium": true,
-		"high":   true,
-		"urgent": true,
-	}
-
-	normalizedPriority := priority
-	if priority == "" || !validPriorities[strings.ToLower(priority)] {
-		normalizedPriority = "medium" // Default to medium if empty or invalid
-	} else {
-		normalizedPriority = strings.ToLower(priority)
+	parsedPriority, err := NewPriority(priority)
+	if err != nil {
+		return err
 	}
 
 	// Update fields
 	t.TaskName = strings.TrimSpace(name)
 	t.TaskDesc = strings.TrimSpace(description)
 	t.TargetDate = targetDate
-	t.Priority = normalizedPriority
-	t.Category = category
+	t.Priority = parsedPriority
 	t.UpdatedAt = time.Now().UTC()
+	t.raiseUpdated()
 
 	return nil
 }
 
-// ToggleCompletion toggles the completion status
+// SetRecurrence attaches an RRULE to the task, anchored on its target date. An empty rule makes the task one-off.
+func (t *Task) SetRecurrence(rule string) error {
+	normalized, err := normalizeRecurrence(rule, t.TargetDate)
+	if err != nil {
+		return err
+	}
+	t.RecurrenceRule = normalized
+	return nil
+}
+
+// normalizeRecurrence validates an RRULE against the target date it is anchored on
+func normalizeRecurrence(rule string, targetDate time.Time) (string, error) {
+	if strings.TrimSpace(rule) == "" {
+		return "", nil
+	}
+	if targetDate.IsZero() {
+		return "", fmt.Errorf("%w: recurring task requires a target date", ErrInvalidRecurrence)
+	}
+
+	recurrence, err := ParseRecurrence(rule)
+	if err != nil {
+		return "", err
+	}
+	return recurrence.String(), nil
+}
+
+// IsRecurring reports whether the task has a recurrence rule
+func (t *Task) IsRecurring() bool {
+	return t.RecurrenceRule != ""
+}
+
+// Occurrences returns at most limit occurrences of the task within [from, to] without materialising them.
+// A one-off task has a single occurrence on its target date.
+func (t *Task) Occurrences(from, to time.Time, limit int) ([]time.Time, error) {
+	if !t.IsRecurring() {
+		if t.TargetDate.IsZero() || t.TargetDate.Before(from) || t.TargetDate.After(to) || limit < 1 {
+			return []time.Time{}, nil
+		}
+		return []time.Time{t.TargetDate}, nil
+	}
+
+	recurrence, err := ParseRecurrence(t.RecurrenceRule)
+	if err != nil {
+		return nil, err
+	}
+	return recurrence.Between(t.TargetDate, from, to, limit), nil
+}
+
+// NextOccurrence creates the task instance that follows this one in its series, or returns nil when the series has ended.
+// The rule moves to the new instance, so completing this one again does not create another follow-up.
+// The new instance starts with an unchecked copy of the checklist.
+func (t *Task) NextOccurrence() (*Task, error) {
+	if !t.IsRecurring() {
+		return nil, nil
+	}
+	recurrence, err := ParseRecurrence(t.RecurrenceRule)
+	if err != nil {
+		return nil, err
+	}
+	t.RecurrenceRule = ""
+	t.UpdatedAt = time.Now().UTC()
+
+	nextDate, ok := recurrence.After(t.TargetDate, t.TargetDate)
+	if !ok {
+		return nil, nil
+	}
+	remaining, ok := recurrence.Advance()
+	if !ok {
+		return nil, nil
+	}
+
+	now := time.Now().UTC()
+	nextId := uuid.New().String()
+	next := &Task{
+		TaskId:         nextId,
+		TaskName:       t.TaskName,
+		TaskDesc:       t.TaskDesc,
+		TaskType:       t.TaskType,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+		TargetDate:     nextDate,
+		Priority:       t.Priority,
+		IsCompleted:    false,
+		IsImportant:    t.IsImportant,
+		RecurrenceRule: remaining.String(),
+		AssigneeId:     t.AssigneeId,
+		Checklist:      t.copyChecklist(nextId, now),
+		Labels:         slices.Clone(t.Labels),
+		Version:        1,
+	}
+	next.raiseCreated()
+	return next, nil
+}
+
+// ToggleCompletion toggles the completion status, raising TaskCompleted when the task becomes completed
+// and TaskReopened when it stops being completed
 func (t *Task) ToggleCompletion(isCompleted bool) {
+	switch {
+	case isCompleted && !t.IsCompleted:
+		t.Raise(TaskCompleted{TaskId: t.TaskId, TaskName: t.TaskName, AssigneeId: t.AssigneeId})
+	case !isCompleted && t.IsCompleted:
+		t.Raise(TaskReopened{TaskId: t.TaskId, TaskName: t.TaskName})
+	}
 	t.IsCompleted = isCompleted
 	t.UpdatedAt = time.Now().UTC()
 }
 
-// ToggleImportant toggles the important status
+// ToggleImportant toggles the important status, raising TaskUpdated when it changes
 func (t *Task) ToggleImportant(isImportant bool) {
+	changed := t.IsImportant != isImportant
 	t.IsImportant = isImportant
 	t.UpdatedAt = time.Now().UTC()
+	if changed {
+		t.raiseUpdated()
+	}
+}
+
+// AssignTo makes the given user responsible for the task
+func (t *Task) AssignTo(userId string) error {
+	if _, err := uuid.Parse(userId); err != nil {
+		return fmt.Errorf("%w: '%s'", ErrInvalidAssignee, userId)
+	}
+	t.AssigneeId = userId
+	t.UpdatedAt = time.Now().UTC()
+	t.raiseUpdated()
+	return nil
+}
+
+// Unassign removes the assignee from the task
+func (t *Task) Unassign() {
+	t.AssigneeId = ""
+	t.UpdatedAt = time.Now().UTC()
+	t.raiseUpdated()
+}
+
+// Delete moves the task to the trash, raising TaskDeleted
+func (t *Task) Delete() {
+	deletedAt := time.Now().UTC()
+	t.DeletedAt = &deletedAt
+	t.Raise(TaskDeleted{TaskId: t.TaskId, TaskName: t.TaskName})
+}
+
+// Restore takes the task back out of the trash, raising TaskRestored
+func (t *Task) Restore() error {
+	if t.DeletedAt == nil {
+		return ErrNotInTrash
+	}
+	t.DeletedAt = nil
+	t.Raise(TaskRestored{TaskId: t.TaskId, TaskName: t.TaskName})
+	return nil
+}
+
+// MoveTo records that the task leaves one container for another, raising TaskMoved
+func (t *Task) MoveTo(fromContainerId string, toContainerId string) {
+	t.Raise(TaskMoved{TaskId: t.TaskId, TaskName: t.TaskName, FromContainerId: fromContainerId, ToContainerId: toContainerId})
+}
+
+// Copy returns a new, open task with the same details and an unchecked copy of the checklist, raising TaskCreated
+// on it. The assignee is carried over only when keepAssignee is set, e.g. when they can see the target container.
+func (t *Task) Copy(keepAssignee bool) *Task {
+	now := time.Now().UTC()
+	copyId := uuid.New().String()
+	copied := &Task{
+		TaskId:         copyId,
+		TaskName:       t.TaskName,
+		TaskDesc:       t.TaskDesc,
+		TaskType:       t.TaskType,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+		TargetDate:     t.TargetDate,
+		Priority:       t.Priority,
+		IsCompleted:    false,
+		IsImportant:    t.IsImportant,
+		RecurrenceRule: t.RecurrenceRule,
+		Checklist:      t.copyChecklist(copyId, now),
+		Labels:         slices.Clone(t.Labels),
+		Version:        1,
+	}
+	if keepAssignee {
+		copied.AssigneeId = t.AssigneeId
+	}
+	copied.raiseCreated()
+	return copied
+}
+
+// IsAssigned reports whether the task has an assignee
+func (t *Task) IsAssigned() bool {
+	return t.AssigneeId != ""
 }
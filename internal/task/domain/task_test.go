@@ -1,6 +1,7 @@
 package domain
 
 import (
+	"errors"
 	"strings"
 	"testing"
 	"time"
@@ -13,7 +14,6 @@ func TestCreateTask(t *testing.T) {
 		description string
 		targetDate  time.Time
 		priority    string
-		category    string
 		wantErr     bool
 		errMsg      string
 	}{
@@ -23,7 +23,6 @@ func TestCreateTask(t *testing.T) {
 			description: "Test Description",
 			targetDate:  time.Now().Add(24 * time.Hour),
 			priority:    "high",
-			category:    "work",
 			wantErr:     false,
 		},
 		{
@@ -32,7 +31,6 @@ func TestCreateTask(t *testing.T) {
 			description: "Test Description",
 			targetDate:  time.Now().Add(24 * time.Hour),
 			priority:    "medium",
-			category:    "work",
 			wantErr:     true,
 			errMsg:      "task name cannot be empty",
 		},
@@ -42,7 +40,6 @@ func TestCreateTask(t *testing.T) {
 			description: "Test Description",
 			targetDate:  time.Now().Add(24 * time.Hour),
 			priority:    "medium",
-			category:    "work",
 			wantErr:     true,
 			errMsg:      "task name cannot be empty",
 		},
@@ -52,18 +49,17 @@ func TestCreateTask(t *testing.T) {
 			description: "Test Description",
 			targetDate:  time.Now().Add(24 * time.Hour),
 			priority:    "medium",
-			category:    "work",
 			wantErr:     true,
 			errMsg:      "task name cannot exceed 255 characters",
 		},
 		{
-			name:        "invalid priority defaults to medium",
+			name:        "invalid priority should fail",
 			taskName:    "Test Task",
 			description: "Test Description",
 			targetDate:  time.Now().Add(24 * time.Hour),
-			priority:    "invalid",
-			category:    "work",
-			wantErr:     false,
+			priority:    "normal",
+			wantErr:     true,
+			errMsg:      `invalid priority: must be one of low, medium, high or urgent, got "normal"`,
 		},
 		{
 			name:        "empty priority defaults to medium",
@@ -71,7 +67,6 @@ func TestCreateTask(t *testing.T) {
 			description: "Test Description",
 			targetDate:  time.Now().Add(24 * time.Hour),
 			priority:    "",
-			category:    "work",
 			wantErr:     false,
 		},
 		{
@@ -80,7 +75,6 @@ func TestCreateTask(t *testing.T) {
 			description: "Test Description",
 			targetDate:  time.Now().Add(24 * time.Hour),
 			priority:    "LOW",
-			category:    "work",
 			wantErr:     false,
 		},
 		{
@@ -89,14 +83,13 @@ func TestCreateTask(t *testing.T) {
 			description: "Test Description",
 			targetDate:  time.Now().Add(24 * time.Hour),
 			priority:    "URGENT",
-			category:    "work",
 			wantErr:     false,
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			task, err := CreateTask(tt.taskName, tt.description, tt.targetDate, tt.priority, tt.category)
+			task, err := CreateTask(tt.taskName, tt.description, tt.targetDate, tt.priority)
 
 			if tt.wantErr {
 				if err == nil {
@@ -140,10 +133,6 @@ func TestCreateTask(t *testing.T) {
 				t.Errorf("CreateTask() TargetDate = %v, want %v", task.TargetDate, tt.targetDate)
 			}
 
-			if task.Category != tt.category {
-				t.Errorf("CreateTask() Category = %v, want %v", task.Category, tt.category)
-			}
-
 			if task.IsCompleted != false {
 				t.Errorf("CreateTask() IsCompleted = %v, want false", task.IsCompleted)
 			}
@@ -154,11 +143,11 @@ func TestCreateTask(t *testing.T) {
 
 			// Verify priority handling
 			expectedPriority := strings.ToLower(tt.priority)
-			if tt.priority == "" || (tt.priority != "low" && tt.priority != "medium" && tt.priority != "high" && tt.priority != "urgent" && strings.ToLower(tt.priority) != "low" && strings.ToLower(tt.priority) != "medium" && strings.ToLower(tt.priority) != "high" && strings.ToLower(tt.priority) != "urgent") {
+			if tt.priority == "" {
 				expectedPriority = "medium"
 			}
 
-			if task.Priority != expectedPriority {
+			if task.Priority != Priority(expectedPriority) {
 				t.Errorf("CreateTask() Priority = %v, want %v", task.Priority, expectedPriority)
 			}
 
@@ -190,7 +179,7 @@ func TestCreateTask_PriorityValidation(t *testing.T) {
 
 	for _, priority := range validPriorities {
 		t.Run("priority_"+priority, func(t *testing.T) {
-			task, err := CreateTask("Test Task", "Description", time.Now().Add(time.Hour), priority, "work")
+			task, err := CreateTask("Test Task", "Description", time.Now().Add(time.Hour), priority)
 
 			if err != nil {
 				t.Errorf("CreateTask() with priority %s failed: %v", priority, err)
@@ -198,7 +187,7 @@ func TestCreateTask_PriorityValidation(t *testing.T) {
 			}
 
 			expectedPriority := strings.ToLower(priority)
-			if task.Priority != expectedPriority {
+			if task.Priority != Priority(expectedPriority) {
 				t.Errorf("CreateTask() Priority = %v, want %v", task.Priority, expectedPriority)
 			}
 		})
@@ -212,7 +201,6 @@ func TestUpdateTask(t *testing.T) {
 		description string
 		targetDate  time.Time
 		priority    string
-		category    string
 		wantErr     bool
 		errMsg      string
 	}{
@@ -222,7 +210,6 @@ func TestUpdateTask(t *testing.T) {
 			description: "Updated Description",
 			targetDate:  time.Now().Add(48 * time.Hour),
 			priority:    "urgent",
-			category:    "personal",
 			wantErr:     false,
 		},
 		{
@@ -231,7 +218,6 @@ func TestUpdateTask(t *testing.T) {
 			description: "Description",
 			targetDate:  time.Now().Add(24 * time.Hour),
 			priority:    "high",
-			category:    "work",
 			wantErr:     true,
 			errMsg:      "task name cannot be empty",
 		},
@@ -241,7 +227,6 @@ func TestUpdateTask(t *testing.T) {
 			description: "Description",
 			targetDate:  time.Now().Add(24 * time.Hour),
 			priority:    "high",
-			category:    "work",
 			wantErr:     true,
 			errMsg:      "task name cannot be empty",
 		},
@@ -251,18 +236,17 @@ func TestUpdateTask(t *testing.T) {
 			description: "Description",
 			targetDate:  time.Now().Add(24 * time.Hour),
 			priority:    "medium",
-			category:    "work",
 			wantErr:     true,
 			errMsg:      "task name cannot exceed 255 characters",
 		},
 		{
-			name:        "invalid priority defaults to medium",
+			name:        "invalid priority should fail",
 			taskName:    "Updated Task",
 			description: "Description",
 			targetDate:  time.Now().Add(24 * time.Hour),
 			priority:    "invalid",
-			category:    "work",
-			wantErr:     false,
+			wantErr:     true,
+			errMsg:      `invalid priority: must be one of low, medium, high or urgent, got "invalid"`,
 		},
 		{
 			name:        "empty priority defaults to medium",
@@ -270,7 +254,6 @@ func TestUpdateTask(t *testing.T) {
 			description: "Description",
 			targetDate:  time.Now().Add(24 * time.Hour),
 			priority:    "",
-			category:    "work",
 			wantErr:     false,
 		},
 	}
@@ -278,7 +261,7 @@ func TestUpdateTask(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			// Create initial task
-			task, err := CreateTask("Original Task", "Original Description", time.Now().Add(24*time.Hour), "low", "work")
+			task, err := CreateTask("Original Task", "Original Description", time.Now().Add(24*time.Hour), "low")
 			if err != nil {
 				t.Fatalf("Failed to create initial task: %v", err)
 			}
@@ -287,7 +270,7 @@ func TestUpdateTask(t *testing.T) {
 			time.Sleep(10 * time.Millisecond) // Ensure UpdatedAt will change
 
 			// Update the task
-			err = task.UpdateTask(tt.taskName, tt.description, tt.targetDate, tt.priority, tt.category)
+			err = task.UpdateTask(tt.taskName, tt.description, tt.targetDate, tt.priority)
 
 			if tt.wantErr {
 				if err == nil {
@@ -318,17 +301,13 @@ func TestUpdateTask(t *testing.T) {
 				t.Errorf("UpdateTask() TargetDate = %v, want %v", task.TargetDate, tt.targetDate)
 			}
 
-			if task.Category != tt.category {
-				t.Errorf("UpdateTask() Category = %v, want %v", task.Category, tt.category)
-			}
-
 			// Verify priority handling
 			expectedPriority := strings.ToLower(tt.priority)
-			if tt.priority == "" || (strings.ToLower(tt.priority) != "low" && strings.ToLower(tt.priority) != "medium" && strings.ToLower(tt.priority) != "high" && strings.ToLower(tt.priority) != "urgent") {
+			if tt.priority == "" {
 				expectedPriority = "medium"
 			}
 
-			if task.Priority != expectedPriority {
+			if task.Priority != Priority(expectedPriority) {
 				t.Errorf("UpdateTask() Priority = %v, want %v", task.Priority, expectedPriority)
 			}
 
@@ -368,7 +347,7 @@ func TestToggleCompletion(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			// Create task
-			task, err := CreateTask("Test Task", "Description", time.Now().Add(24*time.Hour), "medium", "work")
+			task, err := CreateTask("Test Task", "Description", time.Now().Add(24*time.Hour), "medium")
 			if err != nil {
 				t.Fatalf("Failed to create task: %v", err)
 			}
@@ -426,7 +405,7 @@ func TestToggleImportant(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			// Create task
-			task, err := CreateTask("Test Task", "Description", time.Now().Add(24*time.Hour), "medium", "work")
+			task, err := CreateTask("Test Task", "Description", time.Now().Add(24*time.Hour), "medium")
 			if err != nil {
 				t.Fatalf("Failed to create task: %v", err)
 			}
@@ -472,7 +451,7 @@ func TestToggleImportant(t *testing.T) {
 }
 
 func TestUpdateTask_PriorityNormalization(t *testing.T) {
-	task, _ := CreateTask("Test Task", "Description", time.Now().Add(24*time.Hour), "medium", "work")
+	task, _ := CreateTask("Test Task", "Description", time.Now().Add(24*time.Hour), "medium")
 
 	tests := []struct {
 		name             string
@@ -484,26 +463,32 @@ func TestUpdateTask_PriorityNormalization(t *testing.T) {
 		{"uppercase HIGH", "HIGH", "high"},
 		{"uppercase URGENT", "URGENT", "urgent"},
 		{"mixed case Low", "Low", "low"},
-		{"invalid priority", "invalid", "medium"},
 		{"empty priority", "", "medium"},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			err := task.UpdateTask("Test Task", "Description", time.Now().Add(24*time.Hour), tt.priority, "work")
+			err := task.UpdateTask("Test Task", "Description", time.Now().Add(24*time.Hour), tt.priority)
 			if err != nil {
 				t.Fatalf("UpdateTask() failed: %v", err)
 			}
 
-			if task.Priority != tt.expectedPriority {
+			if task.Priority != Priority(tt.expectedPriority) {
 				t.Errorf("UpdateTask() Priority = %v, want %v", task.Priority, tt.expectedPriority)
 			}
 		})
 	}
+
+	t.Run("invalid priority", func(t *testing.T) {
+		err := task.UpdateTask("Test Task", "Description", time.Now().Add(24*time.Hour), "normal")
+		if !errors.Is(err, ErrInvalidPriority) {
+			t.Errorf("UpdateTask() error = %v, want ErrInvalidPriority", err)
+		}
+	})
 }
 
 func TestToggleCompletion_MultipleToggles(t *testing.T) {
-	task, err := CreateTask("Test Task", "Description", time.Now().Add(24*time.Hour), "medium", "work")
+	task, err := CreateTask("Test Task", "Description", time.Now().Add(24*time.Hour), "medium")
 	if err != nil {
 		t.Fatalf("Failed to create task: %v", err)
 	}
@@ -533,7 +518,7 @@ func TestToggleCompletion_MultipleToggles(t *testing.T) {
 }
 
 func TestToggleImportant_MultipleToggles(t *testing.T) {
-	task, err := CreateTask("Test Task", "Description", time.Now().Add(24*time.Hour), "medium", "work")
+	task, err := CreateTask("Test Task", "Description", time.Now().Add(24*time.Hour), "medium")
 	if err != nil {
 		t.Fatalf("Failed to create task: %v", err)
 	}
@@ -560,4 +545,32 @@ func TestToggleImportant_MultipleToggles(t *testing.T) {
 	if !task.IsImportant {
 		t.Errorf("After second ToggleImportant(true), IsImportant should be true")
 	}
-}
\ No newline at end of file
+}
+
+func TestAssignTo(t *testing.T) {
+	task, err := CreateTask("Test Task", "Description", time.Now().Add(24*time.Hour), "medium")
+	if err != nil {
+		t.Fatalf("Failed to create task: %v", err)
+	}
+
+	// Invalid user id should fail and leave the task unassigned
+	if err := task.AssignTo("not-a-uuid"); !errors.Is(err, ErrInvalidAssignee) {
+		t.Errorf("AssignTo(invalid) error = %v, want ErrInvalidAssignee", err)
+	}
+	if task.IsAssigned() {
+		t.Errorf("Task should not be assigned after invalid AssignTo")
+	}
+
+	userId := "01959b38-b3f9-7ec5-8ac8-e353bfe08a2d"
+	if err := task.AssignTo(userId); err != nil {
+		t.Fatalf("AssignTo() unexpected error: %v", err)
+	}
+	if task.AssigneeId != userId || !task.IsAssigned() {
+		t.Errorf("AssigneeId = %q, want %q", task.AssigneeId, userId)
+	}
+
+	task.Unassign()
+	if task.IsAssigned() {
+		t.Errorf("Task should not be assigned after Unassign")
+	}
+}
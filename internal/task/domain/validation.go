@@ -0,0 +1,51 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrInvalidTask is wrapped by every ValidationError
+var ErrInvalidTask = errors.New("invalid task")
+
+// FieldError rejects a single field of a task change.
+// Err keeps the typed error the field was rejected with, if any, for errors.Is.
+type FieldError struct {
+	Field   string
+	Message string
+	Err     error
+}
+
+// ValidationError lists every field a task change was rejected for
+type ValidationError struct {
+	Fields []FieldError
+}
+
+func (e *ValidationError) Error() string {
+	messages := make([]string, len(e.Fields))
+	for i, field := range e.Fields {
+		messages[i] = field.Field + ": " + field.Message
+	}
+	return fmt.Sprintf("%s: %s", ErrInvalidTask, strings.Join(messages, "; "))
+}
+
+func (e *ValidationError) Unwrap() []error {
+	errs := []error{ErrInvalidTask}
+	for _, field := range e.Fields {
+		if field.Err != nil {
+			errs = append(errs, field.Err)
+		}
+	}
+	return errs
+}
+
+func validateTaskName(name string) error {
+	if strings.TrimSpace(name) == "" {
+		return errors.New("task name cannot be empty")
+	}
+	if len(name) > 255 {
+		return errors.New("task name cannot exceed 255 characters")
+	}
+	return nil
+}
@@ -0,0 +1,152 @@
+package repository
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/happYness-Project/taskManagementGolang/internal/task/domain"
+)
+
+// TaskSortField is a column tasks can be ordered by when listing
+type TaskSortField string
+
+const (
+	SortByTargetDate TaskSortField = "target_date"
+	SortByPriority   TaskSortField = "priority"
+	SortByCreatedAt  TaskSortField = "created_at"
+)
+
+func (f TaskSortField) IsValid() bool {
+	switch f {
+	case SortByTargetDate, SortByPriority, SortByCreatedAt:
+		return true
+	}
+	return false
+}
+
+// TaskFilter narrows a task listing. Nil or empty fields are not applied.
+type TaskFilter struct {
+	IsCompleted *bool
+	IsImportant *bool
+	Priority    string
+	LabelIds    []string // tasks carrying all of them
+	TargetFrom  *time.Time
+	TargetTo    *time.Time
+	AssigneeId  string
+}
+
+// TaskKey is the position of a task within a sorted listing, used for keyset pagination
+type TaskKey struct {
+	SortValue string
+	TaskId    string
+}
+
+// TaskListCriteria describes one page of a task listing.
+// Exactly one of UserId, ContainerId or GroupId scopes the listing. A Limit of 0 lists every matching task.
+type TaskListCriteria struct {
+	UserId      string
+	ContainerId string
+	GroupId     int
+
+	Filter     TaskFilter
+	SortBy     TaskSortField
+	Descending bool
+	After      *TaskKey
+	Limit      int
+}
+
+// KeyOf returns the keyset position of the task for the given sort field.
+// It mirrors the sort expressions used by ListTasks.
+func KeyOf(task domain.Task, sortBy TaskSortField) TaskKey {
+	var value string
+	switch sortBy {
+	case SortByPriority:
+		value = fmt.Sprint(task.Priority.Rank())
+	case SortByCreatedAt:
+		value = formatKeyTime(task.CreatedAt, "-infinity")
+	default:
+		value = formatKeyTime(task.TargetDate, "infinity")
+	}
+	return TaskKey{SortValue: value, TaskId: task.TaskId}
+}
+
+func formatKeyTime(t time.Time, zero string) string {
+	if t.IsZero() {
+		return zero
+	}
+	return t.UTC().Format(time.RFC3339Nano)
+}
+
+// buildListTasksQuery assembles the SQL and arguments for a task listing
+func buildListTasksQuery(c TaskListCriteria) (string, []interface{}, error) {
+	var where []string
+	var args []interface{}
+	arg := func(v interface{}) int {
+		args = append(args, v)
+		return len(args)
+	}
+
+	switch {
+	case c.UserId != "":
+		where = append(where, fmt.Sprintf(sqlListTasksScopeUser, arg(c.UserId)))
+	case c.ContainerId != "":
+		where = append(where, fmt.Sprintf(sqlListTasksScopeContainer, arg(c.ContainerId)))
+	case c.GroupId > 0:
+		where = append(where, fmt.Sprintf(sqlListTasksScopeGroup, arg(c.GroupId)))
+	default:
+		return "", nil, fmt.Errorf("task listing requires a user, container or group scope")
+	}
+	where = append(where, sqlListTasksNotDeleted)
+
+	f := c.Filter
+	if f.IsCompleted != nil {
+		where = append(where, fmt.Sprintf(sqlListTasksFilterCompleted, arg(*f.IsCompleted)))
+	}
+	if f.IsImportant != nil {
+		where = append(where, fmt.Sprintf(sqlListTasksFilterImportant, arg(*f.IsImportant)))
+	}
+	if f.Priority != "" {
+		where = append(where, fmt.Sprintf(sqlListTasksFilterPriority, arg(f.Priority)))
+	}
+	if len(f.LabelIds) > 0 {
+		where = append(where, fmt.Sprintf(sqlListTasksFilterLabels, arg(f.LabelIds), arg(len(f.LabelIds))))
+	}
+	if f.TargetFrom != nil {
+		where = append(where, fmt.Sprintf(sqlListTasksFilterTargetFrom, arg(*f.TargetFrom)))
+	}
+	if f.TargetTo != nil {
+		where = append(where, fmt.Sprintf(sqlListTasksFilterTargetTo, arg(*f.TargetTo)))
+	}
+	if f.AssigneeId != "" {
+		where = append(where, fmt.Sprintf(sqlListTasksFilterAssignee, arg(f.AssigneeId)))
+	}
+
+	sortExpr, sortCast := sortExpression(c.SortBy)
+	direction, comparison := "ASC", ">"
+	if c.Descending {
+		direction, comparison = "DESC", "<"
+	}
+	if c.After != nil {
+		where = append(where, fmt.Sprintf(sqlListTasksAfterKey, sortExpr, comparison, arg(c.After.SortValue), sortCast, arg(c.After.TaskId)))
+	}
+
+	query := sqlListTasksSelect +
+		" WHERE " + strings.Join(where, " AND ") +
+		fmt.Sprintf(sqlListTasksOrderBy, sortExpr, direction, direction)
+	if c.Limit > 0 {
+		query += fmt.Sprintf(sqlListTasksLimit, arg(c.Limit))
+	}
+	return query, args, nil
+}
+
+func sortExpression(sortBy TaskSortField) (string, string) {
+	switch sortBy {
+	case SortByPriority:
+		return sqlTaskSortPriority, "int"
+	case SortByCreatedAt:
+		return sqlTaskSortCreatedAt, "timestamptz"
+	default:
+		return sqlTaskSortTargetDate, "timestamptz"
+	}
+}
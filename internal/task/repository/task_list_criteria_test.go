@@ -0,0 +1,112 @@
+package repository
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/happYness-Project/taskManagementGolang/internal/task/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildListTasksQuery(t *testing.T) {
+	t.Run("when no scope is given, Then return error", func(t *testing.T) {
+		_, _, err := buildListTasksQuery(TaskListCriteria{Limit: 10})
+
+		require.Error(t, err)
+	})
+
+	t.Run("when filters are given, Then each filter is bound as an argument", func(t *testing.T) {
+		important := false
+		from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+		criteria := TaskListCriteria{
+			GroupId: 3,
+			Filter:  TaskFilter{IsImportant: &important, Priority: "high", TargetFrom: &from},
+			SortBy:  SortByPriority,
+			Limit:   11,
+		}
+
+		query, args, err := buildListTasksQuery(criteria)
+
+		require.NoError(t, err)
+		assert.Contains(t, query, "tc.usergroup_id = $1")
+		assert.Contains(t, query, "t.is_important = $2")
+		assert.Contains(t, query, "t.priority = $3")
+		assert.Contains(t, query, "t.target_date >= $4")
+		assert.True(t, strings.HasSuffix(query, "ORDER BY "+sqlTaskSortPriority+" ASC, t.id ASC LIMIT $5"))
+		assert.Equal(t, []interface{}{3, false, "high", from, 11}, args)
+	})
+
+	t.Run("when descending with a cursor, Then seek before the cursor key", func(t *testing.T) {
+		taskId := uuid.New().String()
+		criteria := TaskListCriteria{
+			ContainerId: "container-id",
+			SortBy:      SortByCreatedAt,
+			Descending:  true,
+			After:       &TaskKey{SortValue: "2025-01-01T00:00:00Z", TaskId: taskId},
+			Limit:       5,
+		}
+
+		query, args, err := buildListTasksQuery(criteria)
+
+		require.NoError(t, err)
+		assert.Contains(t, query, "("+sqlTaskSortCreatedAt+", t.id) < (CAST($2::text AS timestamptz), CAST($3::text AS uuid))")
+		assert.Contains(t, query, "DESC, t.id DESC")
+		assert.Equal(t, []interface{}{"container-id", "2025-01-01T00:00:00Z", taskId, 5}, args)
+	})
+
+	t.Run("when no limit is given, Then every matching task is listed", func(t *testing.T) {
+		query, args, err := buildListTasksQuery(TaskListCriteria{GroupId: 3, SortBy: SortByTargetDate})
+
+		require.NoError(t, err)
+		assert.NotContains(t, query, "LIMIT")
+		assert.Equal(t, []interface{}{3}, args)
+	})
+
+	t.Run("when labels are given, Then tasks must carry every one of them", func(t *testing.T) {
+		labelIds := []string{uuid.New().String(), uuid.New().String()}
+		criteria := TaskListCriteria{GroupId: 3, Filter: TaskFilter{LabelIds: labelIds}, Limit: 10}
+
+		query, args, err := buildListTasksQuery(criteria)
+
+		require.NoError(t, err)
+		assert.Contains(t, query, "tl.label_id = ANY(CAST($2 AS uuid[]))")
+		assert.Contains(t, query, "HAVING COUNT(*) = $3")
+		assert.Equal(t, []interface{}{3, labelIds, 2, 10}, args)
+	})
+}
+
+func TestTaskRepo_ListTasks(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+	taskRepo := NewTaskRepository(db)
+
+	criteria := TaskListCriteria{UserId: "user-id", SortBy: SortByTargetDate, Limit: 2}
+	query, _, err := buildListTasksQuery(criteria)
+	require.NoError(t, err)
+	now := time.Now().UTC()
+	rows := sqlmock.NewRows([]string{"id", "name", "description", "type", "created_at", "updated_at", "target_date", "priority", "labels", "is_completed", "is_important", "recurrence_rule", "assignee_id", "version"}).
+		AddRow("task-1", "first", "", "", now, now, now, "high", []byte(`[{"id":"label-1","name":"grocery","color":"#00ff00"}]`), false, true, "", nil, 3)
+	mock.ExpectQuery(query).WithArgs("user-id", 2).WillReturnRows(rows)
+
+	tasks, err := taskRepo.ListTasks(criteria)
+
+	require.NoError(t, err)
+	require.Len(t, tasks, 1)
+	assert.Equal(t, "task-1", tasks[0].TaskId)
+	assert.Equal(t, 3, tasks[0].Version)
+	assert.Equal(t, []domain.Label{{Id: "label-1", Name: "grocery", Color: "#00ff00"}}, tasks[0].Labels)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestKeyOf(t *testing.T) {
+	task := domain.Task{TaskId: "task-1", Priority: domain.PriorityUrgent}
+
+	assert.Equal(t, TaskKey{SortValue: "4", TaskId: "task-1"}, KeyOf(task, SortByPriority))
+	assert.Equal(t, "infinity", KeyOf(task, SortByTargetDate).SortValue)
+	assert.Equal(t, "-infinity", KeyOf(task, SortByCreatedAt).SortValue)
+}
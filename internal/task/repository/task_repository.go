@@ -3,10 +3,13 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"time"
 
 	"github.com/happYness-Project/taskManagementGolang/internal/task/domain"
+	"github.com/happYness-Project/taskManagementGolang/pkg/dbs"
 )
 
 const dbTimeout = time.Second * 5
@@ -15,24 +18,58 @@ type TaskRepository interface {
 	GetAllTasks() ([]domain.Task, error)
 	GetAllTasksByGroupId(groupId int) ([]domain.Task, error)
 	GetAllTasksByGroupIdOnlyImportant(groupId int) ([]domain.Task, error)
+	GetAllTasksByUserId(userId string) ([]domain.Task, error)
+	GetGroupIdByTaskId(taskId string) (int, error)
+	GetContainerIdByTaskId(taskId string) (string, error)
 	GetTaskById(id string) (*domain.Task, error)
+	ListTasks(criteria TaskListCriteria) ([]domain.Task, error)
 	GetTasksByContainerId(containerId string) ([]domain.Task, error)
 	CreateTask(taskcontainerId string, task domain.Task) (domain.Task, error)
 	UpdateTask(task domain.Task) error
 	UpdateImportantTask(id string, isImportant bool) error
+	// DeleteTask permanently removes the task; SoftDeleteTask moves it to the trash instead
 	DeleteTask(id string) error
+	SoftDeleteTask(id string, deletedAt time.Time) error
+	// MoveTask relinks the task to another container
+	MoveTask(id string, containerId string) error
+	GetDeletedTaskById(id string) (*domain.Task, error)
+	GetDeletedTasksByGroupId(groupId int) ([]DeletedTask, error)
+	RestoreTask(id string) error
+	// PurgeDeletedTasks permanently removes the tasks trashed before the cutoff and returns how many there were
+	PurgeDeletedTasks(before time.Time) (int64, error)
 	DoneTask(id string, isDone bool) error
+	UpdateAssignee(id string, assigneeId string, updatedAt time.Time) error
+	GetChecklistItems(taskId string) ([]domain.ChecklistItem, error)
+	SaveChecklist(taskId string, items []domain.ChecklistItem) error
+	// SaveLabels replaces the labels attached to a task
+	SaveLabels(taskId string, labels []domain.Label) error
+	// IncrementVersion bumps the task's version and returns the new one. When expectedVersion is not 0
+	// and no longer matches, nothing changes and dbs.ErrVersionConflict is returned.
+	IncrementVersion(id string, expectedVersion int) (int, error)
+	WithTx(tx dbs.DBTX) TaskRepository
 }
+
+// DeletedTask is a task in the trash together with the container it is restored into
+type DeletedTask struct {
+	domain.Task
+	ContainerId string `json:"container_id"`
+}
+
 type TaskRepo struct {
-	DB *sql.DB
+	DB dbs.DBTX
 }
 
-func NewTaskRepository(db *sql.DB) *TaskRepo {
+func NewTaskRepository(db dbs.DBTX) *TaskRepo {
 	return &TaskRepo{
 		DB: db,
 	}
 }
 
+// WithTx returns a copy of the repository bound to the given transaction
+func (m *TaskRepo) WithTx(tx dbs.DBTX) TaskRepository {
+	return &TaskRepo{DB: tx}
+}
+
 func (m *TaskRepo) GetAllTasks() ([]domain.Task, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), dbTimeout)
 	defer cancel()
@@ -67,6 +104,14 @@ func (m *TaskRepo) GetTaskById(id string) (*domain.Task, error) {
 			return nil, err
 		}
 	}
+	if task == nil {
+		return nil, nil
+	}
+
+	task.Checklist, err = m.GetChecklistItems(task.TaskId)
+	if err != nil {
+		return nil, err
+	}
 	return task, nil
 }
 
@@ -89,40 +134,120 @@ func (m *TaskRepo) GetTasksByContainerId(containerId string) ([]domain.Task, err
 }
 
 func (m *TaskRepo) CreateTask(containerId string, task domain.Task) (domain.Task, error) {
-	_, err := m.DB.Exec(sqlCreateTask, task.TaskId, task.TaskName, task.TaskDesc, task.TaskType, task.CreatedAt, task.UpdatedAt, task.TargetDate, task.Priority, task.Category, task.IsCompleted, task.IsImportant)
+	err := dbs.RunInTx(m.DB, func(tx dbs.DBTX) error {
+		_, err := tx.Exec(sqlCreateTask, task.TaskId, task.TaskName, task.TaskDesc, task.TaskType, task.CreatedAt, task.UpdatedAt, task.TargetDate, task.Priority.String(), task.IsCompleted, task.IsImportant, task.RecurrenceRule, nullableUUID(task.AssigneeId))
+		if err != nil {
+			return fmt.Errorf("unable to insert into task table : %w", err)
+		}
+		_, err = tx.Exec(sqlCreateTaskForJoinTable, containerId, task.TaskId)
+		if err != nil {
+			return fmt.Errorf("unable to insert into taskcontainer_task table : %w", err)
+		}
+		if err := insertChecklistItems(tx, task.Checklist); err != nil {
+			return err
+		}
+		return insertTaskLabels(tx, task.TaskId, task.Labels)
+	})
+	task.Version = 1
+	return task, err
+}
+
+func (m *TaskRepo) UpdateTask(task domain.Task) error {
+	_, err := m.DB.Exec(sqlUpdateTask, task.TaskId, task.TaskName, task.TaskDesc, task.UpdatedAt, task.TargetDate, task.Priority.String(), task.RecurrenceRule)
 	if err != nil {
-		return task, fmt.Errorf("unable to insert into task table : %w", err)
+		return err
 	}
-	_, err = m.DB.Exec(sqlCreateTaskForJoinTable,
-		containerId, task.TaskId)
+	return nil
+}
+
+func (m *TaskRepo) DeleteTask(id string) error {
+	return dbs.RunInTx(m.DB, func(tx dbs.DBTX) error {
+		if _, err := tx.Exec(sqlDeleteTaskForJoinTable, id); err != nil {
+			return err
+		}
+		if _, err := tx.Exec(sqlDeleteTask, id); err != nil {
+			return err
+		}
+		return nil
+	})
+}
 
+func (m *TaskRepo) MoveTask(id string, containerId string) error {
+	result, err := m.DB.Exec(sqlMoveTaskForJoinTable, id, containerId)
 	if err != nil {
-		return task, fmt.Errorf("unable to insert into taskcontainer_task table : %w", err)
+		return fmt.Errorf("unable to move task : %w", err)
 	}
-
-	return task, nil
+	if rows, err := result.RowsAffected(); err == nil && rows == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
 }
 
-func (m *TaskRepo) UpdateTask(task domain.Task) error {
-	_, err := m.DB.Exec(sqlUpdateTask, task.TaskId, task.TaskName, task.TaskDesc, task.UpdatedAt, task.TargetDate, task.Priority, task.Category)
+func (m *TaskRepo) SoftDeleteTask(id string, deletedAt time.Time) error {
+	_, err := m.DB.Exec(sqlSoftDeleteTask, id, deletedAt)
 	if err != nil {
-		return err
+		return fmt.Errorf("unable to move task to the trash : %w", err)
 	}
 	return nil
 }
 
-func (m *TaskRepo) DeleteTask(id string) error {
-	_, err := m.DB.Exec(sqlDeleteTaskForJoinTable, id)
+// GetDeletedTaskById returns nil when the task does not exist or is not in the trash
+func (m *TaskRepo) GetDeletedTaskById(id string) (*domain.Task, error) {
+	rows, err := m.DB.Query(sqlGetDeletedTaskById, id)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	_, err = m.DB.Exec(sqlDeleteTask, id)
+	defer rows.Close()
+
+	var task *domain.Task
+	for rows.Next() {
+		var deletedAt time.Time
+		task, err = scanRowsIntoTask(rows, &deletedAt)
+		if err != nil {
+			return nil, err
+		}
+		task.DeletedAt = &deletedAt
+	}
+	return task, rows.Err()
+}
+
+func (m *TaskRepo) GetDeletedTasksByGroupId(groupId int) ([]DeletedTask, error) {
+	rows, err := m.DB.Query(sqlGetDeletedTasksByGroupId, groupId)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	defer rows.Close()
+
+	tasks := []DeletedTask{}
+	for rows.Next() {
+		var deletedAt time.Time
+		var containerId string
+		task, err := scanRowsIntoTask(rows, &deletedAt, &containerId)
+		if err != nil {
+			return nil, err
+		}
+		task.DeletedAt = &deletedAt
+		tasks = append(tasks, DeletedTask{Task: *task, ContainerId: containerId})
+	}
+	return tasks, rows.Err()
+}
+
+func (m *TaskRepo) RestoreTask(id string) error {
+	_, err := m.DB.Exec(sqlRestoreTask, id)
+	if err != nil {
+		return fmt.Errorf("unable to restore task : %w", err)
 	}
 	return nil
 }
 
+func (m *TaskRepo) PurgeDeletedTasks(before time.Time) (int64, error) {
+	result, err := m.DB.Exec(sqlPurgeDeletedTasks, before)
+	if err != nil {
+		return 0, fmt.Errorf("unable to purge deleted tasks : %w", err)
+	}
+	return result.RowsAffected()
+}
+
 func (m *TaskRepo) DoneTask(id string, isDone bool) error {
 	_, err := m.DB.Exec(sqlUpdateTaskDoneField, isDone, id)
 	if err != nil {
@@ -131,6 +256,84 @@ func (m *TaskRepo) DoneTask(id string, isDone bool) error {
 	return nil
 }
 
+func (m *TaskRepo) GetChecklistItems(taskId string) ([]domain.ChecklistItem, error) {
+	rows, err := m.DB.Query(sqlGetChecklistItemsByTaskId, taskId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var items []domain.ChecklistItem
+	for rows.Next() {
+		var item domain.ChecklistItem
+		err := rows.Scan(&item.ItemId, &item.TaskId, &item.Title, &item.IsChecked, &item.Position, &item.CreatedAt, &item.UpdatedAt)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+	return items, rows.Err()
+}
+
+// SaveChecklist replaces the stored checklist of a task with the given items
+func (m *TaskRepo) SaveChecklist(taskId string, items []domain.ChecklistItem) error {
+	return dbs.RunInTx(m.DB, func(tx dbs.DBTX) error {
+		if _, err := tx.Exec(sqlDeleteChecklistItemsByTaskId, taskId); err != nil {
+			return fmt.Errorf("unable to delete checklist items : %w", err)
+		}
+		return insertChecklistItems(tx, items)
+	})
+}
+
+func insertChecklistItems(tx dbs.DBTX, items []domain.ChecklistItem) error {
+	for _, item := range items {
+		_, err := tx.Exec(sqlCreateChecklistItem, item.ItemId, item.TaskId, item.Title, item.IsChecked, item.Position, item.CreatedAt, item.UpdatedAt)
+		if err != nil {
+			return fmt.Errorf("unable to insert into task_checklist_item table : %w", err)
+		}
+	}
+	return nil
+}
+
+func (m *TaskRepo) SaveLabels(taskId string, labels []domain.Label) error {
+	return dbs.RunInTx(m.DB, func(tx dbs.DBTX) error {
+		if _, err := tx.Exec(sqlDeleteTaskLabelsByTaskId, taskId); err != nil {
+			return fmt.Errorf("unable to delete task labels : %w", err)
+		}
+		return insertTaskLabels(tx, taskId, labels)
+	})
+}
+
+func insertTaskLabels(tx dbs.DBTX, taskId string, labels []domain.Label) error {
+	for _, label := range labels {
+		if _, err := tx.Exec(sqlCreateTaskLabel, taskId, label.Id); err != nil {
+			return fmt.Errorf("unable to insert into task_label table : %w", err)
+		}
+	}
+	return nil
+}
+
+// UpdateAssignee sets the task's assignee; an empty assigneeId unassigns it
+func (m *TaskRepo) UpdateAssignee(id string, assigneeId string, updatedAt time.Time) error {
+	_, err := m.DB.Exec(sqlUpdateTaskAssignee, nullableUUID(assigneeId), updatedAt, id)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *TaskRepo) IncrementVersion(id string, expectedVersion int) (int, error) {
+	var version int
+	err := m.DB.QueryRow(sqlIncrementTaskVersion, id, expectedVersion).Scan(&version)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, dbs.ErrVersionConflict
+	}
+	if err != nil {
+		return 0, fmt.Errorf("unable to update task version : %w", err)
+	}
+	return version, nil
+}
+
 func (m *TaskRepo) UpdateImportantTask(id string, isImportant bool) error {
 	_, err := m.DB.Exec(sqlUpdateTaskImportantField, isImportant, id)
 	if err != nil {
@@ -175,9 +378,82 @@ func (m *TaskRepo) GetAllTasksByGroupIdOnlyImportant(groupId int) ([]domain.Task
 	return tasks, nil
 }
 
-func scanRowsIntoTask(rows *sql.Rows) (*domain.Task, error) {
+func (m *TaskRepo) GetAllTasksByUserId(userId string) ([]domain.Task, error) {
+	rows, err := m.DB.Query(sqlGetAllTasksByUserId, userId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	tasks := []domain.Task{}
+	for rows.Next() {
+		task, err := scanRowsIntoTask(rows)
+		if err != nil {
+			return nil, err
+		}
+		tasks = append(tasks, *task)
+	}
+	return tasks, nil
+}
+
+func (m *TaskRepo) GetContainerIdByTaskId(taskId string) (string, error) {
+	var containerId string
+	err := m.DB.QueryRow(sqlGetContainerIdByTaskId, taskId).Scan(&containerId)
+	if err != nil {
+		return "", err
+	}
+	return containerId, nil
+}
+
+func (m *TaskRepo) GetGroupIdByTaskId(taskId string) (int, error) {
+	var groupId int
+	err := m.DB.QueryRow(sqlGetGroupIdByTaskId, taskId).Scan(&groupId)
+	if err != nil {
+		return 0, err
+	}
+	return groupId, nil
+}
+
+func (m *TaskRepo) ListTasks(criteria TaskListCriteria) ([]domain.Task, error) {
+	query, args, err := buildListTasksQuery(criteria)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), dbTimeout)
+	defer cancel()
+
+	rows, err := m.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	tasks := []domain.Task{}
+	for rows.Next() {
+		task, err := scanRowsIntoTask(rows)
+		if err != nil {
+			return nil, err
+		}
+		tasks = append(tasks, *task)
+	}
+	return tasks, rows.Err()
+}
+
+// nullableUUID stores an empty id as NULL
+func nullableUUID(id string) interface{} {
+	if id == "" {
+		return nil
+	}
+	return id
+}
+
+// scanRowsIntoTask scans the task columns followed by any extra columns of the query
+func scanRowsIntoTask(rows *sql.Rows, extra ...any) (*domain.Task, error) {
 	task := new(domain.Task)
-	err := rows.Scan(
+	var assigneeId sql.NullString
+	var labels []byte
+	dest := []any{
 		&task.TaskId,
 		&task.TaskName,
 		&task.TaskDesc,
@@ -186,13 +462,21 @@ func scanRowsIntoTask(rows *sql.Rows) (*domain.Task, error) {
 		&task.UpdatedAt,
 		&task.TargetDate,
 		&task.Priority,
-		&task.Category,
+		&labels,
 		&task.IsCompleted,
 		&task.IsImportant,
-	)
+		&task.RecurrenceRule,
+		&assigneeId,
+		&task.Version,
+	}
+	err := rows.Scan(append(dest, extra...)...)
 	if err != nil {
 		return nil, err
 	}
+	task.AssigneeId = assigneeId.String
+	if err := json.Unmarshal(labels, &task.Labels); err != nil {
+		return nil, fmt.Errorf("unable to decode task labels : %w", err)
+	}
 
 	return task, nil
 }
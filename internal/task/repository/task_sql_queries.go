@@ -1,28 +1,92 @@
 package repository
 
 const (
-	sqlGetAllTasks              = `SELECT * FROM container.task`
-	sqlGetTaskById              = `SELECT * FROM container.task WHERE id = $1`
-	sqlGetAllTasksByContainerId = `SELECT t.id, t.name, t.description, t.type, t.created_at, t.updated_at, t.target_date, t.priority, t.category, t.is_completed, t.is_important
+	// sqlTaskLabels reads the labels of task t as a JSON array, in the position of the task columns
+	sqlTaskLabels = `COALESCE((SELECT json_agg(json_build_object('id', l.id, 'name', l.name, 'color', l.color) ORDER BY LOWER(l.name))
+									FROM container.task_label tl INNER JOIN container.label l ON l.id = tl.label_id
+									WHERE tl.task_id = t.id), '[]')`
+
+	sqlGetAllTasks              = `SELECT id, name, description, type, created_at, updated_at, target_date, priority, ` + sqlTaskLabels + `, is_completed, is_important, recurrence_rule, assignee_id, version FROM container.task t WHERE deleted_at IS NULL`
+	sqlGetTaskById              = `SELECT id, name, description, type, created_at, updated_at, target_date, priority, ` + sqlTaskLabels + `, is_completed, is_important, recurrence_rule, assignee_id, version FROM container.task t WHERE id = $1 AND deleted_at IS NULL`
+	sqlGetAllTasksByContainerId = `SELECT t.id, t.name, t.description, t.type, t.created_at, t.updated_at, t.target_date, t.priority, ` + sqlTaskLabels + `, t.is_completed, t.is_important, t.recurrence_rule, t.assignee_id, t.version
 									FROM container.task t
 									JOIN container.taskcontainer_task tct
 									ON t.id = tct.task_id
-									WHERE taskcontainer_id = $1`
-	sqlGetAllTasksByGroupId = `SELECT t.id, t.name, t.description, t.type, t.created_at, t.updated_at, t.target_date, t.priority, t.category, t.is_completed, t.is_important from container.task t
+									WHERE taskcontainer_id = $1 AND t.deleted_at IS NULL`
+	sqlGetAllTasksByGroupId = `SELECT t.id, t.name, t.description, t.type, t.created_at, t.updated_at, t.target_date, t.priority, ` + sqlTaskLabels + `, t.is_completed, t.is_important, t.recurrence_rule, t.assignee_id, t.version from container.task t
 										INNER JOIN container.taskcontainer_task tct
 										ON t.id = tct.task_id
-										WHERE tct.taskcontainer_id in (SELECT id FROM container.taskcontainer where usergroup_id = $1)`
-	sqlGetAllTasksByGroupIdAndImportant = `SELECT t.id, t.name, t.description, t.type, t.created_at, t.updated_at, t.target_date, t.priority, t.category, t.is_completed, t.is_important from public.task t
+										WHERE tct.taskcontainer_id in (SELECT id FROM container.taskcontainer where usergroup_id = $1) AND t.deleted_at IS NULL`
+	sqlGetAllTasksByUserId = `SELECT t.id, t.name, t.description, t.type, t.created_at, t.updated_at, t.target_date, t.priority, ` + sqlTaskLabels + `, t.is_completed, t.is_important, t.recurrence_rule, t.assignee_id, t.version from container.task t
+								INNER JOIN container.taskcontainer_task tct ON t.id = tct.task_id
+								INNER JOIN container.taskcontainer tc ON tc.id = tct.taskcontainer_id
+								INNER JOIN container.usergroup_user ugu ON ugu.usergroup_id = tc.usergroup_id
+								INNER JOIN container.user u ON u.id = ugu.user_id
+								WHERE u.user_id = $1 AND t.deleted_at IS NULL`
+	// The container and group of a task are also resolved while it is in the trash, so it can be restored
+	sqlGetContainerIdByTaskId = `SELECT taskcontainer_id FROM container.taskcontainer_task WHERE task_id = $1`
+	sqlGetGroupIdByTaskId     = `SELECT tc.usergroup_id FROM container.taskcontainer tc
+								INNER JOIN container.taskcontainer_task tct ON tc.id = tct.taskcontainer_id
+								WHERE tct.task_id = $1`
+	sqlGetAllTasksByGroupIdAndImportant = `SELECT t.id, t.name, t.description, t.type, t.created_at, t.updated_at, t.target_date, t.priority, ` + sqlTaskLabels + `, t.is_completed, t.is_important, t.recurrence_rule, t.assignee_id, t.version from container.task t
 											INNER JOIN container.taskcontainer_task tct
 											ON t.id = tct.task_id
-											WHERE tct.taskcontainer_id in (SELECT id FROM container.taskcontainer where usergroup_id = $1) AND t.is_important = true`
+											WHERE tct.taskcontainer_id in (SELECT id FROM container.taskcontainer where usergroup_id = $1) AND t.is_important = true AND t.deleted_at IS NULL`
+
+	sqlListTasksSelect = `SELECT t.id, t.name, t.description, t.type, t.created_at, t.updated_at, t.target_date, t.priority, ` + sqlTaskLabels + `, t.is_completed, t.is_important, t.recurrence_rule, t.assignee_id, t.version FROM container.task t
+								INNER JOIN container.taskcontainer_task tct ON t.id = tct.task_id
+								INNER JOIN container.taskcontainer tc ON tc.id = tct.taskcontainer_id`
+	sqlListTasksScopeUser = `tc.usergroup_id IN (SELECT ugu.usergroup_id FROM container.usergroup_user ugu
+								INNER JOIN container.user u ON u.id = ugu.user_id WHERE u.user_id = $%d)`
+	sqlListTasksScopeContainer  = `tct.taskcontainer_id = $%d`
+	sqlListTasksNotDeleted      = `t.deleted_at IS NULL`
+	sqlListTasksScopeGroup      = `tc.usergroup_id = $%d`
+	sqlListTasksFilterCompleted = `t.is_completed = $%d`
+	sqlListTasksFilterImportant = `t.is_important = $%d`
+	sqlListTasksFilterPriority  = `t.priority = $%d`
+	// A task matches a label set when it carries every label of the set
+	sqlListTasksFilterLabels = `t.id IN (SELECT tl.task_id FROM container.task_label tl WHERE tl.label_id = ANY(CAST($%d AS uuid[]))
+									GROUP BY tl.task_id HAVING COUNT(*) = $%d)`
+	sqlListTasksFilterTargetFrom = `t.target_date >= $%d`
+	sqlListTasksFilterTargetTo   = `t.target_date <= $%d`
+	sqlListTasksFilterAssignee   = `t.assignee_id = CAST($%d AS uuid)`
+	sqlListTasksAfterKey         = `(%s, t.id) %s (CAST($%d::text AS %s), CAST($%d::text AS uuid))`
+	sqlListTasksOrderBy          = ` ORDER BY %s %s, t.id %s`
+	sqlListTasksLimit            = ` LIMIT $%d`
 
-	sqlCreateTask = `INSERT INTO container.task(id, name, description,type, created_at, updated_at, target_date, priority, category, is_completed, is_important)
-		VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11)`
-	sqlCreateTaskForJoinTable   = `INSERT INTO container.taskcontainer_task(taskcontainer_id, task_id) VALUES ($1, $2)`
-	sqlDeleteTaskForJoinTable   = `DELETE FROM container.taskcontainer_task WHERE task_id=$1`
-	sqlDeleteTask               = `DELETE FROM container.task WHERE id=$1`
-	sqlUpdateTask               = `UPDATE container.task SET name=$2, description=$3, updated_at=$4, target_date=$5, priority=$6, category=$7 WHERE id=$1`
-	sqlUpdateTaskDoneField      = `UPDATE container.task SET is_completed=$1 WHERE id = $2;`
-	sqlUpdateTaskImportantField = `UPDATE container.task SET is_important=$1 WHERE id = $2;`
+	sqlTaskSortTargetDate = `COALESCE(t.target_date, 'infinity'::timestamptz)`
+	sqlTaskSortCreatedAt  = `COALESCE(t.created_at, '-infinity'::timestamptz)`
+	sqlTaskSortPriority   = `CASE t.priority WHEN 'low' THEN 1 WHEN 'medium' THEN 2 WHEN 'high' THEN 3 WHEN 'urgent' THEN 4 ELSE 0 END`
+
+	sqlCreateTask = `INSERT INTO container.task(id, name, description,type, created_at, updated_at, target_date, priority, is_completed, is_important, recurrence_rule, assignee_id)
+		VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12)`
+	sqlCreateTaskForJoinTable = `INSERT INTO container.taskcontainer_task(taskcontainer_id, task_id) VALUES ($1, $2)`
+	sqlDeleteTaskForJoinTable = `DELETE FROM container.taskcontainer_task WHERE task_id=$1`
+	sqlMoveTaskForJoinTable   = `UPDATE container.taskcontainer_task SET taskcontainer_id=$2 WHERE task_id=$1`
+	sqlDeleteTask             = `DELETE FROM container.task WHERE id=$1`
+	sqlSoftDeleteTask         = `UPDATE container.task SET deleted_at=$2 WHERE id=$1 AND deleted_at IS NULL`
+	sqlRestoreTask            = `UPDATE container.task SET deleted_at=NULL WHERE id=$1`
+	sqlPurgeDeletedTasks      = `DELETE FROM container.task WHERE deleted_at < $1`
+	sqlGetDeletedTaskById     = `SELECT id, name, description, type, created_at, updated_at, target_date, priority, ` + sqlTaskLabels + `, is_completed, is_important, recurrence_rule, assignee_id, version, deleted_at FROM container.task t
+									WHERE id = $1 AND deleted_at IS NOT NULL`
+	// Tasks trashed together with their container are listed through the container instead
+	sqlGetDeletedTasksByGroupId = `SELECT t.id, t.name, t.description, t.type, t.created_at, t.updated_at, t.target_date, t.priority, ` + sqlTaskLabels + `, t.is_completed, t.is_important, t.recurrence_rule, t.assignee_id, t.version, t.deleted_at, tct.taskcontainer_id
+									FROM container.task t
+									INNER JOIN container.taskcontainer_task tct ON t.id = tct.task_id
+									INNER JOIN container.taskcontainer tc ON tc.id = tct.taskcontainer_id
+									WHERE tc.usergroup_id = $1 AND t.deleted_at IS NOT NULL AND tc.deleted_at IS NULL
+									ORDER BY t.deleted_at DESC`
+	sqlUpdateTask                = `UPDATE container.task SET name=$2, description=$3, updated_at=$4, target_date=$5, priority=$6, recurrence_rule=$7 WHERE id=$1`
+	sqlGetChecklistItemsByTaskId = `SELECT id, task_id, title, is_checked, position, created_at, updated_at FROM container.task_checklist_item
+									WHERE task_id = $1 ORDER BY position`
+	sqlCreateChecklistItem = `INSERT INTO container.task_checklist_item(id, task_id, title, is_checked, position, created_at, updated_at)
+		VALUES ($1,$2,$3,$4,$5,$6,$7)`
+	sqlDeleteChecklistItemsByTaskId = `DELETE FROM container.task_checklist_item WHERE task_id=$1`
+	sqlCreateTaskLabel              = `INSERT INTO container.task_label(task_id, label_id) VALUES ($1, $2)`
+	sqlDeleteTaskLabelsByTaskId     = `DELETE FROM container.task_label WHERE task_id=$1`
+	sqlUpdateTaskAssignee           = `UPDATE container.task SET assignee_id=$1, updated_at=$2 WHERE id = $3`
+	sqlUpdateTaskDoneField          = `UPDATE container.task SET is_completed=$1 WHERE id = $2;`
+	sqlUpdateTaskImportantField     = `UPDATE container.task SET is_important=$1 WHERE id = $2;`
+	// An expected version of 0 bumps the version unconditionally
+	sqlIncrementTaskVersion = `UPDATE container.task SET version = version + 1 WHERE id = $1 AND ($2 = 0 OR version = $2) RETURNING version`
 )
@@ -0,0 +1,123 @@
+package route
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/happYness-Project/taskManagementGolang/internal/authorization"
+	"github.com/happYness-Project/taskManagementGolang/internal/task/application/command"
+	"github.com/happYness-Project/taskManagementGolang/internal/task/application/query"
+	"github.com/happYness-Project/taskManagementGolang/internal/task/domain"
+	"github.com/happYness-Project/taskManagementGolang/pkg/constants"
+	"github.com/happYness-Project/taskManagementGolang/pkg/response"
+)
+
+func (h *Handler) handleAssignTask(w http.ResponseWriter, r *http.Request) {
+	expectedVersion, ok := response.IfMatch(w, r, h.logger)
+	if !ok {
+		return
+	}
+
+	var assignDto AssignTaskDto
+	if err := response.ParseJson(r, &assignDto); err != nil {
+		h.logger.Error().Err(err).Str("ErrorCode", constants.RequestBodyError).Msg("Invalid JSON body for AssignTaskDto")
+		response.ErrorResponse(w, http.StatusBadRequest, *(response.New(constants.RequestBodyError, "Invalid Json Body", err.Error())))
+		return
+	}
+
+	// Use Command Bus
+	_, err := h.commandBus.Execute(r.Context(), command.AssignTaskCommand{
+		TaskId:          chi.URLParam(r, "taskID"),
+		AssigneeId:      assignDto.AssigneeId,
+		ExpectedVersion: expectedVersion,
+		RequesterId:     authorization.RequesterId(r),
+	})
+	if response.VersionConflict(w, err, h.logger) {
+		return
+	}
+	if authorization.IsForbiddenError(err) {
+		h.logger.Error().Err(err).Str("ErrorCode", constants.PermissionDenied).Msg(err.Error())
+		response.Forbidden(w, constants.PermissionDenied, err.Error())
+		return
+	}
+	if errors.Is(err, domain.ErrInvalidAssignee) || errors.Is(err, command.ErrAssigneeNotMember) {
+		h.logger.Error().Err(err).Str("ErrorCode", TaskAssignInvalidAssignee).Msg(err.Error())
+		response.ErrorResponse(w, http.StatusBadRequest, *(response.New(TaskAssignInvalidAssignee, "Invalid assignee", err.Error())))
+		return
+	}
+	if err != nil {
+		h.logger.Error().Err(err).Str("ErrorCode", TaskAssignServerError).Msg("Error occurred during AssignTask")
+		response.ErrorResponse(w, http.StatusInternalServerError, *(response.New(TaskAssignServerError, "Failed to assign task", err.Error())))
+		return
+	}
+	response.WriteJsonWithEncode(w, http.StatusOK, "task assigned successfully")
+}
+
+func (h *Handler) handleUnassignTask(w http.ResponseWriter, r *http.Request) {
+	expectedVersion, ok := response.IfMatch(w, r, h.logger)
+	if !ok {
+		return
+	}
+
+	// Use Command Bus
+	_, err := h.commandBus.Execute(r.Context(), command.UnassignTaskCommand{
+		TaskId:          chi.URLParam(r, "taskID"),
+		ExpectedVersion: expectedVersion,
+		RequesterId:     authorization.RequesterId(r),
+	})
+	if response.VersionConflict(w, err, h.logger) {
+		return
+	}
+	if authorization.IsForbiddenError(err) {
+		h.logger.Error().Err(err).Str("ErrorCode", constants.PermissionDenied).Msg(err.Error())
+		response.Forbidden(w, constants.PermissionDenied, err.Error())
+		return
+	}
+	if err != nil {
+		h.logger.Error().Err(err).Str("ErrorCode", TaskAssignServerError).Msg("Error occurred during UnassignTask")
+		response.ErrorResponse(w, http.StatusInternalServerError, *(response.New(TaskAssignServerError, "Failed to unassign task", err.Error())))
+		return
+	}
+	response.WriteJsonWithEncode(w, http.StatusOK, "task unassigned successfully")
+}
+
+func (h *Handler) handleGetTasksByAssignee(w http.ResponseWriter, r *http.Request) {
+	userId := chi.URLParam(r, "userID")
+	if userId == "" {
+		h.logger.Error().Msg("user Id missing")
+		response.BadRequestMissingParameters(w, "missing user id")
+		return
+	}
+
+	listParams, err := parseTaskListParams(r)
+	if err != nil {
+		h.logger.Error().Err(err).Str("ErrorCode", TaskListInvalidParameter).Msg(err.Error())
+		badRequestListParams(w, err)
+		return
+	}
+
+	// Use Query Bus
+	result, err := h.queryBus.Execute(query.GetTasksByAssigneeQuery{
+		AssigneeId:  userId,
+		List:        listParams,
+		RequesterId: authorization.RequesterId(r),
+	})
+	if errors.Is(err, query.ErrInvalidListParams) {
+		h.logger.Error().Err(err).Str("ErrorCode", TaskListInvalidParameter).Msg(err.Error())
+		badRequestListParams(w, err)
+		return
+	}
+	if err != nil {
+		h.logger.Error().Err(err).Str("ErrorCode", TaskGetServerError).Msg("Error occurred during GetTasksByAssignee")
+		response.ErrorResponse(w, http.StatusInternalServerError, *(response.New(TaskGetServerError, "Failed to get tasks by assignee", err.Error())))
+		return
+	}
+	page := result.(*query.TaskPage)
+	writeNextCursor(w, page)
+	if acceptsCSV(w, r) {
+		writeTasksCSV(w, page.Tasks)
+		return
+	}
+	response.WriteJsonWithEncode(w, http.StatusOK, page.Tasks)
+}
@@ -0,0 +1,213 @@
+package route
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/happYness-Project/taskManagementGolang/internal/authorization"
+	"github.com/happYness-Project/taskManagementGolang/internal/task/application"
+	"github.com/happYness-Project/taskManagementGolang/internal/task/application/command"
+	"github.com/happYness-Project/taskManagementGolang/internal/task/domain"
+	"github.com/happYness-Project/taskManagementGolang/pkg/constants"
+	"github.com/happYness-Project/taskManagementGolang/pkg/dbs"
+	errorTitles "github.com/happYness-Project/taskManagementGolang/pkg/errors"
+	"github.com/happYness-Project/taskManagementGolang/pkg/response"
+)
+
+const (
+	batchModeAtomic     = "atomic"
+	batchModeBestEffort = "best_effort"
+)
+
+// handleBatchTasks runs a list of operations on the tasks of a container and answers with one result per operation:
+// 200 when every operation succeeded, 207 otherwise. An atomic batch applies all of its operations or none of them.
+func (h *Handler) handleBatchTasks(w http.ResponseWriter, r *http.Request) {
+	var batchDto BatchTasksDto
+	if err := response.ParseJson(r, &batchDto); err != nil {
+		h.logger.Error().Err(err).Str("ErrorCode", constants.RequestBodyError).Msg("Invalid JSON body for BatchTasksDto")
+		response.ErrorResponse(w, http.StatusBadRequest, *(response.New(constants.RequestBodyError, "Invalid Json Body", err.Error())))
+		return
+	}
+	if batchDto.Mode == "" {
+		batchDto.Mode = batchModeAtomic
+	}
+
+	container, err := h.containerRepo.GetById(chi.URLParam(r, "containerID"))
+	if err != nil || container == nil || container.Id == "" {
+		h.logger.Error().Err(err).Str("ErrorCode", TaskGetTaskContainerNotFound).Msg("Task container not found")
+		response.NotFound(w, TaskGetTaskContainerNotFound, "task container does not exist")
+		return
+	}
+
+	commands, fields := batchCommands(container.Id, batchDto, authorization.RequesterId(r))
+	if len(fields) > 0 {
+		h.logger.Error().Str("ErrorCode", TaskBatchInvalidInput).Msg("Invalid batch of task operations")
+		problem := response.New(TaskBatchInvalidInput, "Invalid batch", "one or more operations were rejected").WithErrors(fields...)
+		response.ErrorResponse(w, http.StatusBadRequest, *problem)
+		return
+	}
+
+	// Use Command Bus
+	results := h.commandBus.ExecuteBatch(r.Context(), container.Id, commands, batchDto.Mode == batchModeAtomic)
+
+	body := BatchResponseDto{Mode: batchDto.Mode, Results: make([]BatchResultDto, len(results))}
+	for i, result := range results {
+		operation := batchDto.Operations[i]
+		item := BatchResultDto{Index: i, Op: operation.Op, TaskId: operation.TaskId}
+		if result.Err != nil {
+			item.Status, item.Error = h.batchProblem(result.Err)
+			body.Failed++
+		} else {
+			item.Status = http.StatusOK
+			if task, ok := result.Result.(domain.Task); ok {
+				item.Status, item.Task, item.TaskId = http.StatusCreated, &task, task.TaskId
+			}
+			body.Succeeded++
+		}
+		body.Results[i] = item
+	}
+
+	status := http.StatusOK
+	if body.Failed > 0 {
+		status = http.StatusMultiStatus
+	}
+	response.WriteJsonWithEncode(w, status, body)
+}
+
+// batchProblem maps the error an operation failed with to its status and problem details
+func (h *Handler) batchProblem(err error) (int, *response.ProblemDetails) {
+	var invalid *domain.ValidationError
+	switch {
+	case errors.Is(err, application.ErrBatchAborted):
+		return http.StatusFailedDependency, response.New(TaskBatchAborted, "Not applied", err.Error())
+	case authorization.IsForbiddenError(err):
+		return http.StatusForbidden, response.New(constants.PermissionDenied, errorTitles.PermissionDenied, err.Error())
+	case errors.Is(err, application.ErrTaskNotInContainer):
+		return http.StatusNotFound, response.New(TaskBatchTaskNotFound, "Not found", err.Error())
+	case errors.Is(err, command.ErrTargetContainerNotFound):
+		return http.StatusNotFound, response.New(TaskBatchTargetNotFound, "Not found", err.Error())
+	case errors.Is(err, dbs.ErrVersionConflict):
+		return http.StatusPreconditionFailed, response.New(constants.PreconditionFailed, errorTitles.PreconditionFailed, err.Error())
+	case errors.Is(err, domain.ErrInvalidPriority):
+		return http.StatusUnprocessableEntity, invalidPriorityProblem(err)
+	case errors.As(err, &invalid):
+		fields := make([]response.FieldError, len(invalid.Fields))
+		for i, field := range invalid.Fields {
+			fields[i] = response.FieldError{Field: field.Field, Message: field.Message}
+		}
+		return http.StatusBadRequest, response.New(TaskBatchInvalidInput, "Invalid task", "one or more fields were rejected").WithErrors(fields...)
+	default:
+		h.logger.Error().Err(err).Str("ErrorCode", TaskBatchOperationRejected).Msg("Batch operation failed")
+		return http.StatusBadRequest, response.New(TaskBatchOperationRejected, "Operation failed", err.Error())
+	}
+}
+
+// batchCommands turns the operations of a batch into task commands, reporting every malformed operation at once
+func batchCommands(containerId string, batchDto BatchTasksDto, requesterId string) ([]interface{}, []response.FieldError) {
+	var fields []response.FieldError
+	if batchDto.Mode != batchModeAtomic && batchDto.Mode != batchModeBestEffort {
+		fields = append(fields, response.FieldError{Field: "mode", Message: "must be atomic or best_effort"})
+	}
+	if len(batchDto.Operations) == 0 {
+		fields = append(fields, response.FieldError{Field: "operations", Message: "must contain at least one operation"})
+	}
+	if len(batchDto.Operations) > application.MaxBatchSize {
+		fields = append(fields, response.FieldError{Field: "operations", Message: fmt.Sprintf("must not contain more than %d operations", application.MaxBatchSize)})
+		return nil, fields
+	}
+
+	commands := make([]interface{}, len(batchDto.Operations))
+	for i, operation := range batchDto.Operations {
+		field := func(name string) string { return fmt.Sprintf("operations[%d].%s", i, name) }
+		reject := func(name string, message string) {
+			fields = append(fields, response.FieldError{Field: field(name), Message: message})
+		}
+		var version int
+		if operation.Op != "create" {
+			if operation.TaskId == "" {
+				reject("task_id", "is required")
+			}
+			var err error
+			if version, err = operationVersion(operation.Version); err != nil {
+				reject("version", err.Error())
+			}
+		}
+
+		switch operation.Op {
+		case "create":
+			var createDto CreateTaskDto
+			if err := json.Unmarshal(operation.Task, &createDto); err != nil {
+				reject("task", "must be a task object")
+			}
+			commands[i] = command.CreateTaskCommand{
+				ContainerId:    containerId,
+				TaskName:       createDto.TaskName,
+				TaskDesc:       createDto.TaskDesc,
+				TargetDate:     createDto.TargetDate,
+				Priority:       createDto.Priority,
+				RecurrenceRule: createDto.RecurrenceRule,
+				RequesterId:    requesterId,
+			}
+		case "update":
+			var updateDto UpdateTaskDto
+			if err := json.Unmarshal(operation.Task, &updateDto); err != nil {
+				reject("task", "must be a task object")
+			}
+			commands[i] = command.UpdateTaskCommand{
+				TaskId:          operation.TaskId,
+				TaskName:        updateDto.TaskName,
+				TaskDesc:        updateDto.TaskDesc,
+				TargetDate:      updateDto.TargetDate,
+				Priority:        updateDto.Priority,
+				RecurrenceRule:  updateDto.RecurrenceRule,
+				ExpectedVersion: version,
+				RequesterId:     requesterId,
+			}
+		case "complete":
+			isCompleted := true
+			if operation.IsCompleted != nil {
+				isCompleted = *operation.IsCompleted
+			}
+			commands[i] = command.ToggleCompletionCommand{
+				TaskId:          operation.TaskId,
+				IsCompleted:     isCompleted,
+				ExpectedVersion: version,
+				RequesterId:     requesterId,
+			}
+		case "delete":
+			commands[i] = command.DeleteTaskCommand{TaskId: operation.TaskId, ExpectedVersion: version, RequesterId: requesterId}
+		case "move":
+			if operation.TargetContainerId == "" {
+				reject("target_container_id", "is required")
+			}
+			commands[i] = command.MoveTaskCommand{
+				TaskId:            operation.TaskId,
+				TargetContainerId: operation.TargetContainerId,
+				ExpectedVersion:   version,
+				RequesterId:       requesterId,
+			}
+		default:
+			reject("op", "must be one of create, update, complete, delete or move")
+		}
+	}
+	return commands, fields
+}
+
+// operationVersion returns the version an operation expects, or 0 for "*", the way If-Match does for single tasks
+func operationVersion(raw json.RawMessage) (int, error) {
+	if len(raw) == 0 || string(raw) == "null" {
+		return 0, errors.New(`is required; send the version you read, or "*" to skip the check`)
+	}
+	var anyVersion string
+	if err := json.Unmarshal(raw, &anyVersion); err == nil && anyVersion == "*" {
+		return 0, nil
+	}
+	var version int
+	if err := json.Unmarshal(raw, &version); err != nil || version < 1 {
+		return 0, errors.New(`must be a version number or "*"`)
+	}
+	return version, nil
+}
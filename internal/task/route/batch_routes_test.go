@@ -0,0 +1,67 @@
+package route
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/happYness-Project/taskManagementGolang/internal/task/application/command"
+	"github.com/happYness-Project/taskManagementGolang/pkg/response"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBatchCommands(t *testing.T) {
+	t.Run("when operations on existing tasks have no version, Then each is rejected", func(t *testing.T) {
+		// Arrange
+		batchDto := BatchTasksDto{Mode: batchModeAtomic, Operations: []BatchOperationDto{
+			{Op: "create", Task: json.RawMessage(`{"task_name":"Water plants"}`)},
+			{Op: "update", TaskId: "task-1", Task: json.RawMessage(`{"task_name":"Feed cat"}`)},
+			{Op: "complete", TaskId: "task-1"},
+			{Op: "delete", TaskId: "task-1", Version: json.RawMessage(`null`)},
+			{Op: "move", TaskId: "task-1", TargetContainerId: "container-2"},
+		}}
+
+		// Act
+		_, fields := batchCommands("container-1", batchDto, testRequesterId)
+
+		// Assert
+		rejected := []string{}
+		for _, field := range fields {
+			rejected = append(rejected, field.Field)
+		}
+		assert.Equal(t, []string{"operations[1].version", "operations[2].version", "operations[3].version", "operations[4].version"}, rejected)
+	})
+
+	t.Run("when an operation sends a version or \"*\", Then it is expected or the check is skipped", func(t *testing.T) {
+		// Arrange
+		batchDto := BatchTasksDto{Mode: batchModeBestEffort, Operations: []BatchOperationDto{
+			{Op: "complete", TaskId: "task-1", Version: json.RawMessage(`3`)},
+			{Op: "delete", TaskId: "task-2", Version: json.RawMessage(`"*"`)},
+		}}
+
+		// Act
+		commands, fields := batchCommands("container-1", batchDto, testRequesterId)
+
+		// Assert
+		require.Empty(t, fields)
+		assert.Equal(t, 3, commands[0].(command.ToggleCompletionCommand).ExpectedVersion)
+		assert.Zero(t, commands[1].(command.DeleteTaskCommand).ExpectedVersion)
+	})
+
+	t.Run("when a version is not a version number, Then it is rejected", func(t *testing.T) {
+		// Arrange
+		batchDto := BatchTasksDto{Mode: batchModeAtomic, Operations: []BatchOperationDto{
+			{Op: "delete", TaskId: "task-1", Version: json.RawMessage(`0`)},
+			{Op: "delete", TaskId: "task-2", Version: json.RawMessage(`"3"`)},
+		}}
+
+		// Act
+		_, fields := batchCommands("container-1", batchDto, testRequesterId)
+
+		// Assert
+		assert.Equal(t, []response.FieldError{
+			{Field: "operations[0].version", Message: `must be a version number or "*"`},
+			{Field: "operations[1].version", Message: `must be a version number or "*"`},
+		}, fields)
+	})
+}
@@ -0,0 +1,131 @@
+package route
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/happYness-Project/taskManagementGolang/internal/authorization"
+	"github.com/happYness-Project/taskManagementGolang/internal/task/application/command"
+	"github.com/happYness-Project/taskManagementGolang/internal/task/domain"
+	"github.com/happYness-Project/taskManagementGolang/pkg/constants"
+	"github.com/happYness-Project/taskManagementGolang/pkg/dbs"
+	"github.com/happYness-Project/taskManagementGolang/pkg/response"
+)
+
+func (h *Handler) handleAddChecklistItem(w http.ResponseWriter, r *http.Request) {
+	var addDto AddChecklistItemDto
+	if err := response.ParseJson(r, &addDto); err != nil {
+		h.logger.Error().Err(err).Str("ErrorCode", constants.RequestBodyError).Msg("Invalid JSON body for AddChecklistItemDto")
+		response.ErrorResponse(w, http.StatusBadRequest, *(response.New(constants.RequestBodyError, "Invalid Json Body", err.Error())))
+		return
+	}
+
+	// Use Command Bus
+	result, err := h.commandBus.Execute(r.Context(), command.AddChecklistItemCommand{
+		TaskId:      chi.URLParam(r, "taskID"),
+		Title:       addDto.Title,
+		RequesterId: authorization.RequesterId(r),
+	})
+	if err != nil {
+		h.checklistError(w, err, "Failed to add checklist item")
+		return
+	}
+	response.WriteJsonWithEncode(w, http.StatusCreated, result)
+}
+
+func (h *Handler) handleToggleChecklistItem(w http.ResponseWriter, r *http.Request) {
+	expectedVersion, ok := response.IfMatch(w, r, h.logger)
+	if !ok {
+		return
+	}
+
+	var toggleDto ToggleChecklistItemDto
+	if err := response.ParseJson(r, &toggleDto); err != nil {
+		h.logger.Error().Err(err).Msg("Invalid JSON body for toggle checklist item")
+		response.InvalidJsonBody(w, "Invalid Json body for toggle checklist item")
+		return
+	}
+
+	// Use Command Bus
+	_, err := h.commandBus.Execute(r.Context(), command.ToggleChecklistItemCommand{
+		TaskId:          chi.URLParam(r, "taskID"),
+		ItemId:          chi.URLParam(r, "itemID"),
+		IsChecked:       toggleDto.IsChecked,
+		ExpectedVersion: expectedVersion,
+		RequesterId:     authorization.RequesterId(r),
+	})
+	if err != nil {
+		h.checklistError(w, err, "Failed to toggle checklist item")
+		return
+	}
+	response.WriteJsonWithEncode(w, http.StatusOK, "checklist item is changed.")
+}
+
+func (h *Handler) handleRemoveChecklistItem(w http.ResponseWriter, r *http.Request) {
+	expectedVersion, ok := response.IfMatch(w, r, h.logger)
+	if !ok {
+		return
+	}
+
+	// Use Command Bus
+	_, err := h.commandBus.Execute(r.Context(), command.RemoveChecklistItemCommand{
+		TaskId:          chi.URLParam(r, "taskID"),
+		ItemId:          chi.URLParam(r, "itemID"),
+		ExpectedVersion: expectedVersion,
+		RequesterId:     authorization.RequesterId(r),
+	})
+	if err != nil {
+		h.checklistError(w, err, "Failed to remove checklist item")
+		return
+	}
+	response.WriteJsonWithEncode(w, http.StatusNoContent, "checklist item has been removed.")
+}
+
+func (h *Handler) handleReorderChecklist(w http.ResponseWriter, r *http.Request) {
+	expectedVersion, ok := response.IfMatch(w, r, h.logger)
+	if !ok {
+		return
+	}
+
+	var reorderDto ReorderChecklistDto
+	if err := response.ParseJson(r, &reorderDto); err != nil {
+		h.logger.Error().Err(err).Str("ErrorCode", constants.RequestBodyError).Msg("Invalid JSON body for ReorderChecklistDto")
+		response.ErrorResponse(w, http.StatusBadRequest, *(response.New(constants.RequestBodyError, "Invalid Json Body", err.Error())))
+		return
+	}
+
+	// Use Command Bus
+	_, err := h.commandBus.Execute(r.Context(), command.ReorderChecklistCommand{
+		TaskId:          chi.URLParam(r, "taskID"),
+		ItemIds:         reorderDto.ItemIds,
+		ExpectedVersion: expectedVersion,
+		RequesterId:     authorization.RequesterId(r),
+	})
+	if err != nil {
+		h.checklistError(w, err, "Failed to reorder checklist")
+		return
+	}
+	response.WriteJsonWithEncode(w, http.StatusOK, "checklist is reordered.")
+}
+
+// checklistError maps checklist command failures onto responses
+func (h *Handler) checklistError(w http.ResponseWriter, err error, title string) {
+	switch {
+	case authorization.IsForbiddenError(err):
+		h.logger.Error().Err(err).Str("ErrorCode", constants.PermissionDenied).Msg(err.Error())
+		response.Forbidden(w, constants.PermissionDenied, err.Error())
+	case errors.Is(err, dbs.ErrVersionConflict):
+		h.logger.Error().Err(err).Str("ErrorCode", constants.PreconditionFailed).Msg(err.Error())
+		response.PreconditionFailed(w, err.Error())
+	case errors.Is(err, domain.ErrChecklistItemNotFound):
+		h.logger.Error().Err(err).Str("ErrorCode", TaskChecklistItemNotFound).Msg(err.Error())
+		response.ErrorResponse(w, http.StatusNotFound, *(response.New(TaskChecklistItemNotFound, title, err.Error())))
+	case errors.Is(err, domain.ErrInvalidChecklist):
+		h.logger.Error().Err(err).Str("ErrorCode", TaskChecklistInvalidInput).Msg(err.Error())
+		response.ErrorResponse(w, http.StatusBadRequest, *(response.New(TaskChecklistInvalidInput, title, err.Error())))
+	default:
+		h.logger.Error().Err(err).Str("ErrorCode", TaskChecklistServerError).Msg(err.Error())
+		response.ErrorResponse(w, http.StatusInternalServerError, *(response.New(TaskChecklistServerError, title, err.Error())))
+	}
+}
@@ -10,15 +10,46 @@ const (
 	TaskGetRateLimitedExceeded   = prefix + "get_rate_limited_exceeded"
 	TaskGetServerError           = prefix + "get_server_error"
 	TaskGetTaskContainerNotFound = prefix + "get_taskcontainer_not_found"
+	TaskListInvalidParameter     = prefix + "list_invalid_parameter"
+	TaskOccurrenceInvalidWindow  = prefix + "occurrence_invalid_window"
 
 	TaskCreateInvalidInput   = prefix + "create_invalid_input"
+	TaskInvalidPriority      = prefix + "invalid_priority"
 	TaskCreateServerError    = prefix + "create_server_error"
 	TaskUpdateServerError    = prefix + "update_server_error"
+	TaskPatchInvalidInput    = prefix + "patch_invalid_input"
+	TaskPatchUnsupported     = prefix + "patch_unsupported_media_type"
 	TaskStatusDoneError      = prefix + "status_done_error"
 	TaskUpdateImportantError = prefix + "update_important_error"
 
+	TaskChecklistInvalidInput = prefix + "checklist_invalid_input"
+	TaskChecklistItemNotFound = prefix + "checklist_item_not_found"
+	TaskChecklistServerError  = prefix + "checklist_server_error"
+
+	TaskAssignInvalidAssignee = prefix + "assign_invalid_assignee"
+	TaskAssignServerError     = prefix + "assign_server_error"
+
+	TaskLabelInvalidInput = prefix + "label_invalid_input"
+	TaskLabelNotFound     = prefix + "label_not_found"
+	TaskLabelServerError  = prefix + "label_server_error"
+
+	TaskMoveInvalidInput   = prefix + "move_invalid_input"
+	TaskMoveTargetNotFound = prefix + "move_target_not_found"
+	TaskMoveServerError    = prefix + "move_server_error"
+	TaskCopyServerError    = prefix + "copy_server_error"
+
 	TaskDeleteInvalidID         = prefix + "delete_invalid_order_id"
 	TaskDeleteNotFound          = prefix + "delete_not_found"
 	TaskDeleteRateLimitExceeded = prefix + "delete_rate_limit_exceeded"
 	TaskDeleteServerError       = prefix + "delete_server_error"
+
+	TaskRestoreNotInTrash       = prefix + "restore_not_in_trash"
+	TaskRestoreContainerInTrash = prefix + "restore_container_in_trash"
+	TaskRestoreServerError      = prefix + "restore_server_error"
+
+	TaskBatchInvalidInput      = prefix + "batch_invalid_input"
+	TaskBatchTaskNotFound      = prefix + "batch_task_not_found"
+	TaskBatchTargetNotFound    = prefix + "batch_target_container_not_found"
+	TaskBatchOperationRejected = prefix + "batch_operation_rejected"
+	TaskBatchAborted           = prefix + "batch_aborted"
 )
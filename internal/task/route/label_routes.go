@@ -0,0 +1,83 @@
+package route
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/happYness-Project/taskManagementGolang/internal/authorization"
+	labelDomain "github.com/happYness-Project/taskManagementGolang/internal/label/domain"
+	"github.com/happYness-Project/taskManagementGolang/internal/task/application/command"
+	"github.com/happYness-Project/taskManagementGolang/internal/task/domain"
+	"github.com/happYness-Project/taskManagementGolang/pkg/constants"
+	"github.com/happYness-Project/taskManagementGolang/pkg/response"
+)
+
+func (h *Handler) handleAddTaskLabel(w http.ResponseWriter, r *http.Request) {
+	expectedVersion, ok := response.IfMatch(w, r, h.logger)
+	if !ok {
+		return
+	}
+
+	// Use Command Bus
+	_, err := h.commandBus.Execute(r.Context(), command.AddTaskLabelCommand{
+		TaskId:          chi.URLParam(r, "taskID"),
+		LabelId:         chi.URLParam(r, "labelID"),
+		ExpectedVersion: expectedVersion,
+		RequesterId:     authorization.RequesterId(r),
+	})
+	if h.taskLabelError(w, err) {
+		return
+	}
+	if err != nil {
+		h.logger.Error().Err(err).Str("ErrorCode", TaskLabelServerError).Msg("Error occurred during AddTaskLabel")
+		response.ErrorResponse(w, http.StatusInternalServerError, *(response.New(TaskLabelServerError, "Failed to label task", err.Error())))
+		return
+	}
+	response.WriteJsonWithEncode(w, http.StatusOK, "label added to task")
+}
+
+func (h *Handler) handleRemoveTaskLabel(w http.ResponseWriter, r *http.Request) {
+	expectedVersion, ok := response.IfMatch(w, r, h.logger)
+	if !ok {
+		return
+	}
+
+	// Use Command Bus
+	_, err := h.commandBus.Execute(r.Context(), command.RemoveTaskLabelCommand{
+		TaskId:          chi.URLParam(r, "taskID"),
+		LabelId:         chi.URLParam(r, "labelID"),
+		ExpectedVersion: expectedVersion,
+		RequesterId:     authorization.RequesterId(r),
+	})
+	if h.taskLabelError(w, err) {
+		return
+	}
+	if err != nil {
+		h.logger.Error().Err(err).Str("ErrorCode", TaskLabelServerError).Msg("Error occurred during RemoveTaskLabel")
+		response.ErrorResponse(w, http.StatusInternalServerError, *(response.New(TaskLabelServerError, "Failed to remove task label", err.Error())))
+		return
+	}
+	response.WriteJsonWithEncode(w, http.StatusOK, "label removed from task")
+}
+
+// taskLabelError answers 412, 403, 404 or 400 for the errors labelling a task can fail with
+func (h *Handler) taskLabelError(w http.ResponseWriter, err error) bool {
+	if response.VersionConflict(w, err, h.logger) {
+		return true
+	}
+	switch {
+	case authorization.IsForbiddenError(err):
+		h.logger.Error().Err(err).Str("ErrorCode", constants.PermissionDenied).Msg(err.Error())
+		response.Forbidden(w, constants.PermissionDenied, err.Error())
+	case errors.Is(err, labelDomain.ErrLabelNotFound):
+		h.logger.Error().Err(err).Str("ErrorCode", TaskLabelNotFound).Msg(err.Error())
+		response.NotFound(w, TaskLabelNotFound, err.Error())
+	case errors.Is(err, command.ErrLabelNotInGroup), errors.Is(err, domain.ErrInvalidLabels):
+		h.logger.Error().Err(err).Str("ErrorCode", TaskLabelInvalidInput).Msg(err.Error())
+		response.ErrorResponse(w, http.StatusBadRequest, *(response.New(TaskLabelInvalidInput, "Invalid label", err.Error())))
+	default:
+		return false
+	}
+	return true
+}
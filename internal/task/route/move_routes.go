@@ -0,0 +1,98 @@
+package route
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/happYness-Project/taskManagementGolang/internal/authorization"
+	"github.com/happYness-Project/taskManagementGolang/internal/task/application/command"
+	"github.com/happYness-Project/taskManagementGolang/pkg/constants"
+	"github.com/happYness-Project/taskManagementGolang/pkg/response"
+)
+
+func (h *Handler) handleMoveTask(w http.ResponseWriter, r *http.Request) {
+	expectedVersion, ok := response.IfMatch(w, r, h.logger)
+	if !ok {
+		return
+	}
+	targetDto, ok := h.parseTargetContainer(w, r)
+	if !ok {
+		return
+	}
+
+	// Use Command Bus
+	_, err := h.commandBus.Execute(r.Context(), command.MoveTaskCommand{
+		TaskId:            chi.URLParam(r, "taskID"),
+		TargetContainerId: targetDto.TargetContainerId,
+		ExpectedVersion:   expectedVersion,
+		RequesterId:       authorization.RequesterId(r),
+	})
+	if response.VersionConflict(w, err, h.logger) {
+		return
+	}
+	if h.targetContainerError(w, err) {
+		return
+	}
+	if err != nil {
+		h.logger.Error().Err(err).Str("ErrorCode", TaskMoveServerError).Msg("Error occurred during MoveTask")
+		response.ErrorResponse(w, http.StatusInternalServerError, *(response.New(TaskMoveServerError, "Failed to move task", err.Error())))
+		return
+	}
+	response.WriteJsonWithEncode(w, http.StatusOK, "task has been moved.")
+}
+
+func (h *Handler) handleCopyTask(w http.ResponseWriter, r *http.Request) {
+	targetDto, ok := h.parseTargetContainer(w, r)
+	if !ok {
+		return
+	}
+
+	// Use Command Bus
+	result, err := h.commandBus.Execute(r.Context(), command.CopyTaskCommand{
+		TaskId:            chi.URLParam(r, "taskID"),
+		TargetContainerId: targetDto.TargetContainerId,
+		RequesterId:       authorization.RequesterId(r),
+	})
+	if h.targetContainerError(w, err) {
+		return
+	}
+	if err != nil {
+		h.logger.Error().Err(err).Str("ErrorCode", TaskCopyServerError).Msg("Error occurred during CopyTask")
+		response.ErrorResponse(w, http.StatusInternalServerError, *(response.New(TaskCopyServerError, "Failed to copy task", err.Error())))
+		return
+	}
+	response.WriteJsonWithEncode(w, http.StatusCreated, result)
+}
+
+func (h *Handler) parseTargetContainer(w http.ResponseWriter, r *http.Request) (TargetContainerDto, bool) {
+	var targetDto TargetContainerDto
+	if err := response.ParseJson(r, &targetDto); err != nil {
+		h.logger.Error().Err(err).Str("ErrorCode", constants.RequestBodyError).Msg("Invalid JSON body for TargetContainerDto")
+		response.ErrorResponse(w, http.StatusBadRequest, *(response.New(constants.RequestBodyError, "Invalid Json Body", err.Error())))
+		return targetDto, false
+	}
+	if targetDto.TargetContainerId == "" {
+		h.logger.Error().Str("ErrorCode", TaskMoveInvalidInput).Msg("missing target container id")
+		problem := response.New(TaskMoveInvalidInput, "Invalid target", "target container is required").
+			WithErrors(response.FieldError{Field: "target_container_id", Message: "is required"})
+		response.ErrorResponse(w, http.StatusBadRequest, *problem)
+		return targetDto, false
+	}
+	return targetDto, true
+}
+
+// targetContainerError answers 403 or 404 when the requester cannot reach the task or the target container
+func (h *Handler) targetContainerError(w http.ResponseWriter, err error) bool {
+	if authorization.IsForbiddenError(err) {
+		h.logger.Error().Err(err).Str("ErrorCode", constants.PermissionDenied).Msg(err.Error())
+		response.Forbidden(w, constants.PermissionDenied, err.Error())
+		return true
+	}
+	if errors.Is(err, command.ErrTargetContainerNotFound) {
+		h.logger.Error().Err(err).Str("ErrorCode", TaskMoveTargetNotFound).Msg(err.Error())
+		response.NotFound(w, TaskMoveTargetNotFound, err.Error())
+		return true
+	}
+	return false
+}
@@ -0,0 +1,156 @@
+package route
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"maps"
+	"mime"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/happYness-Project/taskManagementGolang/internal/authorization"
+	"github.com/happYness-Project/taskManagementGolang/internal/task/application/command"
+	"github.com/happYness-Project/taskManagementGolang/internal/task/domain"
+	"github.com/happYness-Project/taskManagementGolang/pkg/constants"
+	"github.com/happYness-Project/taskManagementGolang/pkg/response"
+)
+
+const mergePatchMediaType = "application/merge-patch+json"
+
+func (h *Handler) handlePatchTask(w http.ResponseWriter, r *http.Request) {
+	if mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type")); err != nil ||
+		(mediaType != mergePatchMediaType && mediaType != "application/json") {
+		h.logger.Error().Str("ErrorCode", TaskPatchUnsupported).Msg("Unsupported Content-Type for task patch")
+		w.Header().Set("Accept-Patch", mergePatchMediaType)
+		response.ErrorResponse(w, http.StatusUnsupportedMediaType, *(response.New(TaskPatchUnsupported, "Unsupported Media Type", "task patches must be sent as "+mergePatchMediaType)))
+		return
+	}
+	expectedVersion, ok := response.IfMatch(w, r, h.logger)
+	if !ok {
+		return
+	}
+
+	patch, err := decodeTaskPatch(r.Body)
+	var invalid *domain.ValidationError
+	if err != nil && !errors.As(err, &invalid) {
+		h.logger.Error().Err(err).Str("ErrorCode", constants.RequestBodyError).Msg("Invalid merge patch for task")
+		response.ErrorResponse(w, http.StatusBadRequest, *(response.New(constants.RequestBodyError, "Request Body Error", err.Error())))
+		return
+	}
+	if invalid != nil {
+		h.invalidPatch(w, invalid)
+		return
+	}
+
+	// Use Command Bus
+	result, err := h.commandBus.Execute(r.Context(), command.PatchTaskCommand{
+		TaskId:          chi.URLParam(r, "taskID"),
+		Patch:           patch,
+		ExpectedVersion: expectedVersion,
+		RequesterId:     authorization.RequesterId(r),
+	})
+	if response.VersionConflict(w, err, h.logger) {
+		return
+	}
+	if authorization.IsForbiddenError(err) {
+		h.logger.Error().Err(err).Str("ErrorCode", constants.PermissionDenied).Msg(err.Error())
+		response.Forbidden(w, constants.PermissionDenied, err.Error())
+		return
+	}
+	if errors.As(err, &invalid) {
+		h.invalidPatch(w, invalid)
+		return
+	}
+	if err != nil {
+		h.logger.Error().Err(err).Str("ErrorCode", TaskUpdateServerError).Msg("Not able to patch task")
+		response.ErrorResponse(w, http.StatusBadRequest, *(response.New(TaskUpdateServerError, "Failed to update task", err.Error())))
+		return
+	}
+
+	task := result.(domain.Task)
+	response.SetETag(w, task.Version)
+	response.WriteJsonWithEncode(w, http.StatusOK, task)
+}
+
+// invalidPatch answers with one entry per rejected field: 422 when the priority is not supported,
+// as creating and updating tasks do, and 400 otherwise
+func (h *Handler) invalidPatch(w http.ResponseWriter, invalid *domain.ValidationError) {
+	status, code, title := http.StatusBadRequest, TaskPatchInvalidInput, "Invalid task patch"
+	if errors.Is(invalid, domain.ErrInvalidPriority) {
+		status, code, title = http.StatusUnprocessableEntity, TaskInvalidPriority, "Invalid priority"
+	}
+	h.logger.Error().Err(invalid).Str("ErrorCode", code).Msg(invalid.Error())
+	fields := make([]response.FieldError, len(invalid.Fields))
+	for i, field := range invalid.Fields {
+		fields[i] = response.FieldError{Field: field.Field, Message: field.Message}
+	}
+	problem := response.New(code, title, "one or more fields were rejected").WithErrors(fields...)
+	response.ErrorResponse(w, status, *problem)
+}
+
+// decodeTaskPatch reads an RFC 7396 merge patch. Members that are unknown or of the wrong type are
+// reported together as a *domain.ValidationError; a body that is not a JSON object is a plain error.
+func decodeTaskPatch(body io.Reader) (domain.TaskPatch, error) {
+	var patch domain.TaskPatch
+	var document map[string]json.RawMessage
+	if err := json.NewDecoder(body).Decode(&document); err != nil {
+		return patch, err
+	}
+	if document == nil {
+		return patch, errors.New("merge patch must be a JSON object")
+	}
+
+	var fields []domain.FieldError
+	for _, member := range slices.Sorted(maps.Keys(document)) {
+		raw := document[member]
+		var err error
+		switch member {
+		case "name":
+			patch.Name, err = patchString(raw)
+		case "description":
+			patch.Description, err = patchString(raw)
+		case "target_date":
+			patch.TargetDate, err = patchTime(raw)
+		case "priority":
+			patch.Priority, err = patchString(raw)
+		case "recurrence_rule":
+			patch.RecurrenceRule, err = patchString(raw)
+		default:
+			err = errors.New("field cannot be patched")
+		}
+		if err != nil {
+			fields = append(fields, domain.FieldError{Field: member, Message: err.Error()})
+		}
+	}
+	if len(fields) > 0 {
+		return patch, &domain.ValidationError{Fields: fields}
+	}
+	return patch, nil
+}
+
+// patchString decodes a string member, mapping null to the empty string
+func patchString(raw json.RawMessage) (*string, error) {
+	value := new(string)
+	if string(raw) == "null" {
+		return value, nil
+	}
+	if err := json.Unmarshal(raw, value); err != nil {
+		return nil, errors.New("must be a string or null")
+	}
+	return value, nil
+}
+
+// patchTime decodes a date-time member, mapping null to the zero time
+func patchTime(raw json.RawMessage) (*time.Time, error) {
+	value := new(time.Time)
+	if string(raw) == "null" {
+		return value, nil
+	}
+	if err := json.Unmarshal(raw, value); err != nil {
+		return nil, errors.New("must be an RFC 3339 date-time or null")
+	}
+	return value, nil
+}
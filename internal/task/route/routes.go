@@ -2,18 +2,26 @@ package route
 
 import (
 	"encoding/json"
+	"errors"
 	"net/http"
 	"strconv"
 
 	"github.com/go-chi/chi/v5"
+	auditApp "github.com/happYness-Project/taskManagementGolang/internal/audit/application"
+	"github.com/happYness-Project/taskManagementGolang/internal/authorization"
+	labelRepo "github.com/happYness-Project/taskManagementGolang/internal/label/repository"
+	outboxRepo "github.com/happYness-Project/taskManagementGolang/internal/outbox/repository"
 	"github.com/happYness-Project/taskManagementGolang/internal/task/application"
 	"github.com/happYness-Project/taskManagementGolang/internal/task/application/command"
 	"github.com/happYness-Project/taskManagementGolang/internal/task/application/query"
+	"github.com/happYness-Project/taskManagementGolang/internal/task/domain"
 	taskRepo "github.com/happYness-Project/taskManagementGolang/internal/task/repository"
 	containerRepo "github.com/happYness-Project/taskManagementGolang/internal/taskcontainer/repository"
+	userRepo "github.com/happYness-Project/taskManagementGolang/internal/user/repository"
 	usergroupRepo "github.com/happYness-Project/taskManagementGolang/internal/usergroup/repository"
 	usergroupRoute "github.com/happYness-Project/taskManagementGolang/internal/usergroup/route"
 	"github.com/happYness-Project/taskManagementGolang/pkg/constants"
+	"github.com/happYness-Project/taskManagementGolang/pkg/dbs"
 	"github.com/happYness-Project/taskManagementGolang/pkg/loggers"
 	"github.com/happYness-Project/taskManagementGolang/pkg/response"
 )
@@ -26,11 +34,11 @@ type Handler struct {
 	groupRepo     usergroupRepo.UserGroupRepository
 }
 
-func NewHandler(logger *loggers.AppLogger, repo taskRepo.TaskRepository, tcRepo containerRepo.ContainerRepository, ugRepo usergroupRepo.UserGroupRepository) *Handler {
+func NewHandler(logger *loggers.AppLogger, repo taskRepo.TaskRepository, tcRepo containerRepo.ContainerRepository, ugRepo usergroupRepo.UserGroupRepository, uRepo userRepo.UserRepository, lRepo labelRepo.LabelRepository, policy *authorization.Policy, uow dbs.UnitOfWork, recorder *auditApp.Recorder, outbox outboxRepo.OutboxRepository) *Handler {
 	return &Handler{
 		logger:        logger,
-		commandBus:    application.NewCommandBus(repo),
-		queryBus:      application.NewQueryBus(repo),
+		commandBus:    application.NewCommandBus(repo, tcRepo, ugRepo, uRepo, lRepo, policy, uow, recorder, outbox),
+		queryBus:      application.NewQueryBus(repo, tcRepo, policy),
 		containerRepo: tcRepo,
 		groupRepo:     ugRepo,
 	}
@@ -39,33 +47,75 @@ func (h *Handler) RegisterRoutes(router chi.Router) {
 	router.Route("/api/tasks", func(r chi.Router) {
 		r.Get("/", h.handleGetTasks)
 		r.Get("/{taskID}", h.handleGetTask)
+		r.Get("/{taskID}/occurrences", h.handleGetTaskOccurrences)
 		r.Put("/{taskID}", h.handleUpdateTask)
+		r.Patch("/{taskID}", h.handlePatchTask)
 		r.Delete("/{taskID}", h.handleDeleteTask)
+		r.Post("/{taskID}/restore", h.handleRestoreTask)
+		r.Post("/{taskID}/move", h.handleMoveTask)
+		r.Post("/{taskID}/copy", h.handleCopyTask)
 		r.Patch("/{taskID}/toggle-completion", h.handleDoneTask)
 		r.Patch("/{taskID}/toggle-important", h.handleImportantTask)
+		r.Post("/{taskID}/checklist", h.handleAddChecklistItem)
+		r.Put("/{taskID}/checklist/order", h.handleReorderChecklist)
+		r.Patch("/{taskID}/checklist/{itemID}/toggle", h.handleToggleChecklistItem)
+		r.Delete("/{taskID}/checklist/{itemID}", h.handleRemoveChecklistItem)
+		r.Put("/{taskID}/assignee", h.handleAssignTask)
+		r.Delete("/{taskID}/assignee", h.handleUnassignTask)
+		r.Put("/{taskID}/labels/{labelID}", h.handleAddTaskLabel)
+		r.Delete("/{taskID}/labels/{labelID}", h.handleRemoveTaskLabel)
 	})
 	router.Get("/api/task-containers/{containerID}/tasks", h.handleGetTasksByContainerId)
+	router.Get("/api/task-containers/{containerID}/occurrences", h.handleGetContainerOccurrences)
 	router.Post("/api/task-containers/{containerID}/tasks", h.handleCreateTask)
+	router.Post("/api/task-containers/{containerID}/tasks:batch", h.handleBatchTasks)
 	router.Get("/api/user-groups/{usergroupID}/tasks", h.handleGetTasksByGroupId)
+	router.Get("/api/users/{userID}/tasks", h.handleGetTasksByAssignee)
 }
 func (h *Handler) handleGetTasks(w http.ResponseWriter, r *http.Request) {
+	listParams, err := parseTaskListParams(r)
+	if err != nil {
+		h.logger.Error().Err(err).Str("ErrorCode", TaskListInvalidParameter).Msg(err.Error())
+		badRequestListParams(w, err)
+		return
+	}
+
 	// Use Query Bus
-	result, err := h.queryBus.Execute(query.GetAllTasksQuery{})
+	result, err := h.queryBus.Execute(query.GetAllTasksQuery{List: listParams, RequesterId: authorization.RequesterId(r)})
+	if errors.Is(err, query.ErrInvalidListParams) {
+		h.logger.Error().Err(err).Str("ErrorCode", TaskListInvalidParameter).Msg(err.Error())
+		badRequestListParams(w, err)
+		return
+	}
 	if err != nil {
 		h.logger.Error().Err(err).Str("ErrorCode", TaskGetServerError).Msg(err.Error())
 		response.InternalServerError(w, "Error occurred during getting all tasks.")
 		return
 	}
-	response.SuccessJson(w, result, "successfully get tasks", http.StatusOK)
+	page := result.(*query.TaskPage)
+	writeNextCursor(w, page)
+	if acceptsCSV(w, r) {
+		writeTasksCSV(w, page.Tasks)
+		return
+	}
+	response.SuccessJson(w, page.Tasks, "successfully get tasks", http.StatusOK)
 }
 func (h *Handler) handleGetTask(w http.ResponseWriter, r *http.Request) {
 	// Use Query Bus
-	result, err := h.queryBus.Execute(query.GetTaskByIdQuery{TaskId: chi.URLParam(r, "taskID")})
+	result, err := h.queryBus.Execute(query.GetTaskByIdQuery{TaskId: chi.URLParam(r, "taskID"), RequesterId: authorization.RequesterId(r)})
+	if authorization.IsForbiddenError(err) {
+		h.logger.Error().Err(err).Str("ErrorCode", constants.PermissionDenied).Msg(err.Error())
+		response.Forbidden(w, constants.PermissionDenied, err.Error())
+		return
+	}
 	if err != nil {
 		h.logger.Error().Err(err).Str("ErrorCode", TaskGetNotFound).Msg("Error occurred during GetTask.")
 		response.ErrorResponse(w, http.StatusNotFound, *(response.New(TaskGetNotFound, "Not Found", "task does not exist")))
 		return
 	}
+	if task, ok := result.(*domain.Task); ok && task != nil {
+		response.SetETag(w, task.Version)
+	}
 	response.WriteJsonWithEncode(w, http.StatusOK, result)
 }
 
@@ -77,14 +127,37 @@ func (h *Handler) handleGetTasksByContainerId(w http.ResponseWriter, r *http.Req
 		return
 	}
 
+	listParams, err := parseTaskListParams(r)
+	if err != nil {
+		h.logger.Error().Err(err).Str("ErrorCode", TaskListInvalidParameter).Msg(err.Error())
+		badRequestListParams(w, err)
+		return
+	}
+
 	// Use Query Bus
-	result, err := h.queryBus.Execute(query.GetTasksByContainerIdQuery{ContainerId: containerId})
+	result, err := h.queryBus.Execute(query.GetTasksByContainerIdQuery{ContainerId: containerId, List: listParams, RequesterId: authorization.RequesterId(r)})
+	if authorization.IsForbiddenError(err) {
+		h.logger.Error().Err(err).Str("ErrorCode", constants.PermissionDenied).Msg(err.Error())
+		response.Forbidden(w, constants.PermissionDenied, err.Error())
+		return
+	}
+	if errors.Is(err, query.ErrInvalidListParams) {
+		h.logger.Error().Err(err).Str("ErrorCode", TaskListInvalidParameter).Msg(err.Error())
+		badRequestListParams(w, err)
+		return
+	}
 	if err != nil {
 		h.logger.Error().Err(err).Str("ErrorCode", TaskGetServerError).Msg("Error occurred during GetTasksByContainerId")
 		response.ErrorResponse(w, http.StatusInternalServerError, *(response.New(TaskGetServerError, "Failed to get tasks by container id", err.Error())))
 		return
 	}
-	response.WriteJsonWithEncode(w, http.StatusOK, result)
+	page := result.(*query.TaskPage)
+	writeNextCursor(w, page)
+	if acceptsCSV(w, r) {
+		writeTasksCSV(w, page.Tasks)
+		return
+	}
+	response.WriteJsonWithEncode(w, http.StatusOK, page.Tasks)
 }
 
 func (h *Handler) handleCreateTask(w http.ResponseWriter, r *http.Request) {
@@ -109,15 +182,24 @@ func (h *Handler) handleCreateTask(w http.ResponseWriter, r *http.Request) {
 
 	// Use Command Bus
 	cmd := command.CreateTaskCommand{
-		ContainerId: container.Id,
-		TaskName:    createDto.TaskName,
-		TaskDesc:    createDto.TaskDesc,
-		TargetDate:  createDto.TargetDate,
-		Priority:    createDto.Priority,
-		Category:    createDto.Category,
+		ContainerId:    container.Id,
+		TaskName:       createDto.TaskName,
+		TaskDesc:       createDto.TaskDesc,
+		TargetDate:     createDto.TargetDate,
+		Priority:       createDto.Priority,
+		RecurrenceRule: createDto.RecurrenceRule,
+		RequesterId:    authorization.RequesterId(r),
 	}
 
-	result, err := h.commandBus.Execute(cmd)
+	result, err := h.commandBus.Execute(r.Context(), cmd)
+	if authorization.IsForbiddenError(err) {
+		h.logger.Error().Err(err).Str("ErrorCode", constants.PermissionDenied).Msg(err.Error())
+		response.Forbidden(w, constants.PermissionDenied, err.Error())
+		return
+	}
+	if h.invalidPriority(w, err) {
+		return
+	}
 	if err != nil {
 		h.logger.Error().Err(err).Str("ErrorCode", TaskCreateServerError).Msg("Error occurred during CreateTask")
 		response.ErrorResponse(w, http.StatusBadRequest, *(response.New(TaskCreateServerError, "Failed to create task", err.Error())))
@@ -127,6 +209,11 @@ func (h *Handler) handleCreateTask(w http.ResponseWriter, r *http.Request) {
 }
 
 func (h *Handler) handleUpdateTask(w http.ResponseWriter, r *http.Request) {
+	expectedVersion, ok := response.IfMatch(w, r, h.logger)
+	if !ok {
+		return
+	}
+
 	var updateDto UpdateTaskDto
 	if err := response.ParseJson(r, &updateDto); err != nil {
 		h.logger.Error().Err(err).Str("ErrorCode", constants.RequestBodyError).Msg("Invalid JSON body for UpdateTaskDto")
@@ -136,15 +223,28 @@ func (h *Handler) handleUpdateTask(w http.ResponseWriter, r *http.Request) {
 
 	// Use Command Bus
 	cmd := command.UpdateTaskCommand{
-		TaskId:     chi.URLParam(r, "taskID"),
-		TaskName:   updateDto.TaskName,
-		TaskDesc:   updateDto.TaskDesc,
-		TargetDate: updateDto.TargetDate,
-		Priority:   updateDto.Priority,
-		Category:   updateDto.Category,
+		TaskId:          chi.URLParam(r, "taskID"),
+		TaskName:        updateDto.TaskName,
+		TaskDesc:        updateDto.TaskDesc,
+		TargetDate:      updateDto.TargetDate,
+		Priority:        updateDto.Priority,
+		RecurrenceRule:  updateDto.RecurrenceRule,
+		ExpectedVersion: expectedVersion,
+		RequesterId:     authorization.RequesterId(r),
 	}
 
-	_, err := h.commandBus.Execute(cmd)
+	_, err := h.commandBus.Execute(r.Context(), cmd)
+	if response.VersionConflict(w, err, h.logger) {
+		return
+	}
+	if authorization.IsForbiddenError(err) {
+		h.logger.Error().Err(err).Str("ErrorCode", constants.PermissionDenied).Msg(err.Error())
+		response.Forbidden(w, constants.PermissionDenied, err.Error())
+		return
+	}
+	if h.invalidPriority(w, err) {
+		return
+	}
 	if err != nil {
 		h.logger.Error().Err(err).Str("ErrorCode", TaskUpdateServerError).Msg("Not able to update task")
 		response.ErrorResponse(w, http.StatusBadRequest, *(response.New(TaskUpdateServerError, "Failed to update task", err.Error())))
@@ -154,9 +254,22 @@ func (h *Handler) handleUpdateTask(w http.ResponseWriter, r *http.Request) {
 }
 
 func (h *Handler) handleDeleteTask(w http.ResponseWriter, r *http.Request) {
+	expectedVersion, ok := response.IfMatch(w, r, h.logger)
+	if !ok {
+		return
+	}
+
 	// Use Command Bus
-	cmd := command.DeleteTaskCommand{TaskId: chi.URLParam(r, "taskID")}
-	_, err := h.commandBus.Execute(cmd)
+	cmd := command.DeleteTaskCommand{TaskId: chi.URLParam(r, "taskID"), ExpectedVersion: expectedVersion, RequesterId: authorization.RequesterId(r)}
+	_, err := h.commandBus.Execute(r.Context(), cmd)
+	if response.VersionConflict(w, err, h.logger) {
+		return
+	}
+	if authorization.IsForbiddenError(err) {
+		h.logger.Error().Err(err).Str("ErrorCode", constants.PermissionDenied).Msg(err.Error())
+		response.Forbidden(w, constants.PermissionDenied, err.Error())
+		return
+	}
 	if err != nil {
 		// TODO : Different types of error. need to identify
 		h.logger.Error().Err(err).Str("ErrorCode", TaskDeleteServerError).Msg("Error occurred during deleting a task")
@@ -166,7 +279,39 @@ func (h *Handler) handleDeleteTask(w http.ResponseWriter, r *http.Request) {
 	response.WriteJsonWithEncode(w, http.StatusNoContent, "task has been removed.")
 }
 
+func (h *Handler) handleRestoreTask(w http.ResponseWriter, r *http.Request) {
+	// Use Command Bus
+	cmd := command.RestoreTaskCommand{TaskId: chi.URLParam(r, "taskID"), RequesterId: authorization.RequesterId(r)}
+	_, err := h.commandBus.Execute(r.Context(), cmd)
+	if authorization.IsForbiddenError(err) {
+		h.logger.Error().Err(err).Str("ErrorCode", constants.PermissionDenied).Msg(err.Error())
+		response.Forbidden(w, constants.PermissionDenied, err.Error())
+		return
+	}
+	if errors.Is(err, domain.ErrNotInTrash) {
+		h.logger.Error().Err(err).Str("ErrorCode", TaskRestoreNotInTrash).Msg(err.Error())
+		response.NotFound(w, TaskRestoreNotInTrash, err.Error())
+		return
+	}
+	if errors.Is(err, command.ErrContainerInTrash) {
+		h.logger.Error().Err(err).Str("ErrorCode", TaskRestoreContainerInTrash).Msg(err.Error())
+		response.ErrorResponse(w, http.StatusConflict, *(response.New(TaskRestoreContainerInTrash, "Container In Trash", err.Error())))
+		return
+	}
+	if err != nil {
+		h.logger.Error().Err(err).Str("ErrorCode", TaskRestoreServerError).Msg("Error occurred during restoring a task")
+		response.InternalServerError(w, "Error occurred during restoring a task.")
+		return
+	}
+	response.WriteJsonWithEncode(w, http.StatusOK, "task has been restored.")
+}
+
 func (h *Handler) handleDoneTask(w http.ResponseWriter, r *http.Request) {
+	expectedVersion, ok := response.IfMatch(w, r, h.logger)
+	if !ok {
+		return
+	}
+
 	taskId := chi.URLParam(r, "taskID")
 	if taskId == "" {
 		h.logger.Error().Msg("missing Task ID")
@@ -187,11 +332,21 @@ func (h *Handler) handleDoneTask(w http.ResponseWriter, r *http.Request) {
 
 	// Use Command Bus
 	cmd := command.ToggleCompletionCommand{
-		TaskId:      taskId,
-		IsCompleted: toggleBody.IsCompleted,
+		TaskId:          taskId,
+		IsCompleted:     toggleBody.IsCompleted,
+		ExpectedVersion: expectedVersion,
+		RequesterId:     authorization.RequesterId(r),
 	}
 
-	_, err = h.commandBus.Execute(cmd)
+	_, err = h.commandBus.Execute(r.Context(), cmd)
+	if response.VersionConflict(w, err, h.logger) {
+		return
+	}
+	if authorization.IsForbiddenError(err) {
+		h.logger.Error().Err(err).Str("ErrorCode", constants.PermissionDenied).Msg(err.Error())
+		response.Forbidden(w, constants.PermissionDenied, err.Error())
+		return
+	}
 	if err != nil {
 		h.logger.Error().Err(err).Str("ErrorCode", TaskStatusDoneError).Msg("Error occurred during done task")
 		response.ErrorResponse(w, http.StatusNotFound, *(response.New(TaskStatusDoneError, "Failed to toggle done")))
@@ -201,6 +356,11 @@ func (h *Handler) handleDoneTask(w http.ResponseWriter, r *http.Request) {
 }
 
 func (h *Handler) handleImportantTask(w http.ResponseWriter, r *http.Request) {
+	expectedVersion, ok := response.IfMatch(w, r, h.logger)
+	if !ok {
+		return
+	}
+
 	taskId := chi.URLParam(r, "taskID")
 	if taskId == "" {
 		h.logger.Error().Msg("missing Task ID")
@@ -221,11 +381,21 @@ func (h *Handler) handleImportantTask(w http.ResponseWriter, r *http.Request) {
 
 	// Use Command Bus
 	cmd := command.ToggleImportantCommand{
-		TaskId:      taskId,
-		IsImportant: toggleBody.IsImportant,
+		TaskId:          taskId,
+		IsImportant:     toggleBody.IsImportant,
+		ExpectedVersion: expectedVersion,
+		RequesterId:     authorization.RequesterId(r),
 	}
 
-	_, err = h.commandBus.Execute(cmd)
+	_, err = h.commandBus.Execute(r.Context(), cmd)
+	if response.VersionConflict(w, err, h.logger) {
+		return
+	}
+	if authorization.IsForbiddenError(err) {
+		h.logger.Error().Err(err).Str("ErrorCode", constants.PermissionDenied).Msg(err.Error())
+		response.Forbidden(w, constants.PermissionDenied, err.Error())
+		return
+	}
 	if err != nil {
 		h.logger.Error().Err(err).Str("ErrorCode", TaskUpdateImportantError).Msg("Error occurred during important toggle task")
 		response.ErrorResponse(w, http.StatusBadRequest, *(response.New(TaskUpdateImportantError, "Failed to toggle important")))
@@ -254,23 +424,54 @@ func (h *Handler) handleGetTasksByGroupId(w http.ResponseWriter, r *http.Request
 		return
 	}
 
-	// Check if only important tasks are requested
-	onlyImportant := r.URL.Query().Get("important") == "true"
-	if r.URL.Query().Get("important") == "false" {
-		h.logger.Error().Msg("not implemented for false case")
-		response.ErrorResponse(w, http.StatusBadRequest, *(response.New(TaskGetServerError, "Not implemented for important=false case")))
+	listParams, err := parseTaskListParams(r)
+	if err != nil {
+		h.logger.Error().Err(err).Str("ErrorCode", TaskListInvalidParameter).Msg(err.Error())
+		badRequestListParams(w, err)
 		return
 	}
 
 	// Use Query Bus
 	result, err := h.queryBus.Execute(query.GetAllTasksByGroupIdQuery{
-		GroupId:       usergroup.GroupId,
-		OnlyImportant: onlyImportant,
+		GroupId:     usergroup.GroupId,
+		List:        listParams,
+		RequesterId: authorization.RequesterId(r),
 	})
+	if authorization.IsForbiddenError(err) {
+		h.logger.Error().Err(err).Str("ErrorCode", constants.PermissionDenied).Msg(err.Error())
+		response.Forbidden(w, constants.PermissionDenied, err.Error())
+		return
+	}
+	if errors.Is(err, query.ErrInvalidListParams) {
+		h.logger.Error().Err(err).Str("ErrorCode", TaskListInvalidParameter).Msg(err.Error())
+		badRequestListParams(w, err)
+		return
+	}
 	if err != nil {
 		h.logger.Error().Err(err).Msg("error occurred during getting tasks")
 		response.ErrorResponse(w, http.StatusBadRequest, *(response.New(TaskGetServerError, "Failed to get tasks")))
 		return
 	}
-	response.WriteJsonWithEncode(w, http.StatusOK, result)
+	page := result.(*query.TaskPage)
+	writeNextCursor(w, page)
+	if acceptsCSV(w, r) {
+		writeTasksCSV(w, page.Tasks)
+		return
+	}
+	response.WriteJsonWithEncode(w, http.StatusOK, page.Tasks)
+}
+
+// invalidPriority answers 422 when a task was rejected for its priority
+func (h *Handler) invalidPriority(w http.ResponseWriter, err error) bool {
+	if !errors.Is(err, domain.ErrInvalidPriority) {
+		return false
+	}
+	h.logger.Error().Err(err).Str("ErrorCode", TaskInvalidPriority).Msg(err.Error())
+	response.ErrorResponse(w, http.StatusUnprocessableEntity, *invalidPriorityProblem(err))
+	return true
+}
+
+func invalidPriorityProblem(err error) *response.ProblemDetails {
+	return response.New(TaskInvalidPriority, "Invalid priority", "the task's priority is not supported").
+		WithErrors(response.FieldError{Field: "priority", Message: err.Error()})
 }
@@ -0,0 +1,153 @@
+package route
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/happYness-Project/taskManagementGolang/internal/authorization"
+	"github.com/happYness-Project/taskManagementGolang/internal/mocks"
+	"github.com/happYness-Project/taskManagementGolang/internal/task/application/query"
+	"github.com/happYness-Project/taskManagementGolang/internal/task/domain"
+	"github.com/happYness-Project/taskManagementGolang/internal/task/repository"
+	usergroupDomain "github.com/happYness-Project/taskManagementGolang/internal/usergroup/domain"
+	"github.com/happYness-Project/taskManagementGolang/pkg/configs"
+	"github.com/happYness-Project/taskManagementGolang/pkg/dbs"
+	"github.com/happYness-Project/taskManagementGolang/pkg/loggers"
+	"github.com/happYness-Project/taskManagementGolang/pkg/response"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testGroupId     = 3
+	testRequesterId = "requester-id"
+)
+
+// stubTaskRepo keeps tasks in memory; other methods are not used by these tests
+type stubTaskRepo struct {
+	repository.TaskRepository
+	tasks map[string]*domain.Task
+}
+
+func (s *stubTaskRepo) GetTaskById(id string) (*domain.Task, error) { return s.tasks[id], nil }
+
+func (s *stubTaskRepo) GetGroupIdByTaskId(taskId string) (int, error) { return testGroupId, nil }
+
+func (s *stubTaskRepo) IncrementVersion(id string, expectedVersion int) (int, error) {
+	task := s.tasks[id]
+	if expectedVersion != 0 && expectedVersion != task.Version {
+		return 0, dbs.ErrVersionConflict
+	}
+	task.Version++
+	return task.Version, nil
+}
+
+func (s *stubTaskRepo) ListTasks(criteria repository.TaskListCriteria) ([]domain.Task, error) {
+	tasks := []domain.Task{}
+	for _, task := range s.tasks {
+		tasks = append(tasks, *task)
+	}
+	if criteria.Limit > 0 && len(tasks) > criteria.Limit {
+		return tasks[:criteria.Limit], nil
+	}
+	return tasks, nil
+}
+
+func (s *stubTaskRepo) WithTx(tx dbs.DBTX) repository.TaskRepository { return s }
+
+func newTestRouter(t *testing.T, taskRepo repository.TaskRepository) *chi.Mux {
+	t.Helper()
+	userRepo := new(mocks.MockUserRepo)
+	userRepo.On("GetUserRoleInGroup", testRequesterId, testGroupId).Return("member", nil)
+	groupRepo := new(mocks.MockUserGroupRepo)
+	groupRepo.On("GetById", testGroupId).Return(&usergroupDomain.UserGroup{GroupId: testGroupId}, nil)
+	handler := NewHandler(loggers.Setup(configs.Env{}), taskRepo, new(mocks.MockContainerRepo), groupRepo, userRepo,
+		new(mocks.MockLabelRepo), authorization.NewPolicy(userRepo), &mocks.MockUnitOfWork{}, nil, &mocks.MockOutboxRepo{})
+	router := chi.NewRouter()
+	router.Use(func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			next.ServeHTTP(w, mocks.WithRequester(r, testRequesterId))
+		})
+	})
+	handler.RegisterRoutes(router)
+	return router
+}
+
+func TestPatchTask(t *testing.T) {
+	newTask := func(t *testing.T) *domain.Task {
+		t.Helper()
+		task, err := domain.CreateTask("Water plants", "", time.Now(), "high")
+		require.NoError(t, err)
+		task.TaskId, task.Version = "task-1", 1
+		return task
+	}
+	patch := func(body string) *http.Request {
+		req := httptest.NewRequest(http.MethodPatch, "/api/tasks/task-1", strings.NewReader(body))
+		req.Header.Set("Content-Type", mergePatchMediaType)
+		req.Header.Set("If-Match", `"1"`)
+		return req
+	}
+
+	t.Run("when the priority is not supported, Then returns 422 as create and update do", func(t *testing.T) {
+		// Arrange
+		task := newTask(t)
+		router := newTestRouter(t, &stubTaskRepo{tasks: map[string]*domain.Task{"task-1": task}})
+		rr := httptest.NewRecorder()
+
+		// Act
+		router.ServeHTTP(rr, patch(`{"priority":"critical"}`))
+
+		// Assert
+		assert.Equal(t, http.StatusUnprocessableEntity, rr.Code)
+		var problem response.ProblemDetails
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &problem))
+		assert.Equal(t, TaskInvalidPriority, problem.ErrorCode)
+		require.Len(t, problem.Errors, 1)
+		assert.Equal(t, "priority", problem.Errors[0].Field)
+		assert.Equal(t, domain.PriorityHigh, task.Priority)
+	})
+
+	t.Run("when another field is rejected, Then returns 400", func(t *testing.T) {
+		// Arrange
+		router := newTestRouter(t, &stubTaskRepo{tasks: map[string]*domain.Task{"task-1": newTask(t)}})
+		rr := httptest.NewRecorder()
+
+		// Act
+		router.ServeHTTP(rr, patch(`{"name":"  "}`))
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		var problem response.ProblemDetails
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &problem))
+		assert.Equal(t, TaskPatchInvalidInput, problem.ErrorCode)
+	})
+}
+
+func TestGetTasksByGroupId(t *testing.T) {
+	t.Run("when neither limit nor cursor is given, Then returns every task without a next cursor", func(t *testing.T) {
+		// Arrange
+		tasks := map[string]*domain.Task{}
+		for i := 0; i < query.DefaultTaskPageLimit+1; i++ {
+			id := fmt.Sprintf("task-%d", i)
+			tasks[id] = &domain.Task{TaskId: id}
+		}
+		router := newTestRouter(t, &stubTaskRepo{tasks: tasks})
+		rr := httptest.NewRecorder()
+
+		// Act
+		router.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/user-groups/%d/tasks", testGroupId), nil))
+
+		// Assert
+		require.Equal(t, http.StatusOK, rr.Code)
+		var listed []domain.Task
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &listed))
+		assert.Len(t, listed, query.DefaultTaskPageLimit+1)
+		assert.Empty(t, rr.Header().Get("X-Next-Cursor"))
+	})
+}
@@ -0,0 +1,71 @@
+package route
+
+import (
+	"encoding/csv"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/happYness-Project/taskManagementGolang/internal/task/domain"
+	"github.com/happYness-Project/taskManagementGolang/pkg/response"
+)
+
+var taskCSVHeader = []string{
+	"id", "name", "description", "target_date", "priority", "labels",
+	"is_completed", "is_important", "assignee_id", "recurrence_rule", "created_at", "updated_at",
+}
+
+// acceptsCSV reports whether the client asked for a task listing as CSV rather than JSON
+func acceptsCSV(w http.ResponseWriter, r *http.Request) bool {
+	w.Header().Add("Vary", "Accept")
+	return response.Negotiate(r, response.ContentTypeJSON, response.ContentTypeCSV) == response.ContentTypeCSV
+}
+
+// writeTasksCSV writes the tasks as CSV with a header row. Dates are RFC 3339, empty when unset, and labels are
+// separated by semicolons. Paging is the same as for JSON, through the cursor header.
+func writeTasksCSV(w http.ResponseWriter, tasks []domain.Task) error {
+	w.Header().Set("Content-Type", response.ContentTypeCSV+"; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+
+	writer := csv.NewWriter(w)
+	if err := writer.Write(taskCSVHeader); err != nil {
+		return err
+	}
+	for _, task := range tasks {
+		record := []string{
+			task.TaskId,
+			csvText(task.TaskName),
+			csvText(task.TaskDesc),
+			csvTime(task.TargetDate),
+			task.Priority.String(),
+			csvText(strings.Join(task.LabelNames(), ";")),
+			strconv.FormatBool(task.IsCompleted),
+			strconv.FormatBool(task.IsImportant),
+			task.AssigneeId,
+			task.RecurrenceRule,
+			csvTime(task.CreatedAt),
+			csvTime(task.UpdatedAt),
+		}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+// csvText keeps spreadsheets from evaluating user text as a formula by quoting text that starts like one
+func csvText(value string) string {
+	if value != "" && strings.ContainsRune("=+-@\t\r", rune(value[0])) {
+		return "'" + value
+	}
+	return value
+}
+
+func csvTime(value time.Time) string {
+	if value.IsZero() {
+		return ""
+	}
+	return value.UTC().Format(time.RFC3339)
+}
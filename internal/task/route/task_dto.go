@@ -1,19 +1,80 @@
 package route
 
-import "time"
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/happYness-Project/taskManagementGolang/internal/task/domain"
+	"github.com/happYness-Project/taskManagementGolang/pkg/response"
+)
 
 type CreateTaskDto struct {
-	TaskName   string    `json:"name"`
-	TaskDesc   string    `json:"description"`
-	TargetDate time.Time `json:"target_date"`
-	Priority   string    `json:"priority"`
-	Category   string    `json:"category"`
+	TaskName       string    `json:"name"`
+	TaskDesc       string    `json:"description"`
+	TargetDate     time.Time `json:"target_date"`
+	Priority       string    `json:"priority"`
+	RecurrenceRule string    `json:"recurrence_rule"`
 }
 
 type UpdateTaskDto struct {
-	TaskName   string    `json:"name"`
-	TaskDesc   string    `json:"description"`
-	TargetDate time.Time `json:"target_date"`
-	Priority   string    `json:"priority"`
-	Category   string    `json:"category"`
+	TaskName       string    `json:"name"`
+	TaskDesc       string    `json:"description"`
+	TargetDate     time.Time `json:"target_date"`
+	Priority       string    `json:"priority"`
+	RecurrenceRule string    `json:"recurrence_rule"`
+}
+
+type AddChecklistItemDto struct {
+	Title string `json:"title"`
+}
+
+type ToggleChecklistItemDto struct {
+	IsChecked bool `json:"is_checked"`
+}
+
+type ReorderChecklistDto struct {
+	ItemIds []string `json:"item_ids"`
+}
+
+type AssignTaskDto struct {
+	AssigneeId string `json:"assignee_id"`
+}
+
+// TargetContainerDto names the container a task is moved or copied to
+type TargetContainerDto struct {
+	TargetContainerId string `json:"target_container_id"`
+}
+
+// BatchTasksDto is the body of a batch of task operations. Mode is "atomic" (the default) or "best_effort".
+type BatchTasksDto struct {
+	Mode       string              `json:"mode"`
+	Operations []BatchOperationDto `json:"operations"`
+}
+
+// BatchOperationDto is one operation of a batch. Op is one of create, update, complete, delete or move.
+// Version plays the part of If-Match and is required for operations on an existing task:
+// the version the client read, or "*" to apply the operation whatever the current version.
+type BatchOperationDto struct {
+	Op                string          `json:"op"`
+	TaskId            string          `json:"task_id"`
+	Version           json.RawMessage `json:"version"`
+	Task              json.RawMessage `json:"task"`
+	IsCompleted       *bool           `json:"is_completed"`
+	TargetContainerId string          `json:"target_container_id"`
+}
+
+type BatchResultDto struct {
+	Index  int                      `json:"index"`
+	Op     string                   `json:"op"`
+	Status int                      `json:"status"`
+	TaskId string                   `json:"task_id,omitempty"`
+	Task   *domain.Task             `json:"task,omitempty"`
+	Error  *response.ProblemDetails `json:"error,omitempty"`
+}
+
+type BatchResponseDto struct {
+	Mode      string           `json:"mode"`
+	Succeeded int              `json:"succeeded"`
+	Failed    int              `json:"failed"`
+	Results   []BatchResultDto `json:"results"`
 }
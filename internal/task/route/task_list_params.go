@@ -0,0 +1,94 @@
+package route
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/happYness-Project/taskManagementGolang/internal/task/application/query"
+	"github.com/happYness-Project/taskManagementGolang/pkg/response"
+)
+
+const NextCursorHeader = "X-Next-Cursor"
+
+// parseTaskListParams reads paging, sorting and filtering from the query string:
+// cursor, limit, sort, completed, important, priority, labels, target_from, target_to.
+// labels is a comma separated list of label ids; tasks must carry all of them.
+func parseTaskListParams(r *http.Request) (query.TaskListParams, error) {
+	values := r.URL.Query()
+	params := query.TaskListParams{
+		Cursor:   values.Get("cursor"),
+		Sort:     values.Get("sort"),
+		Priority: values.Get("priority"),
+	}
+	if v := values.Get("labels"); v != "" {
+		for _, labelId := range strings.Split(v, ",") {
+			params.LabelIds = append(params.LabelIds, strings.TrimSpace(labelId))
+		}
+	}
+
+	if v := values.Get("limit"); v != "" {
+		limit, err := strconv.Atoi(v)
+		if err != nil || limit < 1 {
+			return params, fmt.Errorf("limit must be a positive integer")
+		}
+		params.Limit = limit
+	}
+
+	var err error
+	if params.IsCompleted, err = parseOptionalBool(values.Get("completed"), "completed"); err != nil {
+		return params, err
+	}
+	if params.IsImportant, err = parseOptionalBool(values.Get("important"), "important"); err != nil {
+		return params, err
+	}
+	if params.TargetFrom, err = parseOptionalDate(values.Get("target_from"), "target_from", false); err != nil {
+		return params, err
+	}
+	if params.TargetTo, err = parseOptionalDate(values.Get("target_to"), "target_to", true); err != nil {
+		return params, err
+	}
+	return params, nil
+}
+
+func parseOptionalBool(v, name string) (*bool, error) {
+	if v == "" {
+		return nil, nil
+	}
+	b, err := strconv.ParseBool(v)
+	if err != nil {
+		return nil, fmt.Errorf("%s must be true or false", name)
+	}
+	return &b, nil
+}
+
+// parseOptionalDate accepts RFC3339 timestamps or plain dates (YYYY-MM-DD).
+// A plain date used as an upper bound covers the whole day.
+func parseOptionalDate(v, name string, endOfDay bool) (*time.Time, error) {
+	if v == "" {
+		return nil, nil
+	}
+	if t, err := time.Parse(time.RFC3339, v); err == nil {
+		return &t, nil
+	}
+	t, err := time.Parse(time.DateOnly, v)
+	if err != nil {
+		return nil, fmt.Errorf("%s must be a RFC3339 timestamp or YYYY-MM-DD date", name)
+	}
+	if endOfDay {
+		t = t.Add(24*time.Hour - time.Nanosecond)
+	}
+	return &t, nil
+}
+
+func writeNextCursor(w http.ResponseWriter, page *query.TaskPage) {
+	if page.NextCursor != "" {
+		w.Header().Set(NextCursorHeader, page.NextCursor)
+	}
+}
+
+func badRequestListParams(w http.ResponseWriter, err error) {
+	response.ErrorResponse(w, http.StatusBadRequest, *(response.New(TaskListInvalidParameter, "Invalid parameter", err.Error())))
+}
@@ -0,0 +1,111 @@
+package route
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/happYness-Project/taskManagementGolang/internal/authorization"
+	"github.com/happYness-Project/taskManagementGolang/internal/task/application/query"
+	"github.com/happYness-Project/taskManagementGolang/internal/task/domain"
+	"github.com/happYness-Project/taskManagementGolang/pkg/constants"
+	"github.com/happYness-Project/taskManagementGolang/pkg/response"
+)
+
+// parseOccurrenceWindow reads the optional from/to bounds; the query applies defaults
+func parseOccurrenceWindow(r *http.Request) (time.Time, time.Time, error) {
+	var from, to time.Time
+	values := r.URL.Query()
+	fromPtr, err := parseOptionalDate(values.Get("from"), "from", false)
+	if err != nil {
+		return from, to, err
+	}
+	toPtr, err := parseOptionalDate(values.Get("to"), "to", true)
+	if err != nil {
+		return from, to, err
+	}
+	if fromPtr != nil {
+		from = *fromPtr
+	}
+	if toPtr != nil {
+		to = *toPtr
+	}
+	return from, to, nil
+}
+
+func badRequestOccurrenceWindow(w http.ResponseWriter, err error) {
+	response.ErrorResponse(w, http.StatusBadRequest, *(response.New(TaskOccurrenceInvalidWindow, "Invalid occurrence window", err.Error())))
+}
+
+func (h *Handler) handleGetTaskOccurrences(w http.ResponseWriter, r *http.Request) {
+	from, to, err := parseOccurrenceWindow(r)
+	if err != nil {
+		h.logger.Error().Err(err).Str("ErrorCode", TaskOccurrenceInvalidWindow).Msg(err.Error())
+		badRequestOccurrenceWindow(w, err)
+		return
+	}
+
+	// Use Query Bus
+	result, err := h.queryBus.Execute(query.GetTaskOccurrencesQuery{
+		TaskId:      chi.URLParam(r, "taskID"),
+		From:        from,
+		To:          to,
+		RequesterId: authorization.RequesterId(r),
+	})
+	if authorization.IsForbiddenError(err) {
+		h.logger.Error().Err(err).Str("ErrorCode", constants.PermissionDenied).Msg(err.Error())
+		response.Forbidden(w, constants.PermissionDenied, err.Error())
+		return
+	}
+	if errors.Is(err, query.ErrInvalidOccurrenceWindow) || errors.Is(err, domain.ErrInvalidRecurrence) {
+		h.logger.Error().Err(err).Str("ErrorCode", TaskOccurrenceInvalidWindow).Msg(err.Error())
+		badRequestOccurrenceWindow(w, err)
+		return
+	}
+	if err != nil {
+		h.logger.Error().Err(err).Str("ErrorCode", TaskGetNotFound).Msg("Error occurred during GetTaskOccurrences")
+		response.ErrorResponse(w, http.StatusNotFound, *(response.New(TaskGetNotFound, "Not Found", "task does not exist")))
+		return
+	}
+	response.WriteJsonWithEncode(w, http.StatusOK, result)
+}
+
+func (h *Handler) handleGetContainerOccurrences(w http.ResponseWriter, r *http.Request) {
+	containerId := chi.URLParam(r, "containerID")
+	if containerId == "" {
+		h.logger.Error().Msg("container Id missing")
+		response.BadRequestMissingParameters(w)
+		return
+	}
+	from, to, err := parseOccurrenceWindow(r)
+	if err != nil {
+		h.logger.Error().Err(err).Str("ErrorCode", TaskOccurrenceInvalidWindow).Msg(err.Error())
+		badRequestOccurrenceWindow(w, err)
+		return
+	}
+
+	// Use Query Bus
+	result, err := h.queryBus.Execute(query.GetContainerOccurrencesQuery{
+		ContainerId: containerId,
+		From:        from,
+		To:          to,
+		RequesterId: authorization.RequesterId(r),
+	})
+	if authorization.IsForbiddenError(err) {
+		h.logger.Error().Err(err).Str("ErrorCode", constants.PermissionDenied).Msg(err.Error())
+		response.Forbidden(w, constants.PermissionDenied, err.Error())
+		return
+	}
+	if errors.Is(err, query.ErrInvalidOccurrenceWindow) || errors.Is(err, domain.ErrInvalidRecurrence) {
+		h.logger.Error().Err(err).Str("ErrorCode", TaskOccurrenceInvalidWindow).Msg(err.Error())
+		badRequestOccurrenceWindow(w, err)
+		return
+	}
+	if err != nil {
+		h.logger.Error().Err(err).Str("ErrorCode", TaskGetServerError).Msg("Error occurred during GetContainerOccurrences")
+		response.ErrorResponse(w, http.StatusInternalServerError, *(response.New(TaskGetServerError, "Failed to get occurrences", err.Error())))
+		return
+	}
+	response.WriteJsonWithEncode(w, http.StatusOK, result)
+}
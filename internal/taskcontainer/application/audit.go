@@ -0,0 +1,94 @@
+package application
+
+import (
+	auditApp "github.com/happYness-Project/taskManagementGolang/internal/audit/application"
+	auditDomain "github.com/happYness-Project/taskManagementGolang/internal/audit/domain"
+	cmd "github.com/happYness-Project/taskManagementGolang/internal/taskcontainer/application/command"
+	"github.com/happYness-Project/taskManagementGolang/internal/taskcontainer/domain"
+)
+
+// auditSubject describes the container a command mutates for the activity log
+func (bus *CommandBus) auditSubject(command interface{}) auditApp.Subject {
+	subject := auditApp.Subject{
+		Action:        auditApp.ActionOf(command),
+		AggregateType: auditDomain.AggregateTaskContainer,
+		Load:          bus.loadContainer,
+	}
+
+	switch c := command.(type) {
+	case cmd.CreateContainerCommand:
+		subject.ActorId = c.RequesterId
+		subject.GroupId = c.UserGroupId
+		subject.Resolve = func(result interface{}) (string, int) {
+			containerId, _ := result.(string)
+			return containerId, 0
+		}
+	case cmd.DeleteContainerCommand:
+		subject.ActorId = c.RequesterId
+		subject.AggregateId = c.ContainerId
+		if container, err := bus.containerRepo.GetById(c.ContainerId); err == nil && container != nil {
+			subject.GroupId = container.UsergroupId
+		}
+	case cmd.RestoreContainerCommand:
+		subject.ActorId = c.RequesterId
+		subject.AggregateId = c.ContainerId
+		if container, err := bus.containerRepo.GetDeletedContainerById(c.ContainerId); err == nil && container != nil {
+			subject.GroupId = container.UsergroupId
+		}
+	case cmd.CloneContainerCommand:
+		subject.ActorId = c.RequesterId
+		subject.GroupId = c.UserGroupId
+		if c.UserGroupId == 0 {
+			if container, err := bus.containerRepo.GetById(c.ContainerId); err == nil && container != nil {
+				subject.GroupId = container.UsergroupId
+			}
+		}
+		subject.Resolve = func(result interface{}) (string, int) {
+			containerId, _ := result.(string)
+			return containerId, 0
+		}
+	case cmd.InstantiateTemplateCommand:
+		subject.ActorId = c.RequesterId
+		subject.GroupId = c.UserGroupId
+		subject.Resolve = func(result interface{}) (string, int) {
+			containerId, _ := result.(string)
+			return containerId, 0
+		}
+	case cmd.SaveContainerTemplateCommand:
+		subject.ActorId = c.RequesterId
+		subject.AggregateType = auditDomain.AggregateContainerTemplate
+		subject.Load = bus.loadTemplate
+		subject.Resolve = func(result interface{}) (string, int) {
+			if template, ok := result.(*domain.ContainerTemplate); ok {
+				return template.Id, template.UsergroupId
+			}
+			return "", 0
+		}
+	case cmd.DeleteTemplateCommand:
+		subject.ActorId = c.RequesterId
+		subject.AggregateType = auditDomain.AggregateContainerTemplate
+		subject.AggregateId = c.TemplateId
+		subject.Load = bus.loadTemplate
+		if template, err := bus.templateRepo.GetTemplateById(c.TemplateId); err == nil && template != nil {
+			subject.GroupId = template.UsergroupId
+		}
+	}
+	return subject
+}
+
+// loadContainer returns nil for a missing container; GetById returns an empty one
+func (bus *CommandBus) loadContainer(containerId string) (interface{}, error) {
+	container, err := bus.containerRepo.GetById(containerId)
+	if err != nil || container == nil || container.Id == "" {
+		return nil, err
+	}
+	return container, nil
+}
+
+func (bus *CommandBus) loadTemplate(templateId string) (interface{}, error) {
+	template, err := bus.templateRepo.GetTemplateById(templateId)
+	if err != nil || template == nil {
+		return nil, err
+	}
+	return template, nil
+}
@@ -0,0 +1,118 @@
+package command
+
+import (
+	"fmt"
+
+	labelDomain "github.com/happYness-Project/taskManagementGolang/internal/label/domain"
+	labelRepo "github.com/happYness-Project/taskManagementGolang/internal/label/repository"
+	outboxRepo "github.com/happYness-Project/taskManagementGolang/internal/outbox/repository"
+	taskDomain "github.com/happYness-Project/taskManagementGolang/internal/task/domain"
+	taskRepo "github.com/happYness-Project/taskManagementGolang/internal/task/repository"
+	"github.com/happYness-Project/taskManagementGolang/internal/taskcontainer/domain"
+	"github.com/happYness-Project/taskManagementGolang/internal/taskcontainer/repository"
+	"github.com/happYness-Project/taskManagementGolang/pkg/dbs"
+)
+
+// CloneContainerCommand represents the command to copy a task container and its tasks
+type CloneContainerCommand struct {
+	ContainerId string
+	UserGroupId int    // group of the clone; 0 keeps the container's group
+	Name        string // defaults to the container's name
+	RequesterId string // UUID from JWT
+}
+
+// CloneContainerCommandHandler handles copying a task container and its tasks
+type CloneContainerCommandHandler struct {
+	containerRepo repository.ContainerRepository
+	taskRepo      taskRepo.TaskRepository
+	labelRepo     labelRepo.LabelRepository
+	outboxRepo    outboxRepo.OutboxRepository
+	uow           dbs.UnitOfWork
+}
+
+func NewCloneContainerCommandHandler(
+	containerRepo repository.ContainerRepository,
+	taskRepo taskRepo.TaskRepository,
+	labelRepo labelRepo.LabelRepository,
+	outboxRepo outboxRepo.OutboxRepository,
+	uow dbs.UnitOfWork,
+) *CloneContainerCommandHandler {
+	return &CloneContainerCommandHandler{
+		containerRepo: containerRepo,
+		taskRepo:      taskRepo,
+		labelRepo:     labelRepo,
+		outboxRepo:    outboxRepo,
+		uow:           uow,
+	}
+}
+
+// Handle executes the clone container command and returns the clone's id.
+// Tasks are copied open, with their checklists unchecked; they keep their assignee only within the same group.
+// In another group their labels become that group's labels of the same names.
+func (h *CloneContainerCommandHandler) Handle(cmd CloneContainerCommand) (string, error) {
+	var cloneId string
+	err := h.uow.Do(func(tx dbs.DBTX) error {
+		containerRepo := h.containerRepo.WithTx(tx)
+		taskRepo := h.taskRepo.WithTx(tx)
+
+		source, err := containerRepo.GetById(cmd.ContainerId)
+		if err != nil || source == nil || source.Id == "" {
+			return fmt.Errorf("%w: %s", domain.ErrContainerNotFound, cmd.ContainerId)
+		}
+		groupId := cmd.UserGroupId
+		if groupId == 0 {
+			groupId = source.UsergroupId
+		}
+
+		clone, err := source.Clone(cmd.Name, groupId)
+		if err != nil {
+			return err
+		}
+		sourceTasks, err := loadContainerTasks(taskRepo, source.Id)
+		if err != nil {
+			return err
+		}
+		sameGroup := groupId == source.UsergroupId
+		var groupLabels []taskDomain.Label
+		if !sameGroup {
+			labels, err := h.labelRepo.WithTx(tx).GetByGroupId(groupId)
+			if err != nil {
+				return fmt.Errorf("failed to retrieve group labels: %w", err)
+			}
+			groupLabels = labelDomain.TaskLabels(labels)
+		}
+		tasks := make([]*taskDomain.Task, 0, len(sourceTasks))
+		for _, task := range sourceTasks {
+			copied := task.Copy(sameGroup)
+			if !sameGroup {
+				copied.MatchLabels(groupLabels)
+			}
+			tasks = append(tasks, copied)
+		}
+
+		cloneId = clone.Id
+		return createContainerWithTasks(containerRepo, taskRepo, h.outboxRepo.WithTx(tx), clone, tasks)
+	})
+	if err != nil {
+		return "", err
+	}
+	return cloneId, nil
+}
+
+// createContainerWithTasks persists a new container and its tasks, then stores all their events under its group
+func createContainerWithTasks(containerRepo repository.ContainerRepository, taskRepo taskRepo.TaskRepository, outbox outboxRepo.OutboxRepository, container *domain.TaskContainer, tasks []*taskDomain.Task) error {
+	if err := containerRepo.CreateContainer(*container); err != nil {
+		return fmt.Errorf("failed to create container: %w", err)
+	}
+	events := container.PullEvents()
+	for _, task := range tasks {
+		if _, err := taskRepo.CreateTask(container.Id, *task); err != nil {
+			return fmt.Errorf("failed to create task: %w", err)
+		}
+		events = append(events, task.PullEvents()...)
+	}
+	if err := outbox.Append(container.UsergroupId, events...); err != nil {
+		return fmt.Errorf("failed to store container events: %w", err)
+	}
+	return nil
+}
@@ -3,9 +3,10 @@ package command
 import (
 	"fmt"
 
-	"github.com/google/uuid"
+	outboxRepo "github.com/happYness-Project/taskManagementGolang/internal/outbox/repository"
 	"github.com/happYness-Project/taskManagementGolang/internal/taskcontainer/domain"
 	"github.com/happYness-Project/taskManagementGolang/internal/taskcontainer/repository"
+	"github.com/happYness-Project/taskManagementGolang/pkg/dbs"
 )
 
 // CreateContainerCommand represents the command to create a new task container
@@ -14,38 +15,48 @@ type CreateContainerCommand struct {
 	Description string
 	Type        string
 	UserGroupId int
+	RequesterId string // UUID from JWT
 }
 
 // CreateContainerCommandHandler handles creating a new task container
 type CreateContainerCommandHandler struct {
 	containerRepo repository.ContainerRepository
+	outboxRepo    outboxRepo.OutboxRepository
+	uow           dbs.UnitOfWork
 }
 
 func NewCreateContainerCommandHandler(
 	containerRepo repository.ContainerRepository,
+	outboxRepo outboxRepo.OutboxRepository,
+	uow dbs.UnitOfWork,
 ) *CreateContainerCommandHandler {
 	return &CreateContainerCommandHandler{
 		containerRepo: containerRepo,
+		outboxRepo:    outboxRepo,
+		uow:           uow,
 	}
 }
 
 // Handle executes the create container command
 func (h *CreateContainerCommandHandler) Handle(cmd CreateContainerCommand) (string, error) {
 	// Create domain model
-	container := domain.TaskContainer{
-		Id:             uuid.New().String(),
-		Name:           cmd.Name,
-		Description:    cmd.Description,
-		Type:           cmd.Type,
-		IsActive:       true,
-		Activity_level: 0,
-		UsergroupId:    cmd.UserGroupId,
-	}
+	container := domain.NewTaskContainer(cmd.Name, cmd.Description, cmd.Type, cmd.UserGroupId)
 
 	// Persist
-	err := h.containerRepo.CreateContainer(container)
+	err := h.uow.Do(func(tx dbs.DBTX) error {
+		err := h.containerRepo.WithTx(tx).CreateContainer(*container)
+		if err != nil {
+			return fmt.Errorf("failed to create container: %w", err)
+		}
+
+		err = h.outboxRepo.WithTx(tx).Append(container.UsergroupId, container.PullEvents()...)
+		if err != nil {
+			return fmt.Errorf("failed to store container events: %w", err)
+		}
+		return nil
+	})
 	if err != nil {
-		return "", fmt.Errorf("failed to create container: %w", err)
+		return "", err
 	}
 
 	return container.Id, nil
@@ -3,33 +3,66 @@ package command
 import (
 	"fmt"
 
+	outboxRepo "github.com/happYness-Project/taskManagementGolang/internal/outbox/repository"
 	"github.com/happYness-Project/taskManagementGolang/internal/taskcontainer/repository"
+	"github.com/happYness-Project/taskManagementGolang/pkg/dbs"
 )
 
 // DeleteContainerCommand represents the command to delete a task container
 type DeleteContainerCommand struct {
-	ContainerId string
+	ContainerId     string
+	RequesterId     string // UUID from JWT
+	ExpectedVersion int    // from If-Match; 0 skips the version check
 }
 
 // DeleteContainerCommandHandler handles deleting a task container
 type DeleteContainerCommandHandler struct {
 	containerRepo repository.ContainerRepository
+	outboxRepo    outboxRepo.OutboxRepository
+	uow           dbs.UnitOfWork
 }
 
 func NewDeleteContainerCommandHandler(
 	containerRepo repository.ContainerRepository,
+	outboxRepo outboxRepo.OutboxRepository,
+	uow dbs.UnitOfWork,
 ) *DeleteContainerCommandHandler {
 	return &DeleteContainerCommandHandler{
 		containerRepo: containerRepo,
+		outboxRepo:    outboxRepo,
+		uow:           uow,
 	}
 }
 
 // Handle executes the delete container command
 func (h *DeleteContainerCommandHandler) Handle(cmd DeleteContainerCommand) error {
-	err := h.containerRepo.DeleteContainer(cmd.ContainerId)
-	if err != nil {
-		return fmt.Errorf("failed to delete container: %w", err)
-	}
+	return h.uow.Do(func(tx dbs.DBTX) error {
+		containerRepo := h.containerRepo.WithTx(tx)
+
+		container, err := containerRepo.GetById(cmd.ContainerId)
+		if err != nil || container == nil || container.Id == "" {
+			return fmt.Errorf("task container not found: %s", cmd.ContainerId)
+		}
+		if _, err = containerRepo.IncrementVersion(cmd.ContainerId, cmd.ExpectedVersion); err != nil {
+			return err
+		}
+		container.Delete()
+
+		// Tasks go to the trash with the container's timestamp, so restoring it brings back exactly these tasks
+		err = containerRepo.SoftDeleteTasksByContainerId(cmd.ContainerId, *container.DeletedAt)
+		if err != nil {
+			return fmt.Errorf("failed to delete container tasks: %w", err)
+		}
+
+		err = containerRepo.SoftDeleteContainer(cmd.ContainerId, *container.DeletedAt)
+		if err != nil {
+			return fmt.Errorf("failed to delete container: %w", err)
+		}
 
-	return nil
+		err = h.outboxRepo.WithTx(tx).Append(container.UsergroupId, container.PullEvents()...)
+		if err != nil {
+			return fmt.Errorf("failed to store container events: %w", err)
+		}
+		return nil
+	})
 }
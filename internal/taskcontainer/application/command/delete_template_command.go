@@ -0,0 +1,25 @@
+package command
+
+import (
+	"github.com/happYness-Project/taskManagementGolang/internal/taskcontainer/repository"
+)
+
+// DeleteTemplateCommand represents the command to delete a container template
+type DeleteTemplateCommand struct {
+	TemplateId  string
+	RequesterId string // UUID from JWT
+}
+
+// DeleteTemplateCommandHandler handles deleting a container template
+type DeleteTemplateCommandHandler struct {
+	templateRepo repository.TemplateRepository
+}
+
+func NewDeleteTemplateCommandHandler(templateRepo repository.TemplateRepository) *DeleteTemplateCommandHandler {
+	return &DeleteTemplateCommandHandler{templateRepo: templateRepo}
+}
+
+// Handle executes the delete template command; instances of the template are left untouched
+func (h *DeleteTemplateCommandHandler) Handle(cmd DeleteTemplateCommand) error {
+	return h.templateRepo.DeleteTemplate(cmd.TemplateId)
+}
@@ -0,0 +1,83 @@
+package command
+
+import (
+	"fmt"
+	"time"
+
+	labelDomain "github.com/happYness-Project/taskManagementGolang/internal/label/domain"
+	labelRepo "github.com/happYness-Project/taskManagementGolang/internal/label/repository"
+	outboxRepo "github.com/happYness-Project/taskManagementGolang/internal/outbox/repository"
+	taskRepo "github.com/happYness-Project/taskManagementGolang/internal/task/repository"
+	"github.com/happYness-Project/taskManagementGolang/internal/taskcontainer/domain"
+	"github.com/happYness-Project/taskManagementGolang/internal/taskcontainer/repository"
+	"github.com/happYness-Project/taskManagementGolang/pkg/dbs"
+)
+
+// InstantiateTemplateCommand represents the command to create a task container from a template
+type InstantiateTemplateCommand struct {
+	TemplateId  string
+	UserGroupId int
+	Name        string    // defaults to the template's name
+	StartDate   time.Time // relative target dates count from it; defaults to today
+	RequesterId string    // UUID from JWT
+}
+
+// InstantiateTemplateCommandHandler handles creating a task container from a template
+type InstantiateTemplateCommandHandler struct {
+	containerRepo repository.ContainerRepository
+	taskRepo      taskRepo.TaskRepository
+	templateRepo  repository.TemplateRepository
+	labelRepo     labelRepo.LabelRepository
+	outboxRepo    outboxRepo.OutboxRepository
+	uow           dbs.UnitOfWork
+}
+
+func NewInstantiateTemplateCommandHandler(
+	containerRepo repository.ContainerRepository,
+	taskRepo taskRepo.TaskRepository,
+	templateRepo repository.TemplateRepository,
+	labelRepo labelRepo.LabelRepository,
+	outboxRepo outboxRepo.OutboxRepository,
+	uow dbs.UnitOfWork,
+) *InstantiateTemplateCommandHandler {
+	return &InstantiateTemplateCommandHandler{
+		containerRepo: containerRepo,
+		taskRepo:      taskRepo,
+		templateRepo:  templateRepo,
+		labelRepo:     labelRepo,
+		outboxRepo:    outboxRepo,
+		uow:           uow,
+	}
+}
+
+// Handle executes the instantiate template command and returns the new container's id
+func (h *InstantiateTemplateCommandHandler) Handle(cmd InstantiateTemplateCommand) (string, error) {
+	template, err := h.templateRepo.GetTemplateById(cmd.TemplateId)
+	if err != nil {
+		return "", fmt.Errorf("failed to retrieve template: %w", err)
+	}
+	if template == nil {
+		return "", domain.ErrTemplateNotFound
+	}
+
+	start := cmd.StartDate
+	if start.IsZero() {
+		start = time.Now()
+	}
+	groupLabels, err := h.labelRepo.GetByGroupId(cmd.UserGroupId)
+	if err != nil {
+		return "", fmt.Errorf("failed to retrieve group labels: %w", err)
+	}
+	container, tasks, err := template.Instantiate(cmd.Name, cmd.UserGroupId, start, labelDomain.TaskLabels(groupLabels))
+	if err != nil {
+		return "", err
+	}
+
+	err = h.uow.Do(func(tx dbs.DBTX) error {
+		return createContainerWithTasks(h.containerRepo.WithTx(tx), h.taskRepo.WithTx(tx), h.outboxRepo.WithTx(tx), container, tasks)
+	})
+	if err != nil {
+		return "", err
+	}
+	return container.Id, nil
+}
@@ -0,0 +1,72 @@
+package command
+
+import (
+	"fmt"
+
+	outboxRepo "github.com/happYness-Project/taskManagementGolang/internal/outbox/repository"
+	"github.com/happYness-Project/taskManagementGolang/internal/taskcontainer/domain"
+	"github.com/happYness-Project/taskManagementGolang/internal/taskcontainer/repository"
+	"github.com/happYness-Project/taskManagementGolang/pkg/dbs"
+)
+
+// RestoreContainerCommand represents the command to take a task container back out of the trash
+type RestoreContainerCommand struct {
+	ContainerId string
+	RequesterId string // UUID from JWT
+}
+
+// RestoreContainerCommandHandler handles restoring a task container together with its tasks
+type RestoreContainerCommandHandler struct {
+	containerRepo repository.ContainerRepository
+	outboxRepo    outboxRepo.OutboxRepository
+	uow           dbs.UnitOfWork
+}
+
+func NewRestoreContainerCommandHandler(
+	containerRepo repository.ContainerRepository,
+	outboxRepo outboxRepo.OutboxRepository,
+	uow dbs.UnitOfWork,
+) *RestoreContainerCommandHandler {
+	return &RestoreContainerCommandHandler{
+		containerRepo: containerRepo,
+		outboxRepo:    outboxRepo,
+		uow:           uow,
+	}
+}
+
+// Handle executes the restore container command
+func (h *RestoreContainerCommandHandler) Handle(cmd RestoreContainerCommand) error {
+	return h.uow.Do(func(tx dbs.DBTX) error {
+		containerRepo := h.containerRepo.WithTx(tx)
+
+		container, err := containerRepo.GetDeletedContainerById(cmd.ContainerId)
+		if err != nil {
+			return fmt.Errorf("failed to find deleted container: %w", err)
+		}
+		if container == nil {
+			return domain.ErrNotInTrash
+		}
+		if err := container.Restore(); err != nil {
+			return err
+		}
+
+		// Tasks are matched on the container's deleted_at, so they are restored while it is still set
+		err = containerRepo.RestoreTasksByContainerId(cmd.ContainerId)
+		if err != nil {
+			return fmt.Errorf("failed to restore container tasks: %w", err)
+		}
+		err = containerRepo.RestoreContainer(cmd.ContainerId)
+		if err != nil {
+			return fmt.Errorf("failed to restore container: %w", err)
+		}
+		if _, err = containerRepo.IncrementVersion(cmd.ContainerId, 0); err != nil {
+			return err
+		}
+
+		err = h.outboxRepo.WithTx(tx).Append(container.UsergroupId, container.PullEvents()...)
+		if err != nil {
+			return fmt.Errorf("failed to store container events: %w", err)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,73 @@
+package command
+
+import (
+	"fmt"
+	"time"
+
+	taskDomain "github.com/happYness-Project/taskManagementGolang/internal/task/domain"
+	taskRepo "github.com/happYness-Project/taskManagementGolang/internal/task/repository"
+	"github.com/happYness-Project/taskManagementGolang/internal/taskcontainer/domain"
+	"github.com/happYness-Project/taskManagementGolang/internal/taskcontainer/repository"
+)
+
+// SaveContainerTemplateCommand represents the command to save a task container and its tasks as a template
+type SaveContainerTemplateCommand struct {
+	ContainerId string
+	Name        string // defaults to the container's name
+	RequesterId string // UUID from JWT
+}
+
+// SaveContainerTemplateCommandHandler handles saving a task container as a template
+type SaveContainerTemplateCommandHandler struct {
+	containerRepo repository.ContainerRepository
+	taskRepo      taskRepo.TaskRepository
+	templateRepo  repository.TemplateRepository
+}
+
+func NewSaveContainerTemplateCommandHandler(
+	containerRepo repository.ContainerRepository,
+	taskRepo taskRepo.TaskRepository,
+	templateRepo repository.TemplateRepository,
+) *SaveContainerTemplateCommandHandler {
+	return &SaveContainerTemplateCommandHandler{
+		containerRepo: containerRepo,
+		taskRepo:      taskRepo,
+		templateRepo:  templateRepo,
+	}
+}
+
+// Handle executes the save container template command
+func (h *SaveContainerTemplateCommandHandler) Handle(cmd SaveContainerTemplateCommand) (*domain.ContainerTemplate, error) {
+	container, err := h.containerRepo.GetById(cmd.ContainerId)
+	if err != nil || container == nil || container.Id == "" {
+		return nil, fmt.Errorf("%w: %s", domain.ErrContainerNotFound, cmd.ContainerId)
+	}
+	tasks, err := loadContainerTasks(h.taskRepo, cmd.ContainerId)
+	if err != nil {
+		return nil, err
+	}
+
+	template, err := domain.NewContainerTemplate(cmd.Name, *container, tasks, cmd.RequesterId, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if err := h.templateRepo.CreateTemplate(*template); err != nil {
+		return nil, fmt.Errorf("failed to create template: %w", err)
+	}
+	return template, nil
+}
+
+// loadContainerTasks returns the tasks of the container with their checklists
+func loadContainerTasks(repo taskRepo.TaskRepository, containerId string) ([]taskDomain.Task, error) {
+	tasks, err := repo.GetTasksByContainerId(containerId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve container tasks: %w", err)
+	}
+	for i := range tasks {
+		tasks[i].Checklist, err = repo.GetChecklistItems(tasks[i].TaskId)
+		if err != nil {
+			return nil, fmt.Errorf("failed to retrieve task checklist: %w", err)
+		}
+	}
+	return tasks, nil
+}
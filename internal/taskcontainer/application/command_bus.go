@@ -1,36 +1,198 @@
 package application
 
 import (
+	"context"
 	"fmt"
 
+	auditApp "github.com/happYness-Project/taskManagementGolang/internal/audit/application"
+	"github.com/happYness-Project/taskManagementGolang/internal/authorization"
+	labelRepo "github.com/happYness-Project/taskManagementGolang/internal/label/repository"
+	outboxRepo "github.com/happYness-Project/taskManagementGolang/internal/outbox/repository"
+	taskRepo "github.com/happYness-Project/taskManagementGolang/internal/task/repository"
 	cmd "github.com/happYness-Project/taskManagementGolang/internal/taskcontainer/application/command"
+	"github.com/happYness-Project/taskManagementGolang/internal/taskcontainer/domain"
 	"github.com/happYness-Project/taskManagementGolang/internal/taskcontainer/repository"
+	"github.com/happYness-Project/taskManagementGolang/pkg/dbs"
 )
 
 // CommandBus routes commands to their handlers
 type CommandBus struct {
-	createContainerHandler *cmd.CreateContainerCommandHandler
-	deleteContainerHandler *cmd.DeleteContainerCommandHandler
+	createContainerHandler  *cmd.CreateContainerCommandHandler
+	deleteContainerHandler  *cmd.DeleteContainerCommandHandler
+	restoreContainerHandler *cmd.RestoreContainerCommandHandler
+	saveTemplateHandler     *cmd.SaveContainerTemplateCommandHandler
+	instantiateHandler      *cmd.InstantiateTemplateCommandHandler
+	cloneContainerHandler   *cmd.CloneContainerCommandHandler
+	deleteTemplateHandler   *cmd.DeleteTemplateCommandHandler
+
+	containerRepo repository.ContainerRepository
+	templateRepo  repository.TemplateRepository
+	taskRepo      taskRepo.TaskRepository
+	labelRepo     labelRepo.LabelRepository
+	outboxRepo    outboxRepo.OutboxRepository
+	policy        *authorization.Policy
+	uow           dbs.UnitOfWork
+	recorder      *auditApp.Recorder
 }
 
 // NewCommandBus creates a new command bus with all handlers registered
 func NewCommandBus(
 	containerRepo repository.ContainerRepository,
+	templateRepo repository.TemplateRepository,
+	taskRepo taskRepo.TaskRepository,
+	labelRepo labelRepo.LabelRepository,
+	policy *authorization.Policy,
+	uow dbs.UnitOfWork,
+	recorder *auditApp.Recorder,
+	outboxRepo outboxRepo.OutboxRepository,
 ) *CommandBus {
 	return &CommandBus{
-		createContainerHandler: cmd.NewCreateContainerCommandHandler(containerRepo),
-		deleteContainerHandler: cmd.NewDeleteContainerCommandHandler(containerRepo),
+		createContainerHandler:  cmd.NewCreateContainerCommandHandler(containerRepo, outboxRepo, uow),
+		deleteContainerHandler:  cmd.NewDeleteContainerCommandHandler(containerRepo, outboxRepo, uow),
+		restoreContainerHandler: cmd.NewRestoreContainerCommandHandler(containerRepo, outboxRepo, uow),
+		saveTemplateHandler:     cmd.NewSaveContainerTemplateCommandHandler(containerRepo, taskRepo, templateRepo),
+		instantiateHandler:      cmd.NewInstantiateTemplateCommandHandler(containerRepo, taskRepo, templateRepo, labelRepo, outboxRepo, uow),
+		cloneContainerHandler:   cmd.NewCloneContainerCommandHandler(containerRepo, taskRepo, labelRepo, outboxRepo, uow),
+		deleteTemplateHandler:   cmd.NewDeleteTemplateCommandHandler(templateRepo),
+		containerRepo:           containerRepo,
+		templateRepo:            templateRepo,
+		taskRepo:                taskRepo,
+		labelRepo:               labelRepo,
+		outboxRepo:              outboxRepo,
+		policy:                  policy,
+		uow:                     uow,
+		recorder:                recorder,
 	}
 }
 
-// Execute dispatches the command to the appropriate handler
-func (bus *CommandBus) Execute(command interface{}) (interface{}, error) {
+// withTx returns a bus whose handlers and activity log run inside the given transaction.
+// The handlers' own units of work become savepoints of it.
+func (bus *CommandBus) withTx(tx dbs.DBTX) *CommandBus {
+	return NewCommandBus(
+		bus.containerRepo.WithTx(tx),
+		bus.templateRepo.WithTx(tx),
+		bus.taskRepo.WithTx(tx),
+		bus.labelRepo.WithTx(tx),
+		bus.policy,
+		dbs.NewUnitOfWork(tx),
+		bus.recorder.WithTx(tx),
+		bus.outboxRepo.WithTx(tx),
+	)
+}
+
+// Execute authorizes the command, then dispatches it to the appropriate handler and records it in the activity log
+// in one transaction.
+// ctx carries the request id of the HTTP request that issued the command.
+func (bus *CommandBus) Execute(ctx context.Context, command interface{}) (interface{}, error) {
+	if err := bus.authorize(command); err != nil {
+		return nil, err
+	}
+
+	var result interface{}
+	err := bus.uow.Do(func(tx dbs.DBTX) error {
+		txBus := bus.withTx(tx)
+		var err error
+		result, err = txBus.recorder.Track(ctx, txBus.auditSubject(command), func() (interface{}, error) {
+			return txBus.dispatch(command)
+		})
+		return err
+	})
+	return result, err
+}
+
+// dispatch routes the command to its handler
+func (bus *CommandBus) dispatch(command interface{}) (interface{}, error) {
 	switch c := command.(type) {
 	case cmd.CreateContainerCommand:
 		return bus.createContainerHandler.Handle(c)
 	case cmd.DeleteContainerCommand:
 		return nil, bus.deleteContainerHandler.Handle(c)
+	case cmd.RestoreContainerCommand:
+		return nil, bus.restoreContainerHandler.Handle(c)
+	case cmd.SaveContainerTemplateCommand:
+		return bus.saveTemplateHandler.Handle(c)
+	case cmd.InstantiateTemplateCommand:
+		return bus.instantiateHandler.Handle(c)
+	case cmd.CloneContainerCommand:
+		return bus.cloneContainerHandler.Handle(c)
+	case cmd.DeleteTemplateCommand:
+		return nil, bus.deleteTemplateHandler.Handle(c)
 	default:
 		return nil, fmt.Errorf("unknown command type: %T", command)
 	}
 }
+
+// authorize checks the requester's role in the group owning the container.
+// Any member can create a container, only admins can delete or restore one.
+// Templates and clones need membership of both the source group and the target group.
+// A template can be deleted by its author or by an admin of its group.
+func (bus *CommandBus) authorize(command interface{}) error {
+	switch c := command.(type) {
+	case cmd.CreateContainerCommand:
+		return bus.policy.RequireMember(c.RequesterId, c.UserGroupId)
+	case cmd.DeleteContainerCommand:
+		container, err := bus.containerRepo.GetById(c.ContainerId)
+		if err != nil || container == nil {
+			return fmt.Errorf("task container not found: %w", err)
+		}
+		return bus.policy.RequireAdmin(c.RequesterId, container.UsergroupId)
+	case cmd.RestoreContainerCommand:
+		container, err := bus.containerRepo.GetDeletedContainerById(c.ContainerId)
+		if err != nil {
+			return fmt.Errorf("task container not found: %w", err)
+		}
+		if container == nil {
+			return domain.ErrNotInTrash
+		}
+		return bus.policy.RequireAdmin(c.RequesterId, container.UsergroupId)
+	case cmd.SaveContainerTemplateCommand:
+		container, err := bus.containerRepo.GetById(c.ContainerId)
+		if err != nil || container == nil || container.Id == "" {
+			return fmt.Errorf("%w: %s", domain.ErrContainerNotFound, c.ContainerId)
+		}
+		return bus.policy.RequireMember(c.RequesterId, container.UsergroupId)
+	case cmd.CloneContainerCommand:
+		container, err := bus.containerRepo.GetById(c.ContainerId)
+		if err != nil || container == nil || container.Id == "" {
+			return fmt.Errorf("%w: %s", domain.ErrContainerNotFound, c.ContainerId)
+		}
+		if err := bus.policy.RequireMember(c.RequesterId, container.UsergroupId); err != nil {
+			return err
+		}
+		if c.UserGroupId == 0 {
+			return nil
+		}
+		return bus.policy.RequireMember(c.RequesterId, c.UserGroupId)
+	case cmd.InstantiateTemplateCommand:
+		template, err := bus.getTemplate(c.TemplateId)
+		if err != nil {
+			return err
+		}
+		if err := bus.policy.RequireMember(c.RequesterId, template.UsergroupId); err != nil {
+			return err
+		}
+		return bus.policy.RequireMember(c.RequesterId, c.UserGroupId)
+	case cmd.DeleteTemplateCommand:
+		template, err := bus.getTemplate(c.TemplateId)
+		if err != nil {
+			return err
+		}
+		if template.CreatedBy == c.RequesterId {
+			return bus.policy.RequireMember(c.RequesterId, template.UsergroupId)
+		}
+		return bus.policy.RequireAdmin(c.RequesterId, template.UsergroupId)
+	default:
+		return nil
+	}
+}
+
+func (bus *CommandBus) getTemplate(templateId string) (*domain.ContainerTemplate, error) {
+	template, err := bus.templateRepo.GetTemplateById(templateId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve template: %w", err)
+	}
+	if template == nil {
+		return nil, domain.ErrTemplateNotFound
+	}
+	return template, nil
+}
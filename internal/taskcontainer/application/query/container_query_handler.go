@@ -8,14 +8,18 @@ import (
 )
 
 // Queries define the read operations
-type GetAllContainersQuery struct{}
+type GetAllContainersQuery struct {
+	RequesterId string // UUID from JWT
+}
 
 type GetContainerByIdQuery struct {
 	ContainerId string
+	RequesterId string // UUID from JWT
 }
 
 type GetContainersByGroupIdQuery struct {
-	GroupId int
+	GroupId     int
+	RequesterId string // UUID from JWT
 }
 
 // ContainerQueryHandler handles all read operations for TaskContainer
@@ -31,9 +35,9 @@ func NewContainerQueryHandler(
 	}
 }
 
-// HandleGetAllContainers retrieves all containers
+// HandleGetAllContainers retrieves all containers in the groups the requester belongs to
 func (h *ContainerQueryHandler) HandleGetAllContainers(query GetAllContainersQuery) ([]*domain.TaskContainer, error) {
-	containers, err := h.containerRepo.AllTaskContainers()
+	containers, err := h.containerRepo.GetContainersByUserId(query.RequesterId)
 	if err != nil {
 		return nil, fmt.Errorf("failed to retrieve containers: %w", err)
 	}
@@ -0,0 +1,48 @@
+package query
+
+import (
+	"fmt"
+
+	"github.com/happYness-Project/taskManagementGolang/internal/taskcontainer/domain"
+	"github.com/happYness-Project/taskManagementGolang/internal/taskcontainer/repository"
+)
+
+type GetTemplateByIdQuery struct {
+	TemplateId  string
+	RequesterId string // UUID from JWT
+}
+
+type GetTemplatesByGroupIdQuery struct {
+	GroupId     int
+	RequesterId string // UUID from JWT
+}
+
+// TemplateQueryHandler handles all read operations for container templates
+type TemplateQueryHandler struct {
+	templateRepo repository.TemplateRepository
+}
+
+func NewTemplateQueryHandler(templateRepo repository.TemplateRepository) *TemplateQueryHandler {
+	return &TemplateQueryHandler{templateRepo: templateRepo}
+}
+
+// HandleGetTemplateById retrieves a single template by ID
+func (h *TemplateQueryHandler) HandleGetTemplateById(query GetTemplateByIdQuery) (*domain.ContainerTemplate, error) {
+	template, err := h.templateRepo.GetTemplateById(query.TemplateId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve template: %w", err)
+	}
+	if template == nil {
+		return nil, domain.ErrTemplateNotFound
+	}
+	return template, nil
+}
+
+// HandleGetTemplatesByGroupId retrieves the templates saved in a group
+func (h *TemplateQueryHandler) HandleGetTemplatesByGroupId(query GetTemplatesByGroupIdQuery) ([]domain.ContainerTemplate, error) {
+	templates, err := h.templateRepo.GetTemplatesByGroupId(query.GroupId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve templates by group: %w", err)
+	}
+	return templates, nil
+}
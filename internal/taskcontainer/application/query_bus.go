@@ -3,26 +3,43 @@ package application
 import (
 	"fmt"
 
+	"github.com/happYness-Project/taskManagementGolang/internal/authorization"
 	qry "github.com/happYness-Project/taskManagementGolang/internal/taskcontainer/application/query"
+	"github.com/happYness-Project/taskManagementGolang/internal/taskcontainer/domain"
 	"github.com/happYness-Project/taskManagementGolang/internal/taskcontainer/repository"
 )
 
 // QueryBus routes queries to their handlers
 type QueryBus struct {
-	queryHandler *qry.ContainerQueryHandler
+	queryHandler    *qry.ContainerQueryHandler
+	templateHandler *qry.TemplateQueryHandler
+
+	containerRepo repository.ContainerRepository
+	templateRepo  repository.TemplateRepository
+	policy        *authorization.Policy
 }
 
 // NewQueryBus creates a new query bus with all handlers registered
 func NewQueryBus(
 	containerRepo repository.ContainerRepository,
+	templateRepo repository.TemplateRepository,
+	policy *authorization.Policy,
 ) *QueryBus {
 	return &QueryBus{
-		queryHandler: qry.NewContainerQueryHandler(containerRepo),
+		queryHandler:    qry.NewContainerQueryHandler(containerRepo),
+		templateHandler: qry.NewTemplateQueryHandler(templateRepo),
+		containerRepo:   containerRepo,
+		templateRepo:    templateRepo,
+		policy:          policy,
 	}
 }
 
 // Execute dispatches the query to the appropriate handler
 func (bus *QueryBus) Execute(query interface{}) (interface{}, error) {
+	if err := bus.authorize(query); err != nil {
+		return nil, err
+	}
+
 	switch q := query.(type) {
 	case qry.GetAllContainersQuery:
 		return bus.queryHandler.HandleGetAllContainers(q)
@@ -30,7 +47,39 @@ func (bus *QueryBus) Execute(query interface{}) (interface{}, error) {
 		return bus.queryHandler.HandleGetContainerById(q)
 	case qry.GetContainersByGroupIdQuery:
 		return bus.queryHandler.HandleGetContainersByGroupId(q)
+	case qry.GetTemplateByIdQuery:
+		return bus.templateHandler.HandleGetTemplateById(q)
+	case qry.GetTemplatesByGroupIdQuery:
+		return bus.templateHandler.HandleGetTemplatesByGroupId(q)
 	default:
 		return nil, fmt.Errorf("unknown query type: %T", query)
 	}
 }
+
+// authorize checks that the requester is a member of the group being read.
+// GetAllContainersQuery needs no check because it is already scoped to the requester's groups.
+func (bus *QueryBus) authorize(query interface{}) error {
+	switch q := query.(type) {
+	case qry.GetContainerByIdQuery:
+		container, err := bus.containerRepo.GetById(q.ContainerId)
+		if err != nil || container == nil {
+			return fmt.Errorf("task container not found: %w", err)
+		}
+		return bus.policy.RequireMember(q.RequesterId, container.UsergroupId)
+	case qry.GetContainersByGroupIdQuery:
+		return bus.policy.RequireMember(q.RequesterId, q.GroupId)
+	case qry.GetTemplateByIdQuery:
+		template, err := bus.templateRepo.GetTemplateById(q.TemplateId)
+		if err != nil {
+			return fmt.Errorf("failed to retrieve template: %w", err)
+		}
+		if template == nil {
+			return domain.ErrTemplateNotFound
+		}
+		return bus.policy.RequireMember(q.RequesterId, template.UsergroupId)
+	case qry.GetTemplatesByGroupIdQuery:
+		return bus.policy.RequireMember(q.RequesterId, q.GroupId)
+	default:
+		return nil
+	}
+}
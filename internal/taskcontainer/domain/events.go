@@ -0,0 +1,42 @@
+package domain
+
+const (
+	EventContainerCreated  = "task_container.created"
+	EventContainerDeleted  = "task_container.deleted"
+	EventContainerRestored = "task_container.restored"
+
+	aggregateTaskContainer = "task_container"
+)
+
+// ContainerCreated is raised when a task container is created in a group
+type ContainerCreated struct {
+	ContainerId string `json:"container_id"`
+	Name        string `json:"name"`
+	GroupId     int    `json:"group_id"`
+}
+
+func (e ContainerCreated) EventType() string     { return EventContainerCreated }
+func (e ContainerCreated) AggregateType() string { return aggregateTaskContainer }
+func (e ContainerCreated) AggregateId() string   { return e.ContainerId }
+
+// ContainerDeleted is raised when a task container is moved to the trash together with its tasks
+type ContainerDeleted struct {
+	ContainerId string `json:"container_id"`
+	Name        string `json:"name"`
+	GroupId     int    `json:"group_id"`
+}
+
+func (e ContainerDeleted) EventType() string     { return EventContainerDeleted }
+func (e ContainerDeleted) AggregateType() string { return aggregateTaskContainer }
+func (e ContainerDeleted) AggregateId() string   { return e.ContainerId }
+
+// ContainerRestored is raised when a task container is taken back out of the trash together with its tasks
+type ContainerRestored struct {
+	ContainerId string `json:"container_id"`
+	Name        string `json:"name"`
+	GroupId     int    `json:"group_id"`
+}
+
+func (e ContainerRestored) EventType() string     { return EventContainerRestored }
+func (e ContainerRestored) AggregateType() string { return aggregateTaskContainer }
+func (e ContainerRestored) AggregateId() string   { return e.ContainerId }
@@ -1,6 +1,21 @@
 package domain
 
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/happYness-Project/taskManagementGolang/pkg/events"
+)
+
+var (
+	ErrNotInTrash        = errors.New("task container is not in the trash")
+	ErrContainerNotFound = errors.New("task container not found")
+)
+
 type TaskContainer struct {
+	events.Aggregate `json:"-"`
+
 	Id             string `json:"id"`
 	Name           string `json:"name"`
 	Description    string `json:"description"`
@@ -8,4 +23,42 @@ type TaskContainer struct {
 	IsActive       bool   `json:"is_active"`
 	Activity_level int    `json:"activity_level"`
 	UsergroupId    int    `json:"usergroup_id"`
+	Version        int    `json:"version"`
+	// DeletedAt is set while the container is in the trash
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+}
+
+// NewTaskContainer creates an active container in the user group, raising ContainerCreated
+func NewTaskContainer(name, description, containerType string, usergroupId int) *TaskContainer {
+	container := &TaskContainer{
+		Id:             uuid.New().String(),
+		Name:           name,
+		Description:    description,
+		Type:           containerType,
+		IsActive:       true,
+		Activity_level: 0,
+		UsergroupId:    usergroupId,
+		Version:        1,
+	}
+	container.Raise(ContainerCreated{ContainerId: container.Id, Name: container.Name, GroupId: container.UsergroupId})
+	return container
+}
+
+// Delete moves the container to the trash, raising ContainerDeleted
+func (c *TaskContainer) Delete() {
+	deletedAt := time.Now().UTC()
+	c.IsActive = false
+	c.DeletedAt = &deletedAt
+	c.Raise(ContainerDeleted{ContainerId: c.Id, Name: c.Name, GroupId: c.UsergroupId})
+}
+
+// Restore takes the container back out of the trash, raising ContainerRestored
+func (c *TaskContainer) Restore() error {
+	if c.DeletedAt == nil {
+		return ErrNotInTrash
+	}
+	c.IsActive = true
+	c.DeletedAt = nil
+	c.Raise(ContainerRestored{ContainerId: c.Id, Name: c.Name, GroupId: c.UsergroupId})
+	return nil
 }
@@ -0,0 +1,191 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	taskDomain "github.com/happYness-Project/taskManagementGolang/internal/task/domain"
+)
+
+var (
+	ErrInvalidContainer    = errors.New("invalid task container")
+	ErrInvalidTemplate     = errors.New("invalid container template")
+	ErrTemplateNotFound    = errors.New("container template not found")
+	ErrInvalidRelativeDate = errors.New(`relative date must look like "+3 days" or "+1 week"`)
+)
+
+const maxContainerNameLength = 100
+
+var relativeDatePattern = regexp.MustCompile(`^\+\s*(\d{1,4})\s*(day|days|week|weeks)$`)
+
+// RelativeDate is a target date counted in whole days from the day a template is instantiated, written "+3 days"
+type RelativeDate struct {
+	Days int
+}
+
+// ParseRelativeDate reads "+N day(s)" or "+N week(s)"
+func ParseRelativeDate(value string) (RelativeDate, error) {
+	match := relativeDatePattern.FindStringSubmatch(strings.ToLower(strings.TrimSpace(value)))
+	if match == nil {
+		return RelativeDate{}, fmt.Errorf("%w, got %q", ErrInvalidRelativeDate, value)
+	}
+	count, _ := strconv.Atoi(match[1])
+	if strings.HasPrefix(match[2], "week") {
+		count *= 7
+	}
+	return RelativeDate{Days: count}, nil
+}
+
+func (d RelativeDate) String() string {
+	if d.Days == 1 {
+		return "+1 day"
+	}
+	return fmt.Sprintf("+%d days", d.Days)
+}
+
+func (d RelativeDate) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+func (d *RelativeDate) UnmarshalText(text []byte) error {
+	parsed, err := ParseRelativeDate(string(text))
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
+
+// From returns the date the given number of days after start
+func (d RelativeDate) From(start time.Time) time.Time {
+	return start.AddDate(0, 0, d.Days)
+}
+
+// TemplateTask is a task saved in a template. Its target date, when it has one, is relative.
+type TemplateTask struct {
+	Name           string        `json:"name"`
+	Description    string        `json:"description,omitempty"`
+	Priority       string        `json:"priority,omitempty"`
+	Labels         []string      `json:"labels,omitempty"` // names, matched against the labels of the group instantiating it
+	IsImportant    bool          `json:"is_important"`
+	RecurrenceRule string        `json:"recurrence_rule,omitempty"`
+	TargetDate     *RelativeDate `json:"target_date,omitempty"`
+	Checklist      []string      `json:"checklist,omitempty"`
+}
+
+// ContainerTemplate is a reusable copy of a container and its open and completed tasks, owned by the group it was
+// saved in. It can be instantiated into any group the requester belongs to.
+type ContainerTemplate struct {
+	Id          string         `json:"id"`
+	Name        string         `json:"name"`
+	Description string         `json:"description"`
+	Type        string         `json:"type"`
+	UsergroupId int            `json:"usergroup_id"`
+	CreatedBy   string         `json:"created_by"`
+	CreatedAt   time.Time      `json:"created_at"`
+	Tasks       []TemplateTask `json:"tasks"`
+}
+
+// NewContainerTemplate saves the container and its tasks as a template named name, or after the container when
+// name is empty. Target dates become relative to the day the template is saved; overdue ones become "+0 days".
+func NewContainerTemplate(name string, container TaskContainer, tasks []taskDomain.Task, createdBy string, now time.Time) (*ContainerTemplate, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		name = strings.TrimSpace(container.Name)
+	}
+	if name == "" || len(name) > maxContainerNameLength {
+		return nil, fmt.Errorf("%w: name must be between 1 and %d characters", ErrInvalidTemplate, maxContainerNameLength)
+	}
+
+	today := startOfDay(now)
+	templateTasks := make([]TemplateTask, 0, len(tasks))
+	for _, task := range tasks {
+		templateTask := TemplateTask{
+			Name:           task.TaskName,
+			Description:    task.TaskDesc,
+			Priority:       task.Priority.String(),
+			Labels:         task.LabelNames(),
+			IsImportant:    task.IsImportant,
+			RecurrenceRule: task.RecurrenceRule,
+		}
+		if !task.TargetDate.IsZero() {
+			days := int(startOfDay(task.TargetDate).Sub(today).Hours() / 24)
+			templateTask.TargetDate = &RelativeDate{Days: max(days, 0)}
+		}
+		for _, item := range task.Checklist {
+			templateTask.Checklist = append(templateTask.Checklist, item.Title)
+		}
+		templateTasks = append(templateTasks, templateTask)
+	}
+
+	return &ContainerTemplate{
+		Id:          uuid.New().String(),
+		Name:        name,
+		Description: container.Description,
+		Type:        strings.TrimSpace(container.Type),
+		UsergroupId: container.UsergroupId,
+		CreatedBy:   createdBy,
+		CreatedAt:   now.UTC(),
+		Tasks:       templateTasks,
+	}, nil
+}
+
+// Instantiate creates a container in the group from the template, named name or after the template, together with
+// its tasks. Relative target dates count from start, and task labels are the group's labels of the same names.
+// The container raises ContainerCreated and every task TaskCreated.
+func (t *ContainerTemplate) Instantiate(name string, usergroupId int, start time.Time, groupLabels []taskDomain.Label) (*TaskContainer, []*taskDomain.Task, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		name = t.Name
+	}
+	if len(name) > maxContainerNameLength {
+		return nil, nil, fmt.Errorf("%w: name cannot exceed %d characters", ErrInvalidTemplate, maxContainerNameLength)
+	}
+
+	container := NewTaskContainer(name, t.Description, t.Type, usergroupId)
+	tasks := make([]*taskDomain.Task, 0, len(t.Tasks))
+	for _, templateTask := range t.Tasks {
+		var targetDate time.Time
+		if templateTask.TargetDate != nil {
+			targetDate = templateTask.TargetDate.From(start)
+		}
+		task, err := taskDomain.CreateTask(templateTask.Name, templateTask.Description, targetDate, templateTask.Priority)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%w: task %q: %v", ErrInvalidTemplate, templateTask.Name, err)
+		}
+		if err := task.SetRecurrence(templateTask.RecurrenceRule); err != nil {
+			return nil, nil, fmt.Errorf("%w: task %q: %v", ErrInvalidTemplate, templateTask.Name, err)
+		}
+		task.IsImportant = templateTask.IsImportant
+		task.Labels = taskDomain.LabelsNamed(templateTask.Labels, groupLabels)
+		for _, title := range templateTask.Checklist {
+			if _, err := task.AddChecklistItem(title); err != nil {
+				return nil, nil, fmt.Errorf("%w: task %q: %v", ErrInvalidTemplate, templateTask.Name, err)
+			}
+		}
+		tasks = append(tasks, task)
+	}
+	return container, tasks, nil
+}
+
+// Clone creates an empty copy of the container in the group, named name or after the container, raising ContainerCreated
+func (c *TaskContainer) Clone(name string, usergroupId int) (*TaskContainer, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		name = c.Name
+	}
+	if len(name) > maxContainerNameLength {
+		return nil, fmt.Errorf("%w: name cannot exceed %d characters", ErrInvalidContainer, maxContainerNameLength)
+	}
+	return NewTaskContainer(name, c.Description, strings.TrimSpace(c.Type), usergroupId), nil
+}
+
+func startOfDay(t time.Time) time.Time {
+	year, month, day := t.UTC().Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,132 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	taskDomain "github.com/happYness-Project/taskManagementGolang/internal/task/domain"
+)
+
+func TestParseRelativeDate(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    int
+		wantErr bool
+	}{
+		{value: "+3 days", want: 3},
+		{value: "+1 day", want: 1},
+		{value: " +2 Weeks ", want: 14},
+		{value: "+0 days", want: 0},
+		{value: "3 days", wantErr: true},
+		{value: "-1 day", wantErr: true},
+		{value: "+1 month", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := ParseRelativeDate(tt.value)
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidRelativeDate) {
+					t.Fatalf("ParseRelativeDate(%q) error = %v, want ErrInvalidRelativeDate", tt.value, err)
+				}
+				return
+			}
+			if err != nil || got.Days != tt.want {
+				t.Errorf("ParseRelativeDate(%q) = %v, %v, want %d days", tt.value, got, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestContainerTemplate(t *testing.T) {
+	saved := time.Date(2025, time.March, 10, 18, 30, 0, 0, time.UTC)
+	container := TaskContainer{Id: "container-1", Name: "Weekly groceries", Type: "grocery   ", UsergroupId: 2}
+
+	newTask := func(t *testing.T, name string, targetDate time.Time, checklist ...string) taskDomain.Task {
+		t.Helper()
+		task, err := taskDomain.CreateTask(name, "", targetDate, "high")
+		if err != nil {
+			t.Fatalf("CreateTask() unexpected error: %v", err)
+		}
+		for _, title := range checklist {
+			task.AddChecklistItem(title)
+		}
+		task.ToggleCompletion(true)
+		return *task
+	}
+
+	t.Run("when container is saved, Then target dates become relative to the day it is saved", func(t *testing.T) {
+		tasks := []taskDomain.Task{
+			newTask(t, "Apple pie ingredients", time.Date(2025, time.March, 13, 8, 0, 0, 0, time.UTC), "Apples"),
+			newTask(t, "Overdue errand", time.Date(2025, time.March, 1, 8, 0, 0, 0, time.UTC)),
+			newTask(t, "Someday", time.Time{}),
+		}
+
+		template, err := NewContainerTemplate("", container, tasks, "requester-id", saved)
+		if err != nil {
+			t.Fatalf("NewContainerTemplate() unexpected error: %v", err)
+		}
+
+		if template.Name != "Weekly groceries" || template.Type != "grocery" || template.UsergroupId != 2 {
+			t.Errorf("template = %+v, want the container's name, type and group", template)
+		}
+		if got := template.Tasks[0].TargetDate; got == nil || got.String() != "+3 days" {
+			t.Errorf("Tasks[0].TargetDate = %v, want +3 days", got)
+		}
+		if got := template.Tasks[1].TargetDate; got == nil || got.Days != 0 {
+			t.Errorf("Tasks[1].TargetDate = %v, want +0 days", got)
+		}
+		if template.Tasks[2].TargetDate != nil {
+			t.Errorf("Tasks[2].TargetDate = %v, want none", template.Tasks[2].TargetDate)
+		}
+		if len(template.Tasks[0].Checklist) != 1 || template.Tasks[0].Checklist[0] != "Apples" {
+			t.Errorf("Tasks[0].Checklist = %v, want [Apples]", template.Tasks[0].Checklist)
+		}
+	})
+
+	t.Run("when template is instantiated, Then open tasks are due relative to the start date and carry the group's labels", func(t *testing.T) {
+		template := &ContainerTemplate{Name: "Weekly groceries", Tasks: []TemplateTask{
+			{Name: "Apple pie ingredients", Priority: "high", Labels: []string{"Grocery", "Baking"}, TargetDate: &RelativeDate{Days: 3}, Checklist: []string{"Apples"}},
+			{Name: "Someday"},
+		}}
+		start := time.Date(2025, time.April, 1, 0, 0, 0, 0, time.UTC)
+		groupLabels := []taskDomain.Label{{Id: "label-1", Name: "grocery", Color: "#00ff00"}}
+
+		instance, tasks, err := template.Instantiate("", 5, start, groupLabels)
+		if err != nil {
+			t.Fatalf("Instantiate() unexpected error: %v", err)
+		}
+
+		if instance.Name != "Weekly groceries" || instance.UsergroupId != 5 {
+			t.Errorf("container = %+v, want the template's name in group 5", instance)
+		}
+		if len(tasks) != 2 {
+			t.Fatalf("len(tasks) = %d, want 2", len(tasks))
+		}
+		if want := time.Date(2025, time.April, 4, 0, 0, 0, 0, time.UTC); !tasks[0].TargetDate.Equal(want) {
+			t.Errorf("tasks[0].TargetDate = %v, want %v", tasks[0].TargetDate, want)
+		}
+		if !tasks[1].TargetDate.IsZero() {
+			t.Errorf("tasks[1].TargetDate = %v, want none", tasks[1].TargetDate)
+		}
+		if tasks[0].IsCompleted || len(tasks[0].Checklist) != 1 || tasks[0].Checklist[0].IsChecked {
+			t.Errorf("tasks[0] = %+v, want an open task with an unchecked checklist", tasks[0])
+		}
+		if len(tasks[0].Labels) != 1 || tasks[0].Labels[0].Id != "label-1" {
+			t.Errorf("tasks[0].Labels = %+v, want the group's grocery label only", tasks[0].Labels)
+		}
+		if events := instance.PullEvents(); len(events) != 1 {
+			t.Errorf("container events = %+v, want ContainerCreated", events)
+		}
+	})
+
+	t.Run("when template name is too long, Then ErrInvalidTemplate is returned", func(t *testing.T) {
+		_, err := NewContainerTemplate(strings.Repeat("a", 101), container, nil, "requester-id", saved)
+
+		if !errors.Is(err, ErrInvalidTemplate) {
+			t.Errorf("NewContainerTemplate() error = %v, want ErrInvalidTemplate", err)
+		}
+	})
+}
@@ -3,10 +3,12 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"time"
 
 	"github.com/happYness-Project/taskManagementGolang/internal/taskcontainer/domain"
+	"github.com/happYness-Project/taskManagementGolang/pkg/dbs"
 )
 
 const dbTimeout = time.Second * 5
@@ -15,21 +17,43 @@ type ContainerRepository interface {
 	AllTaskContainers() ([]*domain.TaskContainer, error)
 	GetById(id string) (*domain.TaskContainer, error)
 	GetContainersByGroupId(groupId int) ([]domain.TaskContainer, error)
+	GetContainersByUserId(userId string) ([]*domain.TaskContainer, error)
 	CreateContainer(container domain.TaskContainer) error
+	// DeleteContainer and DeleteTasksByContainerId remove rows permanently; the SoftDelete methods move them to the trash
 	DeleteContainer(id string) error
+	DeleteTasksByContainerId(id string) error
+	SoftDeleteContainer(id string, deletedAt time.Time) error
+	SoftDeleteTasksByContainerId(id string, deletedAt time.Time) error
+	GetDeletedContainerById(id string) (*domain.TaskContainer, error)
+	GetDeletedContainersByGroupId(groupId int) ([]domain.TaskContainer, error)
+	// RestoreTasksByContainerId must run before RestoreContainer, as it matches tasks on the container's deleted_at
+	RestoreTasksByContainerId(id string) error
+	RestoreContainer(id string) error
+	// PurgeDeletedContainers permanently removes the containers trashed before the cutoff, with all their tasks,
+	// and returns how many containers there were
+	PurgeDeletedContainers(before time.Time) (int64, error)
 	RemoveContainerByUsergroupId(groupId int) error
+	// IncrementVersion bumps the container's version and returns the new one. When expectedVersion is not 0
+	// and no longer matches, nothing changes and dbs.ErrVersionConflict is returned.
+	IncrementVersion(id string, expectedVersion int) (int, error)
+	WithTx(tx dbs.DBTX) ContainerRepository
 }
 
 type ContainerRepo struct {
-	DB *sql.DB
+	DB dbs.DBTX
 }
 
-func NewContainerRepository(db *sql.DB) *ContainerRepo {
+func NewContainerRepository(db dbs.DBTX) *ContainerRepo {
 	return &ContainerRepo{
 		DB: db,
 	}
 }
 
+// WithTx returns a copy of the repository bound to the given transaction
+func (m *ContainerRepo) WithTx(tx dbs.DBTX) ContainerRepository {
+	return &ContainerRepo{DB: tx}
+}
+
 func (m *ContainerRepo) AllTaskContainers() ([]*domain.TaskContainer, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), dbTimeout)
 	defer cancel()
@@ -90,6 +114,28 @@ func (m *ContainerRepo) GetContainersByGroupId(groupId int) ([]domain.TaskContai
 	return containers, nil
 }
 
+func (m *ContainerRepo) GetContainersByUserId(userId string) ([]*domain.TaskContainer, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), dbTimeout)
+	defer cancel()
+
+	rows, err := m.DB.QueryContext(ctx, sqlGetContainersByUserId, userId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	containers := []*domain.TaskContainer{}
+	for rows.Next() {
+		container, err := scanRowsIntoContainer(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		containers = append(containers, container)
+	}
+	return containers, nil
+}
+
 func (m *ContainerRepo) CreateContainer(c domain.TaskContainer) error {
 	_, err := m.DB.Exec(sqlCreateContainer, c.Id, c.Name, c.Description, c.IsActive, c.Activity_level, c.Type, c.UsergroupId)
 	if err != nil {
@@ -106,6 +152,105 @@ func (m *ContainerRepo) DeleteContainer(id string) error {
 	return nil
 }
 
+func (m *ContainerRepo) DeleteTasksByContainerId(id string) error {
+	_, err := m.DB.Exec(sqlDeleteTasksByContainerId, id)
+	if err != nil {
+		return fmt.Errorf("unable to remove tasks of task container : %w", err)
+	}
+	return nil
+}
+
+func (m *ContainerRepo) SoftDeleteContainer(id string, deletedAt time.Time) error {
+	_, err := m.DB.Exec(sqlSoftDeleteContainer, id, deletedAt)
+	if err != nil {
+		return fmt.Errorf("unable to move task container to the trash : %w", err)
+	}
+	return nil
+}
+
+func (m *ContainerRepo) SoftDeleteTasksByContainerId(id string, deletedAt time.Time) error {
+	_, err := m.DB.Exec(sqlSoftDeleteTasksByContainerId, id, deletedAt)
+	if err != nil {
+		return fmt.Errorf("unable to move tasks of task container to the trash : %w", err)
+	}
+	return nil
+}
+
+// GetDeletedContainerById returns nil when the container does not exist or is not in the trash
+func (m *ContainerRepo) GetDeletedContainerById(id string) (*domain.TaskContainer, error) {
+	rows, err := m.DB.Query(sqlGetDeletedContainerById, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var container *domain.TaskContainer
+	for rows.Next() {
+		var deletedAt time.Time
+		container, err = scanRowsIntoContainer(rows, &deletedAt)
+		if err != nil {
+			return nil, err
+		}
+		container.DeletedAt = &deletedAt
+	}
+	return container, rows.Err()
+}
+
+func (m *ContainerRepo) GetDeletedContainersByGroupId(groupId int) ([]domain.TaskContainer, error) {
+	rows, err := m.DB.Query(sqlGetDeletedContainersByGroupId, groupId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	containers := []domain.TaskContainer{}
+	for rows.Next() {
+		var deletedAt time.Time
+		container, err := scanRowsIntoContainer(rows, &deletedAt)
+		if err != nil {
+			return nil, err
+		}
+		container.DeletedAt = &deletedAt
+		containers = append(containers, *container)
+	}
+	return containers, rows.Err()
+}
+
+func (m *ContainerRepo) RestoreTasksByContainerId(id string) error {
+	_, err := m.DB.Exec(sqlRestoreTasksByContainerId, id)
+	if err != nil {
+		return fmt.Errorf("unable to restore tasks of task container : %w", err)
+	}
+	return nil
+}
+
+func (m *ContainerRepo) RestoreContainer(id string) error {
+	_, err := m.DB.Exec(sqlRestoreContainer, id)
+	if err != nil {
+		return fmt.Errorf("unable to restore task container : %w", err)
+	}
+	return nil
+}
+
+func (m *ContainerRepo) PurgeDeletedContainers(before time.Time) (int64, error) {
+	var purged int64
+	err := dbs.RunInTx(m.DB, func(tx dbs.DBTX) error {
+		if _, err := tx.Exec(sqlPurgeTasksOfDeletedContainers, before); err != nil {
+			return err
+		}
+		result, err := tx.Exec(sqlPurgeDeletedContainers, before)
+		if err != nil {
+			return err
+		}
+		purged, err = result.RowsAffected()
+		return err
+	})
+	if err != nil {
+		return 0, fmt.Errorf("unable to purge deleted task containers : %w", err)
+	}
+	return purged, nil
+}
+
 func (m *ContainerRepo) RemoveContainerByUsergroupId(groupId int) error {
 	_, err := m.DB.Exec(sqlDeleteContainerByUsergroupId, groupId)
 	if err != nil {
@@ -114,15 +259,30 @@ func (m *ContainerRepo) RemoveContainerByUsergroupId(groupId int) error {
 	return nil
 }
 
-func scanRowsIntoContainer(rows *sql.Rows) (*domain.TaskContainer, error) {
+func (m *ContainerRepo) IncrementVersion(id string, expectedVersion int) (int, error) {
+	var version int
+	err := m.DB.QueryRow(sqlIncrementContainerVersion, id, expectedVersion).Scan(&version)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, dbs.ErrVersionConflict
+	}
+	if err != nil {
+		return 0, fmt.Errorf("unable to update container version : %w", err)
+	}
+	return version, nil
+}
+
+// scanRowsIntoContainer scans the container columns followed by any extra columns of the query
+func scanRowsIntoContainer(rows *sql.Rows, extra ...any) (*domain.TaskContainer, error) {
 	container := new(domain.TaskContainer)
-	err := rows.Scan(
+	dest := []any{
 		&container.Id,
 		&container.Name,
 		&container.Description,
 		&container.IsActive,
 		&container.UsergroupId,
-	)
+		&container.Version,
+	}
+	err := rows.Scan(append(dest, extra...)...)
 	if err != nil {
 		return nil, err
 	}
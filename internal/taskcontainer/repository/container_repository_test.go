@@ -8,6 +8,7 @@ import (
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/google/uuid"
 	"github.com/happYness-Project/taskManagementGolang/internal/taskcontainer/domain"
+	"github.com/happYness-Project/taskManagementGolang/pkg/dbs"
 	"github.com/stretchr/testify/require"
 )
 
@@ -96,6 +97,33 @@ func TestContainerRepo_GetContainersByGroupId(t *testing.T) {
 		require.Nil(t, err)
 	})
 }
+func TestContainerRepo_IncrementVersion(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+	containerRepo := NewContainerRepository(db)
+
+	t.Run("when the expected version matches, Then the next version is returned", func(t *testing.T) {
+		mock.ExpectQuery(sqlIncrementContainerVersion).
+			WithArgs("container-1", 2).
+			WillReturnRows(sqlmock.NewRows([]string{"version"}).AddRow(3))
+
+		version, err := containerRepo.IncrementVersion("container-1", 2)
+
+		require.NoError(t, err)
+		require.Equal(t, 3, version)
+	})
+
+	t.Run("when the version has moved, Then a version conflict is returned", func(t *testing.T) {
+		mock.ExpectQuery(sqlIncrementContainerVersion).
+			WithArgs("container-1", 2).
+			WillReturnError(sql.ErrNoRows)
+
+		_, err := containerRepo.IncrementVersion("container-1", 2)
+
+		require.ErrorIs(t, err, dbs.ErrVersionConflict)
+	})
+}
 
 func mockContainerObj() domain.TaskContainer {
 	return domain.TaskContainer{
@@ -104,9 +132,10 @@ func mockContainerObj() domain.TaskContainer {
 		Description: "testdesc",
 		IsActive:    true,
 		UsergroupId: 1,
+		Version:     2,
 	}
 }
 func mockContainerRows(c domain.TaskContainer) *sqlmock.Rows {
-	return sqlmock.NewRows([]string{"id", "name", "description", "is_active", "usergroup_id"}).
-		AddRow(c.Id, c.Name, c.Description, c.IsActive, c.UsergroupId)
+	return sqlmock.NewRows([]string{"id", "name", "description", "is_active", "usergroup_id", "version"}).
+		AddRow(c.Id, c.Name, c.Description, c.IsActive, c.UsergroupId, c.Version)
 }
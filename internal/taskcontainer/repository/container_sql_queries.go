@@ -1,11 +1,34 @@
 package repository
 
 const (
-	sqlGetAllContainers       = `SELECT id,name,description,is_active,usergroup_id FROM container.taskcontainer`
-	sqlGetById                = `SELECT id,name,description,is_active,usergroup_id FROM container.taskcontainer WHERE id = $1`
-	sqlGetContainersByGroupId = `SELECT id,name,description,is_active,usergroup_id FROM container.taskcontainer WHERE usergroup_id = $1`
-	sqlCreateContainer        = `INSERT INTO container.taskcontainer(id, name, description, is_active, activity_level, type, usergroup_id)
+	sqlGetAllContainers       = `SELECT id,name,description,is_active,usergroup_id,version FROM container.taskcontainer WHERE deleted_at IS NULL`
+	sqlGetById                = `SELECT id,name,description,is_active,usergroup_id,version FROM container.taskcontainer WHERE id = $1 AND deleted_at IS NULL`
+	sqlGetContainersByGroupId = `SELECT id,name,description,is_active,usergroup_id,version FROM container.taskcontainer WHERE usergroup_id = $1 AND deleted_at IS NULL`
+	sqlGetContainersByUserId  = `SELECT tc.id,tc.name,tc.description,tc.is_active,tc.usergroup_id,tc.version FROM container.taskcontainer tc
+								INNER JOIN container.usergroup_user ugu ON ugu.usergroup_id = tc.usergroup_id
+								INNER JOIN container.user u ON u.id = ugu.user_id
+								WHERE u.user_id = $1 AND tc.deleted_at IS NULL`
+	sqlCreateContainer = `INSERT INTO container.taskcontainer(id, name, description, is_active, activity_level, type, usergroup_id)
 								VALUES ($1,$2,$3,$4,$5,$6,$7);`
 	sqlDeleteContainer              = `DELETE FROM container.taskcontainer WHERE id = $1;`
+	sqlDeleteTasksByContainerId     = `DELETE FROM container.task WHERE id IN (SELECT task_id FROM container.taskcontainer_task WHERE taskcontainer_id = $1);`
 	sqlDeleteContainerByUsergroupId = `DELETE FROM container.taskcontainer WHERE usergroup_id = $1;`
+
+	sqlSoftDeleteContainer          = `UPDATE container.taskcontainer SET deleted_at = $2 WHERE id = $1 AND deleted_at IS NULL;`
+	sqlSoftDeleteTasksByContainerId = `UPDATE container.task SET deleted_at = $2
+								WHERE deleted_at IS NULL AND id IN (SELECT task_id FROM container.taskcontainer_task WHERE taskcontainer_id = $1);`
+	sqlGetDeletedContainerById = `SELECT id,name,description,is_active,usergroup_id,version,deleted_at FROM container.taskcontainer
+								WHERE id = $1 AND deleted_at IS NOT NULL`
+	sqlGetDeletedContainersByGroupId = `SELECT id,name,description,is_active,usergroup_id,version,deleted_at FROM container.taskcontainer
+								WHERE usergroup_id = $1 AND deleted_at IS NOT NULL ORDER BY deleted_at DESC`
+	sqlRestoreContainer = `UPDATE container.taskcontainer SET deleted_at = NULL WHERE id = $1;`
+	// Only the tasks trashed together with the container share its deleted_at; tasks trashed before it stay in the trash
+	sqlRestoreTasksByContainerId = `UPDATE container.task SET deleted_at = NULL
+								WHERE deleted_at = (SELECT deleted_at FROM container.taskcontainer WHERE id = $1)
+								AND id IN (SELECT task_id FROM container.taskcontainer_task WHERE taskcontainer_id = $1);`
+	sqlPurgeTasksOfDeletedContainers = `DELETE FROM container.task WHERE id IN (SELECT tct.task_id FROM container.taskcontainer_task tct
+								INNER JOIN container.taskcontainer tc ON tc.id = tct.taskcontainer_id WHERE tc.deleted_at < $1);`
+	sqlPurgeDeletedContainers = `DELETE FROM container.taskcontainer WHERE deleted_at < $1;`
+	// An expected version of 0 bumps the version unconditionally
+	sqlIncrementContainerVersion = `UPDATE container.taskcontainer SET version = version + 1 WHERE id = $1 AND ($2 = 0 OR version = $2) RETURNING version`
 )
@@ -0,0 +1,111 @@
+package repository
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	"github.com/happYness-Project/taskManagementGolang/internal/taskcontainer/domain"
+	"github.com/happYness-Project/taskManagementGolang/pkg/dbs"
+)
+
+type TemplateRepository interface {
+	CreateTemplate(template domain.ContainerTemplate) error
+	// GetTemplateById returns nil when the template does not exist
+	GetTemplateById(id string) (*domain.ContainerTemplate, error)
+	GetTemplatesByGroupId(groupId int) ([]domain.ContainerTemplate, error)
+	DeleteTemplate(id string) error
+	WithTx(tx dbs.DBTX) TemplateRepository
+}
+
+type TemplateRepo struct {
+	DB dbs.DBTX
+}
+
+func NewTemplateRepository(db dbs.DBTX) *TemplateRepo {
+	return &TemplateRepo{DB: db}
+}
+
+// WithTx returns a copy of the repository bound to the given transaction
+func (m *TemplateRepo) WithTx(tx dbs.DBTX) TemplateRepository {
+	return &TemplateRepo{DB: tx}
+}
+
+func (m *TemplateRepo) CreateTemplate(t domain.ContainerTemplate) error {
+	tasks, err := json.Marshal(t.Tasks)
+	if err != nil {
+		return fmt.Errorf("unable to encode template tasks : %w", err)
+	}
+	_, err = m.DB.Exec(sqlCreateTemplate, t.Id, t.UsergroupId, t.Name, t.Description, t.Type, tasks, t.CreatedBy, t.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("unable to insert into taskcontainer_template table : %w", err)
+	}
+	return nil
+}
+
+func (m *TemplateRepo) GetTemplateById(id string) (*domain.ContainerTemplate, error) {
+	rows, err := m.DB.Query(sqlGetTemplateById, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	if !rows.Next() {
+		return nil, rows.Err()
+	}
+	return scanRowsIntoTemplate(rows)
+}
+
+func (m *TemplateRepo) GetTemplatesByGroupId(groupId int) ([]domain.ContainerTemplate, error) {
+	rows, err := m.DB.Query(sqlGetTemplatesByGroupId, groupId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	templates := []domain.ContainerTemplate{}
+	for rows.Next() {
+		template, err := scanRowsIntoTemplate(rows)
+		if err != nil {
+			return nil, err
+		}
+		templates = append(templates, *template)
+	}
+	return templates, rows.Err()
+}
+
+func (m *TemplateRepo) DeleteTemplate(id string) error {
+	result, err := m.DB.Exec(sqlDeleteTemplate, id)
+	if err != nil {
+		return fmt.Errorf("unable to delete template : %w", err)
+	}
+	if rows, _ := result.RowsAffected(); rows == 0 {
+		return domain.ErrTemplateNotFound
+	}
+	return nil
+}
+
+func scanRowsIntoTemplate(rows *sql.Rows) (*domain.ContainerTemplate, error) {
+	template := new(domain.ContainerTemplate)
+	var description, templateType sql.NullString
+	var tasks []byte
+	err := rows.Scan(
+		&template.Id,
+		&template.UsergroupId,
+		&template.Name,
+		&description,
+		&templateType,
+		&tasks,
+		&template.CreatedBy,
+		&template.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	template.Description = description.String
+	template.Type = templateType.String
+	if err := json.Unmarshal(tasks, &template.Tasks); err != nil {
+		return nil, fmt.Errorf("unable to decode template tasks : %w", err)
+	}
+	return template, nil
+}
@@ -0,0 +1,11 @@
+package repository
+
+const (
+	sqlTemplateColumns = `id, usergroup_id, name, description, type, tasks, created_by, created_at`
+
+	sqlCreateTemplate = `INSERT INTO container.taskcontainer_template(` + sqlTemplateColumns + `)
+		VALUES ($1,$2,$3,$4,$5,$6,$7,$8)`
+	sqlGetTemplateById       = `SELECT ` + sqlTemplateColumns + ` FROM container.taskcontainer_template WHERE id = $1`
+	sqlGetTemplatesByGroupId = `SELECT ` + sqlTemplateColumns + ` FROM container.taskcontainer_template WHERE usergroup_id = $1 ORDER BY name, created_at`
+	sqlDeleteTemplate        = `DELETE FROM container.taskcontainer_template WHERE id = $1`
+)
@@ -7,8 +7,15 @@ const (
 	TaskContainerDomainError = prefix + "domain_validation_error"
 	TaskContainerGetError    = prefix + "get_server_error"
 
-	TaskContainerGetNotFound = prefix + "get_not_found"
-	DeleteTaskContainerError = prefix + "delete_server_error"
+	TaskContainerGetNotFound  = prefix + "get_not_found"
+	DeleteTaskContainerError  = prefix + "delete_server_error"
+	RestoreNotInTrash         = prefix + "restore_not_in_trash"
+	RestoreTaskContainerError = prefix + "restore_server_error"
+
+	TemplateInvalidInput    = prefix + "template_invalid_input"
+	TemplateNotFound        = prefix + "template_not_found"
+	TemplateServerError     = prefix + "template_server_error"
+	CloneTaskContainerError = prefix + "clone_server_error"
 	// UserGroupGetRateLimitedExceeded = prefix + "get_rate_limited_exceeded"
 	// UserNotFound                    = prefix + "user_get_not_found"
 	// UserGroupCreationFailure        = prefix + "create_error"
@@ -1,16 +1,24 @@
 package route
 
 import (
+	"errors"
 	"net/http"
 	"strconv"
 
 	"github.com/go-chi/chi/v5"
+	auditApp "github.com/happYness-Project/taskManagementGolang/internal/audit/application"
+	"github.com/happYness-Project/taskManagementGolang/internal/authorization"
+	label "github.com/happYness-Project/taskManagementGolang/internal/label/repository"
+	outboxRepo "github.com/happYness-Project/taskManagementGolang/internal/outbox/repository"
+	task "github.com/happYness-Project/taskManagementGolang/internal/task/repository"
 	"github.com/happYness-Project/taskManagementGolang/internal/taskcontainer/application"
 	"github.com/happYness-Project/taskManagementGolang/internal/taskcontainer/application/command"
 	"github.com/happYness-Project/taskManagementGolang/internal/taskcontainer/application/query"
+	"github.com/happYness-Project/taskManagementGolang/internal/taskcontainer/domain"
 	container "github.com/happYness-Project/taskManagementGolang/internal/taskcontainer/repository"
 	user "github.com/happYness-Project/taskManagementGolang/internal/user/repository"
 	"github.com/happYness-Project/taskManagementGolang/pkg/constants"
+	"github.com/happYness-Project/taskManagementGolang/pkg/dbs"
 	"github.com/happYness-Project/taskManagementGolang/pkg/loggers"
 	"github.com/happYness-Project/taskManagementGolang/pkg/response"
 )
@@ -21,11 +29,11 @@ type Handler struct {
 	queryBus   *application.QueryBus
 }
 
-func NewHandler(logger *loggers.AppLogger, repo container.ContainerRepository, userRepo user.UserRepository) *Handler {
+func NewHandler(logger *loggers.AppLogger, repo container.ContainerRepository, templateRepo container.TemplateRepository, taskRepo task.TaskRepository, labelRepo label.LabelRepository, userRepo user.UserRepository, policy *authorization.Policy, uow dbs.UnitOfWork, recorder *auditApp.Recorder, outbox outboxRepo.OutboxRepository) *Handler {
 	return &Handler{
 		logger:     logger,
-		commandBus: application.NewCommandBus(repo),
-		queryBus:   application.NewQueryBus(repo),
+		commandBus: application.NewCommandBus(repo, templateRepo, taskRepo, labelRepo, policy, uow, recorder, outbox),
+		queryBus:   application.NewQueryBus(repo, templateRepo, policy),
 	}
 }
 func (h *Handler) RegisterRoutes(router chi.Router) {
@@ -34,12 +42,21 @@ func (h *Handler) RegisterRoutes(router chi.Router) {
 		r.Get("/", h.handleGetTaskContainers)
 		r.Get("/{containerID}", h.handleGetTaskContainerById)
 		r.Delete("/{containerID}", h.handleDeleteTaskContainer)
+		r.Post("/{containerID}/restore", h.handleRestoreTaskContainer)
+		r.Post("/{containerID}/templates", h.handleSaveContainerTemplate)
+		r.Post("/{containerID}/clone", h.handleCloneTaskContainer)
+	})
+	router.Route("/api/container-templates", func(r chi.Router) {
+		r.Get("/{templateID}", h.handleGetContainerTemplateById)
+		r.Delete("/{templateID}", h.handleDeleteContainerTemplate)
+		r.Post("/{templateID}/instantiate", h.handleInstantiateContainerTemplate)
 	})
 	router.Get("/api/user-groups/{usergroupID}/task-containers", h.handleGetTaskContainersByGroupId)
+	router.Get("/api/user-groups/{usergroupID}/container-templates", h.handleGetContainerTemplatesByGroupId)
 }
 func (h *Handler) handleGetTaskContainers(w http.ResponseWriter, r *http.Request) {
 	// Use Query Bus
-	result, err := h.queryBus.Execute(query.GetAllContainersQuery{})
+	result, err := h.queryBus.Execute(query.GetAllContainersQuery{RequesterId: authorization.RequesterId(r)})
 	if err != nil {
 		h.logger.Error().Err(err).Str("ErrorCode", TaskContainerGetError).Msg(err.Error())
 		response.InternalServerError(w, "Error occurred during getting all task containers.")
@@ -56,12 +73,20 @@ func (h *Handler) handleGetTaskContainerById(w http.ResponseWriter, r *http.Requ
 	}
 
 	// Use Query Bus
-	result, err := h.queryBus.Execute(query.GetContainerByIdQuery{ContainerId: containerId})
+	result, err := h.queryBus.Execute(query.GetContainerByIdQuery{ContainerId: containerId, RequesterId: authorization.RequesterId(r)})
+	if authorization.IsForbiddenError(err) {
+		h.logger.Error().Err(err).Str("ErrorCode", constants.PermissionDenied).Msg(err.Error())
+		response.Forbidden(w, constants.PermissionDenied, err.Error())
+		return
+	}
 	if err != nil {
 		h.logger.Error().Err(err).Str("ErrorCode", TaskContainerGetNotFound).Msg(err.Error())
 		response.NotFound(w, TaskContainerGetNotFound, "Container does not exist")
 		return
 	}
+	if container, ok := result.(*domain.TaskContainer); ok && container != nil {
+		response.SetETag(w, container.Version)
+	}
 	response.WriteJsonWithEncode(w, http.StatusOK, result)
 }
 func (h *Handler) handleGetTaskContainersByGroupId(w http.ResponseWriter, r *http.Request) {
@@ -79,7 +104,12 @@ func (h *Handler) handleGetTaskContainersByGroupId(w http.ResponseWriter, r *htt
 	}
 
 	// Use Query Bus
-	result, err := h.queryBus.Execute(query.GetContainersByGroupIdQuery{GroupId: groupId})
+	result, err := h.queryBus.Execute(query.GetContainersByGroupIdQuery{GroupId: groupId, RequesterId: authorization.RequesterId(r)})
+	if authorization.IsForbiddenError(err) {
+		h.logger.Error().Err(err).Str("ErrorCode", constants.PermissionDenied).Msg(err.Error())
+		response.Forbidden(w, constants.PermissionDenied, err.Error())
+		return
+	}
 	if err != nil {
 		h.logger.Error().Err(err).Str("ErrorCode", TaskContainerGetNotFound).Msg(err.Error())
 		response.NotFound(w, TaskContainerGetNotFound, "Error occurred during retrieving containers by group id")
@@ -101,9 +131,15 @@ func (h *Handler) handleCreateTaskContainer(w http.ResponseWriter, r *http.Reque
 		Description: createDto.Description,
 		Type:        createDto.Type,
 		UserGroupId: createDto.UserGroupId,
+		RequesterId: authorization.RequesterId(r),
 	}
 
-	result, err := h.commandBus.Execute(cmd)
+	result, err := h.commandBus.Execute(r.Context(), cmd)
+	if authorization.IsForbiddenError(err) {
+		h.logger.Error().Err(err).Str("ErrorCode", constants.PermissionDenied).Msg(err.Error())
+		response.Forbidden(w, constants.PermissionDenied, err.Error())
+		return
+	}
 	if err != nil {
 		h.logger.Error().Err(err).Str("ErrorCode", TaskContainerServerError).Msg(err.Error())
 		response.InternalServerError(w, "Error occurred during creating container")
@@ -115,10 +151,22 @@ func (h *Handler) handleCreateTaskContainer(w http.ResponseWriter, r *http.Reque
 }
 func (h *Handler) handleDeleteTaskContainer(w http.ResponseWriter, r *http.Request) {
 	containerId := chi.URLParam(r, "containerID")
+	expectedVersion, ok := response.IfMatch(w, r, h.logger)
+	if !ok {
+		return
+	}
 
 	// Use Command Bus
-	cmd := command.DeleteContainerCommand{ContainerId: containerId}
-	_, err := h.commandBus.Execute(cmd)
+	cmd := command.DeleteContainerCommand{ContainerId: containerId, RequesterId: authorization.RequesterId(r), ExpectedVersion: expectedVersion}
+	_, err := h.commandBus.Execute(r.Context(), cmd)
+	if authorization.IsForbiddenError(err) {
+		h.logger.Error().Err(err).Str("ErrorCode", constants.PermissionDenied).Msg(err.Error())
+		response.Forbidden(w, constants.PermissionDenied, err.Error())
+		return
+	}
+	if response.VersionConflict(w, err, h.logger) {
+		return
+	}
 	if err != nil {
 		h.logger.Error().Err(err).Str("ErrorCode", DeleteTaskContainerError).Msg(err.Error())
 		response.NotFound(w, DeleteTaskContainerError, "Error occurred during delete container")
@@ -127,3 +175,28 @@ func (h *Handler) handleDeleteTaskContainer(w http.ResponseWriter, r *http.Reque
 
 	response.WriteJsonWithEncode(w, http.StatusNoContent, "task container is removed.")
 }
+
+func (h *Handler) handleRestoreTaskContainer(w http.ResponseWriter, r *http.Request) {
+	containerId := chi.URLParam(r, "containerID")
+
+	// Use Command Bus
+	cmd := command.RestoreContainerCommand{ContainerId: containerId, RequesterId: authorization.RequesterId(r)}
+	_, err := h.commandBus.Execute(r.Context(), cmd)
+	if authorization.IsForbiddenError(err) {
+		h.logger.Error().Err(err).Str("ErrorCode", constants.PermissionDenied).Msg(err.Error())
+		response.Forbidden(w, constants.PermissionDenied, err.Error())
+		return
+	}
+	if errors.Is(err, domain.ErrNotInTrash) {
+		h.logger.Error().Err(err).Str("ErrorCode", RestoreNotInTrash).Msg(err.Error())
+		response.NotFound(w, RestoreNotInTrash, err.Error())
+		return
+	}
+	if err != nil {
+		h.logger.Error().Err(err).Str("ErrorCode", RestoreTaskContainerError).Msg(err.Error())
+		response.InternalServerError(w, "Error occurred during restore container")
+		return
+	}
+
+	response.WriteJsonWithEncode(w, http.StatusOK, "task container is restored.")
+}
@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	"github.com/go-chi/chi/v5"
+	"github.com/happYness-Project/taskManagementGolang/internal/authorization"
 	"github.com/happYness-Project/taskManagementGolang/internal/mocks"
 	"github.com/happYness-Project/taskManagementGolang/internal/taskcontainer/domain"
 	"github.com/happYness-Project/taskManagementGolang/pkg/configs"
@@ -21,15 +22,16 @@ func TestTaskContainerHandler(t *testing.T) {
 	logger := loggers.Setup(env)
 	mockContainerRepo := new(mocks.MockContainerRepo)
 	mockUserRepo := new(mocks.MockUserRepo)
-	handler := NewHandler(logger, mockContainerRepo, mockUserRepo)
+	handler := NewHandler(logger, mockContainerRepo, nil, nil, nil, mockUserRepo, authorization.NewPolicy(mockUserRepo), &mocks.MockUnitOfWork{}, nil, &mocks.MockOutboxRepo{})
+	requesterId := "requester-id"
 
 	t.Run("when get all task containers, Then return status code 200 and containers array", func(t *testing.T) {
 		// Arrange
 		expectedContainers := []*domain.TaskContainer{
 			{Id: "1", Name: "Container1", Description: "Desc1", Type: "typeA", IsActive: true, Activity_level: 0, UsergroupId: 2},
 		}
-		mockContainerRepo.On("AllTaskContainers").Return(expectedContainers, nil)
-		req := httptest.NewRequest(http.MethodGet, "/api/task-containers", nil)
+		mockContainerRepo.On("GetContainersByUserId", requesterId).Return(expectedContainers, nil)
+		req := mocks.WithRequester(httptest.NewRequest(http.MethodGet, "/api/task-containers", nil), requesterId)
 		rr := httptest.NewRecorder()
 		router := chi.NewRouter()
 		router.Get("/api/task-containers", handler.handleGetTaskContainers)
@@ -57,7 +59,8 @@ func TestTaskContainerHandler(t *testing.T) {
 		containerId := "abcd"
 		expectedContainer := &domain.TaskContainer{Id: containerId, Name: "Container2", Description: "Desc2", Type: "typeB", IsActive: false, Activity_level: 1, UsergroupId: 3}
 		mockContainerRepo.On("GetById", containerId).Return(expectedContainer, nil)
-		req := httptest.NewRequest(http.MethodGet, "/api/task-containers/"+containerId, nil)
+		mockUserRepo.On("GetUserRoleInGroup", requesterId, expectedContainer.UsergroupId).Return("member", nil)
+		req := mocks.WithRequester(httptest.NewRequest(http.MethodGet, "/api/task-containers/"+containerId, nil), requesterId)
 		rr := httptest.NewRecorder()
 		router := chi.NewRouter()
 		router.Get("/api/task-containers/{containerID}", handler.handleGetTaskContainerById)
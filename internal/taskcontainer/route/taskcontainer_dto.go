@@ -6,3 +6,19 @@ type CreateContainerDto struct {
 	Type        string `json:"type"`
 	UserGroupId int    `json:"usergroup_id"`
 }
+
+type SaveTemplateDto struct {
+	Name string `json:"name"`
+}
+
+type InstantiateTemplateDto struct {
+	UserGroupId int    `json:"usergroup_id"`
+	Name        string `json:"name"`
+	// StartDate is "2006-01-02" or RFC 3339; relative target dates count from it, today when empty
+	StartDate string `json:"start_date"`
+}
+
+type CloneContainerDto struct {
+	UserGroupId int    `json:"usergroup_id"` // 0 keeps the container's group
+	Name        string `json:"name"`
+}
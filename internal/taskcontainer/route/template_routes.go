@@ -0,0 +1,196 @@
+package route
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/happYness-Project/taskManagementGolang/internal/authorization"
+	"github.com/happYness-Project/taskManagementGolang/internal/taskcontainer/application/command"
+	"github.com/happYness-Project/taskManagementGolang/internal/taskcontainer/application/query"
+	"github.com/happYness-Project/taskManagementGolang/internal/taskcontainer/domain"
+	"github.com/happYness-Project/taskManagementGolang/pkg/constants"
+	"github.com/happYness-Project/taskManagementGolang/pkg/response"
+)
+
+func (h *Handler) handleSaveContainerTemplate(w http.ResponseWriter, r *http.Request) {
+	var saveDto SaveTemplateDto
+	if !h.parseOptionalJson(w, r, &saveDto) {
+		return
+	}
+
+	// Use Command Bus
+	result, err := h.commandBus.Execute(r.Context(), command.SaveContainerTemplateCommand{
+		ContainerId: chi.URLParam(r, "containerID"),
+		Name:        saveDto.Name,
+		RequesterId: authorization.RequesterId(r),
+	})
+	if h.templateError(w, err) {
+		return
+	}
+	if err != nil {
+		h.logger.Error().Err(err).Str("ErrorCode", TemplateServerError).Msg(err.Error())
+		response.InternalServerError(w, "Error occurred during saving container template")
+		return
+	}
+	response.WriteJsonWithEncode(w, http.StatusCreated, result)
+}
+
+func (h *Handler) handleCloneTaskContainer(w http.ResponseWriter, r *http.Request) {
+	var cloneDto CloneContainerDto
+	if !h.parseOptionalJson(w, r, &cloneDto) {
+		return
+	}
+
+	// Use Command Bus
+	result, err := h.commandBus.Execute(r.Context(), command.CloneContainerCommand{
+		ContainerId: chi.URLParam(r, "containerID"),
+		UserGroupId: cloneDto.UserGroupId,
+		Name:        cloneDto.Name,
+		RequesterId: authorization.RequesterId(r),
+	})
+	if h.templateError(w, err) {
+		return
+	}
+	if err != nil {
+		h.logger.Error().Err(err).Str("ErrorCode", CloneTaskContainerError).Msg(err.Error())
+		response.InternalServerError(w, "Error occurred during cloning container")
+		return
+	}
+	response.WriteJsonWithEncode(w, http.StatusCreated, result)
+}
+
+func (h *Handler) handleInstantiateContainerTemplate(w http.ResponseWriter, r *http.Request) {
+	var instantiateDto InstantiateTemplateDto
+	if err := response.ParseJson(r, &instantiateDto); err != nil {
+		h.logger.Error().Err(err).Str("ErrorCode", constants.RequestBodyError).Msg("Error occurred during parsing json of InstantiateTemplateDto")
+		response.InvalidJsonBody(w, "Error occurred during parsing json of InstantiateTemplateDto")
+		return
+	}
+	var fieldErrors []response.FieldError
+	if instantiateDto.UserGroupId <= 0 {
+		fieldErrors = append(fieldErrors, response.FieldError{Field: "usergroup_id", Message: "is required"})
+	}
+	startDate, err := parseStartDate(instantiateDto.StartDate)
+	if err != nil {
+		fieldErrors = append(fieldErrors, response.FieldError{Field: "start_date", Message: "must be a date like 2006-01-02 or an RFC 3339 timestamp"})
+	}
+	if len(fieldErrors) > 0 {
+		h.logger.Error().Str("ErrorCode", TemplateInvalidInput).Msg("invalid template instantiation")
+		problem := response.New(TemplateInvalidInput, "Invalid Template Instantiation", "the request has invalid fields").WithErrors(fieldErrors...)
+		response.ErrorResponse(w, http.StatusBadRequest, *problem)
+		return
+	}
+
+	// Use Command Bus
+	result, err := h.commandBus.Execute(r.Context(), command.InstantiateTemplateCommand{
+		TemplateId:  chi.URLParam(r, "templateID"),
+		UserGroupId: instantiateDto.UserGroupId,
+		Name:        instantiateDto.Name,
+		StartDate:   startDate,
+		RequesterId: authorization.RequesterId(r),
+	})
+	if h.templateError(w, err) {
+		return
+	}
+	if err != nil {
+		h.logger.Error().Err(err).Str("ErrorCode", TemplateServerError).Msg(err.Error())
+		response.InternalServerError(w, "Error occurred during instantiating container template")
+		return
+	}
+	response.WriteJsonWithEncode(w, http.StatusCreated, result)
+}
+
+func (h *Handler) handleGetContainerTemplateById(w http.ResponseWriter, r *http.Request) {
+	// Use Query Bus
+	result, err := h.queryBus.Execute(query.GetTemplateByIdQuery{TemplateId: chi.URLParam(r, "templateID"), RequesterId: authorization.RequesterId(r)})
+	if h.templateError(w, err) {
+		return
+	}
+	if err != nil {
+		h.logger.Error().Err(err).Str("ErrorCode", TemplateServerError).Msg(err.Error())
+		response.InternalServerError(w, "Error occurred during getting container template")
+		return
+	}
+	response.WriteJsonWithEncode(w, http.StatusOK, result)
+}
+
+func (h *Handler) handleGetContainerTemplatesByGroupId(w http.ResponseWriter, r *http.Request) {
+	groupId, err := strconv.Atoi(chi.URLParam(r, "usergroupID"))
+	if err != nil {
+		h.logger.Error().Err(err).Str("ErrorCode", constants.InvalidParameter).Msg(err.Error())
+		response.ErrorResponse(w, http.StatusBadRequest, *(response.New(constants.InvalidParameter, "Invalid Parameter", "Invalid Group ID")))
+		return
+	}
+
+	// Use Query Bus
+	result, err := h.queryBus.Execute(query.GetTemplatesByGroupIdQuery{GroupId: groupId, RequesterId: authorization.RequesterId(r)})
+	if h.templateError(w, err) {
+		return
+	}
+	if err != nil {
+		h.logger.Error().Err(err).Str("ErrorCode", TemplateServerError).Msg(err.Error())
+		response.InternalServerError(w, "Error occurred during getting container templates by group id")
+		return
+	}
+	response.WriteJsonWithEncode(w, http.StatusOK, result)
+}
+
+func (h *Handler) handleDeleteContainerTemplate(w http.ResponseWriter, r *http.Request) {
+	// Use Command Bus
+	_, err := h.commandBus.Execute(r.Context(), command.DeleteTemplateCommand{TemplateId: chi.URLParam(r, "templateID"), RequesterId: authorization.RequesterId(r)})
+	if h.templateError(w, err) {
+		return
+	}
+	if err != nil {
+		h.logger.Error().Err(err).Str("ErrorCode", TemplateServerError).Msg(err.Error())
+		response.InternalServerError(w, "Error occurred during deleting container template")
+		return
+	}
+	response.WriteJsonWithEncode(w, http.StatusNoContent, "container template is removed.")
+}
+
+// parseOptionalJson decodes the request body into v; an empty body leaves v untouched
+func (h *Handler) parseOptionalJson(w http.ResponseWriter, r *http.Request, v any) bool {
+	if err := response.ParseJson(r, v); err != nil && !errors.Is(err, io.EOF) {
+		h.logger.Error().Err(err).Str("ErrorCode", constants.RequestBodyError).Msg(err.Error())
+		response.InvalidJsonBody(w, err.Error())
+		return false
+	}
+	return true
+}
+
+// templateError answers 403, 404 or 400 for the errors templates and clones share
+func (h *Handler) templateError(w http.ResponseWriter, err error) bool {
+	switch {
+	case authorization.IsForbiddenError(err):
+		h.logger.Error().Err(err).Str("ErrorCode", constants.PermissionDenied).Msg(err.Error())
+		response.Forbidden(w, constants.PermissionDenied, err.Error())
+	case errors.Is(err, domain.ErrTemplateNotFound):
+		h.logger.Error().Err(err).Str("ErrorCode", TemplateNotFound).Msg(err.Error())
+		response.NotFound(w, TemplateNotFound, err.Error())
+	case errors.Is(err, domain.ErrContainerNotFound):
+		h.logger.Error().Err(err).Str("ErrorCode", TaskContainerGetNotFound).Msg(err.Error())
+		response.NotFound(w, TaskContainerGetNotFound, err.Error())
+	case errors.Is(err, domain.ErrInvalidTemplate), errors.Is(err, domain.ErrInvalidContainer):
+		h.logger.Error().Err(err).Str("ErrorCode", TaskContainerDomainError).Msg(err.Error())
+		response.ErrorResponse(w, http.StatusBadRequest, *(response.New(TaskContainerDomainError, "Invalid Input", err.Error())))
+	default:
+		return false
+	}
+	return true
+}
+
+// parseStartDate reads a date or an RFC 3339 timestamp; an empty value is the zero time
+func parseStartDate(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	return time.Parse(time.DateOnly, value)
+}
@@ -0,0 +1,78 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	taskRepo "github.com/happYness-Project/taskManagementGolang/internal/task/repository"
+	containerRepo "github.com/happYness-Project/taskManagementGolang/internal/taskcontainer/repository"
+	"github.com/happYness-Project/taskManagementGolang/pkg/loggers"
+)
+
+const (
+	DefaultRetention     = 30 * 24 * time.Hour
+	DefaultPurgeInterval = time.Hour
+)
+
+// Purger permanently removes tasks and containers that have been in the trash for longer than Retention
+type Purger struct {
+	taskRepo      taskRepo.TaskRepository
+	containerRepo containerRepo.ContainerRepository
+	logger        *loggers.AppLogger
+
+	Retention    time.Duration
+	PollInterval time.Duration
+	now          func() time.Time
+}
+
+// NewPurger keeps deleted items for retentionDays, or DefaultRetention when retentionDays is not positive
+func NewPurger(taskRepo taskRepo.TaskRepository, containerRepo containerRepo.ContainerRepository, logger *loggers.AppLogger, retentionDays int) *Purger {
+	retention := DefaultRetention
+	if retentionDays > 0 {
+		retention = time.Duration(retentionDays) * 24 * time.Hour
+	}
+	return &Purger{
+		taskRepo:      taskRepo,
+		containerRepo: containerRepo,
+		logger:        logger,
+		Retention:     retention,
+		PollInterval:  DefaultPurgeInterval,
+		now:           func() time.Time { return time.Now().UTC() },
+	}
+}
+
+// Run purges the trash every PollInterval until ctx is cancelled
+func (p *Purger) Run(ctx context.Context) {
+	ticker := time.NewTicker(p.PollInterval)
+	defer ticker.Stop()
+	for {
+		if err := p.Purge(); err != nil {
+			p.logger.Error().Err(err).Msg("failed to purge the trash")
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Purge removes everything deleted before the retention cutoff. Containers go first, taking
+// every task linked to them; the tasks deleted on their own follow.
+func (p *Purger) Purge() error {
+	cutoff := p.now().Add(-p.Retention)
+
+	containers, err := p.containerRepo.PurgeDeletedContainers(cutoff)
+	if err != nil {
+		return fmt.Errorf("failed to purge deleted containers: %w", err)
+	}
+	tasks, err := p.taskRepo.PurgeDeletedTasks(cutoff)
+	if err != nil {
+		return fmt.Errorf("failed to purge deleted tasks: %w", err)
+	}
+	if containers > 0 || tasks > 0 {
+		p.logger.Info().Int64("containers", containers).Int64("tasks", tasks).Msg("purged the trash")
+	}
+	return nil
+}
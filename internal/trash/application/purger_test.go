@@ -0,0 +1,85 @@
+package application
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/happYness-Project/taskManagementGolang/internal/mocks"
+	taskRepo "github.com/happYness-Project/taskManagementGolang/internal/task/repository"
+	"github.com/happYness-Project/taskManagementGolang/pkg/configs"
+	"github.com/happYness-Project/taskManagementGolang/pkg/loggers"
+	"github.com/stretchr/testify/assert"
+)
+
+// stubPurgeTaskRepo records the cutoff it was asked to purge with
+type stubPurgeTaskRepo struct {
+	taskRepo.TaskRepository
+	before *time.Time
+}
+
+func (s *stubPurgeTaskRepo) PurgeDeletedTasks(before time.Time) (int64, error) {
+	s.before = &before
+	return 2, nil
+}
+
+func TestPurger_Purge(t *testing.T) {
+	logger := loggers.Setup(configs.Env{})
+	now := time.Date(2025, 3, 31, 12, 0, 0, 0, time.UTC)
+
+	newPurger := func(containerRepo *mocks.MockContainerRepo, tasks *stubPurgeTaskRepo, retentionDays int) *Purger {
+		purger := NewPurger(tasks, containerRepo, logger, retentionDays)
+		purger.now = func() time.Time { return now }
+		return purger
+	}
+
+	t.Run("when retention is not configured, Then items deleted before the default retention are purged", func(t *testing.T) {
+		// Arrange
+		containerRepo := new(mocks.MockContainerRepo)
+		cutoff := now.Add(-DefaultRetention)
+		containerRepo.On("PurgeDeletedContainers", cutoff).Return(int64(1), nil)
+		tasks := &stubPurgeTaskRepo{}
+
+		// Act
+		err := newPurger(containerRepo, tasks, 0).Purge()
+
+		// Assert
+		assert.NoError(t, err)
+		containerRepo.AssertExpectations(t)
+		if assert.NotNil(t, tasks.before) {
+			assert.Equal(t, cutoff, *tasks.before)
+		}
+	})
+
+	t.Run("when retention is configured in days, Then the cutoff follows it", func(t *testing.T) {
+		// Arrange
+		containerRepo := new(mocks.MockContainerRepo)
+		cutoff := now.Add(-7 * 24 * time.Hour)
+		containerRepo.On("PurgeDeletedContainers", cutoff).Return(int64(0), nil)
+		tasks := &stubPurgeTaskRepo{}
+
+		// Act
+		err := newPurger(containerRepo, tasks, 7).Purge()
+
+		// Assert
+		assert.NoError(t, err)
+		containerRepo.AssertExpectations(t)
+		if assert.NotNil(t, tasks.before) {
+			assert.Equal(t, cutoff, *tasks.before)
+		}
+	})
+
+	t.Run("when purging containers fails, Then tasks are left alone and the error is returned", func(t *testing.T) {
+		// Arrange
+		containerRepo := new(mocks.MockContainerRepo)
+		containerRepo.On("PurgeDeletedContainers", now.Add(-DefaultRetention)).Return(int64(0), errors.New("db down"))
+		tasks := &stubPurgeTaskRepo{}
+
+		// Act
+		err := newPurger(containerRepo, tasks, 0).Purge()
+
+		// Assert
+		assert.ErrorContains(t, err, "failed to purge deleted containers")
+		assert.Nil(t, tasks.before)
+	})
+}
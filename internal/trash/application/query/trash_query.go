@@ -0,0 +1,47 @@
+package query
+
+import (
+	"fmt"
+
+	taskRepo "github.com/happYness-Project/taskManagementGolang/internal/task/repository"
+	containerDomain "github.com/happYness-Project/taskManagementGolang/internal/taskcontainer/domain"
+	containerRepo "github.com/happYness-Project/taskManagementGolang/internal/taskcontainer/repository"
+)
+
+// GetGroupTrashQuery lists what a group has deleted and can still restore, most recently deleted first
+type GetGroupTrashQuery struct {
+	GroupId     int
+	RequesterId string // UUID from JWT
+}
+
+// Trash holds the deleted containers of a group and the tasks deleted on their own.
+// Tasks deleted together with their container are restored with it, so they are not listed separately.
+type Trash struct {
+	Containers []containerDomain.TaskContainer `json:"containers"`
+	Tasks      []taskRepo.DeletedTask          `json:"tasks"`
+}
+
+// TrashQueryHandler handles all read operations for the trash
+type TrashQueryHandler struct {
+	taskRepo      taskRepo.TaskRepository
+	containerRepo containerRepo.ContainerRepository
+}
+
+func NewTrashQueryHandler(taskRepo taskRepo.TaskRepository, containerRepo containerRepo.ContainerRepository) *TrashQueryHandler {
+	return &TrashQueryHandler{
+		taskRepo:      taskRepo,
+		containerRepo: containerRepo,
+	}
+}
+
+func (h *TrashQueryHandler) HandleGetGroupTrash(query GetGroupTrashQuery) (*Trash, error) {
+	containers, err := h.containerRepo.GetDeletedContainersByGroupId(query.GroupId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve deleted containers: %w", err)
+	}
+	tasks, err := h.taskRepo.GetDeletedTasksByGroupId(query.GroupId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve deleted tasks: %w", err)
+	}
+	return &Trash{Containers: containers, Tasks: tasks}, nil
+}
@@ -0,0 +1,49 @@
+package application
+
+import (
+	"fmt"
+
+	"github.com/happYness-Project/taskManagementGolang/internal/authorization"
+	taskRepo "github.com/happYness-Project/taskManagementGolang/internal/task/repository"
+	containerRepo "github.com/happYness-Project/taskManagementGolang/internal/taskcontainer/repository"
+	qry "github.com/happYness-Project/taskManagementGolang/internal/trash/application/query"
+)
+
+// QueryBus routes queries to their handlers
+type QueryBus struct {
+	queryHandler *qry.TrashQueryHandler
+
+	policy *authorization.Policy
+}
+
+// NewQueryBus creates a new query bus with all handlers registered
+func NewQueryBus(taskRepo taskRepo.TaskRepository, containerRepo containerRepo.ContainerRepository, policy *authorization.Policy) *QueryBus {
+	return &QueryBus{
+		queryHandler: qry.NewTrashQueryHandler(taskRepo, containerRepo),
+		policy:       policy,
+	}
+}
+
+// Execute dispatches the query to the appropriate handler
+func (bus *QueryBus) Execute(query interface{}) (interface{}, error) {
+	if err := bus.authorize(query); err != nil {
+		return nil, err
+	}
+
+	switch q := query.(type) {
+	case qry.GetGroupTrashQuery:
+		return bus.queryHandler.HandleGetGroupTrash(q)
+	default:
+		return nil, fmt.Errorf("unknown query type: %T", query)
+	}
+}
+
+// authorize checks that the requester is a member of the group whose trash is read
+func (bus *QueryBus) authorize(query interface{}) error {
+	switch q := query.(type) {
+	case qry.GetGroupTrashQuery:
+		return bus.policy.RequireMember(q.RequesterId, q.GroupId)
+	default:
+		return nil
+	}
+}
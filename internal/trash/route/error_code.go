@@ -0,0 +1,7 @@
+package route
+
+const prefix = "trash_"
+
+const (
+	TrashGetServerError = prefix + "get_server_error"
+)
@@ -0,0 +1,55 @@
+package route
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/happYness-Project/taskManagementGolang/internal/authorization"
+	taskRepo "github.com/happYness-Project/taskManagementGolang/internal/task/repository"
+	containerRepo "github.com/happYness-Project/taskManagementGolang/internal/taskcontainer/repository"
+	"github.com/happYness-Project/taskManagementGolang/internal/trash/application"
+	"github.com/happYness-Project/taskManagementGolang/internal/trash/application/query"
+	"github.com/happYness-Project/taskManagementGolang/pkg/constants"
+	"github.com/happYness-Project/taskManagementGolang/pkg/loggers"
+	"github.com/happYness-Project/taskManagementGolang/pkg/response"
+)
+
+type Handler struct {
+	logger   *loggers.AppLogger
+	queryBus *application.QueryBus
+}
+
+func NewHandler(logger *loggers.AppLogger, taskRepo taskRepo.TaskRepository, containerRepo containerRepo.ContainerRepository, policy *authorization.Policy) *Handler {
+	return &Handler{
+		logger:   logger,
+		queryBus: application.NewQueryBus(taskRepo, containerRepo, policy),
+	}
+}
+
+func (h *Handler) RegisterRoutes(router chi.Router) {
+	router.Get("/api/user-groups/{groupID}/trash", h.handleGetGroupTrash)
+}
+
+func (h *Handler) handleGetGroupTrash(w http.ResponseWriter, r *http.Request) {
+	groupId, err := strconv.Atoi(chi.URLParam(r, "groupID"))
+	if err != nil {
+		h.logger.Error().Err(err).Msg("invalid Group ID")
+		response.ErrorResponse(w, http.StatusBadRequest, *(response.New(constants.InvalidParameter, "Invalid Group ID")))
+		return
+	}
+
+	// Use Query Bus
+	result, err := h.queryBus.Execute(query.GetGroupTrashQuery{GroupId: groupId, RequesterId: authorization.RequesterId(r)})
+	if authorization.IsForbiddenError(err) {
+		h.logger.Error().Err(err).Str("ErrorCode", constants.PermissionDenied).Msg(err.Error())
+		response.Forbidden(w, constants.PermissionDenied, err.Error())
+		return
+	}
+	if err != nil {
+		h.logger.Error().Err(err).Str("ErrorCode", TrashGetServerError).Msg("Error occurred during GetGroupTrash")
+		response.ErrorResponse(w, http.StatusInternalServerError, *(response.New(TrashGetServerError, "Failed to get trash", err.Error())))
+		return
+	}
+	response.WriteJsonWithEncode(w, http.StatusOK, result)
+}
@@ -0,0 +1,37 @@
+package application
+
+import (
+	auditApp "github.com/happYness-Project/taskManagementGolang/internal/audit/application"
+	auditDomain "github.com/happYness-Project/taskManagementGolang/internal/audit/domain"
+	cmd "github.com/happYness-Project/taskManagementGolang/internal/user/application/command"
+)
+
+// auditSubject describes the user a command mutates for the activity log.
+// User activity belongs to no group.
+func (bus *CommandBus) auditSubject(command interface{}) auditApp.Subject {
+	subject := auditApp.Subject{
+		Action:        auditApp.ActionOf(command),
+		AggregateType: auditDomain.AggregateUser,
+		Load:          bus.loadUser,
+	}
+
+	switch c := command.(type) {
+	case cmd.CreateUserCommand:
+		subject.ActorId, subject.AggregateId = c.UserId, c.UserId
+	case cmd.UpdateUserCommand:
+		subject.ActorId, subject.AggregateId = c.RequesterId, c.UserId
+	case cmd.UpdateDefaultGroupCommand:
+		subject.ActorId, subject.AggregateId = c.RequesterId, c.UserId
+	case cmd.DeactivateUserCommand:
+		subject.ActorId, subject.AggregateId = c.RequesterId, c.UserId
+	}
+	return subject
+}
+
+func (bus *CommandBus) loadUser(userId string) (interface{}, error) {
+	user, err := bus.userRepo.GetUserByUserId(userId)
+	if err != nil || user == nil {
+		return nil, err
+	}
+	return user, nil
+}
@@ -0,0 +1,57 @@
+package command
+
+import (
+	"fmt"
+
+	"github.com/happYness-Project/taskManagementGolang/internal/user/domain"
+	"github.com/happYness-Project/taskManagementGolang/internal/user/repository"
+	"github.com/happYness-Project/taskManagementGolang/pkg/dbs"
+)
+
+// DeactivateUserCommand represents the command to deactivate a user's account
+type DeactivateUserCommand struct {
+	UserId      string // UUID
+	RequesterId string // UUID from JWT
+}
+
+// DeactivateUserCommandHandler handles deactivating a user
+type DeactivateUserCommandHandler struct {
+	userRepo repository.UserRepository
+	uow      dbs.UnitOfWork
+}
+
+func NewDeactivateUserCommandHandler(
+	userRepo repository.UserRepository,
+	uow dbs.UnitOfWork,
+) *DeactivateUserCommandHandler {
+	return &DeactivateUserCommandHandler{
+		userRepo: userRepo,
+		uow:      uow,
+	}
+}
+
+// Handle executes the deactivate user command
+func (h *DeactivateUserCommandHandler) Handle(cmd DeactivateUserCommand) error {
+	return h.uow.Do(func(tx dbs.DBTX) error {
+		userRepo := h.userRepo.WithTx(tx)
+
+		user, err := userRepo.GetUserByUserId(cmd.UserId)
+		if err != nil {
+			return fmt.Errorf("failed to get user: %w", err)
+		}
+		if user == nil {
+			return domain.ErrUserNotFound
+		}
+
+		if err := user.Deactivate(); err != nil {
+			return err
+		}
+
+		err = userRepo.UpdateUser(*user)
+		if err != nil {
+			return fmt.Errorf("failed to update user: %w", err)
+		}
+
+		return nil
+	})
+}
@@ -5,49 +5,58 @@ import (
 
 	"github.com/happYness-Project/taskManagementGolang/internal/user/domain"
 	"github.com/happYness-Project/taskManagementGolang/internal/user/repository"
+	"github.com/happYness-Project/taskManagementGolang/pkg/dbs"
 )
 
 // UpdateDefaultGroupCommand represents the command to update user's default group
 type UpdateDefaultGroupCommand struct {
 	UserId         string // UUID
 	DefaultGroupId int
+	RequesterId    string // UUID from JWT
 }
 
 // UpdateDefaultGroupCommandHandler handles updating a user's default group
 type UpdateDefaultGroupCommandHandler struct {
 	userRepo repository.UserRepository
+	uow      dbs.UnitOfWork
 }
 
 func NewUpdateDefaultGroupCommandHandler(
 	userRepo repository.UserRepository,
+	uow dbs.UnitOfWork,
 ) *UpdateDefaultGroupCommandHandler {
 	return &UpdateDefaultGroupCommandHandler{
 		userRepo: userRepo,
+		uow:      uow,
 	}
 }
 
 // Handle executes the update default group command
 func (h *UpdateDefaultGroupCommandHandler) Handle(cmd UpdateDefaultGroupCommand) error {
-	// Validate user exists
-	user, err := h.userRepo.GetUserByUserId(cmd.UserId)
-	if err != nil {
-		return fmt.Errorf("failed to get user: %w", err)
-	}
-	if user == nil {
-		return domain.ErrUserNotFound
-	}
-
-	// Update default group using domain logic (includes validation)
-	err = user.UpdateDefaultGroupId(cmd.DefaultGroupId)
-	if err != nil {
-		return err // Return domain validation error as-is
-	}
-
-	// Persist changes
-	err = h.userRepo.UpdateUser(*user)
-	if err != nil {
-		return fmt.Errorf("failed to update user: %w", err)
-	}
-
-	return nil
+	return h.uow.Do(func(tx dbs.DBTX) error {
+		userRepo := h.userRepo.WithTx(tx)
+
+		// Validate user exists
+		user, err := userRepo.GetUserByUserId(cmd.UserId)
+		if err != nil {
+			return fmt.Errorf("failed to get user: %w", err)
+		}
+		if user == nil {
+			return domain.ErrUserNotFound
+		}
+
+		// Update default group using domain logic (includes validation)
+		err = user.UpdateDefaultGroupId(cmd.DefaultGroupId)
+		if err != nil {
+			return err // Return domain validation error as-is
+		}
+
+		// Persist changes
+		err = userRepo.UpdateUser(*user)
+		if err != nil {
+			return fmt.Errorf("failed to update user: %w", err)
+		}
+
+		return nil
+	})
 }
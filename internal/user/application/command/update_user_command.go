@@ -5,48 +5,57 @@ import (
 
 	"github.com/happYness-Project/taskManagementGolang/internal/user/domain"
 	"github.com/happYness-Project/taskManagementGolang/internal/user/repository"
+	"github.com/happYness-Project/taskManagementGolang/pkg/dbs"
 )
 
 // UpdateUserCommand represents the command to update a user's profile
 type UpdateUserCommand struct {
-	UserId    string // UUID
-	FirstName string
-	LastName  string
-	Email     string
+	UserId      string // UUID
+	FirstName   string
+	LastName    string
+	Email       string
+	RequesterId string // UUID from JWT
 }
 
 // UpdateUserCommandHandler handles updating a user
 type UpdateUserCommandHandler struct {
 	userRepo repository.UserRepository
+	uow      dbs.UnitOfWork
 }
 
 func NewUpdateUserCommandHandler(
 	userRepo repository.UserRepository,
+	uow dbs.UnitOfWork,
 ) *UpdateUserCommandHandler {
 	return &UpdateUserCommandHandler{
 		userRepo: userRepo,
+		uow:      uow,
 	}
 }
 
 // Handle executes the update user command
 func (h *UpdateUserCommandHandler) Handle(cmd UpdateUserCommand) error {
-	// Validate user exists
-	user, err := h.userRepo.GetUserByUserId(cmd.UserId)
-	if err != nil {
-		return fmt.Errorf("failed to get user: %w", err)
-	}
-	if user == nil {
-		return domain.ErrUserNotFound
-	}
-
-	// Update user using domain logic
-	user.UpdateUser(cmd.FirstName, cmd.LastName, cmd.Email)
-
-	// Persist changes
-	err = h.userRepo.UpdateUser(*user)
-	if err != nil {
-		return fmt.Errorf("failed to update user: %w", err)
-	}
-
-	return nil
+	return h.uow.Do(func(tx dbs.DBTX) error {
+		userRepo := h.userRepo.WithTx(tx)
+
+		// Validate user exists
+		user, err := userRepo.GetUserByUserId(cmd.UserId)
+		if err != nil {
+			return fmt.Errorf("failed to get user: %w", err)
+		}
+		if user == nil {
+			return domain.ErrUserNotFound
+		}
+
+		// Update user using domain logic
+		user.UpdateUser(cmd.FirstName, cmd.LastName, cmd.Email)
+
+		// Persist changes
+		err = userRepo.UpdateUser(*user)
+		if err != nil {
+			return fmt.Errorf("failed to update user: %w", err)
+		}
+
+		return nil
+	})
 }
@@ -1,32 +1,77 @@
 package application
 
 import (
+	"context"
 	"fmt"
 
+	auditApp "github.com/happYness-Project/taskManagementGolang/internal/audit/application"
+	"github.com/happYness-Project/taskManagementGolang/internal/authorization"
 	cmd "github.com/happYness-Project/taskManagementGolang/internal/user/application/command"
 	"github.com/happYness-Project/taskManagementGolang/internal/user/repository"
+	"github.com/happYness-Project/taskManagementGolang/pkg/dbs"
 )
 
 // CommandBus routes commands to their handlers
 type CommandBus struct {
-	createUserHandler        *cmd.CreateUserCommandHandler
-	updateUserHandler        *cmd.UpdateUserCommandHandler
+	createUserHandler         *cmd.CreateUserCommandHandler
+	updateUserHandler         *cmd.UpdateUserCommandHandler
 	updateDefaultGroupHandler *cmd.UpdateDefaultGroupCommandHandler
+	deactivateUserHandler     *cmd.DeactivateUserCommandHandler
+
+	userRepo repository.UserRepository
+	policy   *authorization.Policy
+	uow      dbs.UnitOfWork
+	recorder *auditApp.Recorder
 }
 
 // NewCommandBus creates a new command bus with all handlers registered
 func NewCommandBus(
 	userRepo repository.UserRepository,
+	policy *authorization.Policy,
+	uow dbs.UnitOfWork,
+	recorder *auditApp.Recorder,
 ) *CommandBus {
 	return &CommandBus{
-		createUserHandler:        cmd.NewCreateUserCommandHandler(userRepo),
-		updateUserHandler:        cmd.NewUpdateUserCommandHandler(userRepo),
-		updateDefaultGroupHandler: cmd.NewUpdateDefaultGroupCommandHandler(userRepo),
+		createUserHandler:         cmd.NewCreateUserCommandHandler(userRepo),
+		updateUserHandler:         cmd.NewUpdateUserCommandHandler(userRepo, uow),
+		updateDefaultGroupHandler: cmd.NewUpdateDefaultGroupCommandHandler(userRepo, uow),
+		deactivateUserHandler:     cmd.NewDeactivateUserCommandHandler(userRepo, uow),
+		userRepo:                  userRepo,
+		policy:                    policy,
+		uow:                       uow,
+		recorder:                  recorder,
 	}
 }
 
-// Execute dispatches the command to the appropriate handler
-func (bus *CommandBus) Execute(command interface{}) (interface{}, error) {
+// withTx returns a bus whose handlers and activity log run inside the given transaction.
+// The handlers' own units of work become savepoints of it.
+func (bus *CommandBus) withTx(tx dbs.DBTX) *CommandBus {
+	return NewCommandBus(bus.userRepo.WithTx(tx), bus.policy, dbs.NewUnitOfWork(tx), bus.recorder.WithTx(tx))
+}
+
+// Execute authorizes the command, then dispatches it to the appropriate handler and records it in the activity log
+// in one transaction. ctx carries the request id of the HTTP request that issued the command; commands of operators are not authorized.
+func (bus *CommandBus) Execute(ctx context.Context, command interface{}) (interface{}, error) {
+	if !authorization.IsOperator(ctx) {
+		if err := bus.authorize(command); err != nil {
+			return nil, err
+		}
+	}
+
+	var result interface{}
+	err := bus.uow.Do(func(tx dbs.DBTX) error {
+		txBus := bus.withTx(tx)
+		var err error
+		result, err = txBus.recorder.Track(ctx, txBus.auditSubject(command), func() (interface{}, error) {
+			return txBus.dispatch(command)
+		})
+		return err
+	})
+	return result, err
+}
+
+// dispatch routes the command to its handler
+func (bus *CommandBus) dispatch(command interface{}) (interface{}, error) {
 	switch c := command.(type) {
 	case cmd.CreateUserCommand:
 		return nil, bus.createUserHandler.Handle(c)
@@ -34,7 +79,30 @@ func (bus *CommandBus) Execute(command interface{}) (interface{}, error) {
 		return nil, bus.updateUserHandler.Handle(c)
 	case cmd.UpdateDefaultGroupCommand:
 		return nil, bus.updateDefaultGroupHandler.Handle(c)
+	case cmd.DeactivateUserCommand:
+		return nil, bus.deactivateUserHandler.Handle(c)
 	default:
 		return nil, fmt.Errorf("unknown command type: %T", command)
 	}
 }
+
+// authorize checks that users only modify their own account.
+// CreateUserCommand needs no check because its UserId is taken from the JWT.
+func (bus *CommandBus) authorize(command interface{}) error {
+	switch c := command.(type) {
+	case cmd.UpdateUserCommand:
+		return bus.policy.RequireSelf(c.RequesterId, c.UserId)
+	case cmd.UpdateDefaultGroupCommand:
+		if err := bus.policy.RequireSelf(c.RequesterId, c.UserId); err != nil {
+			return err
+		}
+		if c.DefaultGroupId > 0 {
+			return bus.policy.RequireMember(c.RequesterId, c.DefaultGroupId)
+		}
+		return nil
+	case cmd.DeactivateUserCommand:
+		return bus.policy.RequireSelf(c.RequesterId, c.UserId)
+	default:
+		return nil
+	}
+}
@@ -23,7 +23,8 @@ type GetUserByUsernameQuery struct {
 }
 
 type GetUsersByGroupIdQuery struct {
-	GroupId int
+	GroupId     int
+	RequesterId string // UUID from JWT
 }
 
 // UserQueryHandler handles all read operations for User
@@ -3,6 +3,7 @@ package application
 import (
 	"fmt"
 
+	"github.com/happYness-Project/taskManagementGolang/internal/authorization"
 	qry "github.com/happYness-Project/taskManagementGolang/internal/user/application/query"
 	"github.com/happYness-Project/taskManagementGolang/internal/user/repository"
 )
@@ -10,19 +11,27 @@ import (
 // QueryBus routes queries to their handlers
 type QueryBus struct {
 	queryHandler *qry.UserQueryHandler
+
+	policy *authorization.Policy
 }
 
 // NewQueryBus creates a new query bus with all handlers registered
 func NewQueryBus(
 	userRepo repository.UserRepository,
+	policy *authorization.Policy,
 ) *QueryBus {
 	return &QueryBus{
 		queryHandler: qry.NewUserQueryHandler(userRepo),
+		policy:       policy,
 	}
 }
 
 // Execute dispatches the query to the appropriate handler
 func (bus *QueryBus) Execute(query interface{}) (interface{}, error) {
+	if err := bus.authorize(query); err != nil {
+		return nil, err
+	}
+
 	switch q := query.(type) {
 	case qry.GetAllUsersQuery:
 		return bus.queryHandler.HandleGetAllUsers(q)
@@ -38,3 +47,14 @@ func (bus *QueryBus) Execute(query interface{}) (interface{}, error) {
 		return nil, fmt.Errorf("unknown query type: %T", query)
 	}
 }
+
+// authorize checks that the requester is a member of the group being read.
+// User lookups by id, email or username stay open so members can be found before being invited.
+func (bus *QueryBus) authorize(query interface{}) error {
+	switch q := query.(type) {
+	case qry.GetUsersByGroupIdQuery:
+		return bus.policy.RequireMember(q.RequesterId, q.GroupId)
+	default:
+		return nil
+	}
+}
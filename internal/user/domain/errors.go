@@ -5,6 +5,7 @@ import "errors"
 // Domain-specific errors
 var (
 	ErrUserNotFound = errors.New("user not found")
+	ErrUserInactive = errors.New("user is already deactivated")
 )
 
 // IsNotFoundError checks if an error is a not-found error
@@ -61,6 +61,16 @@ func (u *User) UpdateUser(firstName, lastName, email string) {
 	u.UpdatedAt = time.Now()
 }
 
+// Deactivate locks the user out of every group they belong to; their membership and data are kept
+func (u *User) Deactivate() error {
+	if !u.IsActive {
+		return ErrUserInactive
+	}
+	u.IsActive = false
+	u.UpdatedAt = time.Now()
+	return nil
+}
+
 type UserWithRole struct {
 	*User
 	Role     string    `json:"role"`
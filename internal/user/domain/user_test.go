@@ -287,6 +287,26 @@ func TestClearDefaultGroup(t *testing.T) {
 	})
 }
 
+func TestDeactivate(t *testing.T) {
+	t.Run("when active user is deactivated, Then IsActive is cleared", func(t *testing.T) {
+		user := NewUser("test-user", "testuser", "First", "Last", "user@example.com")
+
+		err := user.Deactivate()
+
+		assert.NoError(t, err)
+		assert.False(t, user.IsActive)
+	})
+
+	t.Run("when user is deactivated twice, Then ErrUserInactive is returned", func(t *testing.T) {
+		user := NewUser("test-user", "testuser", "First", "Last", "user@example.com")
+		user.Deactivate()
+
+		err := user.Deactivate()
+
+		assert.ErrorIs(t, err, ErrUserInactive)
+	})
+}
+
 func TestUpdateUser(t *testing.T) {
 	t.Run("when updating user fields, Then fields and UpdatedAt are updated", func(t *testing.T) {
 		user := NewUser("test-user", "testuser", "OldFirst", "OldLast", "old@example.com")
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/happYness-Project/taskManagementGolang/internal/user/domain"
+	"github.com/happYness-Project/taskManagementGolang/pkg/dbs"
 )
 
 type UserRepository interface {
@@ -17,15 +18,21 @@ type UserRepository interface {
 	GetUserRoleInGroup(userId string, groupId int) (string, error)
 	CreateUser(user domain.User) error
 	UpdateUser(user domain.User) error
+	WithTx(tx dbs.DBTX) UserRepository
 }
 type UserRepo struct {
-	DB *sql.DB
+	DB dbs.DBTX
 }
 
-func NewUserRepository(db *sql.DB) *UserRepo {
+func NewUserRepository(db dbs.DBTX) *UserRepo {
 	return &UserRepo{DB: db}
 }
 
+// WithTx returns a copy of the repository bound to the given transaction
+func (m *UserRepo) WithTx(tx dbs.DBTX) UserRepository {
+	return &UserRepo{DB: tx}
+}
+
 func (s *UserRepo) GetAllUsers() ([]*domain.User, error) {
 	rows, err := s.DB.Query(sqlGetAllUsers)
 	if err != nil {
@@ -112,25 +119,16 @@ func (m *UserRepo) GetUsersByGroupId(groupId int) ([]*domain.User, error) {
 	return users, nil
 }
 func (m *UserRepo) CreateUser(user domain.User) error {
-
-	tx, err := m.DB.Begin()
-	if err != nil {
-		return err
-	}
-
-	_, err = tx.Exec(sqlCreateUser, user.UserId, user.UserName, user.FirstName, user.LastName, user.Email, user.IsActive, user.CreatedAt, user.UpdatedAt, user.DefaultGroupId)
-	if err != nil {
-		return fmt.Errorf("unable to insert into user table : %w", err)
-	}
-
-	if err = tx.Commit(); err != nil {
-		return fmt.Errorf("commit failure: %w", err)
-	}
-
-	return nil
+	return dbs.RunInTx(m.DB, func(tx dbs.DBTX) error {
+		_, err := tx.Exec(sqlCreateUser, user.UserId, user.UserName, user.FirstName, user.LastName, user.Email, user.IsActive, user.CreatedAt, user.UpdatedAt, user.DefaultGroupId)
+		if err != nil {
+			return fmt.Errorf("unable to insert into user table : %w", err)
+		}
+		return nil
+	})
 }
 func (m *UserRepo) UpdateUser(user domain.User) error {
-	_, err := m.DB.Exec(sqlUpdateUser, user.Id, user.FirstName, user.LastName, user.Email, user.DefaultGroupId, user.UpdatedAt)
+	_, err := m.DB.Exec(sqlUpdateUser, user.Id, user.FirstName, user.LastName, user.Email, user.DefaultGroupId, user.UpdatedAt, user.IsActive)
 	if err != nil {
 		return err
 	}
@@ -2,12 +2,12 @@ package route
 
 import (
 	"encoding/json"
-	"fmt"
 	"net/http"
 	"strconv"
 
 	"github.com/go-chi/chi/v5"
-	"github.com/go-chi/jwtauth"
+	auditApp "github.com/happYness-Project/taskManagementGolang/internal/audit/application"
+	"github.com/happYness-Project/taskManagementGolang/internal/authorization"
 	"github.com/happYness-Project/taskManagementGolang/internal/user/application"
 	"github.com/happYness-Project/taskManagementGolang/internal/user/application/command"
 	"github.com/happYness-Project/taskManagementGolang/internal/user/application/query"
@@ -15,6 +15,7 @@ import (
 	"github.com/happYness-Project/taskManagementGolang/internal/user/repository"
 	userGroupRepo "github.com/happYness-Project/taskManagementGolang/internal/usergroup/repository"
 	"github.com/happYness-Project/taskManagementGolang/pkg/constants"
+	"github.com/happYness-Project/taskManagementGolang/pkg/dbs"
 	"github.com/happYness-Project/taskManagementGolang/pkg/loggers"
 	"github.com/happYness-Project/taskManagementGolang/pkg/response"
 )
@@ -26,11 +27,11 @@ type Handler struct {
 	userGroupRepo userGroupRepo.UserGroupRepository // Keep for now (used in user detail)
 }
 
-func NewHandler(logger *loggers.AppLogger, repo repository.UserRepository, ugRepo userGroupRepo.UserGroupRepository) *Handler {
+func NewHandler(logger *loggers.AppLogger, repo repository.UserRepository, ugRepo userGroupRepo.UserGroupRepository, policy *authorization.Policy, uow dbs.UnitOfWork, recorder *auditApp.Recorder) *Handler {
 	return &Handler{
 		logger:        logger,
-		commandBus:    application.NewCommandBus(repo),
-		queryBus:      application.NewQueryBus(repo),
+		commandBus:    application.NewCommandBus(repo, policy, uow, recorder),
+		queryBus:      application.NewQueryBus(repo, policy),
 		userGroupRepo: ugRepo,
 	}
 }
@@ -120,8 +121,13 @@ func (h *Handler) handleGetUsersByGroupId(w http.ResponseWriter, r *http.Request
 	}
 
 	// Use Query Bus
-	result, err := h.queryBus.Execute(query.GetUsersByGroupIdQuery{GroupId: groupId})
+	result, err := h.queryBus.Execute(query.GetUsersByGroupIdQuery{GroupId: groupId, RequesterId: authorization.RequesterId(r)})
 	if err != nil {
+		if authorization.IsForbiddenError(err) {
+			h.logger.Error().Err(err).Str("ErrorCode", constants.PermissionDenied).Msg(err.Error())
+			response.Forbidden(w, constants.PermissionDenied, err.Error())
+			return
+		}
 		h.logger.Error().Err(err).Msg("Error during Get Users by Group ID with Roles")
 		response.InternalServerError(w, "Error occurred during retrieving users by group ID")
 		return
@@ -199,8 +205,7 @@ func (h *Handler) handleCreateUser(w http.ResponseWriter, r *http.Request) {
 		response.InvalidJsonBody(w)
 		return
 	}
-	_, claims, _ := jwtauth.FromContext(r.Context())
-	userId := fmt.Sprintf("%v", claims["nameid"])
+	userId := authorization.RequesterId(r)
 
 	// Use Command Bus
 	cmd := command.CreateUserCommand{
@@ -211,7 +216,7 @@ func (h *Handler) handleCreateUser(w http.ResponseWriter, r *http.Request) {
 		Email:     createDto.Email,
 	}
 
-	_, err := h.commandBus.Execute(cmd)
+	_, err := h.commandBus.Execute(r.Context(), cmd)
 	if err != nil {
 		h.logger.Error().Err(err).Str("ErrorCode", UserCreateServerError).Msg(err.Error())
 		response.ErrorResponse(w, http.StatusBadRequest, *response.New(UserCreateServerError, "Bad Request", "cannot create a user"))
@@ -229,14 +234,20 @@ func (h *Handler) handleUpdateUser(w http.ResponseWriter, r *http.Request) {
 
 	// Use Command Bus
 	cmd := command.UpdateUserCommand{
-		UserId:    chi.URLParam(r, "userID"),
-		FirstName: updateDto.FirstName,
-		LastName:  updateDto.LastName,
-		Email:     updateDto.Email,
+		UserId:      chi.URLParam(r, "userID"),
+		FirstName:   updateDto.FirstName,
+		LastName:    updateDto.LastName,
+		Email:       updateDto.Email,
+		RequesterId: authorization.RequesterId(r),
 	}
 
-	_, err := h.commandBus.Execute(cmd)
+	_, err := h.commandBus.Execute(r.Context(), cmd)
 	if err != nil {
+		if authorization.IsForbiddenError(err) {
+			h.logger.Error().Err(err).Str("ErrorCode", constants.PermissionDenied).Msg(err.Error())
+			response.Forbidden(w, constants.PermissionDenied, err.Error())
+			return
+		}
 		// Check if it's a not-found error
 		if domain.IsNotFoundError(err) {
 			h.logger.Error().Err(err).Str("ErrorCode", UserGetNotFound).Msg("User not found")
@@ -266,10 +277,16 @@ func (h *Handler) handleUpdateGroupId(w http.ResponseWriter, r *http.Request) {
 	cmd := command.UpdateDefaultGroupCommand{
 		UserId:         chi.URLParam(r, "userID"),
 		DefaultGroupId: jsonBody.DefaultGroupId,
+		RequesterId:    authorization.RequesterId(r),
 	}
 
-	_, err = h.commandBus.Execute(cmd)
+	_, err = h.commandBus.Execute(r.Context(), cmd)
 	if err != nil {
+		if authorization.IsForbiddenError(err) {
+			h.logger.Error().Err(err).Str("ErrorCode", constants.PermissionDenied).Msg(err.Error())
+			response.Forbidden(w, constants.PermissionDenied, err.Error())
+			return
+		}
 		// Check if it's a not-found error
 		if domain.IsNotFoundError(err) {
 			h.logger.Error().Err(err).Str("ErrorCode", UserGetNotFound).Msg("User not found")
@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/go-chi/chi/v5"
+	"github.com/happYness-Project/taskManagementGolang/internal/authorization"
 	"github.com/happYness-Project/taskManagementGolang/internal/mocks"
 	userModel "github.com/happYness-Project/taskManagementGolang/internal/user/domain"
 	userGroupModel "github.com/happYness-Project/taskManagementGolang/internal/usergroup/domain"
@@ -30,7 +31,7 @@ func TestUserHandler(t *testing.T) {
 	logger := loggers.Setup(env)
 	mockUserRepo := new(mocks.MockUserRepo)
 	mockUserGroupRepo := new(mocks.MockUserGroupRepo)
-	handler := NewHandler(logger, mockUserRepo, mockUserGroupRepo)
+	handler := NewHandler(logger, mockUserRepo, mockUserGroupRepo, authorization.NewPolicy(mockUserRepo), &mocks.MockUnitOfWork{}, nil)
 
 	// Reset mocks before each test to prevent interference
 	t.Cleanup(func() {
@@ -190,8 +191,9 @@ func TestUserHandler(t *testing.T) {
 		}
 
 		mockUserRepo.On("GetUsersByGroupIdWithRoles", 1).Return(expectedUsersWithRoles, nil)
+		mockUserRepo.On("GetUserRoleInGroup", "user1", 1).Return("admin", nil)
 
-		req := httptest.NewRequest(http.MethodGet, "/api/user-groups/"+groupID+"/users", nil)
+		req := mocks.WithRequester(httptest.NewRequest(http.MethodGet, "/api/user-groups/"+groupID+"/users", nil), "user1")
 		rr := httptest.NewRecorder()
 		router := chi.NewRouter()
 
@@ -293,8 +295,9 @@ func TestUserHandler(t *testing.T) {
 				u.CreatedAt.Equal(existingUser.CreatedAt)
 		})).Return(nil)
 
+		mockUserRepo.On("GetUserRoleInGroup", userID, 5).Return("member", nil)
 		requestBody := `{"default_group_id": 5}`
-		req := httptest.NewRequest(http.MethodPatch, "/api/users/"+userID+"/default-group", strings.NewReader(requestBody))
+		req := mocks.WithRequester(httptest.NewRequest(http.MethodPatch, "/api/users/"+userID+"/default-group", strings.NewReader(requestBody)), userID)
 		req.Header.Set("Content-Type", "application/json")
 		rr := httptest.NewRecorder()
 		router := chi.NewRouter()
@@ -327,7 +330,7 @@ func TestUserHandler(t *testing.T) {
 		}
 		mockUserRepo.On("GetUserByUserId", userID).Return(existingUser, nil)
 		requestBody := `{"default_group_id": -1}`
-		req := httptest.NewRequest(http.MethodPatch, "/api/users/"+userID+"/default-group", strings.NewReader(requestBody))
+		req := mocks.WithRequester(httptest.NewRequest(http.MethodPatch, "/api/users/"+userID+"/default-group", strings.NewReader(requestBody)), userID)
 		req.Header.Set("Content-Type", "application/json")
 		rr := httptest.NewRecorder()
 		router := chi.NewRouter()
@@ -364,8 +367,9 @@ func TestUserHandler(t *testing.T) {
 		}
 		mockUserRepo.On("GetUserByUserId", userID).Return(existingUser, nil)
 
+		mockUserRepo.On("GetUserRoleInGroup", userID, 5).Return("member", nil)
 		requestBody := `{"default_group_id": 5}`
-		req := httptest.NewRequest(http.MethodPatch, "/api/users/"+userID+"/default-group", strings.NewReader(requestBody))
+		req := mocks.WithRequester(httptest.NewRequest(http.MethodPatch, "/api/users/"+userID+"/default-group", strings.NewReader(requestBody)), userID)
 		req.Header.Set("Content-Type", "application/json")
 		rr := httptest.NewRecorder()
 		router := chi.NewRouter()
@@ -390,8 +394,9 @@ func TestUserHandler(t *testing.T) {
 
 		userID := "non-existent-user"
 		mockUserRepo.On("GetUserByUserId", userID).Return((*userModel.User)(nil), nil)
+		mockUserRepo.On("GetUserRoleInGroup", userID, 5).Return("member", nil)
 		requestBody := `{"default_group_id": 5}`
-		req := httptest.NewRequest(http.MethodPatch, "/api/users/"+userID+"/default-group", strings.NewReader(requestBody))
+		req := mocks.WithRequester(httptest.NewRequest(http.MethodPatch, "/api/users/"+userID+"/default-group", strings.NewReader(requestBody)), userID)
 		req.Header.Set("Content-Type", "application/json")
 		rr := httptest.NewRecorder()
 		router := chi.NewRouter()
@@ -427,7 +432,7 @@ func TestUserHandler(t *testing.T) {
 		mockUserRepo.On("GetUserByUserId", userID).Return(existingUser, nil)
 
 		requestBody := `{"default_group_id": "invalid"}`
-		req := httptest.NewRequest(http.MethodPatch, "/api/users/"+userID+"/default-group", strings.NewReader(requestBody))
+		req := mocks.WithRequester(httptest.NewRequest(http.MethodPatch, "/api/users/"+userID+"/default-group", strings.NewReader(requestBody)), userID)
 		req.Header.Set("Content-Type", "application/json")
 		rr := httptest.NewRecorder()
 		router := chi.NewRouter()
@@ -476,7 +481,7 @@ func TestUserHandler(t *testing.T) {
 		})).Return(nil)
 
 		requestBody := `{"first_name": "NewFirst", "last_name": "NewLast", "email": "new@example.com"}`
-		req := httptest.NewRequest(http.MethodPut, "/api/users/"+userID, strings.NewReader(requestBody))
+		req := mocks.WithRequester(httptest.NewRequest(http.MethodPut, "/api/users/"+userID, strings.NewReader(requestBody)), userID)
 		req.Header.Set("Content-Type", "application/json")
 		rr := httptest.NewRecorder()
 		router := chi.NewRouter()
@@ -499,7 +504,7 @@ func TestUserHandler(t *testing.T) {
 		mockUserRepo.On("GetUserByUserId", userID).Return((*userModel.User)(nil), nil)
 
 		requestBody := `{"first_name": "NewFirst", "last_name": "NewLast", "email": "new@example.com"}`
-		req := httptest.NewRequest(http.MethodPut, "/api/users/"+userID, strings.NewReader(requestBody))
+		req := mocks.WithRequester(httptest.NewRequest(http.MethodPut, "/api/users/"+userID, strings.NewReader(requestBody)), userID)
 		req.Header.Set("Content-Type", "application/json")
 		rr := httptest.NewRecorder()
 		router := chi.NewRouter()
@@ -0,0 +1,77 @@
+package application
+
+import (
+	"database/sql"
+	"errors"
+	"strconv"
+
+	auditApp "github.com/happYness-Project/taskManagementGolang/internal/audit/application"
+	auditDomain "github.com/happYness-Project/taskManagementGolang/internal/audit/domain"
+	cmd "github.com/happYness-Project/taskManagementGolang/internal/usergroup/application/command"
+)
+
+// memberSnapshot is the state of a membership recorded in the activity log
+type memberSnapshot struct {
+	GroupId int    `json:"group_id"`
+	UserId  string `json:"user_id"`
+	Role    string `json:"role"`
+}
+
+// auditSubject describes the group or membership a command mutates for the activity log
+func (bus *CommandBus) auditSubject(command interface{}) auditApp.Subject {
+	subject := auditApp.Subject{
+		Action:        auditApp.ActionOf(command),
+		AggregateType: auditDomain.AggregateUserGroup,
+		Load:          bus.loadGroup,
+	}
+
+	switch c := command.(type) {
+	case cmd.CreateGroupCommand:
+		subject.ActorId = c.CreatorId
+		subject.Resolve = func(result interface{}) (string, int) {
+			groupId, _ := result.(int)
+			return strconv.Itoa(groupId), groupId
+		}
+	case cmd.DeleteGroupCommand:
+		subject.ActorId, subject.GroupId = c.RequesterId, c.GroupId
+		subject.AggregateId = strconv.Itoa(c.GroupId)
+	case cmd.AddMemberCommand:
+		subject = bus.memberSubject(subject, c.RequesterId, c.GroupId, c.UserId)
+	case cmd.RemoveMemberCommand:
+		subject = bus.memberSubject(subject, c.RequesterId, c.GroupId, c.UserId)
+	case cmd.ChangeMemberRoleCommand:
+		subject = bus.memberSubject(subject, c.RequesterId, c.GroupId, c.UserId)
+	}
+	return subject
+}
+
+func (bus *CommandBus) memberSubject(subject auditApp.Subject, requesterId string, groupId int, userId string) auditApp.Subject {
+	subject.ActorId = requesterId
+	subject.GroupId = groupId
+	subject.AggregateType = auditDomain.AggregateUserGroupMember
+	subject.AggregateId = userId
+	subject.Load = func(userId string) (interface{}, error) {
+		role, err := bus.userRepo.GetUserRoleInGroup(userId, groupId)
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		return memberSnapshot{GroupId: groupId, UserId: userId, Role: role}, nil
+	}
+	return subject
+}
+
+// loadGroup returns nil for a missing group; GetById returns an empty one
+func (bus *CommandBus) loadGroup(groupId string) (interface{}, error) {
+	id, err := strconv.Atoi(groupId)
+	if err != nil {
+		return nil, err
+	}
+	group, err := bus.groupRepo.GetById(id)
+	if err != nil || group == nil || group.GroupId == 0 {
+		return nil, err
+	}
+	return group, nil
+}
@@ -3,51 +3,74 @@ package command
 import (
 	"fmt"
 
+	outboxRepo "github.com/happYness-Project/taskManagementGolang/internal/outbox/repository"
 	"github.com/happYness-Project/taskManagementGolang/internal/user/repository"
 	userGroupRepo "github.com/happYness-Project/taskManagementGolang/internal/usergroup/repository"
+	"github.com/happYness-Project/taskManagementGolang/pkg/dbs"
 )
 
 // AddMemberCommand represents the command to add a member to a group
 type AddMemberCommand struct {
-	GroupId int
-	UserId  string // UUID
+	GroupId     int
+	UserId      string // UUID
+	RequesterId string // UUID from JWT
 }
 
 // AddMemberCommandHandler handles adding a member to a group
 type AddMemberCommandHandler struct {
-	groupRepo userGroupRepo.UserGroupRepository
-	userRepo  repository.UserRepository
+	groupRepo  userGroupRepo.UserGroupRepository
+	userRepo   repository.UserRepository
+	outboxRepo outboxRepo.OutboxRepository
+	uow        dbs.UnitOfWork
 }
 
 func NewAddMemberCommandHandler(
 	groupRepo userGroupRepo.UserGroupRepository,
 	userRepo repository.UserRepository,
+	outboxRepo outboxRepo.OutboxRepository,
+	uow dbs.UnitOfWork,
 ) *AddMemberCommandHandler {
 	return &AddMemberCommandHandler{
-		groupRepo: groupRepo,
-		userRepo:  userRepo,
+		groupRepo:  groupRepo,
+		userRepo:   userRepo,
+		outboxRepo: outboxRepo,
+		uow:        uow,
 	}
 }
 
 // Handle executes the add member command
 func (h *AddMemberCommandHandler) Handle(cmd AddMemberCommand) error {
-	// Validate user exists
-	user, err := h.userRepo.GetUserByUserId(cmd.UserId)
-	if err != nil || user == nil {
-		return fmt.Errorf("user not found: %s", cmd.UserId)
-	}
+	return h.uow.Do(func(tx dbs.DBTX) error {
+		groupRepo := h.groupRepo.WithTx(tx)
 
-	// Validate group exists
-	group, err := h.groupRepo.GetById(cmd.GroupId)
-	if err != nil || group.GroupId == 0 {
-		return fmt.Errorf("group not found: %d", cmd.GroupId)
-	}
+		// Validate user exists
+		user, err := h.userRepo.WithTx(tx).GetUserByUserId(cmd.UserId)
+		if err != nil || user == nil {
+			return fmt.Errorf("user not found: %s", cmd.UserId)
+		}
 
-	// Add member with default role (member)
-	err = h.groupRepo.InsertUserGroupUserTable(cmd.GroupId, user.Id)
-	if err != nil {
-		return fmt.Errorf("failed to add member: %w", err)
-	}
+		// Validate group exists
+		group, err := groupRepo.GetById(cmd.GroupId)
+		if err != nil || group.GroupId == 0 {
+			return fmt.Errorf("group not found: %d", cmd.GroupId)
+		}
+		// Membership is part of the group's state, so adding a member moves its version too
+		group.Version, err = groupRepo.IncrementVersion(cmd.GroupId, 0)
+		if err != nil {
+			return err
+		}
+
+		// Add member with default role (member)
+		group.AddMember(cmd.UserId)
+		err = groupRepo.InsertUserGroupUserTable(cmd.GroupId, user.Id)
+		if err != nil {
+			return fmt.Errorf("failed to add member: %w", err)
+		}
 
-	return nil
+		err = h.outboxRepo.WithTx(tx).Append(group.GroupId, group.PullEvents()...)
+		if err != nil {
+			return fmt.Errorf("failed to store group events: %w", err)
+		}
+		return nil
+	})
 }
@@ -3,31 +3,41 @@ package command
 import (
 	"fmt"
 
+	outboxRepo "github.com/happYness-Project/taskManagementGolang/internal/outbox/repository"
 	"github.com/happYness-Project/taskManagementGolang/internal/user/repository"
 	"github.com/happYness-Project/taskManagementGolang/internal/usergroup/domain"
 	userGroupRepo "github.com/happYness-Project/taskManagementGolang/internal/usergroup/repository"
+	"github.com/happYness-Project/taskManagementGolang/pkg/dbs"
 )
 
 // ChangeMemberRoleCommand represents the command to change a member's role
 type ChangeMemberRoleCommand struct {
-	GroupId int
-	UserId  string // UUID
-	NewRole string
+	GroupId         int
+	UserId          string // UUID
+	NewRole         string
+	RequesterId     string // UUID from JWT
+	ExpectedVersion int    // from If-Match; 0 skips the version check
 }
 
 // ChangeMemberRoleCommandHandler handles changing a member's role in a group
 type ChangeMemberRoleCommandHandler struct {
-	groupRepo userGroupRepo.UserGroupRepository
-	userRepo  repository.UserRepository
+	groupRepo  userGroupRepo.UserGroupRepository
+	userRepo   repository.UserRepository
+	outboxRepo outboxRepo.OutboxRepository
+	uow        dbs.UnitOfWork
 }
 
 func NewChangeMemberRoleCommandHandler(
 	groupRepo userGroupRepo.UserGroupRepository,
 	userRepo repository.UserRepository,
+	outboxRepo outboxRepo.OutboxRepository,
+	uow dbs.UnitOfWork,
 ) *ChangeMemberRoleCommandHandler {
 	return &ChangeMemberRoleCommandHandler{
-		groupRepo: groupRepo,
-		userRepo:  userRepo,
+		groupRepo:  groupRepo,
+		userRepo:   userRepo,
+		outboxRepo: outboxRepo,
+		uow:        uow,
 	}
 }
 
@@ -39,17 +49,42 @@ func (h *ChangeMemberRoleCommandHandler) Handle(cmd ChangeMemberRoleCommand) err
 		return err
 	}
 
-	// Validate user exists
-	user, err := h.userRepo.GetUserByUserId(cmd.UserId)
-	if err != nil || user == nil {
-		return fmt.Errorf("user not found: %s", cmd.UserId)
-	}
+	return h.uow.Do(func(tx dbs.DBTX) error {
+		userRepo := h.userRepo.WithTx(tx)
+		groupRepo := h.groupRepo.WithTx(tx)
 
-	// Update the role
-	err = h.groupRepo.UpdateUserRoleInGroup(cmd.GroupId, user.Id, role.String())
-	if err != nil {
-		return fmt.Errorf("failed to update role: %w", err)
-	}
+		// Validate user exists
+		user, err := userRepo.GetUserByUserId(cmd.UserId)
+		if err != nil || user == nil {
+			return fmt.Errorf("user not found: %s", cmd.UserId)
+		}
+
+		// Validate group exists
+		group, err := groupRepo.GetById(cmd.GroupId)
+		if err != nil || group.GroupId == 0 {
+			return fmt.Errorf("group not found: %d", cmd.GroupId)
+		}
+		group.Version, err = groupRepo.IncrementVersion(cmd.GroupId, cmd.ExpectedVersion)
+		if err != nil {
+			return err
+		}
+
+		currentRole, err := userRepo.GetUserRoleInGroup(cmd.UserId, cmd.GroupId)
+		if err != nil {
+			return fmt.Errorf("user is not a member of group %d: %w", cmd.GroupId, err)
+		}
+		group.ChangeMemberRole(domain.NewGroupMember(cmd.UserId, domain.Role(currentRole)), role)
+
+		// Update the role
+		err = groupRepo.UpdateUserRoleInGroup(cmd.GroupId, user.Id, role.String())
+		if err != nil {
+			return fmt.Errorf("failed to update role: %w", err)
+		}
 
-	return nil
+		err = h.outboxRepo.WithTx(tx).Append(group.GroupId, group.PullEvents()...)
+		if err != nil {
+			return fmt.Errorf("failed to store group events: %w", err)
+		}
+		return nil
+	})
 }
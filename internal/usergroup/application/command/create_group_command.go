@@ -6,6 +6,7 @@ import (
 	"github.com/happYness-Project/taskManagementGolang/internal/user/repository"
 	"github.com/happYness-Project/taskManagementGolang/internal/usergroup/domain"
 	userGroupRepo "github.com/happYness-Project/taskManagementGolang/internal/usergroup/repository"
+	"github.com/happYness-Project/taskManagementGolang/pkg/dbs"
 )
 
 // CreateGroupCommand represents the command to create a new group
@@ -20,35 +21,45 @@ type CreateGroupCommand struct {
 type CreateGroupCommandHandler struct {
 	groupRepo userGroupRepo.UserGroupRepository
 	userRepo  repository.UserRepository
+	uow       dbs.UnitOfWork
 }
 
 func NewCreateGroupCommandHandler(
 	groupRepo userGroupRepo.UserGroupRepository,
 	userRepo repository.UserRepository,
+	uow dbs.UnitOfWork,
 ) *CreateGroupCommandHandler {
 	return &CreateGroupCommandHandler{
 		groupRepo: groupRepo,
 		userRepo:  userRepo,
+		uow:       uow,
 	}
 }
 
 // Handle executes the create group command
 func (h *CreateGroupCommandHandler) Handle(cmd CreateGroupCommand) (int, error) {
-	creator, err := h.userRepo.GetUserByUserId(cmd.CreatorId)
-	if err != nil || creator == nil {
-		return 0, fmt.Errorf("creator user not found: %s", cmd.CreatorId)
-	}
-
 	// Create the domain model
 	group, err := domain.NewUserGroup(cmd.GroupName, cmd.GroupDesc, cmd.GroupType)
 	if err != nil {
 		return 0, fmt.Errorf("invalid group data: %w", err)
 	}
 
-	// Persist the group with creator as admin (transactional)
-	groupId, err := h.groupRepo.CreateGroupWithUsers(*group, creator.Id)
+	var groupId int
+	err = h.uow.Do(func(tx dbs.DBTX) error {
+		creator, err := h.userRepo.WithTx(tx).GetUserByUserId(cmd.CreatorId)
+		if err != nil || creator == nil {
+			return fmt.Errorf("creator user not found: %s", cmd.CreatorId)
+		}
+
+		// Persist the group with creator as admin
+		groupId, err = h.groupRepo.WithTx(tx).CreateGroupWithUsers(*group, creator.Id)
+		if err != nil {
+			return fmt.Errorf("failed to create group: %w", err)
+		}
+		return nil
+	})
 	if err != nil {
-		return 0, fmt.Errorf("failed to create group: %w", err)
+		return 0, err
 	}
 
 	return groupId, nil
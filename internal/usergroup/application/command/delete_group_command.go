@@ -4,39 +4,52 @@ import (
 	"fmt"
 
 	userGroupRepo "github.com/happYness-Project/taskManagementGolang/internal/usergroup/repository"
+	"github.com/happYness-Project/taskManagementGolang/pkg/dbs"
 )
 
 // DeleteGroupCommand represents the command to delete a group
 type DeleteGroupCommand struct {
-	GroupId int
+	GroupId         int
+	RequesterId     string // UUID from JWT
+	ExpectedVersion int    // from If-Match; 0 skips the version check
 }
 
 // DeleteGroupCommandHandler handles deleting a group
 type DeleteGroupCommandHandler struct {
 	groupRepo userGroupRepo.UserGroupRepository
+	uow       dbs.UnitOfWork
 }
 
 func NewDeleteGroupCommandHandler(
 	groupRepo userGroupRepo.UserGroupRepository,
+	uow dbs.UnitOfWork,
 ) *DeleteGroupCommandHandler {
 	return &DeleteGroupCommandHandler{
 		groupRepo: groupRepo,
+		uow:       uow,
 	}
 }
 
 // Handle executes the delete group command
 func (h *DeleteGroupCommandHandler) Handle(cmd DeleteGroupCommand) error {
-	// Validate group exists
-	group, err := h.groupRepo.GetById(cmd.GroupId)
-	if err != nil || group.GroupId == 0 {
-		return fmt.Errorf("group not found: %d", cmd.GroupId)
-	}
-
-	// Delete the group (cascade will handle related records)
-	err = h.groupRepo.DeleteUserGroup(cmd.GroupId)
-	if err != nil {
-		return fmt.Errorf("failed to delete group: %w", err)
-	}
-
-	return nil
+	return h.uow.Do(func(tx dbs.DBTX) error {
+		groupRepo := h.groupRepo.WithTx(tx)
+
+		// Validate group exists
+		group, err := groupRepo.GetById(cmd.GroupId)
+		if err != nil || group.GroupId == 0 {
+			return fmt.Errorf("group not found: %d", cmd.GroupId)
+		}
+		if _, err = groupRepo.IncrementVersion(cmd.GroupId, cmd.ExpectedVersion); err != nil {
+			return err
+		}
+
+		// Delete the group (cascade will handle related records)
+		err = groupRepo.DeleteUserGroup(cmd.GroupId)
+		if err != nil {
+			return fmt.Errorf("failed to delete group: %w", err)
+		}
+
+		return nil
+	})
 }
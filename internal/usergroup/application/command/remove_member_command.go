@@ -5,53 +5,67 @@ import (
 
 	"github.com/happYness-Project/taskManagementGolang/internal/user/repository"
 	userGroupRepo "github.com/happYness-Project/taskManagementGolang/internal/usergroup/repository"
+	"github.com/happYness-Project/taskManagementGolang/pkg/dbs"
 )
 
 // RemoveMemberCommand represents the command to remove a member from a group
 type RemoveMemberCommand struct {
-	GroupId int
-	UserId  string // UUID
+	GroupId         int
+	UserId          string // UUID
+	RequesterId     string // UUID from JWT
+	ExpectedVersion int    // from If-Match; 0 skips the version check
 }
 
 // RemoveMemberCommandHandler handles removing a member from a group
 type RemoveMemberCommandHandler struct {
 	groupRepo userGroupRepo.UserGroupRepository
 	userRepo  repository.UserRepository
+	uow       dbs.UnitOfWork
 }
 
 func NewRemoveMemberCommandHandler(
 	groupRepo userGroupRepo.UserGroupRepository,
 	userRepo repository.UserRepository,
+	uow dbs.UnitOfWork,
 ) *RemoveMemberCommandHandler {
 	return &RemoveMemberCommandHandler{
 		groupRepo: groupRepo,
 		userRepo:  userRepo,
+		uow:       uow,
 	}
 }
 
 // Handle executes the remove member command
 func (h *RemoveMemberCommandHandler) Handle(cmd RemoveMemberCommand) error {
-	// Validate user exists
-	user, err := h.userRepo.GetUserByUserId(cmd.UserId)
-	if err != nil || user == nil {
-		return fmt.Errorf("user not found: %s", cmd.UserId)
-	}
+	return h.uow.Do(func(tx dbs.DBTX) error {
+		userRepo := h.userRepo.WithTx(tx)
+		groupRepo := h.groupRepo.WithTx(tx)
 
-	// Business rule: Check if user's default group is being removed
-	if user.DefaultGroupId == cmd.GroupId {
-		user.ClearDefaultGroup()
-		err = h.userRepo.UpdateUser(*user)
-		if err != nil {
-			// Log but continue - this is a side effect
-			// In a full event-driven system, this would be handled by an event handler
+		// Validate user exists
+		user, err := userRepo.GetUserByUserId(cmd.UserId)
+		if err != nil || user == nil {
+			return fmt.Errorf("user not found: %s", cmd.UserId)
 		}
-	}
 
-	// Remove the member
-	err = h.groupRepo.RemoveUserFromUserGroup(cmd.GroupId, user.Id)
-	if err != nil {
-		return fmt.Errorf("failed to remove member: %w", err)
-	}
+		if _, err = groupRepo.IncrementVersion(cmd.GroupId, cmd.ExpectedVersion); err != nil {
+			return err
+		}
+
+		// Business rule: Check if user's default group is being removed
+		if user.DefaultGroupId == cmd.GroupId {
+			user.ClearDefaultGroup()
+			err = userRepo.UpdateUser(*user)
+			if err != nil {
+				return fmt.Errorf("failed to clear default group: %w", err)
+			}
+		}
+
+		// Remove the member
+		err = groupRepo.RemoveUserFromUserGroup(cmd.GroupId, user.Id)
+		if err != nil {
+			return fmt.Errorf("failed to remove member: %w", err)
+		}
 
-	return nil
+		return nil
+	})
 }
@@ -1,11 +1,16 @@
 package application
 
 import (
+	"context"
 	"fmt"
 
+	auditApp "github.com/happYness-Project/taskManagementGolang/internal/audit/application"
+	"github.com/happYness-Project/taskManagementGolang/internal/authorization"
+	outboxRepo "github.com/happYness-Project/taskManagementGolang/internal/outbox/repository"
 	"github.com/happYness-Project/taskManagementGolang/internal/user/repository"
 	cmd "github.com/happYness-Project/taskManagementGolang/internal/usergroup/application/command"
 	userGroupRepo "github.com/happYness-Project/taskManagementGolang/internal/usergroup/repository"
+	"github.com/happYness-Project/taskManagementGolang/pkg/dbs"
 )
 
 // CommandBus routes commands to their handlers
@@ -15,24 +20,75 @@ type CommandBus struct {
 	removeMemberHandler     *cmd.RemoveMemberCommandHandler
 	changeMemberRoleHandler *cmd.ChangeMemberRoleCommandHandler
 	deleteGroupHandler      *cmd.DeleteGroupCommandHandler
+
+	groupRepo  userGroupRepo.UserGroupRepository
+	userRepo   repository.UserRepository
+	outboxRepo outboxRepo.OutboxRepository
+	policy     *authorization.Policy
+	uow        dbs.UnitOfWork
+	recorder   *auditApp.Recorder
 }
 
 // NewCommandBus creates a new command bus with all handlers registered
 func NewCommandBus(
 	groupRepo userGroupRepo.UserGroupRepository,
 	userRepo repository.UserRepository,
+	policy *authorization.Policy,
+	uow dbs.UnitOfWork,
+	recorder *auditApp.Recorder,
+	outboxRepo outboxRepo.OutboxRepository,
 ) *CommandBus {
 	return &CommandBus{
-		createGroupHandler:      cmd.NewCreateGroupCommandHandler(groupRepo, userRepo),
-		addMemberHandler:        cmd.NewAddMemberCommandHandler(groupRepo, userRepo),
-		removeMemberHandler:     cmd.NewRemoveMemberCommandHandler(groupRepo, userRepo),
-		changeMemberRoleHandler: cmd.NewChangeMemberRoleCommandHandler(groupRepo, userRepo),
-		deleteGroupHandler:      cmd.NewDeleteGroupCommandHandler(groupRepo),
+		createGroupHandler:      cmd.NewCreateGroupCommandHandler(groupRepo, userRepo, uow),
+		addMemberHandler:        cmd.NewAddMemberCommandHandler(groupRepo, userRepo, outboxRepo, uow),
+		removeMemberHandler:     cmd.NewRemoveMemberCommandHandler(groupRepo, userRepo, uow),
+		changeMemberRoleHandler: cmd.NewChangeMemberRoleCommandHandler(groupRepo, userRepo, outboxRepo, uow),
+		deleteGroupHandler:      cmd.NewDeleteGroupCommandHandler(groupRepo, uow),
+		groupRepo:               groupRepo,
+		userRepo:                userRepo,
+		outboxRepo:              outboxRepo,
+		policy:                  policy,
+		uow:                     uow,
+		recorder:                recorder,
+	}
+}
+
+// withTx returns a bus whose handlers and activity log run inside the given transaction.
+// The handlers' own units of work become savepoints of it.
+func (bus *CommandBus) withTx(tx dbs.DBTX) *CommandBus {
+	return NewCommandBus(
+		bus.groupRepo.WithTx(tx),
+		bus.userRepo.WithTx(tx),
+		bus.policy,
+		dbs.NewUnitOfWork(tx),
+		bus.recorder.WithTx(tx),
+		bus.outboxRepo.WithTx(tx),
+	)
+}
+
+// Execute authorizes the command, then dispatches it to the appropriate handler and records it in the activity log
+// in one transaction. ctx carries the request id of the HTTP request that issued the command; commands of operators are not authorized.
+func (bus *CommandBus) Execute(ctx context.Context, command interface{}) (interface{}, error) {
+	if !authorization.IsOperator(ctx) {
+		if err := bus.authorize(command); err != nil {
+			return nil, err
+		}
 	}
+
+	var result interface{}
+	err := bus.uow.Do(func(tx dbs.DBTX) error {
+		txBus := bus.withTx(tx)
+		var err error
+		result, err = txBus.recorder.Track(ctx, txBus.auditSubject(command), func() (interface{}, error) {
+			return txBus.dispatch(command)
+		})
+		return err
+	})
+	return result, err
 }
 
-// Execute dispatches the command to the appropriate handler
-func (bus *CommandBus) Execute(command interface{}) (interface{}, error) {
+// dispatch routes the command to its handler
+func (bus *CommandBus) dispatch(command interface{}) (interface{}, error) {
 	switch c := command.(type) {
 	case cmd.CreateGroupCommand:
 		return bus.createGroupHandler.Handle(c)
@@ -48,3 +104,23 @@ func (bus *CommandBus) Execute(command interface{}) (interface{}, error) {
 		return nil, fmt.Errorf("unknown command type: %T", command)
 	}
 }
+
+// authorize checks the requester's role in the group.
+// Managing members and deleting the group require admin; members may always leave a group.
+func (bus *CommandBus) authorize(command interface{}) error {
+	switch c := command.(type) {
+	case cmd.AddMemberCommand:
+		return bus.policy.RequireAdmin(c.RequesterId, c.GroupId)
+	case cmd.RemoveMemberCommand:
+		if c.RequesterId == c.UserId {
+			return bus.policy.RequireMember(c.RequesterId, c.GroupId)
+		}
+		return bus.policy.RequireAdmin(c.RequesterId, c.GroupId)
+	case cmd.ChangeMemberRoleCommand:
+		return bus.policy.RequireAdmin(c.RequesterId, c.GroupId)
+	case cmd.DeleteGroupCommand:
+		return bus.policy.RequireAdmin(c.RequesterId, c.GroupId)
+	default:
+		return nil
+	}
+}
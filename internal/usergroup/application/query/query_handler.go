@@ -9,14 +9,18 @@ import (
 )
 
 // Queries define the read operations
-type GetAllGroupsQuery struct{}
+type GetAllGroupsQuery struct {
+	RequesterId string // UUID from JWT
+}
 
 type GetGroupByIdQuery struct {
-	GroupId int
+	GroupId     int
+	RequesterId string // UUID from JWT
 }
 
 type GetGroupsByUserIdQuery struct {
-	UserId string // UUID
+	UserId      string // UUID
+	RequesterId string // UUID from JWT
 }
 
 type GetGroupMembersQuery struct {
@@ -39,9 +43,17 @@ func NewUserGroupQueryHandler(
 	}
 }
 
-// HandleGetAllGroups retrieves all groups
+// HandleGetAllGroups retrieves all groups the requester belongs to
 func (h *UserGroupQueryHandler) HandleGetAllGroups(query GetAllGroupsQuery) ([]*domain.UserGroup, error) {
-	groups, err := h.groupRepo.GetAllUsergroups()
+	requester, err := h.userRepo.GetUserByUserId(query.RequesterId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve groups: %w", err)
+	}
+	if requester == nil {
+		return []*domain.UserGroup{}, nil
+	}
+
+	groups, err := h.groupRepo.GetUserGroupsByUserId(requester.Id)
 	if err != nil {
 		return nil, fmt.Errorf("failed to retrieve groups: %w", err)
 	}
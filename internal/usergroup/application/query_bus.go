@@ -3,6 +3,7 @@ package application
 import (
 	"fmt"
 
+	"github.com/happYness-Project/taskManagementGolang/internal/authorization"
 	"github.com/happYness-Project/taskManagementGolang/internal/user/repository"
 	qry "github.com/happYness-Project/taskManagementGolang/internal/usergroup/application/query"
 	userGroupRepo "github.com/happYness-Project/taskManagementGolang/internal/usergroup/repository"
@@ -11,20 +12,28 @@ import (
 // QueryBus routes queries to their handlers
 type QueryBus struct {
 	queryHandler *qry.UserGroupQueryHandler
+
+	policy *authorization.Policy
 }
 
 // NewQueryBus creates a new query bus with all handlers registered
 func NewQueryBus(
 	groupRepo userGroupRepo.UserGroupRepository,
 	userRepo repository.UserRepository,
+	policy *authorization.Policy,
 ) *QueryBus {
 	return &QueryBus{
 		queryHandler: qry.NewUserGroupQueryHandler(groupRepo, userRepo),
+		policy:       policy,
 	}
 }
 
 // Execute dispatches the query to the appropriate handler
 func (bus *QueryBus) Execute(query interface{}) (interface{}, error) {
+	if err := bus.authorize(query); err != nil {
+		return nil, err
+	}
+
 	switch q := query.(type) {
 	case qry.GetAllGroupsQuery:
 		return bus.queryHandler.HandleGetAllGroups(q)
@@ -36,3 +45,16 @@ func (bus *QueryBus) Execute(query interface{}) (interface{}, error) {
 		return nil, fmt.Errorf("unknown query type: %T", query)
 	}
 }
+
+// authorize checks that the requester may read the group.
+// GetAllGroupsQuery needs no check because it is already scoped to the requester's groups.
+func (bus *QueryBus) authorize(query interface{}) error {
+	switch q := query.(type) {
+	case qry.GetGroupByIdQuery:
+		return bus.policy.RequireMember(q.RequesterId, q.GroupId)
+	case qry.GetGroupsByUserIdQuery:
+		return bus.policy.RequireSelf(q.RequesterId, q.UserId)
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,33 @@
+package domain
+
+import "strconv"
+
+const (
+	EventMemberAdded = "usergroup.member_added"
+	EventRoleChanged = "usergroup.role_changed"
+
+	aggregateUserGroup = "usergroup"
+)
+
+// MemberAdded is raised when a user joins a group
+type MemberAdded struct {
+	GroupId int    `json:"group_id"`
+	UserId  string `json:"user_id"`
+	Role    Role   `json:"role"`
+}
+
+func (e MemberAdded) EventType() string     { return EventMemberAdded }
+func (e MemberAdded) AggregateType() string { return aggregateUserGroup }
+func (e MemberAdded) AggregateId() string   { return strconv.Itoa(e.GroupId) }
+
+// RoleChanged is raised when a member is promoted or demoted
+type RoleChanged struct {
+	GroupId int    `json:"group_id"`
+	UserId  string `json:"user_id"`
+	OldRole Role   `json:"old_role"`
+	NewRole Role   `json:"new_role"`
+}
+
+func (e RoleChanged) EventType() string     { return EventRoleChanged }
+func (e RoleChanged) AggregateType() string { return aggregateUserGroup }
+func (e RoleChanged) AggregateId() string   { return strconv.Itoa(e.GroupId) }
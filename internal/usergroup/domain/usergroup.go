@@ -1,14 +1,21 @@
 package domain
 
-import "errors"
+import (
+	"errors"
+
+	"github.com/happYness-Project/taskManagementGolang/pkg/events"
+)
 
 type UserGroup struct {
+	events.Aggregate `json:"-"`
+
 	GroupId   int    `json:"id"`
 	GroupName string `json:"name"`
 	GroupDesc string `json:"description"`
 	Type      string `json:"type"`
 	Thumbnail string `json:"thumbnailurl"`
 	IsActive  bool   `json:"is_active"`
+	Version   int    `json:"version"`
 }
 
 func NewUserGroup(name, desc, groupType string) (*UserGroup, error) {
@@ -24,5 +31,23 @@ func NewUserGroup(name, desc, groupType string) (*UserGroup, error) {
 		Type:      groupType,
 		IsActive:  true,
 		Thumbnail: "",
+		Version:   1,
 	}, nil
 }
+
+// AddMember admits a user to the group with the member role
+func (g *UserGroup) AddMember(userId string) *GroupMember {
+	member := NewGroupMember(userId, RoleMember)
+	g.Raise(MemberAdded{GroupId: g.GroupId, UserId: userId, Role: member.Role})
+	return member
+}
+
+// ChangeMemberRole gives a member a new role. Assigning the role the member already has changes nothing.
+func (g *UserGroup) ChangeMemberRole(member *GroupMember, newRole Role) {
+	if member.Role == newRole {
+		return
+	}
+	oldRole := member.Role
+	member.ChangeRole(newRole)
+	g.Raise(RoleChanged{GroupId: g.GroupId, UserId: member.UserId, OldRole: oldRole, NewRole: newRole})
+}
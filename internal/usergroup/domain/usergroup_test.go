@@ -3,6 +3,7 @@ package domain
 import (
 	"testing"
 
+	"github.com/happYness-Project/taskManagementGolang/pkg/events"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -111,3 +112,42 @@ func TestNewUserGroup(t *testing.T) {
 		assert.Equal(t, groupType, userGroup.Type)
 	})
 }
+
+func TestUserGroupMembershipEvents(t *testing.T) {
+	t.Run("when member is added, Then MemberAdded is raised with member role", func(t *testing.T) {
+		// Given
+		group := &UserGroup{GroupId: 7}
+
+		// When
+		member := group.AddMember("user-1")
+
+		// Then
+		assert.Equal(t, RoleMember, member.Role)
+		assert.Equal(t, []events.Event{MemberAdded{GroupId: 7, UserId: "user-1", Role: RoleMember}}, group.PullEvents())
+	})
+
+	t.Run("when member role changes, Then RoleChanged carries old and new role", func(t *testing.T) {
+		// Given
+		group := &UserGroup{GroupId: 7}
+		member := NewGroupMember("user-1", RoleMember)
+
+		// When
+		group.ChangeMemberRole(member, RoleAdmin)
+
+		// Then
+		assert.Equal(t, RoleAdmin, member.Role)
+		assert.Equal(t, []events.Event{RoleChanged{GroupId: 7, UserId: "user-1", OldRole: RoleMember, NewRole: RoleAdmin}}, group.PullEvents())
+	})
+
+	t.Run("when member already has the role, Then no event is raised", func(t *testing.T) {
+		// Given
+		group := &UserGroup{GroupId: 7}
+		member := NewGroupMember("user-1", RoleAdmin)
+
+		// When
+		group.ChangeMemberRole(member, RoleAdmin)
+
+		// Then
+		assert.Empty(t, group.PullEvents())
+	})
+}
@@ -3,10 +3,12 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"time"
 
 	"github.com/happYness-Project/taskManagementGolang/internal/usergroup/domain"
+	"github.com/happYness-Project/taskManagementGolang/pkg/dbs"
 )
 
 const dbTimeout = time.Second * 5
@@ -20,17 +22,26 @@ type UserGroupRepository interface {
 	RemoveUserFromUserGroup(groupId int, userId int) error
 	UpdateUserRoleInGroup(groupId int, userId int, role string) error
 	DeleteUserGroup(id int) error
+	// IncrementVersion bumps the group's version and returns the new one. When expectedVersion is not 0
+	// and no longer matches, nothing changes and dbs.ErrVersionConflict is returned.
+	IncrementVersion(groupId int, expectedVersion int) (int, error)
+	WithTx(tx dbs.DBTX) UserGroupRepository
 }
 type UserGroupRepo struct {
-	DB *sql.DB
+	DB dbs.DBTX
 }
 
-func NewUserGroupRepository(db *sql.DB) *UserGroupRepo {
+func NewUserGroupRepository(db dbs.DBTX) *UserGroupRepo {
 	return &UserGroupRepo{
 		DB: db,
 	}
 }
 
+// WithTx returns a copy of the repository bound to the given transaction
+func (m *UserGroupRepo) WithTx(tx dbs.DBTX) UserGroupRepository {
+	return &UserGroupRepo{DB: tx}
+}
+
 func (m *UserGroupRepo) GetAllUsergroups() ([]*domain.UserGroup, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), dbTimeout)
 	defer cancel()
@@ -99,28 +110,22 @@ func (m *UserGroupRepo) CreateGroup(ug domain.UserGroup) (int, error) {
 	return lastInsertedId, nil
 }
 func (m *UserGroupRepo) CreateGroupWithUsers(ug domain.UserGroup, userId int) (int, error) {
-	tx, err := m.DB.Begin()
-	if err != nil {
-		return 0, err
-	}
-	defer func() {
+	lastInsertedId := 0
+	err := dbs.RunInTx(m.DB, func(tx dbs.DBTX) error {
+		err := tx.QueryRow(sqlCreateUserGroup, ug.GroupName, ug.GroupDesc, ug.Type, ug.Thumbnail, ug.IsActive).Scan(&lastInsertedId)
 		if err != nil {
-			tx.Rollback()
+			return fmt.Errorf("unable to insert into usergroup table : %w", err)
 		}
-	}()
-	lastInsertedId := 0
-	err = tx.QueryRow(sqlCreateUserGroup, ug.GroupName, ug.GroupDesc, ug.Type, ug.Thumbnail, ug.IsActive).Scan(&lastInsertedId)
-	if err != nil {
-		return 0, fmt.Errorf("unable to insert into usergroup table : %w", err)
-	}
 
-	_, err = tx.Exec(sqlAddUserToUserGroupAdmin, lastInsertedId, userId)
+		_, err = tx.Exec(sqlAddUserToUserGroupAdmin, lastInsertedId, userId)
+		if err != nil {
+			return fmt.Errorf("unable to insert into usergroup_user table : %w", err)
+		}
+		return nil
+	})
 	if err != nil {
 		return 0, err
 	}
-	if err = tx.Commit(); err != nil {
-		return 0, err
-	}
 	return lastInsertedId, nil
 }
 
@@ -168,6 +173,18 @@ func (m *UserGroupRepo) DeleteUserGroup(groupId int) error {
 	return nil
 }
 
+func (m *UserGroupRepo) IncrementVersion(groupId int, expectedVersion int) (int, error) {
+	var version int
+	err := m.DB.QueryRow(sqlIncrementUserGroupVersion, groupId, expectedVersion).Scan(&version)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, dbs.ErrVersionConflict
+	}
+	if err != nil {
+		return 0, fmt.Errorf("unable to update usergroup version : %w", err)
+	}
+	return version, nil
+}
+
 func scanRowsIntoUsergroup(rows *sql.Rows) (*domain.UserGroup, error) {
 	usergroup := new(domain.UserGroup)
 	err := rows.Scan(
@@ -177,6 +194,7 @@ func scanRowsIntoUsergroup(rows *sql.Rows) (*domain.UserGroup, error) {
 		&usergroup.Type,
 		&usergroup.Thumbnail,
 		&usergroup.IsActive,
+		&usergroup.Version,
 	)
 	if err != nil {
 		return nil, err
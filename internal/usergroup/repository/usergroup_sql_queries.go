@@ -1,9 +1,9 @@
 package repository
 
 const (
-	sqlGetAllUsergroups      = `SELECT * FROM container.usergroup`
-	sqlGetById               = `SELECT * FROM container.usergroup WHERE id = $1`
-	sqlGetUserGroupsByUserId = `SELECT ug.id, ug.name, ug.description, ug.type, ug.thumbnailurl, ug.is_active
+	sqlGetAllUsergroups      = `SELECT id, name, description, type, thumbnailurl, is_active, version FROM container.usergroup`
+	sqlGetById               = `SELECT id, name, description, type, thumbnailurl, is_active, version FROM container.usergroup WHERE id = $1`
+	sqlGetUserGroupsByUserId = `SELECT ug.id, ug.name, ug.description, ug.type, ug.thumbnailurl, ug.is_active, ug.version
 								FROM container.usergroup ug
 								INNER JOIN container.usergroup_user ugu
 								ON ug.id = ugu.usergroup_id
@@ -18,4 +18,7 @@ const (
 	sqlUpdateUserRoleInGroup   = `UPDATE container.usergroup_user SET role = $3 WHERE usergroup_id = $1 AND user_id = $2`
 
 	sqlDeleteUserGroup = `DELETE FROM container.usergroup WHERE id = $1`
+
+	// An expected version of 0 bumps the version unconditionally
+	sqlIncrementUserGroupVersion = `UPDATE container.usergroup SET version = version + 1 WHERE id = $1 AND ($2 = 0 OR version = $2) RETURNING version`
 )
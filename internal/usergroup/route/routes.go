@@ -7,14 +7,18 @@ import (
 	"strconv"
 
 	"github.com/go-chi/chi/v5"
-	"github.com/go-chi/jwtauth"
+	auditApp "github.com/happYness-Project/taskManagementGolang/internal/audit/application"
+	"github.com/happYness-Project/taskManagementGolang/internal/authorization"
+	outboxRepo "github.com/happYness-Project/taskManagementGolang/internal/outbox/repository"
 	userRepo "github.com/happYness-Project/taskManagementGolang/internal/user/repository"
 	"github.com/happYness-Project/taskManagementGolang/internal/usergroup/application"
 	"github.com/happYness-Project/taskManagementGolang/internal/usergroup/application/command"
 	"github.com/happYness-Project/taskManagementGolang/internal/usergroup/application/query"
+	"github.com/happYness-Project/taskManagementGolang/internal/usergroup/domain"
 
 	"github.com/happYness-Project/taskManagementGolang/internal/usergroup/repository"
 	"github.com/happYness-Project/taskManagementGolang/pkg/constants"
+	"github.com/happYness-Project/taskManagementGolang/pkg/dbs"
 	"github.com/happYness-Project/taskManagementGolang/pkg/loggers"
 	"github.com/happYness-Project/taskManagementGolang/pkg/response"
 )
@@ -25,11 +29,11 @@ type Handler struct {
 	queryBus   *application.QueryBus
 }
 
-func NewHandler(logger *loggers.AppLogger, repo repository.UserGroupRepository, userRepo userRepo.UserRepository) *Handler {
+func NewHandler(logger *loggers.AppLogger, repo repository.UserGroupRepository, userRepo userRepo.UserRepository, policy *authorization.Policy, uow dbs.UnitOfWork, recorder *auditApp.Recorder, outbox outboxRepo.OutboxRepository) *Handler {
 	return &Handler{
 		logger:     logger,
-		commandBus: application.NewCommandBus(repo, userRepo),
-		queryBus:   application.NewQueryBus(repo, userRepo),
+		commandBus: application.NewCommandBus(repo, userRepo, policy, uow, recorder, outbox),
+		queryBus:   application.NewQueryBus(repo, userRepo, policy),
 	}
 }
 func (h *Handler) RegisterRoutes(router chi.Router) {
@@ -47,7 +51,7 @@ func (h *Handler) RegisterRoutes(router chi.Router) {
 
 func (h *Handler) handleGetUserGroups(w http.ResponseWriter, r *http.Request) {
 	// Use Query Bus
-	result, err := h.queryBus.Execute(query.GetAllGroupsQuery{})
+	result, err := h.queryBus.Execute(query.GetAllGroupsQuery{RequesterId: authorization.RequesterId(r)})
 	if err != nil {
 		h.logger.Error().Err(err).Str("ErrorCode", constants.ServerError).Msg("Error occurred during getting groups.")
 		response.InternalServerError(w)
@@ -64,12 +68,20 @@ func (h *Handler) handleGetUserGroupById(w http.ResponseWriter, r *http.Request)
 	}
 
 	// Use Query Bus
-	result, err := h.queryBus.Execute(query.GetGroupByIdQuery{GroupId: groupId})
+	result, err := h.queryBus.Execute(query.GetGroupByIdQuery{GroupId: groupId, RequesterId: authorization.RequesterId(r)})
+	if authorization.IsForbiddenError(err) {
+		h.logger.Error().Err(err).Str("ErrorCode", constants.PermissionDenied).Msg(err.Error())
+		response.Forbidden(w, constants.PermissionDenied, err.Error())
+		return
+	}
 	if err != nil {
 		h.logger.Error().Err(err).Str("ErrorCode", UserGroupGetNotFound).Msg(err.Error())
 		response.NotFound(w, UserGroupGetNotFound, "group does not exist")
 		return
 	}
+	if group, ok := result.(*domain.UserGroup); ok && group != nil {
+		response.SetETag(w, group.Version)
+	}
 
 	response.WriteJsonWithEncode(w, http.StatusOK, result)
 }
@@ -82,18 +94,14 @@ func (h *Handler) handleCreateUserGroup(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	// Get user ID from JWT
-	_, claims, _ := jwtauth.FromContext(r.Context())
-	userid := fmt.Sprintf("%v", claims["nameid"])
-
 	// Use Command Bus
 	cmd := command.CreateGroupCommand{
 		GroupName: createDto.GroupName,
 		GroupDesc: createDto.GroupDesc,
 		GroupType: createDto.GroupType,
-		CreatorId: userid,
+		CreatorId: authorization.RequesterId(r),
 	}
-	result, err := h.commandBus.Execute(cmd)
+	result, err := h.commandBus.Execute(r.Context(), cmd)
 	if err != nil {
 		h.logger.Error().Err(err).Str("ErrorCode", UserGroupCreationFailure).Msg(err.Error())
 		response.ErrorResponse(w, http.StatusBadRequest, *response.New(UserGroupCreationFailure, "Failed to create group", err.Error()))
@@ -113,7 +121,12 @@ func (h *Handler) handleGetUserGroupByUserId(w http.ResponseWriter, r *http.Requ
 	}
 
 	// Use Query Bus
-	result, err := h.queryBus.Execute(query.GetGroupsByUserIdQuery{UserId: userId})
+	result, err := h.queryBus.Execute(query.GetGroupsByUserIdQuery{UserId: userId, RequesterId: authorization.RequesterId(r)})
+	if authorization.IsForbiddenError(err) {
+		h.logger.Error().Err(err).Str("ErrorCode", constants.PermissionDenied).Msg(err.Error())
+		response.Forbidden(w, constants.PermissionDenied, err.Error())
+		return
+	}
 	if err != nil {
 		h.logger.Error().Err(err).Str("ErrorCode", UserGroupGetNotFound).Msg(err.Error())
 		response.NotFound(w, UserGroupGetNotFound, "Not able to find user groups")
@@ -144,11 +157,17 @@ func (h *Handler) handleAddUserToGroup(w http.ResponseWriter, r *http.Request) {
 
 	// Use Command Bus
 	cmd := command.AddMemberCommand{
-		GroupId: groupId,
-		UserId:  jsonBody.UserId,
+		GroupId:     groupId,
+		UserId:      jsonBody.UserId,
+		RequesterId: authorization.RequesterId(r),
 	}
 
-	_, err = h.commandBus.Execute(cmd)
+	_, err = h.commandBus.Execute(r.Context(), cmd)
+	if authorization.IsForbiddenError(err) {
+		h.logger.Error().Err(err).Str("ErrorCode", constants.PermissionDenied).Msg(err.Error())
+		response.Forbidden(w, constants.PermissionDenied, err.Error())
+		return
+	}
 	if err != nil {
 		h.logger.Error().Err(err).Str("ErrorCode", UserGroupAddUserError).Msg(err.Error())
 		response.ErrorResponse(w, http.StatusBadRequest, *response.New(UserGroupAddUserError, "Bad Request", err.Error()))
@@ -165,10 +184,22 @@ func (h *Handler) handleDeleteUserGroup(w http.ResponseWriter, r *http.Request)
 		response.BadRequestMissingParameters(w, "invalid groupId")
 		return
 	}
+	expectedVersion, ok := response.IfMatch(w, r, h.logger)
+	if !ok {
+		return
+	}
 
 	// Use Command Bus
-	cmd := command.DeleteGroupCommand{GroupId: groupId}
-	_, err = h.commandBus.Execute(cmd)
+	cmd := command.DeleteGroupCommand{GroupId: groupId, RequesterId: authorization.RequesterId(r), ExpectedVersion: expectedVersion}
+	_, err = h.commandBus.Execute(r.Context(), cmd)
+	if authorization.IsForbiddenError(err) {
+		h.logger.Error().Err(err).Str("ErrorCode", constants.PermissionDenied).Msg(err.Error())
+		response.Forbidden(w, constants.PermissionDenied, err.Error())
+		return
+	}
+	if response.VersionConflict(w, err, h.logger) {
+		return
+	}
 	if err != nil {
 		h.logger.Error().Err(err).Str("ErrorCode", DeleteUserGroupError).Msg(err.Error())
 		response.ErrorResponse(w, http.StatusBadRequest, *response.New(DeleteUserGroupError, "Bad Request", err.Error()))
@@ -193,14 +224,28 @@ func (h *Handler) handleRemoveUserFromGroup(w http.ResponseWriter, r *http.Reque
 	}
 
 	userId := chi.URLParam(r, "userID")
+	expectedVersion, ok := response.IfMatch(w, r, h.logger)
+	if !ok {
+		return
+	}
 
 	// Use Command Bus (it handles the default group clearing logic)
 	cmd := command.RemoveMemberCommand{
-		GroupId: groupId,
-		UserId:  userId,
+		GroupId:         groupId,
+		UserId:          userId,
+		RequesterId:     authorization.RequesterId(r),
+		ExpectedVersion: expectedVersion,
 	}
 
-	_, err = h.commandBus.Execute(cmd)
+	_, err = h.commandBus.Execute(r.Context(), cmd)
+	if authorization.IsForbiddenError(err) {
+		h.logger.Error().Err(err).Str("ErrorCode", constants.PermissionDenied).Msg(err.Error())
+		response.Forbidden(w, constants.PermissionDenied, err.Error())
+		return
+	}
+	if response.VersionConflict(w, err, h.logger) {
+		return
+	}
 	if err != nil {
 		h.logger.Error().Err(err).Str("ErrorCode", RemoveUserFromUserGroupError).Msg(err.Error())
 		response.ErrorResponse(w, http.StatusBadRequest, *response.New(RemoveUserFromUserGroupError, "Bad Request", err.Error()))
@@ -219,6 +264,10 @@ func (h *Handler) handleUpdateUserRoleInGroup(w http.ResponseWriter, r *http.Req
 	}
 
 	userId := chi.URLParam(r, "userID")
+	expectedVersion, ok := response.IfMatch(w, r, h.logger)
+	if !ok {
+		return
+	}
 
 	var updateDto UpdateUserRoleDto
 	if err := response.ParseJson(r, &updateDto); err != nil {
@@ -229,12 +278,22 @@ func (h *Handler) handleUpdateUserRoleInGroup(w http.ResponseWriter, r *http.Req
 
 	// Use Command Bus (it validates the role using the Role value object)
 	cmd := command.ChangeMemberRoleCommand{
-		GroupId: groupId,
-		UserId:  userId,
-		NewRole: updateDto.Role,
+		GroupId:         groupId,
+		UserId:          userId,
+		NewRole:         updateDto.Role,
+		RequesterId:     authorization.RequesterId(r),
+		ExpectedVersion: expectedVersion,
 	}
 
-	_, err = h.commandBus.Execute(cmd)
+	_, err = h.commandBus.Execute(r.Context(), cmd)
+	if authorization.IsForbiddenError(err) {
+		h.logger.Error().Err(err).Str("ErrorCode", constants.PermissionDenied).Msg(err.Error())
+		response.Forbidden(w, constants.PermissionDenied, err.Error())
+		return
+	}
+	if response.VersionConflict(w, err, h.logger) {
+		return
+	}
 	if err != nil {
 		h.logger.Error().Err(err).Str("ErrorCode", UpdateUserRoleError).Msg(err.Error())
 		response.ErrorResponse(w, http.StatusBadRequest, *response.New(UpdateUserRoleError, "Bad Request", err.Error()))
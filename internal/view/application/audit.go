@@ -0,0 +1,42 @@
+package application
+
+import (
+	auditApp "github.com/happYness-Project/taskManagementGolang/internal/audit/application"
+	auditDomain "github.com/happYness-Project/taskManagementGolang/internal/audit/domain"
+	cmd "github.com/happYness-Project/taskManagementGolang/internal/view/application/command"
+	"github.com/happYness-Project/taskManagementGolang/internal/view/domain"
+)
+
+// auditSubject describes the view a command mutates for the activity log.
+// Views are personal, so their activities belong to no group.
+func (bus *CommandBus) auditSubject(command interface{}) auditApp.Subject {
+	subject := auditApp.Subject{
+		Action:        auditApp.ActionOf(command),
+		AggregateType: auditDomain.AggregateSavedView,
+		Load:          bus.loadView,
+	}
+
+	switch c := command.(type) {
+	case cmd.CreateViewCommand:
+		subject.ActorId = c.RequesterId
+		subject.Resolve = func(result interface{}) (string, int) {
+			if view, ok := result.(*domain.SavedView); ok {
+				return view.Id, 0
+			}
+			return "", 0
+		}
+	case cmd.UpdateViewCommand:
+		subject.ActorId, subject.AggregateId = c.RequesterId, c.ViewId
+	case cmd.DeleteViewCommand:
+		subject.ActorId, subject.AggregateId = c.RequesterId, c.ViewId
+	}
+	return subject
+}
+
+func (bus *CommandBus) loadView(viewId string) (interface{}, error) {
+	view, err := bus.viewRepo.GetViewById(viewId)
+	if err != nil || view == nil {
+		return nil, err
+	}
+	return view, nil
+}
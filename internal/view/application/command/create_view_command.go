@@ -0,0 +1,52 @@
+package command
+
+import (
+	"fmt"
+	"strings"
+
+	taskRepo "github.com/happYness-Project/taskManagementGolang/internal/task/repository"
+	"github.com/happYness-Project/taskManagementGolang/internal/view/domain"
+	"github.com/happYness-Project/taskManagementGolang/internal/view/repository"
+)
+
+// CreateViewCommand represents the command to save a task filter as a view of its owner
+type CreateViewCommand struct {
+	OwnerId     string // UUID of the user owning the view
+	Name        string
+	Filter      domain.Filter
+	Sort        string
+	RequesterId string // UUID from JWT
+}
+
+// CreateViewCommandHandler handles saving a new view
+type CreateViewCommandHandler struct {
+	viewRepo repository.ViewRepository
+}
+
+func NewCreateViewCommandHandler(viewRepo repository.ViewRepository) *CreateViewCommandHandler {
+	return &CreateViewCommandHandler{viewRepo: viewRepo}
+}
+
+// Handle executes the create view command
+func (h *CreateViewCommandHandler) Handle(cmd CreateViewCommand) (*domain.SavedView, error) {
+	if err := validateSort(cmd.Sort); err != nil {
+		return nil, err
+	}
+	view, err := domain.NewSavedView(cmd.OwnerId, cmd.Name, cmd.Filter, cmd.Sort)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.viewRepo.CreateView(*view); err != nil {
+		return nil, fmt.Errorf("failed to create view: %w", err)
+	}
+	return view, nil
+}
+
+// validateSort accepts the sort fields of task listings, optionally prefixed with '-' for descending order
+func validateSort(sort string) error {
+	field := strings.TrimPrefix(strings.TrimSpace(sort), "-")
+	if field != "" && !taskRepo.TaskSortField(field).IsValid() {
+		return fmt.Errorf("%w: unsupported sort field '%s'", domain.ErrInvalidView, field)
+	}
+	return nil
+}
@@ -0,0 +1,34 @@
+package command
+
+import (
+	"github.com/happYness-Project/taskManagementGolang/internal/view/repository"
+	"github.com/happYness-Project/taskManagementGolang/pkg/dbs"
+)
+
+// DeleteViewCommand represents the command to delete a view
+type DeleteViewCommand struct {
+	ViewId      string
+	OwnerId     string // UUID of the user owning the view
+	RequesterId string // UUID from JWT
+}
+
+// DeleteViewCommandHandler handles deleting a view
+type DeleteViewCommandHandler struct {
+	viewRepo repository.ViewRepository
+	uow      dbs.UnitOfWork
+}
+
+func NewDeleteViewCommandHandler(viewRepo repository.ViewRepository, uow dbs.UnitOfWork) *DeleteViewCommandHandler {
+	return &DeleteViewCommandHandler{viewRepo: viewRepo, uow: uow}
+}
+
+// Handle executes the delete view command
+func (h *DeleteViewCommandHandler) Handle(cmd DeleteViewCommand) error {
+	return h.uow.Do(func(tx dbs.DBTX) error {
+		viewRepo := h.viewRepo.WithTx(tx)
+		if _, err := getOwnedView(viewRepo, cmd.ViewId, cmd.OwnerId); err != nil {
+			return err
+		}
+		return viewRepo.DeleteView(cmd.ViewId)
+	})
+}
@@ -0,0 +1,70 @@
+package command
+
+import (
+	"fmt"
+
+	"github.com/happYness-Project/taskManagementGolang/internal/view/domain"
+	"github.com/happYness-Project/taskManagementGolang/internal/view/repository"
+	"github.com/happYness-Project/taskManagementGolang/pkg/dbs"
+)
+
+// UpdateViewCommand represents the command to replace the name, filter and sort of a view
+type UpdateViewCommand struct {
+	ViewId      string
+	OwnerId     string // UUID of the user owning the view
+	Name        string
+	Filter      domain.Filter
+	Sort        string
+	RequesterId string // UUID from JWT
+}
+
+// UpdateViewCommandHandler handles updating a view
+type UpdateViewCommandHandler struct {
+	viewRepo repository.ViewRepository
+	uow      dbs.UnitOfWork
+}
+
+func NewUpdateViewCommandHandler(viewRepo repository.ViewRepository, uow dbs.UnitOfWork) *UpdateViewCommandHandler {
+	return &UpdateViewCommandHandler{viewRepo: viewRepo, uow: uow}
+}
+
+// Handle executes the update view command and returns the updated view
+func (h *UpdateViewCommandHandler) Handle(cmd UpdateViewCommand) (*domain.SavedView, error) {
+	if err := validateSort(cmd.Sort); err != nil {
+		return nil, err
+	}
+
+	var view *domain.SavedView
+	err := h.uow.Do(func(tx dbs.DBTX) error {
+		viewRepo := h.viewRepo.WithTx(tx)
+
+		var err error
+		view, err = getOwnedView(viewRepo, cmd.ViewId, cmd.OwnerId)
+		if err != nil {
+			return err
+		}
+		if err := view.Update(cmd.Name, cmd.Filter, cmd.Sort); err != nil {
+			return err
+		}
+		if err := viewRepo.UpdateView(*view); err != nil {
+			return fmt.Errorf("failed to update view: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return view, nil
+}
+
+// getOwnedView loads the view, reporting views of other users as missing
+func getOwnedView(viewRepo repository.ViewRepository, viewId string, ownerId string) (*domain.SavedView, error) {
+	view, err := viewRepo.GetViewById(viewId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve view: %w", err)
+	}
+	if view == nil || view.OwnerId != ownerId {
+		return nil, domain.ErrViewNotFound
+	}
+	return view, nil
+}
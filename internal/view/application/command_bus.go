@@ -0,0 +1,110 @@
+package application
+
+import (
+	"context"
+	"fmt"
+
+	auditApp "github.com/happYness-Project/taskManagementGolang/internal/audit/application"
+	"github.com/happYness-Project/taskManagementGolang/internal/authorization"
+	cmd "github.com/happYness-Project/taskManagementGolang/internal/view/application/command"
+	"github.com/happYness-Project/taskManagementGolang/internal/view/repository"
+	"github.com/happYness-Project/taskManagementGolang/pkg/dbs"
+)
+
+// CommandBus routes commands to their handlers
+type CommandBus struct {
+	createViewHandler *cmd.CreateViewCommandHandler
+	updateViewHandler *cmd.UpdateViewCommandHandler
+	deleteViewHandler *cmd.DeleteViewCommandHandler
+
+	viewRepo repository.ViewRepository
+	policy   *authorization.Policy
+	uow      dbs.UnitOfWork
+	recorder *auditApp.Recorder
+}
+
+// NewCommandBus creates a new command bus with all handlers registered
+func NewCommandBus(
+	viewRepo repository.ViewRepository,
+	policy *authorization.Policy,
+	uow dbs.UnitOfWork,
+	recorder *auditApp.Recorder,
+) *CommandBus {
+	return &CommandBus{
+		createViewHandler: cmd.NewCreateViewCommandHandler(viewRepo),
+		updateViewHandler: cmd.NewUpdateViewCommandHandler(viewRepo, uow),
+		deleteViewHandler: cmd.NewDeleteViewCommandHandler(viewRepo, uow),
+		viewRepo:          viewRepo,
+		policy:            policy,
+		uow:               uow,
+		recorder:          recorder,
+	}
+}
+
+// withTx returns a bus whose handlers and activity log run inside the given transaction.
+// The handlers' own units of work become savepoints of it.
+func (bus *CommandBus) withTx(tx dbs.DBTX) *CommandBus {
+	return NewCommandBus(bus.viewRepo.WithTx(tx), bus.policy, dbs.NewUnitOfWork(tx), bus.recorder.WithTx(tx))
+}
+
+// Execute authorizes the command, then dispatches it to the appropriate handler and records it in the activity log
+// in one transaction.
+// ctx carries the request id of the HTTP request that issued the command.
+func (bus *CommandBus) Execute(ctx context.Context, command interface{}) (interface{}, error) {
+	if err := bus.authorize(command); err != nil {
+		return nil, err
+	}
+
+	var result interface{}
+	err := bus.uow.Do(func(tx dbs.DBTX) error {
+		txBus := bus.withTx(tx)
+		var err error
+		result, err = txBus.recorder.Track(ctx, txBus.auditSubject(command), func() (interface{}, error) {
+			return txBus.dispatch(command)
+		})
+		return err
+	})
+	return result, err
+}
+
+// dispatch routes the command to its handler
+func (bus *CommandBus) dispatch(command interface{}) (interface{}, error) {
+	switch c := command.(type) {
+	case cmd.CreateViewCommand:
+		return bus.createViewHandler.Handle(c)
+	case cmd.UpdateViewCommand:
+		return bus.updateViewHandler.Handle(c)
+	case cmd.DeleteViewCommand:
+		return nil, bus.deleteViewHandler.Handle(c)
+	default:
+		return nil, fmt.Errorf("unknown command type: %T", command)
+	}
+}
+
+// authorize checks that users only manage their own views, and only narrow them to groups they belong to.
+// Views narrowed to a container are checked when they are evaluated.
+func (bus *CommandBus) authorize(command interface{}) error {
+	switch c := command.(type) {
+	case cmd.CreateViewCommand:
+		if err := bus.policy.RequireSelf(c.RequesterId, c.OwnerId); err != nil {
+			return err
+		}
+		return bus.requireFilterGroup(c.RequesterId, c.Filter.GroupId)
+	case cmd.UpdateViewCommand:
+		if err := bus.policy.RequireSelf(c.RequesterId, c.OwnerId); err != nil {
+			return err
+		}
+		return bus.requireFilterGroup(c.RequesterId, c.Filter.GroupId)
+	case cmd.DeleteViewCommand:
+		return bus.policy.RequireSelf(c.RequesterId, c.OwnerId)
+	default:
+		return nil
+	}
+}
+
+func (bus *CommandBus) requireFilterGroup(requesterId string, groupId int) error {
+	if groupId <= 0 {
+		return nil
+	}
+	return bus.policy.RequireMember(requesterId, groupId)
+}
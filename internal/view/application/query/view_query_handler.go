@@ -0,0 +1,111 @@
+package query
+
+import (
+	"fmt"
+	"time"
+
+	taskQuery "github.com/happYness-Project/taskManagementGolang/internal/task/application/query"
+	"github.com/happYness-Project/taskManagementGolang/internal/view/domain"
+	"github.com/happYness-Project/taskManagementGolang/internal/view/repository"
+)
+
+type GetViewsQuery struct {
+	OwnerId     string // UUID of the user owning the views
+	RequesterId string // UUID from JWT
+}
+
+type GetViewByIdQuery struct {
+	ViewId      string
+	OwnerId     string // UUID of the user owning the view
+	RequesterId string // UUID from JWT
+}
+
+// EvaluateViewQuery lists a page of the tasks matching a view, with relative dates resolved against today
+type EvaluateViewQuery struct {
+	ViewId      string
+	OwnerId     string // UUID of the user owning the view
+	Cursor      string
+	Limit       int
+	RequesterId string // UUID from JWT
+}
+
+// TaskQueryExecutor runs task queries; the task QueryBus checks that the requester may read the tasks
+type TaskQueryExecutor interface {
+	Execute(query interface{}) (interface{}, error)
+}
+
+// ViewQueryHandler handles all read operations for views
+type ViewQueryHandler struct {
+	viewRepo repository.ViewRepository
+	tasks    TaskQueryExecutor
+}
+
+func NewViewQueryHandler(viewRepo repository.ViewRepository, tasks TaskQueryExecutor) *ViewQueryHandler {
+	return &ViewQueryHandler{viewRepo: viewRepo, tasks: tasks}
+}
+
+// HandleGetViews retrieves the views of a user
+func (h *ViewQueryHandler) HandleGetViews(query GetViewsQuery) ([]domain.SavedView, error) {
+	views, err := h.viewRepo.GetViewsByOwnerId(query.OwnerId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve views: %w", err)
+	}
+	return views, nil
+}
+
+// HandleGetViewById retrieves a single view of a user
+func (h *ViewQueryHandler) HandleGetViewById(query GetViewByIdQuery) (*domain.SavedView, error) {
+	return h.getOwnedView(query.ViewId, query.OwnerId)
+}
+
+// HandleEvaluateView lists the tasks matching the view as the matching task listing would
+func (h *ViewQueryHandler) HandleEvaluateView(query EvaluateViewQuery) (*taskQuery.TaskPage, error) {
+	view, err := h.getOwnedView(query.ViewId, query.OwnerId)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := h.tasks.Execute(taskQueryOf(view.Filter, taskListParams(*view, query, time.Now()), query.RequesterId))
+	if err != nil {
+		return nil, err
+	}
+	return result.(*taskQuery.TaskPage), nil
+}
+
+func (h *ViewQueryHandler) getOwnedView(viewId string, ownerId string) (*domain.SavedView, error) {
+	view, err := h.viewRepo.GetViewById(viewId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve view: %w", err)
+	}
+	if view == nil || view.OwnerId != ownerId {
+		return nil, domain.ErrViewNotFound
+	}
+	return view, nil
+}
+
+// taskListParams turns the view's filter into task listing params as of now
+func taskListParams(view domain.SavedView, query EvaluateViewQuery, now time.Time) taskQuery.TaskListParams {
+	return taskQuery.TaskListParams{
+		Cursor:      query.Cursor,
+		Limit:       query.Limit,
+		Sort:        view.Sort,
+		IsCompleted: view.Filter.IsCompleted,
+		IsImportant: view.Filter.IsImportant,
+		Priority:    view.Filter.Priority,
+		LabelIds:    view.Filter.LabelIds,
+		TargetFrom:  view.Filter.TargetFrom.Resolve(now, false),
+		TargetTo:    view.Filter.TargetTo.Resolve(now, true),
+	}
+}
+
+// taskQueryOf picks the task listing matching the scope of the filter
+func taskQueryOf(filter domain.Filter, list taskQuery.TaskListParams, requesterId string) interface{} {
+	switch {
+	case filter.ContainerId != "":
+		return taskQuery.GetTasksByContainerIdQuery{ContainerId: filter.ContainerId, List: list, RequesterId: requesterId}
+	case filter.GroupId > 0:
+		return taskQuery.GetAllTasksByGroupIdQuery{GroupId: filter.GroupId, List: list, RequesterId: requesterId}
+	default:
+		return taskQuery.GetAllTasksQuery{List: list, RequesterId: requesterId}
+	}
+}
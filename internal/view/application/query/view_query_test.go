@@ -0,0 +1,96 @@
+package query
+
+import (
+	"errors"
+	"testing"
+
+	taskQuery "github.com/happYness-Project/taskManagementGolang/internal/task/application/query"
+	"github.com/happYness-Project/taskManagementGolang/internal/view/domain"
+	"github.com/happYness-Project/taskManagementGolang/internal/view/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type stubViewRepo struct {
+	repository.ViewRepository
+	views map[string]*domain.SavedView
+}
+
+func (s *stubViewRepo) GetViewById(id string) (*domain.SavedView, error) {
+	return s.views[id], nil
+}
+
+// stubTaskExecutor records the task query it was asked to run
+type stubTaskExecutor struct {
+	lastQuery interface{}
+}
+
+func (s *stubTaskExecutor) Execute(query interface{}) (interface{}, error) {
+	s.lastQuery = query
+	return &taskQuery.TaskPage{NextCursor: "next"}, nil
+}
+
+func TestHandleEvaluateView(t *testing.T) {
+	important := true
+	repo := &stubViewRepo{views: map[string]*domain.SavedView{
+		"group-view":     {Id: "group-view", OwnerId: "owner-id", Sort: "-priority", Filter: domain.Filter{GroupId: 3, IsImportant: &important, TargetFrom: "today", TargetTo: "+7 days"}},
+		"container-view": {Id: "container-view", OwnerId: "owner-id", Filter: domain.Filter{ContainerId: "container-id"}},
+		"all-view":       {Id: "all-view", OwnerId: "owner-id", Filter: domain.Filter{LabelIds: []string{"0195a1c2-7d7e-7c1a-9f0e-3c2b1a0d9e8f"}}},
+	}}
+
+	t.Run("when view is narrowed to a group, Then the group task listing runs with the resolved filter", func(t *testing.T) {
+		// Arrange
+		tasks := &stubTaskExecutor{}
+		handler := NewViewQueryHandler(repo, tasks)
+
+		// Act
+		page, err := handler.HandleEvaluateView(EvaluateViewQuery{ViewId: "group-view", OwnerId: "owner-id", Cursor: "cursor", Limit: 10, RequesterId: "owner-id"})
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, "next", page.NextCursor)
+		groupQuery, ok := tasks.lastQuery.(taskQuery.GetAllTasksByGroupIdQuery)
+		require.True(t, ok, "got %T", tasks.lastQuery)
+		assert.Equal(t, 3, groupQuery.GroupId)
+		assert.Equal(t, "owner-id", groupQuery.RequesterId)
+		assert.Equal(t, "-priority", groupQuery.List.Sort)
+		assert.Equal(t, "cursor", groupQuery.List.Cursor)
+		assert.Equal(t, 10, groupQuery.List.Limit)
+		assert.Equal(t, &important, groupQuery.List.IsImportant)
+		require.NotNil(t, groupQuery.List.TargetFrom)
+		require.NotNil(t, groupQuery.List.TargetTo)
+		assert.True(t, groupQuery.List.TargetTo.After(*groupQuery.List.TargetFrom))
+	})
+
+	t.Run("when view is narrowed to a container or not at all, Then the matching task listing runs", func(t *testing.T) {
+		// Arrange
+		tasks := &stubTaskExecutor{}
+		handler := NewViewQueryHandler(repo, tasks)
+
+		// Act
+		_, containerErr := handler.HandleEvaluateView(EvaluateViewQuery{ViewId: "container-view", OwnerId: "owner-id"})
+		containerQuery := tasks.lastQuery
+		_, allErr := handler.HandleEvaluateView(EvaluateViewQuery{ViewId: "all-view", OwnerId: "owner-id"})
+
+		// Assert
+		require.NoError(t, containerErr)
+		require.NoError(t, allErr)
+		assert.IsType(t, taskQuery.GetTasksByContainerIdQuery{}, containerQuery)
+		allQuery, ok := tasks.lastQuery.(taskQuery.GetAllTasksQuery)
+		require.True(t, ok, "got %T", tasks.lastQuery)
+		assert.Equal(t, []string{"0195a1c2-7d7e-7c1a-9f0e-3c2b1a0d9e8f"}, allQuery.List.LabelIds)
+	})
+
+	t.Run("when view belongs to another user, Then ErrViewNotFound is returned", func(t *testing.T) {
+		// Arrange
+		tasks := &stubTaskExecutor{}
+		handler := NewViewQueryHandler(repo, tasks)
+
+		// Act
+		_, err := handler.HandleEvaluateView(EvaluateViewQuery{ViewId: "group-view", OwnerId: "other-id"})
+
+		// Assert
+		assert.True(t, errors.Is(err, domain.ErrViewNotFound), "error = %v", err)
+		assert.Nil(t, tasks.lastQuery)
+	})
+}
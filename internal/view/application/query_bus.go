@@ -0,0 +1,58 @@
+package application
+
+import (
+	"fmt"
+
+	"github.com/happYness-Project/taskManagementGolang/internal/authorization"
+	qry "github.com/happYness-Project/taskManagementGolang/internal/view/application/query"
+	"github.com/happYness-Project/taskManagementGolang/internal/view/repository"
+)
+
+// QueryBus routes queries to their handlers
+type QueryBus struct {
+	queryHandler *qry.ViewQueryHandler
+
+	policy *authorization.Policy
+}
+
+// NewQueryBus creates a new query bus with all handlers registered.
+// tasks evaluates views; it is the task QueryBus.
+func NewQueryBus(viewRepo repository.ViewRepository, tasks qry.TaskQueryExecutor, policy *authorization.Policy) *QueryBus {
+	return &QueryBus{
+		queryHandler: qry.NewViewQueryHandler(viewRepo, tasks),
+		policy:       policy,
+	}
+}
+
+// Execute dispatches the query to the appropriate handler
+func (bus *QueryBus) Execute(query interface{}) (interface{}, error) {
+	if err := bus.authorize(query); err != nil {
+		return nil, err
+	}
+
+	switch q := query.(type) {
+	case qry.GetViewsQuery:
+		return bus.queryHandler.HandleGetViews(q)
+	case qry.GetViewByIdQuery:
+		return bus.queryHandler.HandleGetViewById(q)
+	case qry.EvaluateViewQuery:
+		return bus.queryHandler.HandleEvaluateView(q)
+	default:
+		return nil, fmt.Errorf("unknown query type: %T", query)
+	}
+}
+
+// authorize checks that users only read their own views.
+// Evaluating a view also requires access to its container or group, which the task listing checks.
+func (bus *QueryBus) authorize(query interface{}) error {
+	switch q := query.(type) {
+	case qry.GetViewsQuery:
+		return bus.policy.RequireSelf(q.RequesterId, q.OwnerId)
+	case qry.GetViewByIdQuery:
+		return bus.policy.RequireSelf(q.RequesterId, q.OwnerId)
+	case qry.EvaluateViewQuery:
+		return bus.policy.RequireSelf(q.RequesterId, q.OwnerId)
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,97 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var ErrInvalidDateBound = errors.New(`date must be YYYY-MM-DD, a RFC3339 timestamp, "today", "+3 days", "-1 week", "start_of_week", "end_of_week", "start_of_month" or "end_of_month"`)
+
+var relativeBoundPattern = regexp.MustCompile(`^([+-])\s*(\d{1,4})\s*(day|days|week|weeks)$`)
+
+// DateBound is one end of a target date range. It is either a fixed date or a day relative to the day
+// the view is evaluated, so "due this week" stays this week.
+type DateBound string
+
+// Validate checks that the bound is empty or has one of the supported forms
+func (b DateBound) Validate() error {
+	if b == "" {
+		return nil
+	}
+	if _, ok := b.resolve(time.Now(), false); !ok {
+		return fmt.Errorf("%w, got %q", ErrInvalidDateBound, string(b))
+	}
+	return nil
+}
+
+// Resolve returns the instant the bound stands for on the day of now, nil when it is empty.
+// A day used as a lower bound starts at midnight and one used as an upper bound covers the whole day.
+func (b DateBound) Resolve(now time.Time, upper bool) *time.Time {
+	if b == "" {
+		return nil
+	}
+	t, ok := b.resolve(now, upper)
+	if !ok {
+		return nil
+	}
+	return &t
+}
+
+func (b DateBound) resolve(now time.Time, upper bool) (time.Time, bool) {
+	value := strings.TrimSpace(string(b))
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, true
+	}
+	value = strings.ToLower(value)
+	if t, err := time.Parse(time.DateOnly, value); err == nil {
+		return boundOfDay(t, upper), true
+	}
+
+	today := startOfDay(now)
+	switch value {
+	case "today":
+		return boundOfDay(today, upper), true
+	case "start_of_week":
+		return boundOfDay(today.AddDate(0, 0, -daysSinceMonday(today)), upper), true
+	case "end_of_week":
+		return boundOfDay(today.AddDate(0, 0, 6-daysSinceMonday(today)), upper), true
+	case "start_of_month":
+		return boundOfDay(today.AddDate(0, 0, 1-today.Day()), upper), true
+	case "end_of_month":
+		return boundOfDay(today.AddDate(0, 1, -today.Day()), upper), true
+	}
+
+	match := relativeBoundPattern.FindStringSubmatch(value)
+	if match == nil {
+		return time.Time{}, false
+	}
+	days, _ := strconv.Atoi(match[2])
+	if strings.HasPrefix(match[3], "week") {
+		days *= 7
+	}
+	if match[1] == "-" {
+		days = -days
+	}
+	return boundOfDay(today.AddDate(0, 0, days), upper), true
+}
+
+func startOfDay(t time.Time) time.Time {
+	year, month, day := t.UTC().Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func boundOfDay(t time.Time, upper bool) time.Time {
+	if upper {
+		return t.Add(24*time.Hour - time.Nanosecond)
+	}
+	return t
+}
+
+// daysSinceMonday counts weeks from Monday
+func daysSinceMonday(t time.Time) int {
+	return (int(t.Weekday()) + 6) % 7
+}
@@ -0,0 +1,99 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrInvalidView  = errors.New("invalid view")
+	ErrViewNotFound = errors.New("view not found")
+)
+
+const maxViewNameLength = 100
+
+// Filter selects the tasks of a view. Empty fields are not applied.
+// ContainerId or GroupId narrow the view to one container or group; otherwise it spans every group of its owner.
+type Filter struct {
+	Priority    string    `json:"priority,omitempty"`
+	LabelIds    []string  `json:"label_ids,omitempty"` // tasks carrying every one of the labels
+	IsImportant *bool     `json:"is_important,omitempty"`
+	IsCompleted *bool     `json:"is_completed,omitempty"`
+	TargetFrom  DateBound `json:"target_from,omitempty"`
+	TargetTo    DateBound `json:"target_to,omitempty"`
+	ContainerId string    `json:"container_id,omitempty"`
+	GroupId     int       `json:"group_id,omitempty"`
+}
+
+func (f Filter) validate() error {
+	if err := f.TargetFrom.Validate(); err != nil {
+		return fmt.Errorf("%w: target_from: %v", ErrInvalidView, err)
+	}
+	if err := f.TargetTo.Validate(); err != nil {
+		return fmt.Errorf("%w: target_to: %v", ErrInvalidView, err)
+	}
+	if f.GroupId < 0 {
+		return fmt.Errorf("%w: group_id cannot be negative", ErrInvalidView)
+	}
+	if f.ContainerId != "" && f.GroupId > 0 {
+		return fmt.Errorf("%w: a view is narrowed to a container or a group, not both", ErrInvalidView)
+	}
+	if f.ContainerId != "" {
+		if _, err := uuid.Parse(f.ContainerId); err != nil {
+			return fmt.Errorf("%w: container_id must be a UUID", ErrInvalidView)
+		}
+	}
+	for _, labelId := range f.LabelIds {
+		if _, err := uuid.Parse(labelId); err != nil {
+			return fmt.Errorf("%w: label_ids must be UUIDs, got %q", ErrInvalidView, labelId)
+		}
+	}
+	return nil
+}
+
+// SavedView is a named task filter owned by a user, such as "Important and due this week"
+type SavedView struct {
+	Id        string    `json:"id"`
+	OwnerId   string    `json:"owner_id"` // UUID of the owning user
+	Name      string    `json:"name"`
+	Filter    Filter    `json:"filter"`
+	Sort      string    `json:"sort,omitempty"` // task listing sort, e.g. "-priority"
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+func NewSavedView(ownerId string, name string, filter Filter, sort string) (*SavedView, error) {
+	now := time.Now().UTC()
+	view := &SavedView{
+		Id:        uuid.New().String(),
+		OwnerId:   ownerId,
+		CreatedAt: now,
+	}
+	if err := view.Update(name, filter, sort); err != nil {
+		return nil, err
+	}
+	view.UpdatedAt = now
+	return view, nil
+}
+
+// Update replaces the name, filter and sort of the view
+func (v *SavedView) Update(name string, filter Filter, sort string) error {
+	name = strings.TrimSpace(name)
+	if name == "" || len(name) > maxViewNameLength {
+		return fmt.Errorf("%w: name must be between 1 and %d characters", ErrInvalidView, maxViewNameLength)
+	}
+	if err := filter.validate(); err != nil {
+		return err
+	}
+	filter.Priority = strings.TrimSpace(filter.Priority)
+
+	v.Name = name
+	v.Filter = filter
+	v.Sort = strings.TrimSpace(sort)
+	v.UpdatedAt = time.Now().UTC()
+	return nil
+}
@@ -0,0 +1,83 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestDateBoundResolve(t *testing.T) {
+	// Wednesday
+	now := time.Date(2025, 3, 12, 15, 30, 0, 0, time.UTC)
+	tests := []struct {
+		bound DateBound
+		upper bool
+		want  time.Time
+	}{
+		{bound: "today", want: time.Date(2025, 3, 12, 0, 0, 0, 0, time.UTC)},
+		{bound: "today", upper: true, want: time.Date(2025, 3, 13, 0, 0, 0, 0, time.UTC).Add(-time.Nanosecond)},
+		{bound: "+3 days", want: time.Date(2025, 3, 15, 0, 0, 0, 0, time.UTC)},
+		{bound: "-1 week", want: time.Date(2025, 3, 5, 0, 0, 0, 0, time.UTC)},
+		{bound: "start_of_week", want: time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC)},
+		{bound: "end_of_week", upper: true, want: time.Date(2025, 3, 17, 0, 0, 0, 0, time.UTC).Add(-time.Nanosecond)},
+		{bound: "start_of_month", want: time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)},
+		{bound: "end_of_month", want: time.Date(2025, 3, 31, 0, 0, 0, 0, time.UTC)},
+		{bound: "2025-04-01", want: time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC)},
+		{bound: "2025-04-01T08:00:00Z", upper: true, want: time.Date(2025, 4, 1, 8, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.bound), func(t *testing.T) {
+			got := tt.bound.Resolve(now, tt.upper)
+			if got == nil || !got.Equal(tt.want) {
+				t.Errorf("Resolve(%q, %v) = %v, want %v", tt.bound, tt.upper, got, tt.want)
+			}
+		})
+	}
+
+	t.Run("when bound is empty, Then it resolves to nil", func(t *testing.T) {
+		if got := DateBound("").Resolve(now, false); got != nil {
+			t.Errorf("Resolve() = %v, want nil", got)
+		}
+	})
+
+	t.Run("when bound has an unknown form, Then Validate returns ErrInvalidDateBound", func(t *testing.T) {
+		for _, bound := range []DateBound{"tomorrow", "+1 month", "3 days", "2025-13-01"} {
+			if err := bound.Validate(); !errors.Is(err, ErrInvalidDateBound) {
+				t.Errorf("Validate(%q) error = %v, want ErrInvalidDateBound", bound, err)
+			}
+		}
+	})
+}
+
+func TestNewSavedView(t *testing.T) {
+	t.Run("when name and filter are valid, Then the view is created with a trimmed name", func(t *testing.T) {
+		important := true
+		view, err := NewSavedView("owner-id", "  Important this week ", Filter{IsImportant: &important, TargetTo: "end_of_week"}, "target_date")
+		if err != nil {
+			t.Fatalf("NewSavedView() unexpected error: %v", err)
+		}
+		if view.Id == "" || view.Name != "Important this week" || view.OwnerId != "owner-id" {
+			t.Errorf("unexpected view: %+v", view)
+		}
+	})
+
+	t.Run("when name or filter is invalid, Then ErrInvalidView is returned", func(t *testing.T) {
+		invalid := []struct {
+			name   string
+			filter Filter
+		}{
+			{name: "   "},
+			{name: "Soon", filter: Filter{TargetFrom: "someday"}},
+			{name: "Soon", filter: Filter{GroupId: -1}},
+			{name: "Soon", filter: Filter{ContainerId: "not-a-uuid"}},
+			{name: "Soon", filter: Filter{LabelIds: []string{"grocery"}}},
+			{name: "Soon", filter: Filter{ContainerId: "0195a1c2-7d7e-7c1a-9f0e-3c2b1a0d9e8f", GroupId: 2}},
+		}
+		for _, tt := range invalid {
+			if _, err := NewSavedView("owner-id", tt.name, tt.filter, ""); !errors.Is(err, ErrInvalidView) {
+				t.Errorf("NewSavedView(%q, %+v) error = %v, want ErrInvalidView", tt.name, tt.filter, err)
+			}
+		}
+	})
+}
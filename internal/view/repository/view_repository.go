@@ -0,0 +1,126 @@
+package repository
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	"github.com/happYness-Project/taskManagementGolang/internal/view/domain"
+	"github.com/happYness-Project/taskManagementGolang/pkg/dbs"
+)
+
+type ViewRepository interface {
+	CreateView(view domain.SavedView) error
+	// GetViewById returns nil when the view does not exist
+	GetViewById(id string) (*domain.SavedView, error)
+	GetViewsByOwnerId(ownerId string) ([]domain.SavedView, error)
+	UpdateView(view domain.SavedView) error
+	DeleteView(id string) error
+	WithTx(tx dbs.DBTX) ViewRepository
+}
+
+type ViewRepo struct {
+	DB dbs.DBTX
+}
+
+func NewViewRepository(db dbs.DBTX) *ViewRepo {
+	return &ViewRepo{DB: db}
+}
+
+// WithTx returns a copy of the repository bound to the given transaction
+func (m *ViewRepo) WithTx(tx dbs.DBTX) ViewRepository {
+	return &ViewRepo{DB: tx}
+}
+
+func (m *ViewRepo) CreateView(v domain.SavedView) error {
+	filter, err := json.Marshal(v.Filter)
+	if err != nil {
+		return fmt.Errorf("unable to encode view filter : %w", err)
+	}
+	result, err := m.DB.Exec(sqlCreateView, v.Id, v.OwnerId, v.Name, filter, v.Sort, v.CreatedAt, v.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("unable to insert into saved_view table : %w", err)
+	}
+	if rows, err := result.RowsAffected(); err == nil && rows == 0 {
+		return fmt.Errorf("unable to insert into saved_view table : user %s does not exist", v.OwnerId)
+	}
+	return nil
+}
+
+func (m *ViewRepo) GetViewById(id string) (*domain.SavedView, error) {
+	rows, err := m.DB.Query(sqlGetViewById, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	if !rows.Next() {
+		return nil, rows.Err()
+	}
+	return scanRowsIntoView(rows)
+}
+
+func (m *ViewRepo) GetViewsByOwnerId(ownerId string) ([]domain.SavedView, error) {
+	rows, err := m.DB.Query(sqlGetViewsByOwnerId, ownerId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	views := []domain.SavedView{}
+	for rows.Next() {
+		view, err := scanRowsIntoView(rows)
+		if err != nil {
+			return nil, err
+		}
+		views = append(views, *view)
+	}
+	return views, rows.Err()
+}
+
+func (m *ViewRepo) UpdateView(v domain.SavedView) error {
+	filter, err := json.Marshal(v.Filter)
+	if err != nil {
+		return fmt.Errorf("unable to encode view filter : %w", err)
+	}
+	result, err := m.DB.Exec(sqlUpdateView, v.Id, v.Name, filter, v.Sort, v.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("unable to update view : %w", err)
+	}
+	if rows, err := result.RowsAffected(); err == nil && rows == 0 {
+		return domain.ErrViewNotFound
+	}
+	return nil
+}
+
+func (m *ViewRepo) DeleteView(id string) error {
+	result, err := m.DB.Exec(sqlDeleteView, id)
+	if err != nil {
+		return fmt.Errorf("unable to delete view : %w", err)
+	}
+	if rows, err := result.RowsAffected(); err == nil && rows == 0 {
+		return domain.ErrViewNotFound
+	}
+	return nil
+}
+
+func scanRowsIntoView(rows *sql.Rows) (*domain.SavedView, error) {
+	view := new(domain.SavedView)
+	var filter []byte
+	err := rows.Scan(
+		&view.Id,
+		&view.OwnerId,
+		&view.Name,
+		&filter,
+		&view.Sort,
+		&view.CreatedAt,
+		&view.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(filter, &view.Filter); err != nil {
+		return nil, fmt.Errorf("unable to decode view filter : %w", err)
+	}
+	return view, nil
+}
@@ -0,0 +1,17 @@
+package repository
+
+const (
+	sqlViewColumns = `v.id, u.user_id, v.name, v.filter, v.sort, v.created_at, v.updated_at`
+
+	// The owner is stored by the user's internal id; nothing is inserted when the user does not exist
+	sqlCreateView = `INSERT INTO container.saved_view(id, user_id, name, filter, sort, created_at, updated_at)
+						SELECT $1, u.id, $3, $4, $5, $6, $7 FROM container.user u WHERE u.user_id = $2`
+	sqlGetViewById = `SELECT ` + sqlViewColumns + ` FROM container.saved_view v
+						INNER JOIN container.user u ON u.id = v.user_id
+						WHERE v.id = $1`
+	sqlGetViewsByOwnerId = `SELECT ` + sqlViewColumns + ` FROM container.saved_view v
+						INNER JOIN container.user u ON u.id = v.user_id
+						WHERE u.user_id = $1 ORDER BY v.name, v.created_at`
+	sqlUpdateView = `UPDATE container.saved_view SET name = $2, filter = $3, sort = $4, updated_at = $5 WHERE id = $1`
+	sqlDeleteView = `DELETE FROM container.saved_view WHERE id = $1`
+)
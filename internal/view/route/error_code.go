@@ -0,0 +1,10 @@
+package route
+
+const prefix = "views_"
+
+const (
+	ViewInvalidInput          = prefix + "invalid_input"
+	ViewNotFound              = prefix + "not_found"
+	ViewServerError           = prefix + "server_error"
+	ViewTasksInvalidParameter = prefix + "tasks_invalid_parameter"
+)
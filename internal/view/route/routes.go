@@ -0,0 +1,215 @@
+package route
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	auditApp "github.com/happYness-Project/taskManagementGolang/internal/audit/application"
+	"github.com/happYness-Project/taskManagementGolang/internal/authorization"
+	taskApp "github.com/happYness-Project/taskManagementGolang/internal/task/application"
+	taskQuery "github.com/happYness-Project/taskManagementGolang/internal/task/application/query"
+	taskRepo "github.com/happYness-Project/taskManagementGolang/internal/task/repository"
+	containerRepo "github.com/happYness-Project/taskManagementGolang/internal/taskcontainer/repository"
+	"github.com/happYness-Project/taskManagementGolang/internal/view/application"
+	"github.com/happYness-Project/taskManagementGolang/internal/view/application/command"
+	"github.com/happYness-Project/taskManagementGolang/internal/view/application/query"
+	"github.com/happYness-Project/taskManagementGolang/internal/view/domain"
+	"github.com/happYness-Project/taskManagementGolang/internal/view/repository"
+	"github.com/happYness-Project/taskManagementGolang/pkg/constants"
+	"github.com/happYness-Project/taskManagementGolang/pkg/dbs"
+	"github.com/happYness-Project/taskManagementGolang/pkg/loggers"
+	"github.com/happYness-Project/taskManagementGolang/pkg/response"
+)
+
+const NextCursorHeader = "X-Next-Cursor"
+
+type Handler struct {
+	logger     *loggers.AppLogger
+	commandBus *application.CommandBus
+	queryBus   *application.QueryBus
+}
+
+func NewHandler(logger *loggers.AppLogger, viewRepo repository.ViewRepository, taskRepo taskRepo.TaskRepository, containerRepo containerRepo.ContainerRepository, policy *authorization.Policy, uow dbs.UnitOfWork, recorder *auditApp.Recorder) *Handler {
+	return &Handler{
+		logger:     logger,
+		commandBus: application.NewCommandBus(viewRepo, policy, uow, recorder),
+		queryBus:   application.NewQueryBus(viewRepo, taskApp.NewQueryBus(taskRepo, containerRepo, policy), policy),
+	}
+}
+
+func (h *Handler) RegisterRoutes(router chi.Router) {
+	router.Route("/api/users/{userID}/views", func(r chi.Router) {
+		r.Get("/", h.handleGetViews)
+		r.Post("/", h.handleCreateView)
+		r.Get("/{viewID}", h.handleGetViewById)
+		r.Put("/{viewID}", h.handleUpdateView)
+		r.Delete("/{viewID}", h.handleDeleteView)
+		r.Get("/{viewID}/tasks", h.handleGetViewTasks)
+	})
+}
+
+func (h *Handler) handleGetViews(w http.ResponseWriter, r *http.Request) {
+	// Use Query Bus
+	result, err := h.queryBus.Execute(query.GetViewsQuery{OwnerId: chi.URLParam(r, "userID"), RequesterId: authorization.RequesterId(r)})
+	if h.viewError(w, err) {
+		return
+	}
+	if err != nil {
+		h.logger.Error().Err(err).Str("ErrorCode", ViewServerError).Msg("Error occurred during GetViews")
+		response.InternalServerError(w, "Error occurred during getting views")
+		return
+	}
+	response.WriteJsonWithEncode(w, http.StatusOK, result)
+}
+
+func (h *Handler) handleGetViewById(w http.ResponseWriter, r *http.Request) {
+	// Use Query Bus
+	result, err := h.queryBus.Execute(query.GetViewByIdQuery{
+		ViewId:      chi.URLParam(r, "viewID"),
+		OwnerId:     chi.URLParam(r, "userID"),
+		RequesterId: authorization.RequesterId(r),
+	})
+	if h.viewError(w, err) {
+		return
+	}
+	if err != nil {
+		h.logger.Error().Err(err).Str("ErrorCode", ViewServerError).Msg("Error occurred during GetViewById")
+		response.InternalServerError(w, "Error occurred during getting view")
+		return
+	}
+	response.WriteJsonWithEncode(w, http.StatusOK, result)
+}
+
+func (h *Handler) handleCreateView(w http.ResponseWriter, r *http.Request) {
+	var viewDto ViewDto
+	if err := response.ParseJson(r, &viewDto); err != nil {
+		h.logger.Error().Err(err).Str("ErrorCode", constants.RequestBodyError).Msg("Error occurred during parsing json of ViewDto")
+		response.InvalidJsonBody(w, "Error occurred during parsing json of ViewDto")
+		return
+	}
+
+	// Use Command Bus
+	result, err := h.commandBus.Execute(r.Context(), command.CreateViewCommand{
+		OwnerId:     chi.URLParam(r, "userID"),
+		Name:        viewDto.Name,
+		Filter:      viewDto.Filter,
+		Sort:        viewDto.Sort,
+		RequesterId: authorization.RequesterId(r),
+	})
+	if h.viewError(w, err) {
+		return
+	}
+	if err != nil {
+		h.logger.Error().Err(err).Str("ErrorCode", ViewServerError).Msg(err.Error())
+		response.InternalServerError(w, "Error occurred during creating view")
+		return
+	}
+	response.WriteJsonWithEncode(w, http.StatusCreated, result)
+}
+
+func (h *Handler) handleUpdateView(w http.ResponseWriter, r *http.Request) {
+	var viewDto ViewDto
+	if err := response.ParseJson(r, &viewDto); err != nil {
+		h.logger.Error().Err(err).Str("ErrorCode", constants.RequestBodyError).Msg("Error occurred during parsing json of ViewDto")
+		response.InvalidJsonBody(w, "Error occurred during parsing json of ViewDto")
+		return
+	}
+
+	// Use Command Bus
+	result, err := h.commandBus.Execute(r.Context(), command.UpdateViewCommand{
+		ViewId:      chi.URLParam(r, "viewID"),
+		OwnerId:     chi.URLParam(r, "userID"),
+		Name:        viewDto.Name,
+		Filter:      viewDto.Filter,
+		Sort:        viewDto.Sort,
+		RequesterId: authorization.RequesterId(r),
+	})
+	if h.viewError(w, err) {
+		return
+	}
+	if err != nil {
+		h.logger.Error().Err(err).Str("ErrorCode", ViewServerError).Msg(err.Error())
+		response.InternalServerError(w, "Error occurred during updating view")
+		return
+	}
+	response.WriteJsonWithEncode(w, http.StatusOK, result)
+}
+
+func (h *Handler) handleDeleteView(w http.ResponseWriter, r *http.Request) {
+	// Use Command Bus
+	_, err := h.commandBus.Execute(r.Context(), command.DeleteViewCommand{
+		ViewId:      chi.URLParam(r, "viewID"),
+		OwnerId:     chi.URLParam(r, "userID"),
+		RequesterId: authorization.RequesterId(r),
+	})
+	if h.viewError(w, err) {
+		return
+	}
+	if err != nil {
+		h.logger.Error().Err(err).Str("ErrorCode", ViewServerError).Msg(err.Error())
+		response.InternalServerError(w, "Error occurred during deleting view")
+		return
+	}
+	response.WriteJsonWithEncode(w, http.StatusNoContent, "view is removed.")
+}
+
+func (h *Handler) handleGetViewTasks(w http.ResponseWriter, r *http.Request) {
+	evaluateQuery := query.EvaluateViewQuery{
+		ViewId:      chi.URLParam(r, "viewID"),
+		OwnerId:     chi.URLParam(r, "userID"),
+		Cursor:      r.URL.Query().Get("cursor"),
+		RequesterId: authorization.RequesterId(r),
+	}
+	if v := r.URL.Query().Get("limit"); v != "" {
+		limit, err := strconv.Atoi(v)
+		if err != nil || limit < 1 {
+			err = fmt.Errorf("limit must be a positive integer")
+			h.logger.Error().Err(err).Str("ErrorCode", ViewTasksInvalidParameter).Msg(err.Error())
+			response.ErrorResponse(w, http.StatusBadRequest, *(response.New(ViewTasksInvalidParameter, "Invalid parameter", err.Error())))
+			return
+		}
+		evaluateQuery.Limit = limit
+	}
+
+	// Use Query Bus
+	result, err := h.queryBus.Execute(evaluateQuery)
+	if h.viewError(w, err) {
+		return
+	}
+	if errors.Is(err, taskQuery.ErrInvalidListParams) {
+		h.logger.Error().Err(err).Str("ErrorCode", ViewTasksInvalidParameter).Msg(err.Error())
+		response.ErrorResponse(w, http.StatusBadRequest, *(response.New(ViewTasksInvalidParameter, "Invalid parameter", err.Error())))
+		return
+	}
+	if err != nil {
+		h.logger.Error().Err(err).Str("ErrorCode", ViewServerError).Msg(err.Error())
+		response.InternalServerError(w, "Error occurred during evaluating view")
+		return
+	}
+	page := result.(*taskQuery.TaskPage)
+	if page.NextCursor != "" {
+		w.Header().Set(NextCursorHeader, page.NextCursor)
+	}
+	response.WriteJsonWithEncode(w, http.StatusOK, page.Tasks)
+}
+
+// viewError answers 403, 404 or 400 for the errors every view endpoint shares
+func (h *Handler) viewError(w http.ResponseWriter, err error) bool {
+	switch {
+	case authorization.IsForbiddenError(err):
+		h.logger.Error().Err(err).Str("ErrorCode", constants.PermissionDenied).Msg(err.Error())
+		response.Forbidden(w, constants.PermissionDenied, err.Error())
+	case errors.Is(err, domain.ErrViewNotFound):
+		h.logger.Error().Err(err).Str("ErrorCode", ViewNotFound).Msg(err.Error())
+		response.NotFound(w, ViewNotFound, err.Error())
+	case errors.Is(err, domain.ErrInvalidView):
+		h.logger.Error().Err(err).Str("ErrorCode", ViewInvalidInput).Msg(err.Error())
+		response.ErrorResponse(w, http.StatusBadRequest, *(response.New(ViewInvalidInput, "Invalid view", err.Error())))
+	default:
+		return false
+	}
+	return true
+}
@@ -0,0 +1,9 @@
+package route
+
+import "github.com/happYness-Project/taskManagementGolang/internal/view/domain"
+
+type ViewDto struct {
+	Name   string        `json:"name"`
+	Filter domain.Filter `json:"filter"`
+	Sort   string        `json:"sort"`
+}
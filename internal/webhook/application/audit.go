@@ -0,0 +1,43 @@
+package application
+
+import (
+	auditApp "github.com/happYness-Project/taskManagementGolang/internal/audit/application"
+	auditDomain "github.com/happYness-Project/taskManagementGolang/internal/audit/domain"
+	cmd "github.com/happYness-Project/taskManagementGolang/internal/webhook/application/command"
+)
+
+// auditSubject describes the webhook a command mutates for the activity log.
+// Snapshots never contain the signing secret.
+func (bus *CommandBus) auditSubject(command interface{}) auditApp.Subject {
+	subject := auditApp.Subject{
+		Action:        auditApp.ActionOf(command),
+		AggregateType: auditDomain.AggregateWebhook,
+		Load:          bus.loadWebhook,
+	}
+
+	switch c := command.(type) {
+	case cmd.CreateWebhookCommand:
+		subject.ActorId, subject.GroupId = c.RequesterId, c.GroupId
+		subject.Resolve = func(result interface{}) (string, int) {
+			if created, ok := result.(*cmd.CreatedWebhook); ok {
+				return created.Id, c.GroupId
+			}
+			return "", c.GroupId
+		}
+	case cmd.UpdateWebhookCommand:
+		subject.ActorId, subject.GroupId, subject.AggregateId = c.RequesterId, c.GroupId, c.WebhookId
+	case cmd.DeleteWebhookCommand:
+		subject.ActorId, subject.GroupId, subject.AggregateId = c.RequesterId, c.GroupId, c.WebhookId
+	case cmd.RedeliverWebhookCommand:
+		subject.ActorId, subject.GroupId, subject.AggregateId = c.RequesterId, c.GroupId, c.WebhookId
+	}
+	return subject
+}
+
+func (bus *CommandBus) loadWebhook(webhookId string) (interface{}, error) {
+	webhook, err := bus.webhookRepo.GetById(webhookId)
+	if err != nil || webhook == nil {
+		return nil, err
+	}
+	return webhook, nil
+}
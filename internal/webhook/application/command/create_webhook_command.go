@@ -0,0 +1,43 @@
+package command
+
+import (
+	"fmt"
+
+	"github.com/happYness-Project/taskManagementGolang/internal/webhook/domain"
+	"github.com/happYness-Project/taskManagementGolang/internal/webhook/repository"
+)
+
+// CreateWebhookCommand registers an endpoint for the group's events
+type CreateWebhookCommand struct {
+	GroupId     int
+	Url         string
+	EventTypes  []string
+	RequesterId string // UUID from JWT
+}
+
+// CreatedWebhook is the result of CreateWebhookCommand, the only time the signing secret is revealed
+type CreatedWebhook struct {
+	domain.Webhook
+	Secret string `json:"secret"`
+}
+
+type CreateWebhookCommandHandler struct {
+	webhookRepo repository.WebhookRepository
+}
+
+func NewCreateWebhookCommandHandler(webhookRepo repository.WebhookRepository) *CreateWebhookCommandHandler {
+	return &CreateWebhookCommandHandler{webhookRepo: webhookRepo}
+}
+
+func (h *CreateWebhookCommandHandler) Handle(cmd CreateWebhookCommand) (*CreatedWebhook, error) {
+	webhook, err := domain.NewWebhook(cmd.GroupId, cmd.Url, cmd.EventTypes, cmd.RequesterId)
+	if err != nil {
+		return nil, err
+	}
+
+	err = h.webhookRepo.CreateWebhook(*webhook)
+	if err != nil {
+		return nil, fmt.Errorf("failed to persist webhook: %w", err)
+	}
+	return &CreatedWebhook{Webhook: *webhook, Secret: webhook.Secret}, nil
+}
@@ -0,0 +1,40 @@
+package command
+
+import (
+	"fmt"
+
+	"github.com/happYness-Project/taskManagementGolang/internal/webhook/domain"
+	"github.com/happYness-Project/taskManagementGolang/internal/webhook/repository"
+	"github.com/happYness-Project/taskManagementGolang/pkg/dbs"
+)
+
+// DeleteWebhookCommand removes a webhook together with its deliveries
+type DeleteWebhookCommand struct {
+	GroupId     int
+	WebhookId   string
+	RequesterId string // UUID from JWT
+}
+
+type DeleteWebhookCommandHandler struct {
+	webhookRepo repository.WebhookRepository
+	uow         dbs.UnitOfWork
+}
+
+func NewDeleteWebhookCommandHandler(webhookRepo repository.WebhookRepository, uow dbs.UnitOfWork) *DeleteWebhookCommandHandler {
+	return &DeleteWebhookCommandHandler{webhookRepo: webhookRepo, uow: uow}
+}
+
+func (h *DeleteWebhookCommandHandler) Handle(cmd DeleteWebhookCommand) error {
+	return h.uow.Do(func(tx dbs.DBTX) error {
+		webhookRepo := h.webhookRepo.WithTx(tx)
+
+		webhook, err := webhookRepo.GetById(cmd.WebhookId)
+		if err != nil {
+			return fmt.Errorf("failed to get webhook: %w", err)
+		}
+		if webhook == nil || !webhook.BelongsTo(cmd.GroupId) {
+			return domain.ErrWebhookNotFound
+		}
+		return webhookRepo.DeleteWebhook(cmd.WebhookId)
+	})
+}
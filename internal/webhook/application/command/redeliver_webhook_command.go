@@ -0,0 +1,57 @@
+package command
+
+import (
+	"fmt"
+
+	"github.com/happYness-Project/taskManagementGolang/internal/webhook/domain"
+	"github.com/happYness-Project/taskManagementGolang/internal/webhook/repository"
+	"github.com/happYness-Project/taskManagementGolang/pkg/dbs"
+)
+
+// RedeliverWebhookCommand queues a past delivery to be sent again
+type RedeliverWebhookCommand struct {
+	GroupId     int
+	WebhookId   string
+	DeliveryId  string
+	RequesterId string // UUID from JWT
+}
+
+type RedeliverWebhookCommandHandler struct {
+	webhookRepo  repository.WebhookRepository
+	deliveryRepo repository.DeliveryRepository
+	uow          dbs.UnitOfWork
+}
+
+func NewRedeliverWebhookCommandHandler(webhookRepo repository.WebhookRepository, deliveryRepo repository.DeliveryRepository, uow dbs.UnitOfWork) *RedeliverWebhookCommandHandler {
+	return &RedeliverWebhookCommandHandler{webhookRepo: webhookRepo, deliveryRepo: deliveryRepo, uow: uow}
+}
+
+func (h *RedeliverWebhookCommandHandler) Handle(cmd RedeliverWebhookCommand) (*domain.Delivery, error) {
+	var delivery *domain.Delivery
+	err := h.uow.Do(func(tx dbs.DBTX) error {
+		deliveryRepo := h.deliveryRepo.WithTx(tx)
+
+		webhook, err := h.webhookRepo.WithTx(tx).GetById(cmd.WebhookId)
+		if err != nil {
+			return fmt.Errorf("failed to get webhook: %w", err)
+		}
+		if webhook == nil || !webhook.BelongsTo(cmd.GroupId) {
+			return domain.ErrWebhookNotFound
+		}
+
+		delivery, err = deliveryRepo.GetById(cmd.DeliveryId)
+		if err != nil {
+			return fmt.Errorf("failed to get webhook delivery: %w", err)
+		}
+		if delivery == nil || delivery.WebhookId != webhook.Id {
+			return domain.ErrDeliveryNotFound
+		}
+
+		delivery.Redeliver()
+		return deliveryRepo.UpdateState(*delivery)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return delivery, nil
+}
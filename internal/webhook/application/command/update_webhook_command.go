@@ -0,0 +1,53 @@
+package command
+
+import (
+	"fmt"
+
+	"github.com/happYness-Project/taskManagementGolang/internal/webhook/domain"
+	"github.com/happYness-Project/taskManagementGolang/internal/webhook/repository"
+	"github.com/happYness-Project/taskManagementGolang/pkg/dbs"
+)
+
+// UpdateWebhookCommand replaces the endpoint, event types and active flag of a webhook
+type UpdateWebhookCommand struct {
+	GroupId     int
+	WebhookId   string
+	Url         string
+	EventTypes  []string
+	IsActive    bool
+	RequesterId string // UUID from JWT
+}
+
+type UpdateWebhookCommandHandler struct {
+	webhookRepo repository.WebhookRepository
+	uow         dbs.UnitOfWork
+}
+
+func NewUpdateWebhookCommandHandler(webhookRepo repository.WebhookRepository, uow dbs.UnitOfWork) *UpdateWebhookCommandHandler {
+	return &UpdateWebhookCommandHandler{webhookRepo: webhookRepo, uow: uow}
+}
+
+func (h *UpdateWebhookCommandHandler) Handle(cmd UpdateWebhookCommand) (*domain.Webhook, error) {
+	var webhook *domain.Webhook
+	err := h.uow.Do(func(tx dbs.DBTX) error {
+		webhookRepo := h.webhookRepo.WithTx(tx)
+
+		var err error
+		webhook, err = webhookRepo.GetById(cmd.WebhookId)
+		if err != nil {
+			return fmt.Errorf("failed to get webhook: %w", err)
+		}
+		if webhook == nil || !webhook.BelongsTo(cmd.GroupId) {
+			return domain.ErrWebhookNotFound
+		}
+
+		if err := webhook.Update(cmd.Url, cmd.EventTypes, cmd.IsActive); err != nil {
+			return err
+		}
+		return webhookRepo.UpdateWebhook(*webhook)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return webhook, nil
+}
@@ -0,0 +1,105 @@
+package application
+
+import (
+	"context"
+	"fmt"
+
+	auditApp "github.com/happYness-Project/taskManagementGolang/internal/audit/application"
+	"github.com/happYness-Project/taskManagementGolang/internal/authorization"
+	cmd "github.com/happYness-Project/taskManagementGolang/internal/webhook/application/command"
+	"github.com/happYness-Project/taskManagementGolang/internal/webhook/repository"
+	"github.com/happYness-Project/taskManagementGolang/pkg/dbs"
+)
+
+// CommandBus routes commands to their handlers
+type CommandBus struct {
+	createWebhookHandler    *cmd.CreateWebhookCommandHandler
+	updateWebhookHandler    *cmd.UpdateWebhookCommandHandler
+	deleteWebhookHandler    *cmd.DeleteWebhookCommandHandler
+	redeliverWebhookHandler *cmd.RedeliverWebhookCommandHandler
+
+	webhookRepo  repository.WebhookRepository
+	deliveryRepo repository.DeliveryRepository
+	policy       *authorization.Policy
+	uow          dbs.UnitOfWork
+	recorder     *auditApp.Recorder
+}
+
+// NewCommandBus creates a new command bus with all handlers registered
+func NewCommandBus(
+	webhookRepo repository.WebhookRepository,
+	deliveryRepo repository.DeliveryRepository,
+	policy *authorization.Policy,
+	uow dbs.UnitOfWork,
+	recorder *auditApp.Recorder,
+) *CommandBus {
+	return &CommandBus{
+		createWebhookHandler:    cmd.NewCreateWebhookCommandHandler(webhookRepo),
+		updateWebhookHandler:    cmd.NewUpdateWebhookCommandHandler(webhookRepo, uow),
+		deleteWebhookHandler:    cmd.NewDeleteWebhookCommandHandler(webhookRepo, uow),
+		redeliverWebhookHandler: cmd.NewRedeliverWebhookCommandHandler(webhookRepo, deliveryRepo, uow),
+		webhookRepo:             webhookRepo,
+		deliveryRepo:            deliveryRepo,
+		policy:                  policy,
+		uow:                     uow,
+		recorder:                recorder,
+	}
+}
+
+// withTx returns a bus whose handlers and activity log run inside the given transaction.
+// The handlers' own units of work become savepoints of it.
+func (bus *CommandBus) withTx(tx dbs.DBTX) *CommandBus {
+	return NewCommandBus(bus.webhookRepo.WithTx(tx), bus.deliveryRepo.WithTx(tx), bus.policy, dbs.NewUnitOfWork(tx), bus.recorder.WithTx(tx))
+}
+
+// Execute authorizes the command, then dispatches it to the appropriate handler and records it in the activity log
+// in one transaction.
+// ctx carries the request id of the HTTP request that issued the command.
+func (bus *CommandBus) Execute(ctx context.Context, command interface{}) (interface{}, error) {
+	if err := bus.authorize(command); err != nil {
+		return nil, err
+	}
+
+	var result interface{}
+	err := bus.uow.Do(func(tx dbs.DBTX) error {
+		txBus := bus.withTx(tx)
+		var err error
+		result, err = txBus.recorder.Track(ctx, txBus.auditSubject(command), func() (interface{}, error) {
+			return txBus.dispatch(command)
+		})
+		return err
+	})
+	return result, err
+}
+
+// dispatch routes the command to its handler
+func (bus *CommandBus) dispatch(command interface{}) (interface{}, error) {
+	switch c := command.(type) {
+	case cmd.CreateWebhookCommand:
+		return bus.createWebhookHandler.Handle(c)
+	case cmd.UpdateWebhookCommand:
+		return bus.updateWebhookHandler.Handle(c)
+	case cmd.DeleteWebhookCommand:
+		return nil, bus.deleteWebhookHandler.Handle(c)
+	case cmd.RedeliverWebhookCommand:
+		return bus.redeliverWebhookHandler.Handle(c)
+	default:
+		return nil, fmt.Errorf("unknown command type: %T", command)
+	}
+}
+
+// authorize checks that the requester administers the group; webhooks expose all of its events
+func (bus *CommandBus) authorize(command interface{}) error {
+	switch c := command.(type) {
+	case cmd.CreateWebhookCommand:
+		return bus.policy.RequireAdmin(c.RequesterId, c.GroupId)
+	case cmd.UpdateWebhookCommand:
+		return bus.policy.RequireAdmin(c.RequesterId, c.GroupId)
+	case cmd.DeleteWebhookCommand:
+		return bus.policy.RequireAdmin(c.RequesterId, c.GroupId)
+	case cmd.RedeliverWebhookCommand:
+		return bus.policy.RequireAdmin(c.RequesterId, c.GroupId)
+	default:
+		return nil
+	}
+}
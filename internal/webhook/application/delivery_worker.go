@@ -0,0 +1,165 @@
+package application
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/happYness-Project/taskManagementGolang/internal/webhook/domain"
+	"github.com/happYness-Project/taskManagementGolang/internal/webhook/repository"
+	"github.com/happYness-Project/taskManagementGolang/pkg/loggers"
+	"github.com/happYness-Project/taskManagementGolang/pkg/utils"
+)
+
+// Headers sent with every delivery
+const (
+	SignatureHeader = "X-Webhook-Signature-256"
+	EventHeader     = "X-Webhook-Event"
+	DeliveryHeader  = "X-Webhook-Delivery"
+)
+
+const (
+	DefaultPollInterval = 5 * time.Second
+	DefaultBatchSize    = 20
+	// MaxDeliveryAttempts is how often a delivery is tried before it is marked failed
+	MaxDeliveryAttempts = 8
+
+	requestTimeout = 10 * time.Second
+	// claimLease is how long a claimed batch is kept from other workers while it is sent.
+	// It outlasts a batch of timed out requests.
+	claimLease      = 5 * time.Minute
+	baseRetryDelay  = 30 * time.Second
+	maxRetryDelay   = 6 * time.Hour
+	maxErrorExcerpt = 512
+)
+
+// DeliveryWorker posts queued deliveries to their webhooks.
+// Any 2xx response counts as accepted; everything else, redirects included, is retried with exponential backoff.
+type DeliveryWorker struct {
+	webhookRepo  repository.WebhookRepository
+	deliveryRepo repository.DeliveryRepository
+	logger       *loggers.AppLogger
+
+	Client       *http.Client
+	PollInterval time.Duration
+	BatchSize    int
+	now          func() time.Time
+}
+
+func NewDeliveryWorker(webhookRepo repository.WebhookRepository, deliveryRepo repository.DeliveryRepository, logger *loggers.AppLogger) *DeliveryWorker {
+	return &DeliveryWorker{
+		webhookRepo:  webhookRepo,
+		deliveryRepo: deliveryRepo,
+		logger:       logger,
+		Client:       &http.Client{Timeout: requestTimeout, CheckRedirect: noRedirects},
+		PollInterval: DefaultPollInterval,
+		BatchSize:    DefaultBatchSize,
+		now:          func() time.Time { return time.Now().UTC() },
+	}
+}
+
+// noRedirects keeps the signed payload on the registered https url: a redirect is answered as a failed attempt
+// instead of being followed, possibly to a plain http url
+func noRedirects(req *http.Request, via []*http.Request) error {
+	return http.ErrUseLastResponse
+}
+
+// Run sends due deliveries every PollInterval until ctx is cancelled
+func (w *DeliveryWorker) Run(ctx context.Context) {
+	ticker := time.NewTicker(w.PollInterval)
+	defer ticker.Stop()
+	for {
+		if _, err := w.DeliverDue(ctx); err != nil {
+			w.logger.Error().Err(err).Msg("failed to deliver webhooks")
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// DeliverDue sends one batch of due deliveries and returns how many the endpoints accepted.
+// The batch is claimed with a lease and sent outside any transaction, so slow endpoints hold no row locks;
+// each attempt is saved on its own, and deliveries left unsettled are claimed again once the lease ends.
+func (w *DeliveryWorker) DeliverDue(ctx context.Context) (int, error) {
+	now := w.now()
+	deliveries, err := w.deliveryRepo.ClaimDue(now, now.Add(claimLease), w.BatchSize)
+	if err != nil {
+		return 0, fmt.Errorf("failed to read webhook deliveries: %w", err)
+	}
+
+	accepted := 0
+	for _, delivery := range deliveries {
+		if ctx.Err() != nil {
+			break
+		}
+		webhook, err := w.webhookRepo.GetById(delivery.WebhookId)
+		if err != nil {
+			return accepted, fmt.Errorf("failed to get webhook: %w", err)
+		}
+
+		var attempt domain.DeliveryAttempt
+		if webhook == nil || !webhook.IsActive {
+			attempt = domain.DeliveryAttempt{AttemptedAt: w.now(), Error: "webhook is inactive"}
+			delivery.RecordAttempt(attempt, nil)
+		} else {
+			attempt = w.send(ctx, webhook, delivery)
+			delivery.RecordAttempt(attempt, w.nextAttemptAt(delivery))
+		}
+		if err := w.deliveryRepo.SaveAttempt(delivery, attempt); err != nil {
+			return accepted, err
+		}
+		if attempt.Succeeded() {
+			accepted++
+		}
+	}
+	return accepted, nil
+}
+
+// send posts the signed payload and reports the outcome
+func (w *DeliveryWorker) send(ctx context.Context, webhook *domain.Webhook, delivery domain.Delivery) domain.DeliveryAttempt {
+	attempt := domain.DeliveryAttempt{AttemptedAt: w.now()}
+	started := time.Now()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.Url, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		attempt.Error = err.Error()
+		return attempt
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(SignatureHeader, webhook.Sign(delivery.Payload))
+	req.Header.Set(EventHeader, delivery.EventType)
+	req.Header.Set(DeliveryHeader, delivery.Id)
+
+	resp, err := w.Client.Do(req)
+	if err != nil {
+		attempt.Error = err.Error()
+		attempt.DurationMs = time.Since(started).Milliseconds()
+		return attempt
+	}
+	defer resp.Body.Close()
+
+	attempt.StatusCode = resp.StatusCode
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		excerpt, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorExcerpt))
+		attempt.Error = fmt.Sprintf("endpoint responded %d: %s", resp.StatusCode, excerpt)
+	}
+	attempt.DurationMs = time.Since(started).Milliseconds()
+	return attempt
+}
+
+// nextAttemptAt schedules the retry after a failed attempt, or returns nil once attempts are used up.
+// delivery.Attempts does not yet include the attempt being recorded.
+func (w *DeliveryWorker) nextAttemptAt(delivery domain.Delivery) *time.Time {
+	attempts := delivery.Attempts + 1
+	if attempts >= MaxDeliveryAttempts {
+		return nil
+	}
+	next := w.now().Add(utils.ExponentialBackoff(attempts, baseRetryDelay, maxRetryDelay))
+	return &next
+}
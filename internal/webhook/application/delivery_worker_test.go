@@ -0,0 +1,173 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	outboxDomain "github.com/happYness-Project/taskManagementGolang/internal/outbox/domain"
+	"github.com/happYness-Project/taskManagementGolang/internal/webhook/domain"
+	"github.com/happYness-Project/taskManagementGolang/internal/webhook/repository"
+	"github.com/happYness-Project/taskManagementGolang/pkg/configs"
+	"github.com/happYness-Project/taskManagementGolang/pkg/loggers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type stubWebhookRepo struct {
+	repository.WebhookRepository
+	webhooks map[string]*domain.Webhook
+}
+
+func (s *stubWebhookRepo) GetById(id string) (*domain.Webhook, error) { return s.webhooks[id], nil }
+
+// stubDeliveryRepo hands out the due deliveries once and records the lease and the saved attempts
+type stubDeliveryRepo struct {
+	repository.DeliveryRepository
+	due        []domain.Delivery
+	leaseUntil time.Time
+	saved      []domain.Delivery
+	// failSaveOf is the id of a delivery whose attempt cannot be saved
+	failSaveOf string
+}
+
+func (s *stubDeliveryRepo) ClaimDue(now time.Time, leaseUntil time.Time, limit int) ([]domain.Delivery, error) {
+	s.leaseUntil = leaseUntil
+	due := s.due
+	s.due = nil
+	return due, nil
+}
+
+func (s *stubDeliveryRepo) SaveAttempt(delivery domain.Delivery, attempt domain.DeliveryAttempt) error {
+	if delivery.Id == s.failSaveOf {
+		return errors.New("connection reset")
+	}
+	s.saved = append(s.saved, delivery)
+	return nil
+}
+
+func TestDeliveryWorker_DeliverDue(t *testing.T) {
+	logger := loggers.Setup(configs.Env{})
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	newFixture := func(t *testing.T, handler http.HandlerFunc, attempts int) (*DeliveryWorker, *domain.Webhook, *stubDeliveryRepo) {
+		t.Helper()
+		server := httptest.NewTLSServer(handler)
+		t.Cleanup(server.Close)
+
+		webhook, err := domain.NewWebhook(7, server.URL, []string{"task.created"}, "admin")
+		require.NoError(t, err)
+		delivery, err := domain.NewDelivery(webhook.Id, outboxDomain.Message{EventId: "e1", EventType: "task.created", GroupId: 7})
+		require.NoError(t, err)
+		delivery.Attempts = attempts
+
+		deliveryRepo := &stubDeliveryRepo{due: []domain.Delivery{*delivery}}
+		worker := NewDeliveryWorker(&stubWebhookRepo{webhooks: map[string]*domain.Webhook{webhook.Id: webhook}}, deliveryRepo, logger)
+		worker.Client.Transport = server.Client().Transport
+		worker.now = func() time.Time { return now }
+		return worker, webhook, deliveryRepo
+	}
+
+	t.Run("when endpoint accepts, Then payload is signed and delivery succeeds", func(t *testing.T) {
+		// Arrange
+		var signature, event string
+		var body []byte
+		worker, webhook, repo := newFixture(t, func(w http.ResponseWriter, r *http.Request) {
+			signature, event = r.Header.Get(SignatureHeader), r.Header.Get(EventHeader)
+			body, _ = io.ReadAll(r.Body)
+			w.WriteHeader(http.StatusNoContent)
+		}, 0)
+
+		// Act
+		accepted, err := worker.DeliverDue(context.Background())
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, 1, accepted)
+		assert.Equal(t, "task.created", event)
+		assert.Equal(t, webhook.Sign(body), signature)
+		assert.Equal(t, now.Add(claimLease), repo.leaseUntil)
+		require.Len(t, repo.saved, 1)
+		assert.Equal(t, domain.DeliverySucceeded, repo.saved[0].Status)
+		assert.Equal(t, http.StatusNoContent, repo.saved[0].History[0].StatusCode)
+	})
+
+	t.Run("when endpoint fails, Then delivery is retried with backoff", func(t *testing.T) {
+		worker, _, repo := newFixture(t, func(w http.ResponseWriter, r *http.Request) {
+			http.Error(w, "down for maintenance", http.StatusServiceUnavailable)
+		}, 1)
+
+		accepted, err := worker.DeliverDue(context.Background())
+
+		require.NoError(t, err)
+		assert.Equal(t, 0, accepted)
+		require.Len(t, repo.saved, 1)
+		assert.Equal(t, domain.DeliveryPending, repo.saved[0].Status)
+		require.NotNil(t, repo.saved[0].NextAttemptAt)
+		assert.Equal(t, now.Add(2*baseRetryDelay), *repo.saved[0].NextAttemptAt)
+		assert.Contains(t, repo.saved[0].History[0].Error, "down for maintenance")
+	})
+
+	t.Run("when attempts are used up, Then delivery fails", func(t *testing.T) {
+		worker, _, repo := newFixture(t, func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+		}, MaxDeliveryAttempts-1)
+
+		_, err := worker.DeliverDue(context.Background())
+
+		require.NoError(t, err)
+		require.Len(t, repo.saved, 1)
+		assert.Equal(t, domain.DeliveryFailed, repo.saved[0].Status)
+		assert.Nil(t, repo.saved[0].NextAttemptAt)
+	})
+
+	t.Run("when an attempt cannot be saved, Then the attempts saved before it are kept", func(t *testing.T) {
+		// Arrange
+		posts := 0
+		worker, webhook, repo := newFixture(t, func(w http.ResponseWriter, r *http.Request) {
+			posts++
+			w.WriteHeader(http.StatusOK)
+		}, 0)
+		second, err := domain.NewDelivery(webhook.Id, outboxDomain.Message{EventId: "e2", EventType: "task.created", GroupId: 7})
+		require.NoError(t, err)
+		repo.due = append(repo.due, *second)
+		repo.failSaveOf = second.Id
+
+		// Act
+		accepted, err := worker.DeliverDue(context.Background())
+
+		// Assert
+		require.Error(t, err)
+		assert.Equal(t, 1, accepted)
+		assert.Equal(t, 2, posts)
+		require.Len(t, repo.saved, 1)
+		assert.Equal(t, domain.DeliverySucceeded, repo.saved[0].Status)
+	})
+
+	t.Run("when endpoint redirects to plain http, Then the payload is not sent there", func(t *testing.T) {
+		// Arrange
+		leaked := false
+		plain := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			leaked = true
+		}))
+		defer plain.Close()
+		worker, _, repo := newFixture(t, func(w http.ResponseWriter, r *http.Request) {
+			http.Redirect(w, r, plain.URL, http.StatusTemporaryRedirect)
+		}, 0)
+
+		// Act
+		accepted, err := worker.DeliverDue(context.Background())
+
+		// Assert
+		require.NoError(t, err)
+		assert.Zero(t, accepted)
+		assert.False(t, leaked)
+		require.Len(t, repo.saved, 1)
+		assert.Equal(t, domain.DeliveryPending, repo.saved[0].Status)
+		assert.Equal(t, http.StatusTemporaryRedirect, repo.saved[0].History[0].StatusCode)
+	})
+}
@@ -0,0 +1,111 @@
+package query
+
+import (
+	"fmt"
+
+	"github.com/happYness-Project/taskManagementGolang/internal/webhook/domain"
+	"github.com/happYness-Project/taskManagementGolang/internal/webhook/repository"
+)
+
+const (
+	DefaultDeliveryPageLimit = 50
+	MaxDeliveryPageLimit     = 200
+)
+
+// GetGroupWebhooksQuery lists the webhooks registered for a group
+type GetGroupWebhooksQuery struct {
+	GroupId     int
+	RequesterId string // UUID from JWT
+}
+
+// GetWebhookQuery reads one webhook of a group
+type GetWebhookQuery struct {
+	GroupId     int
+	WebhookId   string
+	RequesterId string // UUID from JWT
+}
+
+// GetWebhookDeliveriesQuery lists a webhook's most recent deliveries, newest first
+type GetWebhookDeliveriesQuery struct {
+	GroupId     int
+	WebhookId   string
+	Limit       int
+	RequesterId string // UUID from JWT
+}
+
+// GetWebhookDeliveryQuery reads one delivery with its attempt history
+type GetWebhookDeliveryQuery struct {
+	GroupId     int
+	WebhookId   string
+	DeliveryId  string
+	RequesterId string // UUID from JWT
+}
+
+// WebhookQueryHandler handles all read operations for webhooks
+type WebhookQueryHandler struct {
+	webhookRepo  repository.WebhookRepository
+	deliveryRepo repository.DeliveryRepository
+}
+
+func NewWebhookQueryHandler(webhookRepo repository.WebhookRepository, deliveryRepo repository.DeliveryRepository) *WebhookQueryHandler {
+	return &WebhookQueryHandler{webhookRepo: webhookRepo, deliveryRepo: deliveryRepo}
+}
+
+func (h *WebhookQueryHandler) HandleGetGroupWebhooks(query GetGroupWebhooksQuery) ([]domain.Webhook, error) {
+	webhooks, err := h.webhookRepo.GetByGroupId(query.GroupId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve webhooks: %w", err)
+	}
+	return webhooks, nil
+}
+
+func (h *WebhookQueryHandler) HandleGetWebhook(query GetWebhookQuery) (*domain.Webhook, error) {
+	return h.groupWebhook(query.GroupId, query.WebhookId)
+}
+
+func (h *WebhookQueryHandler) HandleGetWebhookDeliveries(query GetWebhookDeliveriesQuery) ([]domain.Delivery, error) {
+	if query.Limit < 0 || query.Limit > MaxDeliveryPageLimit {
+		return nil, fmt.Errorf("%w: limit must be between 1 and %d", domain.ErrInvalidWebhook, MaxDeliveryPageLimit)
+	}
+	limit := DefaultDeliveryPageLimit
+	if query.Limit > 0 {
+		limit = query.Limit
+	}
+
+	webhook, err := h.groupWebhook(query.GroupId, query.WebhookId)
+	if err != nil {
+		return nil, err
+	}
+	deliveries, err := h.deliveryRepo.GetByWebhookId(webhook.Id, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve webhook deliveries: %w", err)
+	}
+	return deliveries, nil
+}
+
+func (h *WebhookQueryHandler) HandleGetWebhookDelivery(query GetWebhookDeliveryQuery) (*domain.Delivery, error) {
+	webhook, err := h.groupWebhook(query.GroupId, query.WebhookId)
+	if err != nil {
+		return nil, err
+	}
+	delivery, err := h.deliveryRepo.GetById(query.DeliveryId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve webhook delivery: %w", err)
+	}
+	if delivery == nil || delivery.WebhookId != webhook.Id {
+		return nil, domain.ErrDeliveryNotFound
+	}
+	return delivery, nil
+}
+
+// groupWebhook hides webhooks of other groups as if they did not exist
+func (h *WebhookQueryHandler) groupWebhook(groupId int, webhookId string) (*domain.Webhook, error) {
+	webhook, err := h.webhookRepo.GetById(webhookId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve webhook: %w", err)
+	}
+	if webhook == nil || !webhook.BelongsTo(groupId) {
+		return nil, domain.ErrWebhookNotFound
+	}
+	return webhook, nil
+}
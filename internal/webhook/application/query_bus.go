@@ -0,0 +1,60 @@
+package application
+
+import (
+	"fmt"
+
+	"github.com/happYness-Project/taskManagementGolang/internal/authorization"
+	qry "github.com/happYness-Project/taskManagementGolang/internal/webhook/application/query"
+	"github.com/happYness-Project/taskManagementGolang/internal/webhook/repository"
+)
+
+// QueryBus routes queries to their handlers
+type QueryBus struct {
+	queryHandler *qry.WebhookQueryHandler
+
+	policy *authorization.Policy
+}
+
+// NewQueryBus creates a new query bus with all handlers registered
+func NewQueryBus(webhookRepo repository.WebhookRepository, deliveryRepo repository.DeliveryRepository, policy *authorization.Policy) *QueryBus {
+	return &QueryBus{
+		queryHandler: qry.NewWebhookQueryHandler(webhookRepo, deliveryRepo),
+		policy:       policy,
+	}
+}
+
+// Execute dispatches the query to the appropriate handler
+func (bus *QueryBus) Execute(query interface{}) (interface{}, error) {
+	if err := bus.authorize(query); err != nil {
+		return nil, err
+	}
+
+	switch q := query.(type) {
+	case qry.GetGroupWebhooksQuery:
+		return bus.queryHandler.HandleGetGroupWebhooks(q)
+	case qry.GetWebhookQuery:
+		return bus.queryHandler.HandleGetWebhook(q)
+	case qry.GetWebhookDeliveriesQuery:
+		return bus.queryHandler.HandleGetWebhookDeliveries(q)
+	case qry.GetWebhookDeliveryQuery:
+		return bus.queryHandler.HandleGetWebhookDelivery(q)
+	default:
+		return nil, fmt.Errorf("unknown query type: %T", query)
+	}
+}
+
+// authorize checks that the requester administers the group owning the webhooks
+func (bus *QueryBus) authorize(query interface{}) error {
+	switch q := query.(type) {
+	case qry.GetGroupWebhooksQuery:
+		return bus.policy.RequireAdmin(q.RequesterId, q.GroupId)
+	case qry.GetWebhookQuery:
+		return bus.policy.RequireAdmin(q.RequesterId, q.GroupId)
+	case qry.GetWebhookDeliveriesQuery:
+		return bus.policy.RequireAdmin(q.RequesterId, q.GroupId)
+	case qry.GetWebhookDeliveryQuery:
+		return bus.policy.RequireAdmin(q.RequesterId, q.GroupId)
+	default:
+		return nil
+	}
+}
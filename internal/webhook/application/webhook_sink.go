@@ -0,0 +1,47 @@
+package application
+
+import (
+	"context"
+	"fmt"
+
+	outboxDomain "github.com/happYness-Project/taskManagementGolang/internal/outbox/domain"
+	"github.com/happYness-Project/taskManagementGolang/internal/webhook/domain"
+	"github.com/happYness-Project/taskManagementGolang/internal/webhook/repository"
+)
+
+// WebhookSink is the outbox sink that queues a delivery for every webhook of the event's group
+// subscribed to its type. The DeliveryWorker sends them.
+type WebhookSink struct {
+	webhookRepo  repository.WebhookRepository
+	deliveryRepo repository.DeliveryRepository
+}
+
+func NewWebhookSink(webhookRepo repository.WebhookRepository, deliveryRepo repository.DeliveryRepository) *WebhookSink {
+	return &WebhookSink{webhookRepo: webhookRepo, deliveryRepo: deliveryRepo}
+}
+
+func (s *WebhookSink) Name() string { return "webhook" }
+
+func (s *WebhookSink) Deliver(ctx context.Context, msg outboxDomain.Message) error {
+	if msg.GroupId == 0 {
+		return nil
+	}
+	webhooks, err := s.webhookRepo.GetByGroupId(msg.GroupId)
+	if err != nil {
+		return fmt.Errorf("failed to retrieve webhooks: %w", err)
+	}
+
+	for _, webhook := range webhooks {
+		if !webhook.Subscribes(msg.EventType) {
+			continue
+		}
+		delivery, err := domain.NewDelivery(webhook.Id, msg)
+		if err != nil {
+			return err
+		}
+		if err := s.deliveryRepo.Enqueue(*delivery); err != nil {
+			return fmt.Errorf("failed to queue delivery to webhook %s: %w", webhook.Id, err)
+		}
+	}
+	return nil
+}
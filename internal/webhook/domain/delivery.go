@@ -0,0 +1,100 @@
+package domain
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	outboxDomain "github.com/happYness-Project/taskManagementGolang/internal/outbox/domain"
+)
+
+// Delivery statuses
+const (
+	DeliveryPending   = "pending"
+	DeliverySucceeded = "succeeded"
+	DeliveryFailed    = "failed"
+)
+
+var ErrDeliveryNotFound = errors.New("webhook delivery not found")
+
+// Delivery is one event sent to one webhook, retried until the endpoint accepts it or attempts run out.
+// Payload is the exact body posted to the endpoint.
+type Delivery struct {
+	Id            string            `json:"id"`
+	WebhookId     string            `json:"webhook_id"`
+	EventId       string            `json:"event_id"`
+	EventType     string            `json:"event_type"`
+	Payload       json.RawMessage   `json:"payload"`
+	Status        string            `json:"status"`
+	Attempts      int               `json:"attempts"`
+	NextAttemptAt *time.Time        `json:"next_attempt_at,omitempty"`
+	CreatedAt     time.Time         `json:"created_at"`
+	UpdatedAt     time.Time         `json:"updated_at"`
+	History       []DeliveryAttempt `json:"history,omitempty"`
+}
+
+// DeliveryAttempt records the outcome of one request to the endpoint.
+// StatusCode is 0 when no response was received.
+type DeliveryAttempt struct {
+	Id          int64     `json:"id"`
+	AttemptedAt time.Time `json:"attempted_at"`
+	StatusCode  int       `json:"status_code,omitempty"`
+	Error       string    `json:"error,omitempty"`
+	DurationMs  int64     `json:"duration_ms"`
+}
+
+// NewDelivery prepares an outbox message for delivery to a webhook
+func NewDelivery(webhookId string, msg outboxDomain.Message) (*Delivery, error) {
+	payload, err := json.Marshal(msg)
+	if err != nil {
+		return nil, fmt.Errorf("unable to serialize %s event: %w", msg.EventType, err)
+	}
+	now := time.Now().UTC()
+	return &Delivery{
+		Id:            uuid.New().String(),
+		WebhookId:     webhookId,
+		EventId:       msg.EventId,
+		EventType:     msg.EventType,
+		Payload:       payload,
+		Status:        DeliveryPending,
+		NextAttemptAt: &now,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}, nil
+}
+
+// Succeeded reports whether the attempt was accepted by the endpoint
+func (a DeliveryAttempt) Succeeded() bool {
+	return a.Error == "" && a.StatusCode >= 200 && a.StatusCode < 300
+}
+
+// RecordAttempt applies the outcome of an attempt. A failed attempt is retried at nextAttemptAt;
+// without one the delivery has failed for good.
+func (d *Delivery) RecordAttempt(attempt DeliveryAttempt, nextAttemptAt *time.Time) {
+	d.Attempts++
+	d.UpdatedAt = attempt.AttemptedAt
+	d.History = append(d.History, attempt)
+	switch {
+	case attempt.Succeeded():
+		d.Status = DeliverySucceeded
+		d.NextAttemptAt = nil
+	case nextAttemptAt != nil:
+		d.Status = DeliveryPending
+		d.NextAttemptAt = nextAttemptAt
+	default:
+		d.Status = DeliveryFailed
+		d.NextAttemptAt = nil
+	}
+}
+
+// Redeliver queues the delivery to be sent again right away, whatever its outcome so far.
+// Attempt history is kept; the retry budget starts over.
+func (d *Delivery) Redeliver() {
+	now := time.Now().UTC()
+	d.Status = DeliveryPending
+	d.Attempts = 0
+	d.NextAttemptAt = &now
+	d.UpdatedAt = now
+}
@@ -0,0 +1,157 @@
+package domain
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	notificationDomain "github.com/happYness-Project/taskManagementGolang/internal/notification/domain"
+	taskDomain "github.com/happYness-Project/taskManagementGolang/internal/task/domain"
+	containerDomain "github.com/happYness-Project/taskManagementGolang/internal/taskcontainer/domain"
+	groupDomain "github.com/happYness-Project/taskManagementGolang/internal/usergroup/domain"
+)
+
+const maxUrlLength = 2048
+
+var (
+	ErrInvalidWebhook  = errors.New("invalid webhook")
+	ErrWebhookNotFound = errors.New("webhook not found")
+)
+
+// SupportedEventTypes lists the domain events a webhook can subscribe to
+var SupportedEventTypes = []string{
+	taskDomain.EventTaskCreated,
+	taskDomain.EventTaskUpdated,
+	taskDomain.EventTaskCompleted,
+	taskDomain.EventTaskReopened,
+	taskDomain.EventTaskDeleted,
+	taskDomain.EventTaskRestored,
+	taskDomain.EventTaskMoved,
+	containerDomain.EventContainerCreated,
+	containerDomain.EventContainerDeleted,
+	containerDomain.EventContainerRestored,
+	groupDomain.EventMemberAdded,
+	groupDomain.EventRoleChanged,
+	notificationDomain.EventTaskDueSoon,
+	notificationDomain.EventTaskOverdue,
+}
+
+// Webhook is an HTTPS endpoint registered by a group admin to receive the group's events.
+// The secret signs every payload and is only shown when the webhook is created.
+type Webhook struct {
+	Id         string    `json:"id"`
+	GroupId    int       `json:"group_id"`
+	Url        string    `json:"url"`
+	EventTypes []string  `json:"event_types"`
+	Secret     string    `json:"-"`
+	IsActive   bool      `json:"is_active"`
+	CreatedBy  string    `json:"created_by"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+func NewWebhook(groupId int, endpoint string, eventTypes []string, createdBy string) (*Webhook, error) {
+	secret, err := newSecret()
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now().UTC()
+	webhook := &Webhook{
+		Id:        uuid.New().String(),
+		GroupId:   groupId,
+		Secret:    secret,
+		IsActive:  true,
+		CreatedBy: createdBy,
+		CreatedAt: now,
+	}
+	if err := webhook.Update(endpoint, eventTypes, true); err != nil {
+		return nil, err
+	}
+	return webhook, nil
+}
+
+// Update changes the endpoint, the subscribed event types and whether deliveries are made
+func (w *Webhook) Update(endpoint string, eventTypes []string, isActive bool) error {
+	endpoint = strings.TrimSpace(endpoint)
+	if err := validateUrl(endpoint); err != nil {
+		return err
+	}
+	types, err := normalizeEventTypes(eventTypes)
+	if err != nil {
+		return err
+	}
+	w.Url = endpoint
+	w.EventTypes = types
+	w.IsActive = isActive
+	w.UpdatedAt = time.Now().UTC()
+	return nil
+}
+
+// Subscribes reports whether an event of the given type should be delivered to this webhook
+func (w *Webhook) Subscribes(eventType string) bool {
+	return w.IsActive && slices.Contains(w.EventTypes, eventType)
+}
+
+// Sign returns the HMAC-SHA256 signature of a payload, formatted as "sha256=<hex>"
+func (w *Webhook) Sign(payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(w.Secret))
+	mac.Write(payload)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func validateUrl(endpoint string) error {
+	if endpoint == "" || len(endpoint) > maxUrlLength {
+		return fmt.Errorf("%w: url is required and cannot exceed %d characters", ErrInvalidWebhook, maxUrlLength)
+	}
+	parsed, err := url.Parse(endpoint)
+	if err != nil || parsed.Host == "" {
+		return fmt.Errorf("%w: '%s' is not an absolute url", ErrInvalidWebhook, endpoint)
+	}
+	if parsed.Scheme != "https" {
+		return fmt.Errorf("%w: url must use https", ErrInvalidWebhook)
+	}
+	if parsed.User != nil {
+		return fmt.Errorf("%w: url cannot contain credentials", ErrInvalidWebhook)
+	}
+	return nil
+}
+
+// normalizeEventTypes trims, deduplicates and sorts the event types, rejecting unsupported ones
+func normalizeEventTypes(eventTypes []string) ([]string, error) {
+	types := []string{}
+	for _, t := range eventTypes {
+		t = strings.TrimSpace(t)
+		if !slices.Contains(SupportedEventTypes, t) {
+			return nil, fmt.Errorf("%w: unsupported event type '%s'", ErrInvalidWebhook, t)
+		}
+		if !slices.Contains(types, t) {
+			types = append(types, t)
+		}
+	}
+	if len(types) == 0 {
+		return nil, fmt.Errorf("%w: at least one event type is required", ErrInvalidWebhook)
+	}
+	slices.Sort(types)
+	return types, nil
+}
+
+func newSecret() (string, error) {
+	raw := make([]byte, 32)
+	if _, err := rand.Read(raw); err != nil {
+		return "", fmt.Errorf("unable to generate webhook secret: %w", err)
+	}
+	return hex.EncodeToString(raw), nil
+}
+
+// BelongsTo reports whether the webhook was registered for the group
+func (w *Webhook) BelongsTo(groupId int) bool {
+	return w.GroupId == groupId
+}
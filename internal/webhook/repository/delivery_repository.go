@@ -0,0 +1,172 @@
+package repository
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/happYness-Project/taskManagementGolang/internal/webhook/domain"
+	"github.com/happYness-Project/taskManagementGolang/pkg/dbs"
+)
+
+type DeliveryRepository interface {
+	// Enqueue stores a delivery unless the event was already queued for the webhook
+	Enqueue(delivery domain.Delivery) error
+	// GetById returns the delivery with its attempt history, or nil when it does not exist
+	GetById(id string) (*domain.Delivery, error)
+	GetByWebhookId(webhookId string, limit int) ([]domain.Delivery, error)
+	// ClaimDue claims up to limit deliveries due at now until leaseUntil, so no other worker sends them meanwhile.
+	// A delivery whose worker stopped before saving its attempt is claimed again once the lease ends.
+	ClaimDue(now time.Time, leaseUntil time.Time, limit int) ([]domain.Delivery, error)
+	// SaveAttempt stores the delivery's new state together with the attempt that led to it
+	SaveAttempt(delivery domain.Delivery, attempt domain.DeliveryAttempt) error
+	UpdateState(delivery domain.Delivery) error
+	WithTx(tx dbs.DBTX) DeliveryRepository
+}
+type DeliveryRepo struct {
+	DB dbs.DBTX
+}
+
+func NewDeliveryRepository(db dbs.DBTX) *DeliveryRepo {
+	return &DeliveryRepo{DB: db}
+}
+
+// WithTx returns a copy of the repository bound to the given transaction
+func (m *DeliveryRepo) WithTx(tx dbs.DBTX) DeliveryRepository {
+	return &DeliveryRepo{DB: tx}
+}
+
+func (m *DeliveryRepo) Enqueue(d domain.Delivery) error {
+	_, err := m.DB.Exec(sqlEnqueueDelivery, d.Id, d.WebhookId, d.EventId, d.EventType, string(d.Payload),
+		d.Status, d.Attempts, d.NextAttemptAt, d.CreatedAt, d.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("unable to insert into webhook_delivery table : %w", err)
+	}
+	return nil
+}
+
+func (m *DeliveryRepo) GetById(id string) (*domain.Delivery, error) {
+	rows, err := m.DB.Query(sqlGetDeliveryById, id)
+	if err != nil {
+		return nil, err
+	}
+	if !rows.Next() {
+		rows.Close()
+		return nil, rows.Err()
+	}
+	delivery, err := scanRowsIntoDelivery(rows)
+	rows.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	delivery.History, err = m.getAttempts(id)
+	if err != nil {
+		return nil, err
+	}
+	return delivery, nil
+}
+
+func (m *DeliveryRepo) GetByWebhookId(webhookId string, limit int) ([]domain.Delivery, error) {
+	return m.queryDeliveries(sqlGetDeliveriesByWebhook, webhookId, limit)
+}
+
+func (m *DeliveryRepo) ClaimDue(now time.Time, leaseUntil time.Time, limit int) ([]domain.Delivery, error) {
+	return m.queryDeliveries(sqlClaimDueDeliveries, now, leaseUntil, limit)
+}
+
+func (m *DeliveryRepo) SaveAttempt(d domain.Delivery, a domain.DeliveryAttempt) error {
+	return dbs.RunInTx(m.DB, func(tx dbs.DBTX) error {
+		var statusCode interface{}
+		if a.StatusCode > 0 {
+			statusCode = a.StatusCode
+		}
+		var attemptError interface{}
+		if a.Error != "" {
+			attemptError = a.Error
+		}
+		var id int64
+		err := tx.QueryRow(sqlCreateDeliveryAttempt, d.Id, a.AttemptedAt, statusCode, attemptError, a.DurationMs).Scan(&id)
+		if err != nil {
+			return fmt.Errorf("unable to insert into webhook_delivery_attempt table : %w", err)
+		}
+		return (&DeliveryRepo{DB: tx}).UpdateState(d)
+	})
+}
+
+func (m *DeliveryRepo) UpdateState(d domain.Delivery) error {
+	result, err := m.DB.Exec(sqlUpdateDeliveryState, d.Id, d.Status, d.Attempts, d.NextAttemptAt, d.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("unable to update webhook delivery : %w", err)
+	}
+	if rows, _ := result.RowsAffected(); rows == 0 {
+		return domain.ErrDeliveryNotFound
+	}
+	return nil
+}
+
+func (m *DeliveryRepo) queryDeliveries(query string, args ...interface{}) ([]domain.Delivery, error) {
+	rows, err := m.DB.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	deliveries := []domain.Delivery{}
+	for rows.Next() {
+		delivery, err := scanRowsIntoDelivery(rows)
+		if err != nil {
+			return nil, err
+		}
+		deliveries = append(deliveries, *delivery)
+	}
+	return deliveries, rows.Err()
+}
+
+func (m *DeliveryRepo) getAttempts(deliveryId string) ([]domain.DeliveryAttempt, error) {
+	rows, err := m.DB.Query(sqlGetDeliveryAttempts, deliveryId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	attempts := []domain.DeliveryAttempt{}
+	for rows.Next() {
+		var attempt domain.DeliveryAttempt
+		var statusCode sql.NullInt64
+		var attemptError sql.NullString
+		if err := rows.Scan(&attempt.Id, &attempt.AttemptedAt, &statusCode, &attemptError, &attempt.DurationMs); err != nil {
+			return nil, err
+		}
+		attempt.StatusCode = int(statusCode.Int64)
+		attempt.Error = attemptError.String
+		attempts = append(attempts, attempt)
+	}
+	return attempts, rows.Err()
+}
+
+func scanRowsIntoDelivery(rows *sql.Rows) (*domain.Delivery, error) {
+	delivery := new(domain.Delivery)
+	var payload []byte
+	var nextAttemptAt sql.NullTime
+	err := rows.Scan(
+		&delivery.Id,
+		&delivery.WebhookId,
+		&delivery.EventId,
+		&delivery.EventType,
+		&payload,
+		&delivery.Status,
+		&delivery.Attempts,
+		&nextAttemptAt,
+		&delivery.CreatedAt,
+		&delivery.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	delivery.Payload = payload
+	if nextAttemptAt.Valid {
+		delivery.NextAttemptAt = &nextAttemptAt.Time
+	}
+	return delivery, nil
+}
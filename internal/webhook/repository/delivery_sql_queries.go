@@ -0,0 +1,26 @@
+package repository
+
+const (
+	sqlDeliveryColumns = `id, webhook_id, event_id, event_type, payload, status, attempts, next_attempt_at, created_at, updated_at`
+
+	// An event is queued once per webhook, however often the outbox hands it out
+	sqlEnqueueDelivery = `INSERT INTO container.webhook_delivery(` + sqlDeliveryColumns + `)
+		VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10)
+		ON CONFLICT (webhook_id, event_id) DO NOTHING`
+	sqlGetDeliveryById        = `SELECT ` + sqlDeliveryColumns + ` FROM container.webhook_delivery WHERE id = $1`
+	sqlGetDeliveriesByWebhook = `SELECT ` + sqlDeliveryColumns + ` FROM container.webhook_delivery WHERE webhook_id = $1 ORDER BY created_at DESC, id LIMIT $2`
+	sqlUpdateDeliveryState    = `UPDATE container.webhook_delivery SET status = $2, attempts = $3, next_attempt_at = $4, updated_at = $5 WHERE id = $1`
+	// Claimed deliveries are leased until $2 by moving their next attempt; SKIP LOCKED lets several workers
+	// claim side by side without sending a delivery twice
+	sqlClaimDueDeliveries = `UPDATE container.webhook_delivery d SET next_attempt_at = $2
+								FROM (SELECT id FROM container.webhook_delivery
+									WHERE next_attempt_at <= $1
+									ORDER BY next_attempt_at LIMIT $3
+									FOR UPDATE SKIP LOCKED) due
+								WHERE d.id = due.id
+								RETURNING d.id, d.webhook_id, d.event_id, d.event_type, d.payload, d.status, d.attempts, d.next_attempt_at, d.created_at, d.updated_at`
+
+	sqlGetDeliveryAttempts   = `SELECT id, attempted_at, status_code, error, duration_ms FROM container.webhook_delivery_attempt WHERE delivery_id = $1 ORDER BY id`
+	sqlCreateDeliveryAttempt = `INSERT INTO container.webhook_delivery_attempt(delivery_id, attempted_at, status_code, error, duration_ms)
+		VALUES ($1,$2,$3,$4,$5) RETURNING id`
+)
@@ -0,0 +1,115 @@
+package repository
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/happYness-Project/taskManagementGolang/internal/webhook/domain"
+	"github.com/happYness-Project/taskManagementGolang/pkg/dbs"
+)
+
+type WebhookRepository interface {
+	CreateWebhook(webhook domain.Webhook) error
+	// GetById returns nil when the webhook does not exist
+	GetById(id string) (*domain.Webhook, error)
+	GetByGroupId(groupId int) ([]domain.Webhook, error)
+	UpdateWebhook(webhook domain.Webhook) error
+	DeleteWebhook(id string) error
+	WithTx(tx dbs.DBTX) WebhookRepository
+}
+type WebhookRepo struct {
+	DB dbs.DBTX
+}
+
+func NewWebhookRepository(db dbs.DBTX) *WebhookRepo {
+	return &WebhookRepo{DB: db}
+}
+
+// WithTx returns a copy of the repository bound to the given transaction
+func (m *WebhookRepo) WithTx(tx dbs.DBTX) WebhookRepository {
+	return &WebhookRepo{DB: tx}
+}
+
+func (m *WebhookRepo) CreateWebhook(w domain.Webhook) error {
+	_, err := m.DB.Exec(sqlCreateWebhook, w.Id, w.GroupId, w.Url, strings.Join(w.EventTypes, ","), w.Secret,
+		w.IsActive, w.CreatedBy, w.CreatedAt, w.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("unable to insert into webhook table : %w", err)
+	}
+	return nil
+}
+
+func (m *WebhookRepo) GetById(id string) (*domain.Webhook, error) {
+	rows, err := m.DB.Query(sqlGetWebhookById, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	if !rows.Next() {
+		return nil, rows.Err()
+	}
+	return scanRowsIntoWebhook(rows)
+}
+
+func (m *WebhookRepo) GetByGroupId(groupId int) ([]domain.Webhook, error) {
+	rows, err := m.DB.Query(sqlGetWebhooksByGroupId, groupId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	webhooks := []domain.Webhook{}
+	for rows.Next() {
+		webhook, err := scanRowsIntoWebhook(rows)
+		if err != nil {
+			return nil, err
+		}
+		webhooks = append(webhooks, *webhook)
+	}
+	return webhooks, rows.Err()
+}
+
+func (m *WebhookRepo) UpdateWebhook(w domain.Webhook) error {
+	result, err := m.DB.Exec(sqlUpdateWebhook, w.Id, w.Url, strings.Join(w.EventTypes, ","), w.IsActive, w.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("unable to update webhook : %w", err)
+	}
+	if rows, _ := result.RowsAffected(); rows == 0 {
+		return domain.ErrWebhookNotFound
+	}
+	return nil
+}
+
+func (m *WebhookRepo) DeleteWebhook(id string) error {
+	result, err := m.DB.Exec(sqlDeleteWebhook, id)
+	if err != nil {
+		return fmt.Errorf("unable to delete webhook : %w", err)
+	}
+	if rows, _ := result.RowsAffected(); rows == 0 {
+		return domain.ErrWebhookNotFound
+	}
+	return nil
+}
+
+func scanRowsIntoWebhook(rows *sql.Rows) (*domain.Webhook, error) {
+	webhook := new(domain.Webhook)
+	var eventTypes string
+	err := rows.Scan(
+		&webhook.Id,
+		&webhook.GroupId,
+		&webhook.Url,
+		&eventTypes,
+		&webhook.Secret,
+		&webhook.IsActive,
+		&webhook.CreatedBy,
+		&webhook.CreatedAt,
+		&webhook.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	webhook.EventTypes = strings.Split(eventTypes, ",")
+	return webhook, nil
+}
@@ -0,0 +1,12 @@
+package repository
+
+const (
+	sqlWebhookColumns = `id, usergroup_id, url, event_types, secret, is_active, created_by, created_at, updated_at`
+
+	sqlCreateWebhook = `INSERT INTO container.webhook(` + sqlWebhookColumns + `)
+		VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9)`
+	sqlGetWebhookById       = `SELECT ` + sqlWebhookColumns + ` FROM container.webhook WHERE id = $1`
+	sqlGetWebhooksByGroupId = `SELECT ` + sqlWebhookColumns + ` FROM container.webhook WHERE usergroup_id = $1 ORDER BY created_at`
+	sqlUpdateWebhook        = `UPDATE container.webhook SET url = $2, event_types = $3, is_active = $4, updated_at = $5 WHERE id = $1`
+	sqlDeleteWebhook        = `DELETE FROM container.webhook WHERE id = $1`
+)
@@ -0,0 +1,10 @@
+package route
+
+const prefix = "webhooks_"
+
+const (
+	WebhookInvalidInput     = prefix + "invalid_input"
+	WebhookNotFound         = prefix + "not_found"
+	WebhookDeliveryNotFound = prefix + "delivery_not_found"
+	WebhookServerError      = prefix + "server_error"
+)
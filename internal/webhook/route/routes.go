@@ -0,0 +1,255 @@
+package route
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	auditApp "github.com/happYness-Project/taskManagementGolang/internal/audit/application"
+	"github.com/happYness-Project/taskManagementGolang/internal/authorization"
+	"github.com/happYness-Project/taskManagementGolang/internal/webhook/application"
+	"github.com/happYness-Project/taskManagementGolang/internal/webhook/application/command"
+	"github.com/happYness-Project/taskManagementGolang/internal/webhook/application/query"
+	"github.com/happYness-Project/taskManagementGolang/internal/webhook/domain"
+	"github.com/happYness-Project/taskManagementGolang/internal/webhook/repository"
+	"github.com/happYness-Project/taskManagementGolang/pkg/constants"
+	"github.com/happYness-Project/taskManagementGolang/pkg/dbs"
+	"github.com/happYness-Project/taskManagementGolang/pkg/loggers"
+	"github.com/happYness-Project/taskManagementGolang/pkg/response"
+)
+
+type Handler struct {
+	logger     *loggers.AppLogger
+	commandBus *application.CommandBus
+	queryBus   *application.QueryBus
+}
+
+func NewHandler(logger *loggers.AppLogger, webhookRepo repository.WebhookRepository, deliveryRepo repository.DeliveryRepository, policy *authorization.Policy, uow dbs.UnitOfWork, recorder *auditApp.Recorder) *Handler {
+	return &Handler{
+		logger:     logger,
+		commandBus: application.NewCommandBus(webhookRepo, deliveryRepo, policy, uow, recorder),
+		queryBus:   application.NewQueryBus(webhookRepo, deliveryRepo, policy),
+	}
+}
+
+func (h *Handler) RegisterRoutes(router chi.Router) {
+	router.Route("/api/user-groups/{groupID}/webhooks", func(r chi.Router) {
+		r.Get("/", h.handleGetWebhooks)
+		r.Post("/", h.handleCreateWebhook)
+		r.Get("/{webhookID}", h.handleGetWebhook)
+		r.Put("/{webhookID}", h.handleUpdateWebhook)
+		r.Delete("/{webhookID}", h.handleDeleteWebhook)
+		r.Get("/{webhookID}/deliveries", h.handleGetDeliveries)
+		r.Get("/{webhookID}/deliveries/{deliveryID}", h.handleGetDelivery)
+		r.Post("/{webhookID}/deliveries/{deliveryID}/redeliver", h.handleRedeliver)
+	})
+}
+
+func (h *Handler) handleGetWebhooks(w http.ResponseWriter, r *http.Request) {
+	groupId, ok := h.groupId(w, r)
+	if !ok {
+		return
+	}
+
+	// Use Query Bus
+	result, err := h.queryBus.Execute(query.GetGroupWebhooksQuery{GroupId: groupId, RequesterId: authorization.RequesterId(r)})
+	if err != nil {
+		h.webhookError(w, err, "Failed to get webhooks")
+		return
+	}
+	response.WriteJsonWithEncode(w, http.StatusOK, result)
+}
+
+func (h *Handler) handleCreateWebhook(w http.ResponseWriter, r *http.Request) {
+	groupId, ok := h.groupId(w, r)
+	if !ok {
+		return
+	}
+	var createDto CreateWebhookDto
+	if err := response.ParseJson(r, &createDto); err != nil {
+		h.logger.Error().Err(err).Str("ErrorCode", constants.RequestBodyError).Msg("Invalid JSON body for CreateWebhookDto")
+		response.ErrorResponse(w, http.StatusBadRequest, *(response.New(constants.RequestBodyError, "Invalid Json Body", err.Error())))
+		return
+	}
+
+	// Use Command Bus
+	result, err := h.commandBus.Execute(r.Context(), command.CreateWebhookCommand{
+		GroupId:     groupId,
+		Url:         createDto.Url,
+		EventTypes:  createDto.EventTypes,
+		RequesterId: authorization.RequesterId(r),
+	})
+	if err != nil {
+		h.webhookError(w, err, "Failed to create webhook")
+		return
+	}
+	response.WriteJsonWithEncode(w, http.StatusCreated, result)
+}
+
+func (h *Handler) handleGetWebhook(w http.ResponseWriter, r *http.Request) {
+	groupId, ok := h.groupId(w, r)
+	if !ok {
+		return
+	}
+
+	// Use Query Bus
+	result, err := h.queryBus.Execute(query.GetWebhookQuery{
+		GroupId:     groupId,
+		WebhookId:   chi.URLParam(r, "webhookID"),
+		RequesterId: authorization.RequesterId(r),
+	})
+	if err != nil {
+		h.webhookError(w, err, "Failed to get webhook")
+		return
+	}
+	response.WriteJsonWithEncode(w, http.StatusOK, result)
+}
+
+func (h *Handler) handleUpdateWebhook(w http.ResponseWriter, r *http.Request) {
+	groupId, ok := h.groupId(w, r)
+	if !ok {
+		return
+	}
+	var updateDto UpdateWebhookDto
+	if err := response.ParseJson(r, &updateDto); err != nil {
+		h.logger.Error().Err(err).Str("ErrorCode", constants.RequestBodyError).Msg("Invalid JSON body for UpdateWebhookDto")
+		response.ErrorResponse(w, http.StatusBadRequest, *(response.New(constants.RequestBodyError, "Invalid Json Body", err.Error())))
+		return
+	}
+	isActive := updateDto.IsActive == nil || *updateDto.IsActive
+
+	// Use Command Bus
+	result, err := h.commandBus.Execute(r.Context(), command.UpdateWebhookCommand{
+		GroupId:     groupId,
+		WebhookId:   chi.URLParam(r, "webhookID"),
+		Url:         updateDto.Url,
+		EventTypes:  updateDto.EventTypes,
+		IsActive:    isActive,
+		RequesterId: authorization.RequesterId(r),
+	})
+	if err != nil {
+		h.webhookError(w, err, "Failed to update webhook")
+		return
+	}
+	response.WriteJsonWithEncode(w, http.StatusOK, result)
+}
+
+func (h *Handler) handleDeleteWebhook(w http.ResponseWriter, r *http.Request) {
+	groupId, ok := h.groupId(w, r)
+	if !ok {
+		return
+	}
+
+	// Use Command Bus
+	_, err := h.commandBus.Execute(r.Context(), command.DeleteWebhookCommand{
+		GroupId:     groupId,
+		WebhookId:   chi.URLParam(r, "webhookID"),
+		RequesterId: authorization.RequesterId(r),
+	})
+	if err != nil {
+		h.webhookError(w, err, "Failed to delete webhook")
+		return
+	}
+	response.WriteJsonWithEncode(w, http.StatusNoContent, "webhook has been deleted.")
+}
+
+func (h *Handler) handleGetDeliveries(w http.ResponseWriter, r *http.Request) {
+	groupId, ok := h.groupId(w, r)
+	if !ok {
+		return
+	}
+	limit := 0
+	if v := r.URL.Query().Get("limit"); v != "" {
+		var err error
+		if limit, err = strconv.Atoi(v); err != nil || limit < 1 {
+			h.logger.Error().Str("ErrorCode", WebhookInvalidInput).Msg("invalid limit")
+			response.ErrorResponse(w, http.StatusBadRequest, *(response.New(WebhookInvalidInput, "Invalid parameter", "limit must be a positive integer")))
+			return
+		}
+	}
+
+	// Use Query Bus
+	result, err := h.queryBus.Execute(query.GetWebhookDeliveriesQuery{
+		GroupId:     groupId,
+		WebhookId:   chi.URLParam(r, "webhookID"),
+		Limit:       limit,
+		RequesterId: authorization.RequesterId(r),
+	})
+	if err != nil {
+		h.webhookError(w, err, "Failed to get webhook deliveries")
+		return
+	}
+	response.WriteJsonWithEncode(w, http.StatusOK, result)
+}
+
+func (h *Handler) handleGetDelivery(w http.ResponseWriter, r *http.Request) {
+	groupId, ok := h.groupId(w, r)
+	if !ok {
+		return
+	}
+
+	// Use Query Bus
+	result, err := h.queryBus.Execute(query.GetWebhookDeliveryQuery{
+		GroupId:     groupId,
+		WebhookId:   chi.URLParam(r, "webhookID"),
+		DeliveryId:  chi.URLParam(r, "deliveryID"),
+		RequesterId: authorization.RequesterId(r),
+	})
+	if err != nil {
+		h.webhookError(w, err, "Failed to get webhook delivery")
+		return
+	}
+	response.WriteJsonWithEncode(w, http.StatusOK, result)
+}
+
+func (h *Handler) handleRedeliver(w http.ResponseWriter, r *http.Request) {
+	groupId, ok := h.groupId(w, r)
+	if !ok {
+		return
+	}
+
+	// Use Command Bus
+	result, err := h.commandBus.Execute(r.Context(), command.RedeliverWebhookCommand{
+		GroupId:     groupId,
+		WebhookId:   chi.URLParam(r, "webhookID"),
+		DeliveryId:  chi.URLParam(r, "deliveryID"),
+		RequesterId: authorization.RequesterId(r),
+	})
+	if err != nil {
+		h.webhookError(w, err, "Failed to redeliver webhook")
+		return
+	}
+	response.WriteJsonWithEncode(w, http.StatusAccepted, result)
+}
+
+func (h *Handler) groupId(w http.ResponseWriter, r *http.Request) (int, bool) {
+	groupId, err := strconv.Atoi(chi.URLParam(r, "groupID"))
+	if err != nil {
+		h.logger.Error().Err(err).Msg("invalid Group ID")
+		response.ErrorResponse(w, http.StatusBadRequest, *(response.New(constants.InvalidParameter, "Invalid Group ID")))
+		return 0, false
+	}
+	return groupId, true
+}
+
+// webhookError maps webhook command and query failures onto responses
+func (h *Handler) webhookError(w http.ResponseWriter, err error, title string) {
+	switch {
+	case authorization.IsForbiddenError(err):
+		h.logger.Error().Err(err).Str("ErrorCode", constants.PermissionDenied).Msg(err.Error())
+		response.Forbidden(w, constants.PermissionDenied, err.Error())
+	case errors.Is(err, domain.ErrWebhookNotFound):
+		h.logger.Error().Err(err).Str("ErrorCode", WebhookNotFound).Msg(err.Error())
+		response.ErrorResponse(w, http.StatusNotFound, *(response.New(WebhookNotFound, title, err.Error())))
+	case errors.Is(err, domain.ErrDeliveryNotFound):
+		h.logger.Error().Err(err).Str("ErrorCode", WebhookDeliveryNotFound).Msg(err.Error())
+		response.ErrorResponse(w, http.StatusNotFound, *(response.New(WebhookDeliveryNotFound, title, err.Error())))
+	case errors.Is(err, domain.ErrInvalidWebhook):
+		h.logger.Error().Err(err).Str("ErrorCode", WebhookInvalidInput).Msg(err.Error())
+		response.ErrorResponse(w, http.StatusBadRequest, *(response.New(WebhookInvalidInput, title, err.Error())))
+	default:
+		h.logger.Error().Err(err).Str("ErrorCode", WebhookServerError).Msg(err.Error())
+		response.ErrorResponse(w, http.StatusInternalServerError, *(response.New(WebhookServerError, title, err.Error())))
+	}
+}
@@ -0,0 +1,12 @@
+package route
+
+type CreateWebhookDto struct {
+	Url        string   `json:"url"`
+	EventTypes []string `json:"event_types"`
+}
+
+type UpdateWebhookDto struct {
+	Url        string   `json:"url"`
+	EventTypes []string `json:"event_types"`
+	IsActive   *bool    `json:"is_active"` // defaults to true
+}
@@ -3,6 +3,7 @@ package configs
 import (
 	"log"
 	"os"
+	"strconv"
 
 	"github.com/spf13/viper"
 )
@@ -12,7 +13,7 @@ type Env struct {
 	ServerAddress  string `mapstructure:"SERVER_ADDRESS"`
 	Port           string `mapstructure:"PORT"`
 	ContextTimeout int    `mapstructure:"CONTEXT_TIMEOUT"`
-	LogLevel       string `mapstructure:"LOG_LEVEL`
+	LogLevel       string `mapstructure:"LOG_LEVEL"`
 	Host           string `mapstructure:"HOST"`
 
 	DBHost string `mapstructure:"DB_HOST"`
@@ -25,6 +26,16 @@ type Env struct {
 	RefreshTokenExpiryHour int    `mapstructure:"REFRESH_TOKEN_EXPIRY_HOUR"`
 	AccessTokenSecret      string `mapstructure:"ACCESS_TOKEN_SECRET"`
 	RefreshTokenSecret     string `mapstructure:"REFRESH_TOKEN_SECRET"`
+
+	// TrashRetentionDays is how long deleted tasks and containers stay restorable; 0 keeps the default of 30
+	TrashRetentionDays int `mapstructure:"TRASH_RETENTION_DAYS"`
+
+	// SMTP server reminders are emailed through; reminders are not emailed when SMTPHost is empty
+	SMTPHost     string `mapstructure:"SMTP_HOST"`
+	SMTPPort     string `mapstructure:"SMTP_PORT"`
+	SMTPUsername string `mapstructure:"SMTP_USERNAME"`
+	SMTPPassword string `mapstructure:"SMTP_PASSWORD"`
+	SMTPFrom     string `mapstructure:"SMTP_FROM"`
 }
 
 func InitConfig(envString string) Env {
@@ -46,6 +57,12 @@ func InitConfig(envString string) Env {
 		env.DBPwd = os.Getenv("DB_PWD")
 		env.AccessTokenSecret = os.Getenv("ACCESS_TOKEN_SECRET")
 		env.RefreshTokenSecret = os.Getenv("ACCESS_TOKEN_SECRET")
+		env.TrashRetentionDays, _ = strconv.Atoi(os.Getenv("TRASH_RETENTION_DAYS"))
+		env.SMTPHost = os.Getenv("SMTP_HOST")
+		env.SMTPPort = os.Getenv("SMTP_PORT")
+		env.SMTPUsername = os.Getenv("SMTP_USERNAME")
+		env.SMTPPassword = os.Getenv("SMTP_PASSWORD")
+		env.SMTPFrom = os.Getenv("SMTP_FROM")
 		return env
 	}
 	err := viper.ReadInConfig()
@@ -7,4 +7,8 @@ const (
 	RequestBodyError = prefix + "request_body_invalid"
 	MissingParameter = prefix + "missing_parameter"
 	InvalidParameter = prefix + "invalid_parameter"
+	PermissionDenied = prefix + "permission_denied"
+
+	PreconditionRequired = prefix + "precondition_required"
+	PreconditionFailed   = prefix + "precondition_failed"
 )
@@ -0,0 +1,278 @@
+package migrations
+
+import (
+	"cmp"
+	"context"
+	"database/sql"
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"regexp"
+	"slices"
+	"strconv"
+	"time"
+)
+
+//go:embed sql/*.sql
+var files embed.FS
+
+// lockKey identifies the advisory lock held while migrating, so replicas starting together migrate one at a time
+const lockKey int64 = 7_341_902_114
+
+var (
+	ErrInvalidMigration = errors.New("invalid migration")
+	ErrNoDownMigration  = errors.New("migration cannot be reverted")
+	ErrUnknownMigration = errors.New("applied migration is unknown to this build")
+)
+
+var fileNamePattern = regexp.MustCompile(`^(\d+)_([a-z0-9_]+)\.(up|down)\.sql$`)
+
+// Migration is one versioned schema change, read from <version>_<name>.up.sql and, when it can be reverted,
+// <version>_<name>.down.sql
+type Migration struct {
+	Version int64
+	Name    string
+	Up      string
+	Down    string
+}
+
+// Status is a migration and when it was applied; AppliedAt is nil for pending migrations
+type Status struct {
+	Version   int64      `json:"version"`
+	Name      string     `json:"name"`
+	AppliedAt *time.Time `json:"applied_at"`
+}
+
+// Migrator applies and reverts the migrations of the schema in version order. Each migration runs in its own
+// transaction together with its schema_migrations row, under an advisory lock held for the whole run.
+type Migrator struct {
+	db         *sql.DB
+	migrations []Migration
+}
+
+// New creates a migrator over the migrations embedded in the binary
+func New(db *sql.DB) (*Migrator, error) {
+	sqlFiles, err := fs.Sub(files, "sql")
+	if err != nil {
+		return nil, err
+	}
+	return NewFromFS(db, sqlFiles)
+}
+
+// NewFromFS creates a migrator over the migrations found at the root of fsys
+func NewFromFS(db *sql.DB, fsys fs.FS) (*Migrator, error) {
+	migrations, err := Load(fsys)
+	if err != nil {
+		return nil, err
+	}
+	return &Migrator{db: db, migrations: migrations}, nil
+}
+
+// Load reads the migrations at the root of fsys, ordered by version. Files that are not migrations are ignored.
+func Load(fsys fs.FS) ([]Migration, error) {
+	entries, err := fs.ReadDir(fsys, ".")
+	if err != nil {
+		return nil, fmt.Errorf("unable to read migrations: %w", err)
+	}
+
+	byVersion := map[int64]*Migration{}
+	for _, entry := range entries {
+		match := fileNamePattern.FindStringSubmatch(entry.Name())
+		if entry.IsDir() || match == nil {
+			continue
+		}
+		version, err := strconv.ParseInt(match[1], 10, 64)
+		if err != nil || version <= 0 {
+			return nil, fmt.Errorf("%w: %s: version must be a positive number", ErrInvalidMigration, entry.Name())
+		}
+		content, err := fs.ReadFile(fsys, entry.Name())
+		if err != nil {
+			return nil, fmt.Errorf("unable to read migration %s: %w", entry.Name(), err)
+		}
+
+		migration, ok := byVersion[version]
+		if !ok {
+			migration = &Migration{Version: version, Name: match[2]}
+			byVersion[version] = migration
+		}
+		if migration.Name != match[2] {
+			return nil, fmt.Errorf("%w: version %d is used by %s and %s", ErrInvalidMigration, version, migration.Name, match[2])
+		}
+		if match[3] == "up" {
+			migration.Up = string(content)
+		} else {
+			migration.Down = string(content)
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, migration := range byVersion {
+		if migration.Up == "" {
+			return nil, fmt.Errorf("%w: version %d (%s) has no up migration", ErrInvalidMigration, migration.Version, migration.Name)
+		}
+		migrations = append(migrations, *migration)
+	}
+	slices.SortFunc(migrations, func(a, b Migration) int { return cmp.Compare(a.Version, b.Version) })
+	return migrations, nil
+}
+
+// Up applies every pending migration and returns them in the order they were applied
+func (m *Migrator) Up(ctx context.Context) ([]Migration, error) {
+	var applied []Migration
+	err := m.withLock(ctx, func(conn *sql.Conn) error {
+		versions, err := appliedVersions(ctx, conn)
+		if err != nil {
+			return err
+		}
+		for _, migration := range m.migrations {
+			if _, ok := versions[migration.Version]; ok {
+				continue
+			}
+			if err := run(ctx, conn, migration, migration.Up,
+				`INSERT INTO public.schema_migrations(version, name, applied_at) VALUES ($1, $2, now())`, migration.Version, migration.Name); err != nil {
+				return err
+			}
+			applied = append(applied, migration)
+		}
+		return nil
+	})
+	return applied, err
+}
+
+// Down reverts the last steps applied migrations, latest first, and returns them in the order they were reverted
+func (m *Migrator) Down(ctx context.Context, steps int) ([]Migration, error) {
+	var reverted []Migration
+	err := m.withLock(ctx, func(conn *sql.Conn) error {
+		versions, err := appliedVersions(ctx, conn)
+		if err != nil {
+			return err
+		}
+		latest := make([]int64, 0, len(versions))
+		for version := range versions {
+			latest = append(latest, version)
+		}
+		slices.Sort(latest)
+		slices.Reverse(latest)
+
+		for _, version := range latest[:max(min(steps, len(latest)), 0)] {
+			migration, ok := m.find(version)
+			if !ok {
+				return fmt.Errorf("%w: version %d", ErrUnknownMigration, version)
+			}
+			if migration.Down == "" {
+				return fmt.Errorf("%w: version %d (%s) has no down migration", ErrNoDownMigration, version, migration.Name)
+			}
+			if err := run(ctx, conn, migration, migration.Down,
+				`DELETE FROM public.schema_migrations WHERE version = $1`, migration.Version); err != nil {
+				return err
+			}
+			reverted = append(reverted, migration)
+		}
+		return nil
+	})
+	return reverted, err
+}
+
+// Status lists the known migrations, then any applied migration this build does not know of
+func (m *Migrator) Status(ctx context.Context) ([]Status, error) {
+	var statuses []Status
+	err := m.withLock(ctx, func(conn *sql.Conn) error {
+		versions, err := appliedVersions(ctx, conn)
+		if err != nil {
+			return err
+		}
+		for _, migration := range m.migrations {
+			status := Status{Version: migration.Version, Name: migration.Name}
+			if applied, ok := versions[migration.Version]; ok {
+				status.AppliedAt = &applied.at
+			}
+			statuses = append(statuses, status)
+		}
+		for version, applied := range versions {
+			if _, ok := m.find(version); !ok {
+				statuses = append(statuses, Status{Version: version, Name: applied.name, AppliedAt: &applied.at})
+			}
+		}
+		slices.SortFunc(statuses, func(a, b Status) int { return cmp.Compare(a.Version, b.Version) })
+		return nil
+	})
+	return statuses, err
+}
+
+func (m *Migrator) find(version int64) (Migration, bool) {
+	i := slices.IndexFunc(m.migrations, func(migration Migration) bool { return migration.Version == version })
+	if i < 0 {
+		return Migration{}, false
+	}
+	return m.migrations[i], true
+}
+
+// withLock runs fn on a single connection holding the migration lock, once the schema_migrations table exists.
+// Session advisory locks belong to a connection, so everything runs on the one that took it.
+func (m *Migrator) withLock(ctx context.Context, fn func(conn *sql.Conn) error) error {
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to get a connection: %w", err)
+	}
+	defer conn.Close()
+
+	if _, err := conn.ExecContext(ctx, `SELECT pg_advisory_lock($1)`, lockKey); err != nil {
+		return fmt.Errorf("unable to take the migration lock: %w", err)
+	}
+	defer conn.ExecContext(context.Background(), `SELECT pg_advisory_unlock($1)`, lockKey)
+
+	if _, err := conn.ExecContext(ctx, `CREATE TABLE IF NOT EXISTS public.schema_migrations (
+    version bigint NOT NULL,
+    name character varying(255) NOT NULL,
+    applied_at timestamp with time zone NOT NULL,
+    CONSTRAINT pk_schema_migrations PRIMARY KEY (version)
+)`); err != nil {
+		return fmt.Errorf("unable to create schema_migrations: %w", err)
+	}
+	return fn(conn)
+}
+
+type appliedMigration struct {
+	name string
+	at   time.Time
+}
+
+func appliedVersions(ctx context.Context, conn *sql.Conn) (map[int64]appliedMigration, error) {
+	rows, err := conn.QueryContext(ctx, `SELECT version, name, applied_at FROM public.schema_migrations`)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read applied migrations: %w", err)
+	}
+	defer rows.Close()
+
+	versions := map[int64]appliedMigration{}
+	for rows.Next() {
+		var version int64
+		var applied appliedMigration
+		if err := rows.Scan(&version, &applied.name, &applied.at); err != nil {
+			return nil, fmt.Errorf("unable to read applied migrations: %w", err)
+		}
+		versions[version] = applied
+	}
+	return versions, rows.Err()
+}
+
+// run executes the migration script and records it with query in one transaction
+func run(ctx context.Context, conn *sql.Conn, migration Migration, script string, query string, args ...any) error {
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("unable to begin transaction : %w", err)
+	}
+	if _, err := tx.ExecContext(ctx, script); err != nil {
+		tx.Rollback()
+		return fmt.Errorf("migration %d (%s) failed: %w", migration.Version, migration.Name, err)
+	}
+	if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+		tx.Rollback()
+		return fmt.Errorf("unable to record migration %d (%s): %w", migration.Version, migration.Name, err)
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit failure: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,153 @@
+package migrations
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoad(t *testing.T) {
+	t.Run("when files are migrations, Then they are paired and ordered by version", func(t *testing.T) {
+		fsys := fstest.MapFS{
+			"0010_add_index.up.sql":     {Data: []byte("CREATE INDEX")},
+			"0002_add_table.up.sql":     {Data: []byte("CREATE TABLE")},
+			"0002_add_table.down.sql":   {Data: []byte("DROP TABLE")},
+			"README.md":                 {Data: []byte("not a migration")},
+			"0003_Not_Lowercase.up.sql": {Data: []byte("ignored")},
+		}
+
+		migrations, err := Load(fsys)
+
+		require.NoError(t, err)
+		assert.Equal(t, []Migration{
+			{Version: 2, Name: "add_table", Up: "CREATE TABLE", Down: "DROP TABLE"},
+			{Version: 10, Name: "add_index", Up: "CREATE INDEX"},
+		}, migrations)
+	})
+
+	t.Run("when a migration has only a down file, Then ErrInvalidMigration is returned", func(t *testing.T) {
+		_, err := Load(fstest.MapFS{"0001_orphan.down.sql": {Data: []byte("DROP TABLE")}})
+
+		assert.True(t, errors.Is(err, ErrInvalidMigration))
+	})
+
+	t.Run("when two migrations share a version, Then ErrInvalidMigration is returned", func(t *testing.T) {
+		_, err := Load(fstest.MapFS{
+			"0001_first.up.sql":  {Data: []byte("SELECT 1")},
+			"0001_second.up.sql": {Data: []byte("SELECT 2")},
+		})
+
+		assert.True(t, errors.Is(err, ErrInvalidMigration))
+	})
+
+	t.Run("when the embedded migrations are loaded, Then they start at version 1 without gaps", func(t *testing.T) {
+		migrator, err := New(nil)
+
+		require.NoError(t, err)
+		require.NotEmpty(t, migrator.migrations)
+		for i, migration := range migrator.migrations {
+			assert.Equal(t, int64(i+1), migration.Version)
+			assert.NotEmpty(t, migration.Down, "migration %d has no down migration", migration.Version)
+		}
+	})
+}
+
+func TestMigrator(t *testing.T) {
+	fsys := fstest.MapFS{
+		"0001_create_a.up.sql":   {Data: []byte("CREATE TABLE a")},
+		"0001_create_a.down.sql": {Data: []byte("DROP TABLE a")},
+		"0002_create_b.up.sql":   {Data: []byte("CREATE TABLE b")},
+	}
+
+	newMigrator := func(t *testing.T) (*Migrator, sqlmock.Sqlmock) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		t.Cleanup(func() { db.Close() })
+		migrator, err := NewFromFS(db, fsys)
+		require.NoError(t, err)
+
+		mock.ExpectExec("SELECT pg_advisory_lock").WithArgs(lockKey).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec("CREATE TABLE IF NOT EXISTS public.schema_migrations").WillReturnResult(sqlmock.NewResult(0, 0))
+		return migrator, mock
+	}
+
+	t.Run("when a migration is pending, Then only it is applied and recorded", func(t *testing.T) {
+		// Arrange
+		migrator, mock := newMigrator(t)
+		mock.ExpectQuery("SELECT version, name, applied_at FROM public.schema_migrations").
+			WillReturnRows(sqlmock.NewRows([]string{"version", "name", "applied_at"}).AddRow(1, "create_a", time.Now()))
+		mock.ExpectBegin()
+		mock.ExpectExec("CREATE TABLE b").WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec("INSERT INTO public.schema_migrations").WithArgs(int64(2), "create_b").WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+		mock.ExpectExec("SELECT pg_advisory_unlock").WithArgs(lockKey).WillReturnResult(sqlmock.NewResult(0, 0))
+
+		// Act
+		applied, err := migrator.Up(context.Background())
+
+		// Assert
+		require.NoError(t, err)
+		require.Len(t, applied, 1)
+		assert.Equal(t, int64(2), applied[0].Version)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("when a migration fails, Then it is rolled back and the next ones are not applied", func(t *testing.T) {
+		// Arrange
+		migrator, mock := newMigrator(t)
+		mock.ExpectQuery("SELECT version, name, applied_at FROM public.schema_migrations").
+			WillReturnRows(sqlmock.NewRows([]string{"version", "name", "applied_at"}))
+		mock.ExpectBegin()
+		mock.ExpectExec("CREATE TABLE a").WillReturnError(errors.New("syntax error"))
+		mock.ExpectRollback()
+		mock.ExpectExec("SELECT pg_advisory_unlock").WithArgs(lockKey).WillReturnResult(sqlmock.NewResult(0, 0))
+
+		// Act
+		applied, err := migrator.Up(context.Background())
+
+		// Assert
+		assert.ErrorContains(t, err, "migration 1 (create_a) failed")
+		assert.Empty(t, applied)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("when the latest migration has no down migration, Then ErrNoDownMigration is returned", func(t *testing.T) {
+		// Arrange
+		migrator, mock := newMigrator(t)
+		mock.ExpectQuery("SELECT version, name, applied_at FROM public.schema_migrations").
+			WillReturnRows(sqlmock.NewRows([]string{"version", "name", "applied_at"}).AddRow(1, "create_a", time.Now()).AddRow(2, "create_b", time.Now()))
+		mock.ExpectExec("SELECT pg_advisory_unlock").WithArgs(lockKey).WillReturnResult(sqlmock.NewResult(0, 0))
+
+		// Act
+		reverted, err := migrator.Down(context.Background(), 1)
+
+		// Assert
+		assert.True(t, errors.Is(err, ErrNoDownMigration))
+		assert.Empty(t, reverted)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("when an applied migration is unknown, Then status lists it after the known ones", func(t *testing.T) {
+		// Arrange
+		migrator, mock := newMigrator(t)
+		mock.ExpectQuery("SELECT version, name, applied_at FROM public.schema_migrations").
+			WillReturnRows(sqlmock.NewRows([]string{"version", "name", "applied_at"}).AddRow(1, "create_a", time.Now()).AddRow(7, "from_newer_build", time.Now()))
+		mock.ExpectExec("SELECT pg_advisory_unlock").WithArgs(lockKey).WillReturnResult(sqlmock.NewResult(0, 0))
+
+		// Act
+		statuses, err := migrator.Status(context.Background())
+
+		// Assert
+		require.NoError(t, err)
+		require.Len(t, statuses, 3)
+		assert.NotNil(t, statuses[0].AppliedAt)
+		assert.Nil(t, statuses[1].AppliedAt)
+		assert.Equal(t, "from_newer_build", statuses[2].Name)
+	})
+}
@@ -0,0 +1,2 @@
+DROP SCHEMA IF EXISTS container CASCADE;
+DROP FUNCTION IF EXISTS public.uuid_generate_v7();
@@ -0,0 +1,268 @@
+-- Baseline schema: the tables as they were when migrations were introduced, before labels replaced categories.
+-- Every statement is idempotent so databases created from dev-env/sql/create_tables.sql adopt it unchanged.
+SET LOCAL client_min_messages = warning;
+SELECT pg_catalog.set_config('search_path', '', true);
+SET default_table_access_method = heap;
+
+CREATE SCHEMA IF NOT EXISTS container;
+
+CREATE EXTENSION IF NOT EXISTS "uuid-ossp" SCHEMA public; -- To use uuid_generate_v7 custom function.
+
+-- Creating uuid generate v7 method - this feature is not implemented in postgres 17 yet.
+-- Will be implemented in 18.
+create or replace function public.uuid_generate_v7()
+returns uuid
+as $$
+begin
+  -- use random v4 uuid as starting point (which has the same variant we need)
+  -- then overlay timestamp
+  -- then set version 7 by flipping the 2 and 1 bit in the version 4 string
+  return encode(
+    set_bit(
+      set_bit(
+        overlay(uuid_send(gen_random_uuid())
+                placing substring(int8send(floor(extract(epoch from clock_timestamp()) * 1000)::bigint) from 3)
+                from 1 for 6
+        ),
+        52, 1
+      ),
+      53, 1
+    ),
+    'hex')::uuid;
+end
+$$
+language plpgsql
+volatile;
+
+
+CREATE TABLE IF NOT EXISTS container.user (
+    id bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY ( INCREMENT 1 START 1 MINVALUE 1 MAXVALUE 9223372036854775807 CACHE 1 ),
+    user_id uuid DEFAULT public.uuid_generate_v7(),
+    username CHARACTER VARYING(100),
+    first_name character varying(255),
+    last_name character varying(255),
+    email character varying(255),
+    is_active boolean,
+    created_at timestamp without time zone,
+    updated_at timestamp without time zone,
+    default_group_id bigint,
+    CONSTRAINT pk_user PRIMARY KEY (id)
+);
+
+
+CREATE TABLE IF NOT EXISTS container.usergroup (
+    id int NOT NULL generated always as identity,
+    name CHARACTER VARYING(100) NOT NULL,
+    description CHARACTER VARYING(255) NOT NULL,
+    type CHARACTER VARYING(30),
+    thumbnailUrl CHARACTER VARYING(255),
+    is_active boolean,
+    version int NOT NULL DEFAULT 1,
+    -- full-text search over the name, weighted above the description
+    search_vector tsvector GENERATED ALWAYS AS (
+        setweight(to_tsvector('english', coalesce(name, '')), 'A') ||
+        setweight(to_tsvector('english', coalesce(description, '')), 'B')) STORED,
+    CONSTRAINT pk_usergroup PRIMARY KEY (id)
+);
+CREATE INDEX IF NOT EXISTS idx_usergroup_search ON container.usergroup USING GIN (search_vector);
+
+CREATE TABLE IF NOT EXISTS container.task (
+    id uuid NOT NULL,
+    name character varying(100) NOT NULL,
+    description character varying(512),
+    type CHARACTER VARYING(20),
+    created_at timestamp with time zone,
+    updated_at timestamp with time zone,
+    target_date timestamp with time zone,
+    priority character varying(50),
+    category character varying(20),
+    is_completed boolean NOT NULL,
+    is_important boolean NOT NULL,
+    recurrence_rule character varying(255) NOT NULL DEFAULT '',
+    assignee_id uuid,
+    version int NOT NULL DEFAULT 1,
+    deleted_at timestamp with time zone, -- set while the task is in the trash
+    search_vector tsvector GENERATED ALWAYS AS (
+        setweight(to_tsvector('english', coalesce(name, '')), 'A') ||
+        setweight(to_tsvector('english', coalesce(description, '')), 'B')) STORED,
+    CONSTRAINT pk_task PRIMARY KEY (id)
+);
+CREATE INDEX IF NOT EXISTS idx_task_deleted_at ON container.task(deleted_at) WHERE deleted_at IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_task_search ON container.task USING GIN (search_vector);
+
+CREATE TABLE IF NOT EXISTS container.taskcontainer (
+    id uuid NOT NULL,
+    name CHARACTER varying(100) NOT NULL,
+    description CHARACTER VARYING(255),
+    is_active boolean,
+    activity_level INT,
+    type CHARACTER (50),
+    usergroup_id bigint,
+    version int NOT NULL DEFAULT 1,
+    deleted_at timestamp with time zone, -- set while the container is in the trash
+    search_vector tsvector GENERATED ALWAYS AS (
+        setweight(to_tsvector('english', coalesce(name, '')), 'A') ||
+        setweight(to_tsvector('english', coalesce(description, '')), 'B')) STORED,
+    CONSTRAINT pk_taskcontainer PRIMARY KEY (id),
+    CONSTRAINT fk_usergroup_id_taskcontainer_usergroupId FOREIGN KEY (usergroup_id)
+        REFERENCES container.usergroup ON DELETE CASCADE
+);
+CREATE INDEX IF NOT EXISTS idx_taskcontainer_deleted_at ON container.taskcontainer(deleted_at) WHERE deleted_at IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_taskcontainer_search ON container.taskcontainer USING GIN (search_vector);
+
+-- Reusable snapshot of a container; tasks holds the template tasks with relative target dates
+CREATE TABLE IF NOT EXISTS container.taskcontainer_template (
+    id uuid NOT NULL,
+    usergroup_id bigint NOT NULL,
+    name CHARACTER VARYING(100) NOT NULL,
+    description CHARACTER VARYING(255),
+    type CHARACTER VARYING(50),
+    tasks jsonb NOT NULL DEFAULT '[]',
+    created_by CHARACTER VARYING(36) NOT NULL,
+    created_at timestamp with time zone NOT NULL,
+    CONSTRAINT pk_taskcontainer_template PRIMARY KEY (id),
+    CONSTRAINT fk_taskcontainer_template_usergroup_id FOREIGN KEY (usergroup_id) REFERENCES container.usergroup(id) ON DELETE CASCADE
+);
+CREATE INDEX IF NOT EXISTS idx_taskcontainer_template_usergroup_id ON container.taskcontainer_template(usergroup_id);
+
+-- Personal task filters ("smart lists"); filter holds the criteria, with target dates that may be relative
+CREATE TABLE IF NOT EXISTS container.saved_view (
+    id uuid NOT NULL,
+    user_id bigint NOT NULL,
+    name CHARACTER VARYING(100) NOT NULL,
+    filter jsonb NOT NULL DEFAULT '{}',
+    sort CHARACTER VARYING(20) NOT NULL DEFAULT '',
+    created_at timestamp with time zone NOT NULL,
+    updated_at timestamp with time zone NOT NULL,
+    CONSTRAINT pk_saved_view PRIMARY KEY (id),
+    CONSTRAINT fk_saved_view_user_id FOREIGN KEY (user_id) REFERENCES container.user(id) ON DELETE CASCADE
+);
+CREATE INDEX IF NOT EXISTS idx_saved_view_user_id ON container.saved_view(user_id);
+
+CREATE TABLE IF NOT EXISTS container.taskcontainer_task (
+  taskcontainer_id uuid NOT NULL,
+  task_id uuid NOT NULL,
+  PRIMARY KEY (taskcontainer_id, task_id),
+  CONSTRAINT fk_taskcontainer_task_taskcontainer_id FOREIGN KEY(taskcontainer_id) REFERENCES container.taskcontainer(id) ON DELETE CASCADE,
+  CONSTRAINT fk_taskcontainer_task_task_id FOREIGN KEY(task_id) REFERENCES container.task(id) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS container.task_checklist_item (
+  id uuid NOT NULL,
+  task_id uuid NOT NULL,
+  title character varying(255) NOT NULL,
+  is_checked boolean NOT NULL DEFAULT false,
+  position int NOT NULL,
+  created_at timestamp with time zone,
+  updated_at timestamp with time zone,
+  CONSTRAINT pk_task_checklist_item PRIMARY KEY (id),
+  CONSTRAINT fk_task_checklist_item_task_id FOREIGN KEY(task_id) REFERENCES container.task(id) ON DELETE CASCADE
+);
+CREATE INDEX IF NOT EXISTS idx_task_checklist_item_task_id ON container.task_checklist_item(task_id, position);
+
+CREATE TABLE IF NOT EXISTS container.usergroup_user (
+  usergroup_id bigint NOT NULL,
+  user_id bigint NOT NULL,
+  role character varying(20) NOT NULL DEFAULT 'member',
+  joined_at timestamp with time zone DEFAULT timezone('UTC', now()),
+  PRIMARY KEY (usergroup_id, user_id),
+  CONSTRAINT fk_usergroup_user_usergroup_id FOREIGN KEY(usergroup_id) REFERENCES container.usergroup(id) ON DELETE CASCADE,
+  CONSTRAINT fk_usergroup_user_user_id FOREIGN KEY(user_id) REFERENCES container.user(id) ON DELETE CASCADE,
+  CONSTRAINT chk_usergroup_user_role CHECK (role IN ('admin', 'member'))
+);
+
+-- Append-only audit log. No foreign keys: entries outlive the groups and aggregates they describe.
+CREATE TABLE IF NOT EXISTS container.activity (
+  id bigint NOT NULL GENERATED ALWAYS AS IDENTITY,
+  usergroup_id bigint,
+  actor_id character varying(36) NOT NULL,
+  action character varying(50) NOT NULL,
+  aggregate_type character varying(30) NOT NULL,
+  aggregate_id character varying(64) NOT NULL,
+  before_snapshot jsonb,
+  after_snapshot jsonb,
+  request_id character varying(64) NOT NULL DEFAULT '',
+  occurred_at timestamp with time zone NOT NULL,
+  CONSTRAINT pk_activity PRIMARY KEY (id)
+);
+CREATE INDEX IF NOT EXISTS idx_activity_usergroup_id ON container.activity(usergroup_id, id DESC);
+
+create or replace function container.reject_activity_change()
+returns trigger
+as $$
+begin
+  raise exception 'container.activity is append-only';
+end
+$$
+language plpgsql;
+
+DROP TRIGGER IF EXISTS trg_activity_append_only ON container.activity;
+CREATE TRIGGER trg_activity_append_only BEFORE UPDATE OR DELETE ON container.activity
+  FOR EACH ROW EXECUTE FUNCTION container.reject_activity_change();
+
+-- Transactional outbox: domain events are written in the transaction that raised them
+-- and delivered to the configured sinks by the outbox dispatcher.
+-- next_attempt_at is NULL once delivery has been given up.
+CREATE TABLE IF NOT EXISTS container.outbox_event (
+  id bigint NOT NULL GENERATED ALWAYS AS IDENTITY,
+  event_id uuid NOT NULL,
+  event_type character varying(50) NOT NULL,
+  aggregate_type character varying(30) NOT NULL,
+  aggregate_id character varying(64) NOT NULL,
+  usergroup_id bigint,
+  payload jsonb NOT NULL,
+  occurred_at timestamp with time zone NOT NULL,
+  attempts int NOT NULL DEFAULT 0,
+  last_error text,
+  next_attempt_at timestamp with time zone,
+  dispatched_at timestamp with time zone,
+  CONSTRAINT pk_outbox_event PRIMARY KEY (id),
+  CONSTRAINT uq_outbox_event_event_id UNIQUE (event_id)
+);
+CREATE INDEX IF NOT EXISTS idx_outbox_event_pending ON container.outbox_event(next_attempt_at, id) WHERE dispatched_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_outbox_event_usergroup_id ON container.outbox_event(usergroup_id, id); -- replay for event streams
+
+CREATE TABLE IF NOT EXISTS container.webhook (
+  id uuid NOT NULL,
+  usergroup_id bigint NOT NULL,
+  url character varying(2048) NOT NULL,
+  event_types character varying(500) NOT NULL, -- comma separated
+  secret character varying(64) NOT NULL,
+  is_active boolean NOT NULL DEFAULT true,
+  created_by character varying(36) NOT NULL,
+  created_at timestamp with time zone NOT NULL,
+  updated_at timestamp with time zone NOT NULL,
+  CONSTRAINT pk_webhook PRIMARY KEY (id),
+  CONSTRAINT fk_webhook_usergroup_id FOREIGN KEY (usergroup_id) REFERENCES container.usergroup(id) ON DELETE CASCADE
+);
+CREATE INDEX IF NOT EXISTS idx_webhook_usergroup_id ON container.webhook(usergroup_id);
+
+-- next_attempt_at is NULL once the delivery has succeeded or failed for good
+CREATE TABLE IF NOT EXISTS container.webhook_delivery (
+  id uuid NOT NULL,
+  webhook_id uuid NOT NULL,
+  event_id uuid NOT NULL,
+  event_type character varying(50) NOT NULL,
+  payload jsonb NOT NULL,
+  status character varying(20) NOT NULL,
+  attempts int NOT NULL DEFAULT 0,
+  next_attempt_at timestamp with time zone,
+  created_at timestamp with time zone NOT NULL,
+  updated_at timestamp with time zone NOT NULL,
+  CONSTRAINT pk_webhook_delivery PRIMARY KEY (id),
+  CONSTRAINT uq_webhook_delivery_event UNIQUE (webhook_id, event_id),
+  CONSTRAINT fk_webhook_delivery_webhook_id FOREIGN KEY (webhook_id) REFERENCES container.webhook(id) ON DELETE CASCADE
+);
+CREATE INDEX IF NOT EXISTS idx_webhook_delivery_due ON container.webhook_delivery(next_attempt_at) WHERE next_attempt_at IS NOT NULL;
+
+CREATE TABLE IF NOT EXISTS container.webhook_delivery_attempt (
+  id bigint NOT NULL GENERATED ALWAYS AS IDENTITY,
+  delivery_id uuid NOT NULL,
+  attempted_at timestamp with time zone NOT NULL,
+  status_code int,
+  error text,
+  duration_ms bigint NOT NULL,
+  CONSTRAINT pk_webhook_delivery_attempt PRIMARY KEY (id),
+  CONSTRAINT fk_webhook_delivery_attempt_delivery_id FOREIGN KEY (delivery_id) REFERENCES container.webhook_delivery(id) ON DELETE CASCADE
+);
+CREATE INDEX IF NOT EXISTS idx_webhook_delivery_attempt_delivery_id ON container.webhook_delivery_attempt(delivery_id, id);
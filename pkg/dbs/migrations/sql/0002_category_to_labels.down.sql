@@ -0,0 +1,15 @@
+-- Brings the task category back, filled with the first label of each task by name, and removes labels.
+ALTER TABLE container.task ADD COLUMN IF NOT EXISTS category character varying(20);
+
+UPDATE container.task t
+SET category = first_label.name
+FROM (
+  SELECT tl.task_id, LEFT(MIN(l.name), 20) AS name
+  FROM container.task_label tl
+  INNER JOIN container.label l ON l.id = tl.label_id
+  GROUP BY tl.task_id
+) first_label
+WHERE first_label.task_id = t.id;
+
+DROP TABLE IF EXISTS container.task_label;
+DROP TABLE IF EXISTS container.label;
@@ -0,0 +1,50 @@
+-- Replaces the free-text task category with group labels on databases created before labels existed.
+-- Every distinct category of a group, compared without case, becomes a label of that group and is attached
+-- to the tasks carrying it. Values that are task priorities (e.g. "normal") were never categories and are dropped.
+-- Databases created after labels existed have no category column; it is added empty so the data move is a no-op.
+
+CREATE TABLE IF NOT EXISTS container.label (
+  id uuid NOT NULL,
+  usergroup_id bigint NOT NULL,
+  name character varying(50) NOT NULL,
+  color character(7) NOT NULL, -- #rrggbb
+  created_at timestamp with time zone NOT NULL,
+  updated_at timestamp with time zone NOT NULL,
+  CONSTRAINT pk_label PRIMARY KEY (id),
+  CONSTRAINT fk_label_usergroup_id FOREIGN KEY (usergroup_id) REFERENCES container.usergroup(id) ON DELETE CASCADE
+);
+CREATE UNIQUE INDEX IF NOT EXISTS uq_label_usergroup_name ON container.label(usergroup_id, LOWER(name));
+
+CREATE TABLE IF NOT EXISTS container.task_label (
+  task_id uuid NOT NULL,
+  label_id uuid NOT NULL,
+  PRIMARY KEY (task_id, label_id),
+  CONSTRAINT fk_task_label_task_id FOREIGN KEY(task_id) REFERENCES container.task(id) ON DELETE CASCADE,
+  CONSTRAINT fk_task_label_label_id FOREIGN KEY(label_id) REFERENCES container.label(id) ON DELETE CASCADE
+);
+CREATE INDEX IF NOT EXISTS idx_task_label_label_id ON container.task_label(label_id);
+
+ALTER TABLE container.task ADD COLUMN IF NOT EXISTS category character varying(20);
+
+CREATE TEMPORARY TABLE task_category ON COMMIT DROP AS
+SELECT t.id AS task_id, tc.usergroup_id, TRIM(t.category) AS category
+FROM container.task t
+INNER JOIN container.taskcontainer_task tct ON t.id = tct.task_id
+INNER JOIN container.taskcontainer tc ON tc.id = tct.taskcontainer_id
+WHERE TRIM(COALESCE(t.category, '')) <> ''
+  AND LOWER(TRIM(t.category)) NOT IN ('low', 'medium', 'high', 'urgent', 'normal');
+
+-- The first spelling of a category in a group names its label
+INSERT INTO container.label(id, usergroup_id, name, color, created_at, updated_at)
+SELECT gen_random_uuid(), usergroup_id, MIN(category), '#808080', now(), now()
+FROM task_category
+GROUP BY usergroup_id, LOWER(category)
+ON CONFLICT DO NOTHING;
+
+INSERT INTO container.task_label(task_id, label_id)
+SELECT tcat.task_id, l.id
+FROM task_category tcat
+INNER JOIN container.label l ON l.usergroup_id = tcat.usergroup_id AND LOWER(l.name) = LOWER(tcat.category)
+ON CONFLICT DO NOTHING;
+
+ALTER TABLE container.task DROP COLUMN IF EXISTS category;
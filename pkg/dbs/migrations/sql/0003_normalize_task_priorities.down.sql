@@ -0,0 +1,4 @@
+-- Stops constraining priorities; the normalized values are kept.
+ALTER TABLE container.task DROP CONSTRAINT IF EXISTS chk_task_priority;
+ALTER TABLE container.task ALTER COLUMN priority DROP NOT NULL;
+ALTER TABLE container.task ALTER COLUMN priority DROP DEFAULT;
@@ -0,0 +1,16 @@
+-- Normalizes the priorities of tasks created before priorities were validated, then keeps them valid.
+-- Known priorities are lowercased and trimmed; "normal", empty and unknown values become "medium".
+
+UPDATE container.task
+SET priority = CASE LOWER(TRIM(priority))
+    WHEN 'low' THEN 'low'
+    WHEN 'high' THEN 'high'
+    WHEN 'urgent' THEN 'urgent'
+    ELSE 'medium'
+  END
+WHERE priority IS NULL OR priority NOT IN ('low', 'medium', 'high', 'urgent');
+
+ALTER TABLE container.task ALTER COLUMN priority SET DEFAULT 'medium';
+ALTER TABLE container.task ALTER COLUMN priority SET NOT NULL;
+ALTER TABLE container.task DROP CONSTRAINT IF EXISTS chk_task_priority;
+ALTER TABLE container.task ADD CONSTRAINT chk_task_priority CHECK (priority IN ('low', 'medium', 'high', 'urgent'));
@@ -0,0 +1,2 @@
+-- Drops the feed tokens; every feed URL stops working.
+DROP TABLE IF EXISTS container.feed_token;
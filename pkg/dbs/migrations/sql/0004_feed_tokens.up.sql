@@ -0,0 +1,9 @@
+-- Stores the hashed feed tokens that let calendar clients read the task feeds of a user.
+CREATE TABLE IF NOT EXISTS container.feed_token (
+  user_id bigint NOT NULL,
+  token_hash character(64) NOT NULL,
+  created_at timestamp with time zone NOT NULL,
+  CONSTRAINT pk_feed_token PRIMARY KEY (user_id),
+  CONSTRAINT fk_feed_token_user_id FOREIGN KEY (user_id) REFERENCES container.user(id) ON DELETE CASCADE
+);
+CREATE UNIQUE INDEX IF NOT EXISTS uq_feed_token_token_hash ON container.feed_token(token_hash);
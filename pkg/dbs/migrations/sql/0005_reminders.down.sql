@@ -0,0 +1,5 @@
+-- Drops the reminder settings and every inbox; the scheduler sends again the reminders still in its window.
+DROP INDEX IF EXISTS container.idx_task_target_date;
+DROP TABLE IF EXISTS container.email_delivery;
+DROP TABLE IF EXISTS container.notification;
+DROP TABLE IF EXISTS container.reminder_setting;
@@ -0,0 +1,46 @@
+-- Reminder settings of the users who changed the defaults; offsets are comma separated minutes before due dates.
+CREATE TABLE IF NOT EXISTS container.reminder_setting (
+  user_id bigint NOT NULL,
+  offsets_minutes character varying(100) NOT NULL DEFAULT '',
+  notify_overdue boolean NOT NULL,
+  email_enabled boolean NOT NULL,
+  updated_at timestamp with time zone NOT NULL,
+  CONSTRAINT pk_reminder_setting PRIMARY KEY (user_id),
+  CONSTRAINT fk_reminder_setting_user_id FOREIGN KEY (user_id) REFERENCES container.user(id) ON DELETE CASCADE
+);
+
+-- In-app inbox of the reminders sent by the scheduler. The unique index keeps replicas from sending a reminder twice.
+CREATE TABLE IF NOT EXISTS container.notification (
+  id uuid NOT NULL,
+  user_id bigint NOT NULL,
+  usergroup_id bigint NOT NULL,
+  task_id uuid NOT NULL,
+  kind character varying(20) NOT NULL,
+  offset_minutes int NOT NULL DEFAULT 0,
+  due_at timestamp with time zone NOT NULL,
+  title character varying(255) NOT NULL,
+  created_at timestamp with time zone NOT NULL,
+  read_at timestamp with time zone,
+  CONSTRAINT pk_notification PRIMARY KEY (id),
+  CONSTRAINT fk_notification_user_id FOREIGN KEY (user_id) REFERENCES container.user(id) ON DELETE CASCADE,
+  CONSTRAINT fk_notification_usergroup_id FOREIGN KEY (usergroup_id) REFERENCES container.usergroup(id) ON DELETE CASCADE,
+  CONSTRAINT fk_notification_task_id FOREIGN KEY (task_id) REFERENCES container.task(id) ON DELETE CASCADE,
+  CONSTRAINT chk_notification_kind CHECK (kind IN ('due_soon', 'overdue'))
+);
+CREATE UNIQUE INDEX IF NOT EXISTS uq_notification_reminder ON container.notification(user_id, task_id, kind, offset_minutes, due_at);
+CREATE INDEX IF NOT EXISTS idx_notification_user_id ON container.notification(user_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_task_target_date ON container.task(target_date) WHERE NOT is_completed AND deleted_at IS NULL;
+
+-- Reminder emails, retried by the email worker; next_attempt_at is NULL once the email was sent or given up on.
+CREATE TABLE IF NOT EXISTS container.email_delivery (
+  notification_id uuid NOT NULL,
+  status character varying(20) NOT NULL,
+  attempts int NOT NULL DEFAULT 0,
+  last_error text,
+  next_attempt_at timestamp with time zone,
+  created_at timestamp with time zone NOT NULL,
+  updated_at timestamp with time zone NOT NULL,
+  CONSTRAINT pk_email_delivery PRIMARY KEY (notification_id),
+  CONSTRAINT fk_email_delivery_notification_id FOREIGN KEY (notification_id) REFERENCES container.notification(id) ON DELETE CASCADE
+);
+CREATE INDEX IF NOT EXISTS idx_email_delivery_due ON container.email_delivery(next_attempt_at) WHERE next_attempt_at IS NOT NULL;
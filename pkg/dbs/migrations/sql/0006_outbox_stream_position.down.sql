@@ -0,0 +1,4 @@
+-- Drops the transaction ids of outbox events; event streams fall back to id order.
+DROP INDEX IF EXISTS container.idx_outbox_event_usergroup_position;
+ALTER TABLE container.outbox_event DROP COLUMN IF EXISTS tx_id;
+CREATE INDEX IF NOT EXISTS idx_outbox_event_usergroup_id ON container.outbox_event(usergroup_id, id);
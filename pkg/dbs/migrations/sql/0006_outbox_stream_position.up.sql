@@ -0,0 +1,6 @@
+-- Records the transaction that stored each outbox event. Event streams read events in (tx_id, id) order and hold back
+-- those of transactions newer than the oldest one still running, so an event committed late is not skipped.
+-- Existing events all get the id of this migration's transaction and keep their id order.
+ALTER TABLE container.outbox_event ADD COLUMN IF NOT EXISTS tx_id xid8 NOT NULL DEFAULT pg_current_xact_id();
+DROP INDEX IF EXISTS container.idx_outbox_event_usergroup_id;
+CREATE INDEX IF NOT EXISTS idx_outbox_event_usergroup_position ON container.outbox_event(usergroup_id, tx_id, id); -- replay for event streams
@@ -0,0 +1,96 @@
+package dbs
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+)
+
+// DBTX is the subset of *sql.DB and *sql.Tx used by repositories,
+// so the same repository can run against the pool or inside a transaction.
+type DBTX interface {
+	Exec(query string, args ...any) (sql.Result, error)
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	Query(query string, args ...any) (*sql.Rows, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRow(query string, args ...any) *sql.Row
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+// UnitOfWork runs a group of repository calls atomically.
+// fn receives the transaction; repositories are bound to it with WithTx.
+// Returning an error from fn rolls everything back.
+type UnitOfWork interface {
+	Do(fn func(tx DBTX) error) error
+}
+
+type sqlUnitOfWork struct {
+	db DBTX
+}
+
+// NewUnitOfWork creates a unit of work over a *sql.DB or an open *sql.Tx.
+// Over a *sql.DB each Do begins and commits its own transaction.
+// Over a *sql.Tx each Do runs inside a savepoint, so the outer transaction
+// (e.g. one rolled back at the end of an integration test) stays in control.
+func NewUnitOfWork(db DBTX) UnitOfWork {
+	return &sqlUnitOfWork{db: db}
+}
+
+// RunInTx is a shorthand for NewUnitOfWork(db).Do(fn)
+func RunInTx(db DBTX, fn func(tx DBTX) error) error {
+	return NewUnitOfWork(db).Do(fn)
+}
+
+func (u *sqlUnitOfWork) Do(fn func(tx DBTX) error) error {
+	switch db := u.db.(type) {
+	case *sql.DB:
+		return runInTransaction(db, fn)
+	case *sql.Tx:
+		return runInSavepoint(db, fn)
+	default:
+		// Unknown executors (e.g. test doubles) cannot start a transaction
+		return fn(u.db)
+	}
+}
+
+func runInTransaction(db *sql.DB, fn func(tx DBTX) error) (err error) {
+	tx, err := db.Begin()
+	if err != nil {
+		return fmt.Errorf("unable to begin transaction : %w", err)
+	}
+	defer func() {
+		if p := recover(); p != nil {
+			tx.Rollback()
+			panic(p)
+		}
+	}()
+
+	if err = fn(tx); err != nil {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			return fmt.Errorf("%w (rollback failure: %v)", err, rbErr)
+		}
+		return err
+	}
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("commit failure: %w", err)
+	}
+	return nil
+}
+
+const savepointName = "unit_of_work"
+
+func runInSavepoint(tx *sql.Tx, fn func(tx DBTX) error) error {
+	if _, err := tx.Exec("SAVEPOINT " + savepointName); err != nil {
+		return fmt.Errorf("unable to create savepoint : %w", err)
+	}
+	if err := fn(tx); err != nil {
+		if _, rbErr := tx.Exec("ROLLBACK TO SAVEPOINT " + savepointName); rbErr != nil {
+			return fmt.Errorf("%w (rollback failure: %v)", err, rbErr)
+		}
+		return err
+	}
+	if _, err := tx.Exec("RELEASE SAVEPOINT " + savepointName); err != nil {
+		return fmt.Errorf("unable to release savepoint : %w", err)
+	}
+	return nil
+}
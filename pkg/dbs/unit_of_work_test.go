@@ -0,0 +1,74 @@
+package dbs
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnitOfWork(t *testing.T) {
+	t.Run("when work succeeds over a connection, Then the transaction is committed", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+		mock.ExpectBegin()
+		mock.ExpectExec("INSERT INTO a").WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec("INSERT INTO b").WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+
+		err = NewUnitOfWork(db).Do(func(tx DBTX) error {
+			if _, err := tx.Exec("INSERT INTO a"); err != nil {
+				return err
+			}
+			_, err := tx.Exec("INSERT INTO b")
+			return err
+		})
+
+		require.NoError(t, err)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("when work fails over a connection, Then the transaction is rolled back", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+		mock.ExpectBegin()
+		mock.ExpectExec("INSERT INTO a").WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec("INSERT INTO b").WillReturnError(errors.New("constraint violation"))
+		mock.ExpectRollback()
+
+		err = NewUnitOfWork(db).Do(func(tx DBTX) error {
+			if _, err := tx.Exec("INSERT INTO a"); err != nil {
+				return err
+			}
+			_, err := tx.Exec("INSERT INTO b")
+			return err
+		})
+
+		assert.EqualError(t, err, "constraint violation")
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("when work fails inside an open transaction, Then only its savepoint is rolled back", func(t *testing.T) {
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+		require.NoError(t, err)
+		defer db.Close()
+		mock.ExpectBegin()
+		mock.ExpectExec("SAVEPOINT unit_of_work").WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec("ROLLBACK TO SAVEPOINT unit_of_work").WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectRollback()
+		tx, err := db.Begin()
+		require.NoError(t, err)
+
+		err = RunInTx(tx, func(tx DBTX) error {
+			return errors.New("domain error")
+		})
+
+		assert.EqualError(t, err, "domain error")
+		require.NoError(t, tx.Rollback())
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
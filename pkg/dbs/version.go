@@ -0,0 +1,7 @@
+package dbs
+
+import "errors"
+
+// ErrVersionConflict is returned when a conditional write finds that the row's version has moved on,
+// i.e. someone else changed the resource since the caller read it
+var ErrVersionConflict = errors.New("the resource has been modified since it was read")
@@ -13,6 +13,9 @@ const (
 
 	PermissionDenied = "Permission denied"
 
+	PreconditionRequired = "Precondition required"
+	PreconditionFailed   = "Precondition failed"
+
 	// DB
 	RecordNotFound           = "record not found"
 	BeginTransactionFailure  = "begin transaction failure"
@@ -0,0 +1,30 @@
+package events
+
+// Event is a fact raised by an aggregate, e.g. a task being completed.
+// Events are serialized to JSON as their payload, so their fields need json tags.
+type Event interface {
+	// EventType names the event, e.g. "task.completed"
+	EventType() string
+	// AggregateType names the kind of aggregate that raised the event, e.g. "task"
+	AggregateType() string
+	// AggregateId identifies the aggregate that raised the event
+	AggregateId() string
+}
+
+// Aggregate collects the events raised by an aggregate root until they are pulled into the outbox.
+// Embed it in the aggregate; the zero value is ready to use and serializes to nothing.
+type Aggregate struct {
+	pending []Event
+}
+
+// Raise records an event to be published once the aggregate is persisted
+func (a *Aggregate) Raise(event Event) {
+	a.pending = append(a.pending, event)
+}
+
+// PullEvents returns the events raised so far and clears them
+func (a *Aggregate) PullEvents() []Event {
+	pulled := a.pending
+	a.pending = nil
+	return pulled
+}
@@ -0,0 +1,45 @@
+package events
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type thingHappened struct {
+	Id string `json:"id"`
+}
+
+func (e thingHappened) EventType() string     { return "thing.happened" }
+func (e thingHappened) AggregateType() string { return "thing" }
+func (e thingHappened) AggregateId() string   { return e.Id }
+
+func TestAggregate(t *testing.T) {
+	t.Run("when events are pulled, Then they are returned in order and cleared", func(t *testing.T) {
+		// Arrange
+		var aggregate Aggregate
+		aggregate.Raise(thingHappened{Id: "1"})
+		aggregate.Raise(thingHappened{Id: "2"})
+
+		// Act
+		pulled := aggregate.PullEvents()
+
+		// Assert
+		assert.Equal(t, []Event{thingHappened{Id: "1"}, thingHappened{Id: "2"}}, pulled)
+		assert.Empty(t, aggregate.PullEvents())
+	})
+
+	t.Run("when embedded in an aggregate, Then it is left out of its JSON", func(t *testing.T) {
+		thing := struct {
+			Aggregate
+			Name string `json:"name"`
+		}{Name: "thing"}
+		thing.Raise(thingHappened{Id: "1"})
+
+		raw, err := json.Marshal(thing)
+
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"name":"thing"}`, string(raw))
+	})
+}
@@ -2,6 +2,9 @@ package middlewares
 
 import (
 	"net/http"
+	"net/url"
+	"runtime"
+	"strings"
 	"time"
 
 	"github.com/go-chi/chi/v5/middleware"
@@ -20,7 +23,7 @@ func Logger(logger *loggers.AppLogger) func(http.Handler) http.Handler {
 					Int("bytes", ww.BytesWritten()).
 					Str("method", r.Method).
 					Str("path", r.URL.Path).
-					Str("query", r.URL.RawQuery).
+					Str("query", redactQuery(r.URL.RawQuery)).
 					Str("ip", r.RemoteAddr).
 					// Str("trace.id", trace.SpanFromContext(r.Context()).SpanContext().TraceID().String()).
 					Str("user-agent", r.UserAgent()).
@@ -33,3 +36,34 @@ func Logger(logger *loggers.AppLogger) func(http.Handler) http.Handler {
 		return http.HandlerFunc(fn)
 	}
 }
+
+// ConsoleLogger prints a line per request like chi's middleware.Logger, with credentials redacted from the URI
+func ConsoleLogger(out middleware.LoggerInterface) func(http.Handler) http.Handler {
+	return middleware.RequestLogger(&redactingFormatter{
+		LogFormatter: &middleware.DefaultLogFormatter{Logger: out, NoColor: runtime.GOOS == "windows"},
+	})
+}
+
+type redactingFormatter struct {
+	middleware.LogFormatter
+}
+
+func (f *redactingFormatter) NewLogEntry(r *http.Request) middleware.LogEntry {
+	path, rawQuery, found := strings.Cut(r.RequestURI, "?")
+	if !found {
+		return f.LogFormatter.NewLogEntry(r)
+	}
+	redacted := r.WithContext(r.Context())
+	redacted.RequestURI = path + "?" + redactQuery(rawQuery)
+	return f.LogFormatter.NewLogEntry(redacted)
+}
+
+// redactQuery hides the values of credentials passed in the query string, such as the token of calendar feeds
+func redactQuery(rawQuery string) string {
+	query, err := url.ParseQuery(rawQuery)
+	if err != nil || !query.Has("token") {
+		return rawQuery
+	}
+	query.Set("token", "REDACTED")
+	return query.Encode()
+}
@@ -0,0 +1,52 @@
+package middlewares
+
+import (
+	"bytes"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConsoleLogger(t *testing.T) {
+	t.Run("when a feed is requested with its token, Then the token is not logged", func(t *testing.T) {
+		// Arrange
+		var out bytes.Buffer
+		handler := ConsoleLogger(log.New(&out, "", 0))(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		}))
+		req := httptest.NewRequest(http.MethodGet, "/api/users/u1/tasks.ics?token=s3cr3t-feed-token&component=todo", nil)
+
+		// Act
+		handler.ServeHTTP(httptest.NewRecorder(), req)
+
+		// Assert
+		assert.NotContains(t, out.String(), "s3cr3t-feed-token")
+		assert.Contains(t, out.String(), "/api/users/u1/tasks.ics?component=todo&token=REDACTED")
+		assert.Equal(t, "/api/users/u1/tasks.ics?token=s3cr3t-feed-token&component=todo", req.RequestURI)
+	})
+
+	t.Run("when the request has no query, Then the URI is logged as is", func(t *testing.T) {
+		// Arrange
+		var out bytes.Buffer
+		handler := ConsoleLogger(log.New(&out, "", 0))(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+
+		// Act
+		handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/api/tasks", nil))
+
+		// Assert
+		assert.Contains(t, out.String(), "/api/tasks HTTP/1.1")
+	})
+}
+
+func TestRedactQuery(t *testing.T) {
+	t.Run("when the query has a token, Then its value is redacted", func(t *testing.T) {
+		assert.Equal(t, "component=todo&token=REDACTED", redactQuery("token=s3cr3t&component=todo"))
+	})
+
+	t.Run("when the query has no token, Then it is left as is", func(t *testing.T) {
+		assert.Equal(t, "limit=10&cursor=abc", redactQuery("limit=10&cursor=abc"))
+	})
+}
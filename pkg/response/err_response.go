@@ -33,3 +33,15 @@ func NotFound(w http.ResponseWriter, err_code string, details ...string) {
 	p := New(err_code, "Not found", details...)
 	ErrorResponse(w, http.StatusNotFound, *p)
 }
+func PreconditionRequired(w http.ResponseWriter, details ...string) {
+	p := New(constants.PreconditionRequired, errors.PreconditionRequired, details...)
+	ErrorResponse(w, http.StatusPreconditionRequired, *p)
+}
+func PreconditionFailed(w http.ResponseWriter, details ...string) {
+	p := New(constants.PreconditionFailed, errors.PreconditionFailed, details...)
+	ErrorResponse(w, http.StatusPreconditionFailed, *p)
+}
+func Forbidden(w http.ResponseWriter, err_code string, details ...string) {
+	p := New(err_code, errors.PermissionDenied, details...)
+	ErrorResponse(w, http.StatusForbidden, *p)
+}
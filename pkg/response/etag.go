@@ -0,0 +1,77 @@
+package response
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/happYness-Project/taskManagementGolang/pkg/constants"
+	"github.com/happYness-Project/taskManagementGolang/pkg/dbs"
+	"github.com/happYness-Project/taskManagementGolang/pkg/loggers"
+)
+
+var (
+	ErrIfMatchRequired = errors.New("If-Match header is required; send the ETag of the resource you read")
+	ErrIfMatchFailed   = errors.New("If-Match does not match the current version of the resource")
+)
+
+// SetETag sets the ETag header of a versioned resource
+func SetETag(w http.ResponseWriter, version int) {
+	w.Header().Set("ETag", ETag(version))
+}
+
+// ETag formats a resource version as a strong entity tag
+func ETag(version int) string {
+	return `"` + strconv.Itoa(version) + `"`
+}
+
+// IfMatchVersion returns the version a conditional request expects, or 0 for "If-Match: *".
+// Weak and malformed tags never match, as If-Match uses strong comparison.
+func IfMatchVersion(r *http.Request) (int, error) {
+	value := strings.TrimSpace(r.Header.Get("If-Match"))
+	if value == "" {
+		return 0, ErrIfMatchRequired
+	}
+	if value == "*" {
+		return 0, nil
+	}
+	if len(value) < 2 || value[0] != '"' || value[len(value)-1] != '"' {
+		return 0, ErrIfMatchFailed
+	}
+	version, err := strconv.Atoi(value[1 : len(value)-1])
+	if err != nil || version < 1 {
+		return 0, ErrIfMatchFailed
+	}
+	return version, nil
+}
+
+// PreconditionError writes 428 when If-Match is missing and 412 otherwise
+func PreconditionError(w http.ResponseWriter, err error) {
+	if errors.Is(err, ErrIfMatchRequired) {
+		PreconditionRequired(w, err.Error())
+		return
+	}
+	PreconditionFailed(w, err.Error())
+}
+
+// IfMatch returns the resource version a write expects, answering 428 or 412 itself when If-Match is missing or unusable
+func IfMatch(w http.ResponseWriter, r *http.Request, logger *loggers.AppLogger) (int, bool) {
+	version, err := IfMatchVersion(r)
+	if err != nil {
+		logger.Error().Err(err).Str("ErrorCode", constants.PreconditionFailed).Msg(err.Error())
+		PreconditionError(w, err)
+		return 0, false
+	}
+	return version, true
+}
+
+// VersionConflict answers 412 when the resource changed after the requester read it
+func VersionConflict(w http.ResponseWriter, err error, logger *loggers.AppLogger) bool {
+	if !errors.Is(err, dbs.ErrVersionConflict) {
+		return false
+	}
+	logger.Error().Err(err).Str("ErrorCode", constants.PreconditionFailed).Msg(err.Error())
+	PreconditionFailed(w, err.Error())
+	return true
+}
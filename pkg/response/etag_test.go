@@ -0,0 +1,110 @@
+package response
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/happYness-Project/taskManagementGolang/pkg/configs"
+	"github.com/happYness-Project/taskManagementGolang/pkg/dbs"
+	"github.com/happYness-Project/taskManagementGolang/pkg/loggers"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIfMatchVersion(t *testing.T) {
+	cases := []struct {
+		name    string
+		header  string
+		version int
+		err     error
+	}{
+		{name: "when If-Match is missing, Then it is required", header: "", err: ErrIfMatchRequired},
+		{name: "when If-Match is a strong tag, Then its version is returned", header: `"7"`, version: 7},
+		{name: "when If-Match is a wildcard, Then any version matches", header: "*", version: 0},
+		{name: "when If-Match is a weak tag, Then it never matches", header: `W/"7"`, err: ErrIfMatchFailed},
+		{name: "when If-Match is not a version, Then it never matches", header: `"abc"`, err: ErrIfMatchFailed},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			// Arrange
+			r := httptest.NewRequest(http.MethodPut, "/", nil)
+			if c.header != "" {
+				r.Header.Set("If-Match", c.header)
+			}
+
+			// Act
+			version, err := IfMatchVersion(r)
+
+			// Assert
+			assert.ErrorIs(t, err, c.err)
+			assert.Equal(t, c.version, version)
+		})
+	}
+}
+
+func TestPreconditionError(t *testing.T) {
+	t.Run("when If-Match is missing, Then 428 is written", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+
+		PreconditionError(rr, ErrIfMatchRequired)
+
+		assert.Equal(t, http.StatusPreconditionRequired, rr.Code)
+	})
+
+	t.Run("when If-Match does not match, Then 412 is written", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+
+		PreconditionError(rr, ErrIfMatchFailed)
+
+		assert.Equal(t, http.StatusPreconditionFailed, rr.Code)
+	})
+}
+
+func TestIfMatch(t *testing.T) {
+	logger := loggers.Setup(configs.Env{})
+
+	t.Run("when If-Match is missing, Then 428 is written", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+
+		_, ok := IfMatch(rr, httptest.NewRequest(http.MethodPut, "/", nil), logger)
+
+		assert.False(t, ok)
+		assert.Equal(t, http.StatusPreconditionRequired, rr.Code)
+	})
+
+	t.Run("when If-Match is a strong tag, Then its version is returned and nothing is written", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodPut, "/", nil)
+		r.Header.Set("If-Match", `"3"`)
+
+		version, ok := IfMatch(rr, r, logger)
+
+		assert.True(t, ok)
+		assert.Equal(t, 3, version)
+		assert.Zero(t, rr.Body.Len())
+	})
+}
+
+func TestVersionConflict(t *testing.T) {
+	logger := loggers.Setup(configs.Env{})
+
+	t.Run("when the resource changed, Then 412 is written", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+
+		handled := VersionConflict(rr, fmt.Errorf("failed to update task: %w", dbs.ErrVersionConflict), logger)
+
+		assert.True(t, handled)
+		assert.Equal(t, http.StatusPreconditionFailed, rr.Code)
+	})
+
+	t.Run("when the error is something else, Then nothing is written", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+
+		handled := VersionConflict(rr, errors.New("boom"), logger)
+
+		assert.False(t, handled)
+		assert.Zero(t, rr.Body.Len())
+	})
+}
@@ -0,0 +1,63 @@
+package response
+
+import (
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+const (
+	ContentTypeJSON = "application/json"
+	ContentTypeCSV  = "text/csv"
+)
+
+// Negotiate returns the offered media type the Accept header of the request prefers; ties go to the earlier offer.
+// Without an Accept header, or when it accepts none of the offers, the first offer is returned:
+// it is what the endpoint answered before it offered anything else.
+func Negotiate(r *http.Request, offers ...string) string {
+	header := r.Header.Get("Accept")
+	if strings.TrimSpace(header) == "" {
+		return offers[0]
+	}
+
+	best, bestQuality := offers[0], 0.0
+	for _, offer := range offers {
+		if quality := acceptedQuality(header, offer); quality > bestQuality {
+			best, bestQuality = offer, quality
+		}
+	}
+	return best
+}
+
+// acceptedQuality returns the quality the Accept header gives the offer, taken from the most specific media range
+// matching it: the exact type, then type/*, then */*. It is 0 when no range matches.
+func acceptedQuality(header string, offer string) float64 {
+	quality, specificity := 0.0, -1
+	for _, accepted := range strings.Split(header, ",") {
+		mediaType, params, err := mime.ParseMediaType(strings.TrimSpace(accepted))
+		if err != nil {
+			continue
+		}
+		rangeSpecificity := -1
+		switch {
+		case mediaType == offer:
+			rangeSpecificity = 2
+		case strings.HasSuffix(mediaType, "/*") && strings.HasPrefix(offer, strings.TrimSuffix(mediaType, "*")):
+			rangeSpecificity = 1
+		case mediaType == "*/*":
+			rangeSpecificity = 0
+		}
+		if rangeSpecificity <= specificity {
+			continue
+		}
+		q := 1.0
+		if v, ok := params["q"]; ok {
+			if q, err = strconv.ParseFloat(v, 64); err != nil {
+				continue
+			}
+		}
+		quality, specificity = q, rangeSpecificity
+	}
+	return quality
+}
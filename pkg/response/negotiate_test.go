@@ -0,0 +1,41 @@
+package response
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNegotiate(t *testing.T) {
+	cases := []struct {
+		name   string
+		accept string
+		want   string
+	}{
+		{name: "when Accept is missing, Then the first offer is returned", accept: "", want: ContentTypeJSON},
+		{name: "when Accept names an offer, Then it is returned", accept: "text/csv", want: ContentTypeCSV},
+		{name: "when Accept names an offer with parameters, Then it is returned", accept: "text/csv; charset=utf-8", want: ContentTypeCSV},
+		{name: "when Accept prefers an offer by quality, Then it is returned", accept: "application/json;q=0.5, text/csv", want: ContentTypeCSV},
+		{name: "when Accept matches offers equally, Then the first is returned", accept: "*/*", want: ContentTypeJSON},
+		{name: "when a wildcard is preferred to an exact type, Then the exact type keeps its own quality", accept: "text/*, text/csv;q=0.1, application/json;q=0.5", want: ContentTypeJSON},
+		{name: "when Accept refuses an offer, Then the other is returned", accept: "*/*, application/json;q=0", want: ContentTypeCSV},
+		{name: "when Accept matches no offer, Then the first offer is returned", accept: "image/png", want: ContentTypeJSON},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			// Arrange
+			r := httptest.NewRequest(http.MethodGet, "/", nil)
+			if c.accept != "" {
+				r.Header.Set("Accept", c.accept)
+			}
+
+			// Act
+			got := Negotiate(r, ContentTypeJSON, ContentTypeCSV)
+
+			// Assert
+			assert.Equal(t, c.want, got)
+		})
+	}
+}
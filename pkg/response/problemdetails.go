@@ -6,6 +6,13 @@ type ProblemDetails struct {
 	ErrorCode string `json:"error_code"`
 	Detail    string `json:"detail,omitempty"`
 	Instance  string `json:"instance,omitempty"`
+	// Errors lists the individual request fields that were rejected
+	Errors []FieldError `json:"errors,omitempty"`
+}
+
+type FieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
 }
 
 func New(errorCode string, title string, detail ...string) *ProblemDetails {
@@ -23,3 +30,7 @@ func (p *ProblemDetails) WithInstance(i string) *ProblemDetails {
 	p.Instance = i
 	return p
 }
+func (p *ProblemDetails) WithErrors(errs ...FieldError) *ProblemDetails {
+	p.Errors = append(p.Errors, errs...)
+	return p
+}
@@ -18,3 +18,16 @@ func FormatTimeToISO(timeToFormat time.Time) string {
 func CurrentISOTime() string {
 	return FormatTimeToISO(time.Now().UTC())
 }
+
+// ExponentialBackoff returns the wait before retrying after the given number of failed attempts:
+// base after the first failure, doubling with each further one, never more than max.
+func ExponentialBackoff(attempts int, base, max time.Duration) time.Duration {
+	delay := base
+	for i := 1; i < attempts && delay < max; i++ {
+		delay *= 2
+	}
+	if delay > max {
+		return max
+	}
+	return delay
+}
@@ -15,3 +15,14 @@ func Test_FormatTimeToISO_Success(t *testing.T) {
 		t.Errorf("Expected '%s', but got '%s'", want, got)
 	}
 }
+
+func Test_ExponentialBackoff(t *testing.T) {
+	base, max := 5*time.Second, time.Minute
+	cases := map[int]time.Duration{1: 5 * time.Second, 2: 10 * time.Second, 4: 40 * time.Second, 5: time.Minute, 50: time.Minute}
+
+	for attempts, want := range cases {
+		if got := utils.ExponentialBackoff(attempts, base, max); got != want {
+			t.Errorf("ExponentialBackoff(%d) = %v, want %v", attempts, got, want)
+		}
+	}
+}
@@ -19,7 +19,6 @@ func NewTaskBuilder() *TaskBuilder {
 		"Test Description",
 		time.Now().Add(24*time.Hour), // Due tomorrow
 		"medium",
-		"work",
 	)
 
 	return &TaskBuilder{
@@ -47,15 +46,15 @@ func (b *TaskBuilder) WithDescription(desc string) *TaskBuilder {
 // WithPriority sets the task priority
 func (b *TaskBuilder) WithPriority(priority string) *TaskBuilder {
 	if b.task != nil {
-		b.task.Priority = priority
+		b.task.Priority = domain.Priority(priority)
 	}
 	return b
 }
 
-// WithCategory sets the task category
-func (b *TaskBuilder) WithCategory(category string) *TaskBuilder {
+// WithLabels sets the task labels
+func (b *TaskBuilder) WithLabels(labels ...domain.Label) *TaskBuilder {
 	if b.task != nil {
-		b.task.Category = category
+		b.task.Labels = labels
 	}
 	return b
 }
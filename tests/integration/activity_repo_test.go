@@ -0,0 +1,73 @@
+package integration
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/happYness-Project/taskManagementGolang/internal/audit/domain"
+	auditRepo "github.com/happYness-Project/taskManagementGolang/internal/audit/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestActivityRepository_AppendAndList(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	t.Run("should list a group's activity newest first with filters and cursor", func(t *testing.T) {
+		cleanup := setupTest(t)
+		defer cleanup()
+
+		// Arrange
+		actorId := uuid.NewString()
+		now := time.Now().UTC()
+		for i, aggregateType := range []string{domain.AggregateTask, domain.AggregateTaskContainer, domain.AggregateTask} {
+			_, err := repos.ActivityRepo.Append(domain.Activity{
+				GroupId:       1,
+				ActorId:       actorId,
+				Action:        "update_task",
+				AggregateType: aggregateType,
+				AggregateId:   uuid.NewString(),
+				After:         json.RawMessage(`{"n":1}`),
+				OccurredAt:    now.Add(time.Duration(i) * time.Second),
+			})
+			require.NoError(t, err)
+		}
+		_, err := repos.ActivityRepo.Append(domain.Activity{GroupId: 2, ActorId: actorId, Action: "create_task", AggregateType: domain.AggregateTask, AggregateId: uuid.NewString(), OccurredAt: now})
+		require.NoError(t, err)
+
+		// Act
+		all, err := repos.ActivityRepo.ListByGroupId(auditRepo.ActivityFilter{GroupId: 1, Limit: 10})
+		require.NoError(t, err)
+		tasksOnly, err := repos.ActivityRepo.ListByGroupId(auditRepo.ActivityFilter{GroupId: 1, AggregateType: domain.AggregateTask, Limit: 10})
+		require.NoError(t, err)
+		older, err := repos.ActivityRepo.ListByGroupId(auditRepo.ActivityFilter{GroupId: 1, BeforeId: all[0].Id, Limit: 10})
+		require.NoError(t, err)
+
+		// Assert
+		require.Len(t, all, 3)
+		assert.Greater(t, all[0].Id, all[1].Id)
+		assert.JSONEq(t, `{"n":1}`, string(all[0].After))
+		assert.Empty(t, all[0].Before)
+		assert.Len(t, tasksOnly, 2)
+		assert.Len(t, older, 2)
+	})
+
+	t.Run("should reject updates to recorded activity", func(t *testing.T) {
+		cleanup := setupTest(t)
+		defer cleanup()
+
+		// Arrange
+		id, err := repos.ActivityRepo.Append(domain.Activity{GroupId: 1, ActorId: uuid.NewString(), Action: "delete_task", AggregateType: domain.AggregateTask, AggregateId: uuid.NewString(), OccurredAt: time.Now().UTC()})
+		require.NoError(t, err)
+
+		// Act
+		_, err = repos.ActivityRepo.DB.Exec(`UPDATE container.activity SET action = 'x' WHERE id = $1`, id)
+
+		// Assert
+		assert.Error(t, err)
+	})
+}
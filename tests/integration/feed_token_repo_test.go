@@ -0,0 +1,62 @@
+package integration
+
+import (
+	"testing"
+
+	"github.com/happYness-Project/taskManagementGolang/internal/feed/domain"
+	"github.com/happYness-Project/taskManagementGolang/tests/builders"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFeedTokenRepository(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	t.Run("should replace the previous token of a user when a new one is saved", func(t *testing.T) {
+		cleanup := setupTest(t)
+		defer cleanup()
+
+		// Arrange
+		user := builders.NewUserBuilder().Build()
+		require.NoError(t, repos.UserRepo.CreateUser(*user))
+		first, firstToken, err := domain.NewFeedToken(user.UserId)
+		require.NoError(t, err)
+		second, secondToken, err := domain.NewFeedToken(user.UserId)
+		require.NoError(t, err)
+
+		// Act
+		require.NoError(t, repos.FeedTokenRepo.SaveToken(*first))
+		require.NoError(t, repos.FeedTokenRepo.SaveToken(*second))
+
+		// Assert
+		revoked, err := repos.FeedTokenRepo.GetByHash(domain.HashFeedToken(firstToken))
+		require.NoError(t, err)
+		assert.Nil(t, revoked)
+		found, err := repos.FeedTokenRepo.GetByHash(domain.HashFeedToken(secondToken))
+		require.NoError(t, err)
+		require.NotNil(t, found)
+		assert.Equal(t, user.UserId, found.UserId)
+	})
+
+	t.Run("should report a missing token when deleting it twice", func(t *testing.T) {
+		cleanup := setupTest(t)
+		defer cleanup()
+
+		// Arrange
+		user := builders.NewUserBuilder().Build()
+		require.NoError(t, repos.UserRepo.CreateUser(*user))
+		token, _, err := domain.NewFeedToken(user.UserId)
+		require.NoError(t, err)
+		require.NoError(t, repos.FeedTokenRepo.SaveToken(*token))
+
+		// Act
+		firstErr := repos.FeedTokenRepo.DeleteToken(user.UserId)
+		secondErr := repos.FeedTokenRepo.DeleteToken(user.UserId)
+
+		// Assert
+		assert.NoError(t, firstErr)
+		assert.ErrorIs(t, secondErr, domain.ErrFeedTokenNotFound)
+	})
+}
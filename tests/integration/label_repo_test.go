@@ -0,0 +1,104 @@
+package integration
+
+import (
+	"testing"
+
+	labelDomain "github.com/happYness-Project/taskManagementGolang/internal/label/domain"
+	taskRepo "github.com/happYness-Project/taskManagementGolang/internal/task/repository"
+	"github.com/happYness-Project/taskManagementGolang/tests/builders"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLabelRepository(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	// setupLabels creates a container and the named labels in its group
+	setupLabels := func(t *testing.T, names ...string) (string, []labelDomain.Label) {
+		t.Helper()
+		containerId := setupTaskEnvironment(t)
+		container, err := repos.TaskContainerRepo.GetById(containerId)
+		require.NoError(t, err)
+
+		labels := make([]labelDomain.Label, 0, len(names))
+		for _, name := range names {
+			label, err := labelDomain.NewLabel(container.UsergroupId, name, "")
+			require.NoError(t, err)
+			require.NoError(t, repos.LabelRepo.CreateLabel(*label))
+			labels = append(labels, *label)
+		}
+		return containerId, labels
+	}
+
+	t.Run("should list the labels of a group by name and reject a duplicate name", func(t *testing.T) {
+		cleanup := setupTest(t)
+		defer cleanup()
+
+		// Arrange
+		_, labels := setupLabels(t, "chores", "Bills")
+		duplicate, err := labelDomain.NewLabel(labels[0].UsergroupId, "CHORES", "")
+		require.NoError(t, err)
+
+		// Act
+		found, err := repos.LabelRepo.GetByGroupId(labels[0].UsergroupId)
+		duplicateErr := repos.LabelRepo.CreateLabel(*duplicate)
+
+		// Assert
+		require.NoError(t, err)
+		require.Len(t, found, 2)
+		assert.Equal(t, "Bills", found[0].Name)
+		assert.Equal(t, labelDomain.DefaultColor, found[1].Color)
+		assert.Error(t, duplicateErr)
+	})
+
+	t.Run("should save task labels and filter tasks carrying all of them", func(t *testing.T) {
+		cleanup := setupTest(t)
+		defer cleanup()
+
+		// Arrange
+		containerId, labels := setupLabels(t, "grocery", "urgent errand")
+		both := builders.NewTaskBuilder().WithName("Both").WithLabels(labelDomain.TaskLabels(labels)...).MustBuild()
+		one := builders.NewTaskBuilder().WithName("One").WithLabels(labels[0].TaskLabel()).MustBuild()
+		_, err := repos.TaskRepo.CreateTask(containerId, *both)
+		require.NoError(t, err)
+		_, err = repos.TaskRepo.CreateTask(containerId, *one)
+		require.NoError(t, err)
+
+		// Act
+		tasks, err := repos.TaskRepo.ListTasks(taskRepo.TaskListCriteria{
+			ContainerId: containerId,
+			Filter:      taskRepo.TaskFilter{LabelIds: []string{labels[0].Id, labels[1].Id}},
+			Limit:       10,
+		})
+
+		// Assert
+		require.NoError(t, err)
+		require.Len(t, tasks, 1)
+		assert.Equal(t, both.TaskId, tasks[0].TaskId)
+		assert.Len(t, tasks[0].Labels, 2)
+	})
+
+	t.Run("should detach a deleted label from its tasks", func(t *testing.T) {
+		cleanup := setupTest(t)
+		defer cleanup()
+
+		// Arrange
+		containerId, labels := setupLabels(t, "grocery")
+		task := builders.NewTaskBuilder().WithLabels(labels[0].TaskLabel()).MustBuild()
+		_, err := repos.TaskRepo.CreateTask(containerId, *task)
+		require.NoError(t, err)
+
+		// Act
+		err = repos.LabelRepo.DeleteLabel(labels[0].Id)
+		deleteAgainErr := repos.LabelRepo.DeleteLabel(labels[0].Id)
+
+		// Assert
+		require.NoError(t, err)
+		assert.ErrorIs(t, deleteAgainErr, labelDomain.ErrLabelNotFound)
+		found, err := repos.TaskRepo.GetTaskById(task.TaskId)
+		require.NoError(t, err)
+		assert.Empty(t, found.Labels)
+	})
+}
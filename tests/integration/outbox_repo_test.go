@@ -0,0 +1,207 @@
+package integration
+
+import (
+	"testing"
+	"time"
+
+	outboxDomain "github.com/happYness-Project/taskManagementGolang/internal/outbox/domain"
+	outboxRepo "github.com/happYness-Project/taskManagementGolang/internal/outbox/repository"
+	"github.com/happYness-Project/taskManagementGolang/internal/task/application/command"
+	taskDomain "github.com/happYness-Project/taskManagementGolang/internal/task/domain"
+	"github.com/happYness-Project/taskManagementGolang/pkg/events"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOutboxRepository(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	t.Run("should store the events of a created task in the same transaction", func(t *testing.T) {
+		cleanup := setupTest(t)
+		defer cleanup()
+
+		// Arrange
+		containerId := setupTaskEnvironment(t)
+		handler := command.NewCreateTaskCommandHandler(repos.TaskRepo, repos.OutboxRepo, repos.UnitOfWork)
+
+		// Act
+		task, err := handler.Handle(command.CreateTaskCommand{ContainerId: containerId, TaskName: "Water plants"})
+		require.NoError(t, err)
+		pending, err := repos.OutboxRepo.ClaimPending(time.Now().UTC(), time.Now().UTC().Add(time.Minute), 10)
+
+		// Assert
+		require.NoError(t, err)
+		require.Len(t, pending, 1)
+		assert.Equal(t, taskDomain.EventTaskCreated, pending[0].EventType)
+		assert.Equal(t, task.TaskId, pending[0].AggregateId)
+		assert.NotZero(t, pending[0].GroupId)
+		assert.Contains(t, string(pending[0].Payload), "Water plants")
+	})
+
+	t.Run("should not hand out dispatched messages or failed ones before their retry time", func(t *testing.T) {
+		cleanup := setupTest(t)
+		defer cleanup()
+
+		// Arrange
+		now := time.Now().UTC()
+		for _, id := range []string{"a", "b", "c"} {
+			require.NoError(t, repos.OutboxRepo.Append(1, taskDomain.TaskCompleted{TaskId: id}))
+		}
+		pending, err := repos.OutboxRepo.ClaimPending(now.Add(time.Second), now.Add(30*time.Second), 10)
+		require.NoError(t, err)
+		require.Len(t, pending, 3)
+		retryAt := now.Add(time.Hour)
+
+		// Act
+		require.NoError(t, repos.OutboxRepo.MarkDispatched(pending[0].Id, now))
+		require.NoError(t, repos.OutboxRepo.MarkFailed(pending[1].Id, "sink down", &retryAt))
+		require.NoError(t, repos.OutboxRepo.MarkFailed(pending[2].Id, "sink down", nil))
+
+		// Assert
+		due, err := repos.OutboxRepo.ClaimPending(now.Add(time.Minute), now.Add(2*time.Minute), 10)
+		require.NoError(t, err)
+		assert.Empty(t, due)
+		later, err := repos.OutboxRepo.ClaimPending(retryAt.Add(time.Second), retryAt.Add(time.Minute), 10)
+		require.NoError(t, err)
+		require.Len(t, later, 1)
+		assert.Equal(t, pending[1].Id, later[0].Id)
+		assert.Equal(t, 1, later[0].Attempts)
+	})
+
+	t.Run("should not hand out claimed messages again before their lease ends", func(t *testing.T) {
+		cleanup := setupTest(t)
+		defer cleanup()
+
+		// Arrange
+		now := time.Now().UTC()
+		require.NoError(t, repos.OutboxRepo.Append(1, taskDomain.TaskCompleted{TaskId: "a"}, taskDomain.TaskCompleted{TaskId: "b"}))
+		leaseUntil := now.Add(time.Minute)
+		claimed, err := repos.OutboxRepo.ClaimPending(now.Add(time.Second), leaseUntil, 10)
+		require.NoError(t, err)
+		require.Len(t, claimed, 2)
+		require.Less(t, claimed[0].Id, claimed[1].Id)
+
+		// Act
+		during, err := repos.OutboxRepo.ClaimPending(now.Add(30*time.Second), now.Add(2*time.Minute), 10)
+		require.NoError(t, err)
+		after, err := repos.OutboxRepo.ClaimPending(leaseUntil.Add(time.Second), leaseUntil.Add(time.Minute), 10)
+		require.NoError(t, err)
+
+		// Assert
+		assert.Empty(t, during)
+		assert.Len(t, after, 2)
+	})
+
+	t.Run("should replay the messages of a group stored after a position, dispatched or not", func(t *testing.T) {
+		// Arrange
+		groupId := streamTestGroupId(t)
+		appendCommitted(t, groupId, taskDomain.TaskCompleted{TaskId: "a"}, taskDomain.TaskCompleted{TaskId: "b"})
+		appendCommitted(t, groupId+1, taskDomain.TaskCompleted{TaskId: "other"})
+		appendCommitted(t, groupId, taskDomain.TaskCompleted{TaskId: "c"})
+		all := settledMessages(t, groupId, outboxDomain.Position{}, 3)
+		require.NoError(t, outboxRepo.NewOutboxRepository(testDB).MarkDispatched(all[1].Id, time.Now().UTC()))
+
+		// Act
+		missed, err := outboxRepo.NewOutboxRepository(testDB).GetByGroupIdAfter(groupId, all[0].Position(), 10)
+
+		// Assert
+		require.NoError(t, err)
+		require.Len(t, missed, 2)
+		assert.Equal(t, "b", missed[0].AggregateId)
+		assert.Equal(t, "c", missed[1].AggregateId)
+	})
+
+	t.Run("should hold back messages until the transactions before them have committed", func(t *testing.T) {
+		// Arrange
+		groupId := streamTestGroupId(t)
+		repo := outboxRepo.NewOutboxRepository(testDB)
+		start, err := repo.GetCurrentPosition()
+		require.NoError(t, err)
+		first, err := testDB.Begin()
+		require.NoError(t, err)
+		defer first.Rollback()
+		second, err := testDB.Begin()
+		require.NoError(t, err)
+		defer second.Rollback()
+
+		// Act
+		require.NoError(t, repo.WithTx(first).Append(groupId, taskDomain.TaskCompleted{TaskId: "a"}))
+		require.NoError(t, repo.WithTx(second).Append(groupId, taskDomain.TaskCompleted{TaskId: "b"}))
+		require.NoError(t, second.Commit())
+		heldBack, err := repo.GetByGroupIdAfter(groupId, start, 10)
+		require.NoError(t, err)
+		require.NoError(t, first.Commit())
+
+		// Assert
+		assert.Empty(t, heldBack)
+		settled := settledMessages(t, groupId, start, 2)
+		assert.Equal(t, "a", settled[0].AggregateId)
+		assert.Equal(t, "b", settled[1].AggregateId)
+	})
+
+	t.Run("should order messages by transaction when an earlier transaction stores a later id", func(t *testing.T) {
+		// Arrange
+		groupId := streamTestGroupId(t)
+		repo := outboxRepo.NewOutboxRepository(testDB)
+		start, err := repo.GetCurrentPosition()
+		require.NoError(t, err)
+		earlier, err := testDB.Begin()
+		require.NoError(t, err)
+		defer earlier.Rollback()
+		later, err := testDB.Begin()
+		require.NoError(t, err)
+		defer later.Rollback()
+		// The earlier transaction takes its transaction id first, but stores its message last
+		require.NoError(t, repo.WithTx(earlier).Append(groupId+1, taskDomain.TaskCompleted{TaskId: "other"}))
+		require.NoError(t, repo.WithTx(later).Append(groupId, taskDomain.TaskCompleted{TaskId: "a"}))
+		require.NoError(t, repo.WithTx(earlier).Append(groupId, taskDomain.TaskCompleted{TaskId: "b"}))
+
+		// Act
+		require.NoError(t, earlier.Commit())
+		first := settledMessages(t, groupId, start, 1)
+		require.NoError(t, later.Commit())
+		second := settledMessages(t, groupId, first[0].Position(), 1)
+
+		// Assert
+		assert.Equal(t, "b", first[0].AggregateId)
+		assert.Equal(t, "a", second[0].AggregateId)
+		assert.Less(t, second[0].Id, first[0].Id)
+	})
+}
+
+// streamTestGroupId returns a group id no other test stores messages for. Stream reads only see committed messages,
+// so those tests commit outside the test transaction and delete their messages when they end.
+func streamTestGroupId(t *testing.T) int {
+	t.Helper()
+	groupId := 900000 + int(time.Now().UnixNano()%100000)*2
+	t.Cleanup(func() {
+		_, err := testDB.Exec("DELETE FROM container.outbox_event WHERE usergroup_id IN ($1, $2)", groupId, groupId+1)
+		require.NoError(t, err)
+	})
+	return groupId
+}
+
+// appendCommitted stores the events in a transaction of their own
+func appendCommitted(t *testing.T, groupId int, evts ...events.Event) {
+	t.Helper()
+	tx, err := testDB.Begin()
+	require.NoError(t, err)
+	defer tx.Rollback()
+	require.NoError(t, outboxRepo.NewOutboxRepository(tx).Append(groupId, evts...))
+	require.NoError(t, tx.Commit())
+}
+
+// settledMessages waits for the transactions still running elsewhere to finish, so the group's messages can be read
+func settledMessages(t *testing.T, groupId int, after outboxDomain.Position, count int) []outboxDomain.Message {
+	t.Helper()
+	var messages []outboxDomain.Message
+	require.Eventually(t, func() bool {
+		var err error
+		messages, err = outboxRepo.NewOutboxRepository(testDB).GetByGroupIdAfter(groupId, after, 10)
+		require.NoError(t, err)
+		return len(messages) >= count
+	}, 5*time.Second, 20*time.Millisecond)
+	return messages
+}
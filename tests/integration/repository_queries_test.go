@@ -1,8 +1,10 @@
 package integration
 
 import (
+	"database/sql"
 	"testing"
 
+	taskRepo "github.com/happYness-Project/taskManagementGolang/internal/task/repository"
 	"github.com/happYness-Project/taskManagementGolang/tests/builders"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -163,6 +165,12 @@ func TestRepository_GetUserRoleInGroup(t *testing.T) {
 	// Test: Get role for non-existent user-group relationship
 	_, err = repos.UserRepo.GetUserRoleInGroup("non-existent-user", groupId)
 	assert.Error(t, err)
+
+	// Test: Deactivated users hold no role
+	require.NoError(t, userFromDB.Deactivate())
+	require.NoError(t, repos.UserRepo.UpdateUser(*userFromDB))
+	_, err = repos.UserRepo.GetUserRoleInGroup(userFromDB.UserId, groupId)
+	assert.ErrorIs(t, err, sql.ErrNoRows)
 }
 
 // TestRepository_GetUsersByGroupId tests basic user retrieval by group
@@ -328,3 +336,49 @@ func TestRepository_GetAllTasksByGroupIdOnlyImportant(t *testing.T) {
 		assert.True(t, task.IsImportant)
 	}
 }
+
+func TestRepository_ListTasks(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	cleanup := setupTest(t)
+	defer cleanup()
+
+	// Setup
+	user := builders.NewUserBuilder().Build()
+	repos.UserRepo.CreateUser(*user)
+	userFromDB, _ := repos.UserRepo.GetUserByUserId(user.UserId)
+
+	group := builders.NewUserGroupBuilder().WithName("Family").MustBuild()
+	groupId, _ := repos.UserGroupRepo.CreateGroupWithUsers(*group, userFromDB.Id)
+
+	container := builders.NewTaskContainerBuilder().
+		WithUsergroupId(groupId).
+		Build()
+	repos.TaskContainerRepo.CreateContainer(*container)
+
+	for _, name := range []string{"Task 1", "Task 2", "Task 3"} {
+		task := builders.NewTaskBuilder().WithName(name).MustBuild()
+		repos.TaskRepo.CreateTask(container.Id, *task)
+	}
+
+	// Test: page through the group two tasks at a time
+	criteria := taskRepo.TaskListCriteria{GroupId: groupId, SortBy: taskRepo.SortByCreatedAt, Limit: 2}
+	firstPage, err := repos.TaskRepo.ListTasks(criteria)
+	require.NoError(t, err)
+	require.Len(t, firstPage, 2)
+
+	key := taskRepo.KeyOf(firstPage[1], taskRepo.SortByCreatedAt)
+	criteria.After = &key
+	secondPage, err := repos.TaskRepo.ListTasks(criteria)
+	require.NoError(t, err)
+	require.Len(t, secondPage, 1)
+	assert.NotContains(t, []string{firstPage[0].TaskId, firstPage[1].TaskId}, secondPage[0].TaskId)
+
+	// Test: important=false filter
+	notImportant := false
+	tasks, err := repos.TaskRepo.ListTasks(taskRepo.TaskListCriteria{UserId: user.UserId, Filter: taskRepo.TaskFilter{IsImportant: &notImportant}, Limit: 10})
+	require.NoError(t, err)
+	assert.Len(t, tasks, 3)
+}
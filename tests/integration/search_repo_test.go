@@ -0,0 +1,59 @@
+package integration
+
+import (
+	"testing"
+
+	"github.com/happYness-Project/taskManagementGolang/internal/search/domain"
+	searchRepo "github.com/happYness-Project/taskManagementGolang/internal/search/repository"
+	"github.com/happYness-Project/taskManagementGolang/tests/builders"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSearchRepository(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	t.Run("should rank matches of the requester's groups and highlight them", func(t *testing.T) {
+		cleanup := setupTest(t)
+		defer cleanup()
+
+		// Arrange
+		user := builders.NewUserBuilder().Build()
+		require.NoError(t, repos.UserRepo.CreateUser(*user))
+		userFromDB, err := repos.UserRepo.GetUserByUserId(user.UserId)
+		require.NoError(t, err)
+		groupId, err := repos.UserGroupRepo.CreateGroupWithUsers(*builders.NewUserGroupBuilder().WithName("Baking club").MustBuild(), userFromDB.Id)
+		require.NoError(t, err)
+		otherGroupId, err := repos.UserGroupRepo.CreateGroup(*builders.NewUserGroupBuilder().WithName("Strangers").MustBuild())
+		require.NoError(t, err)
+
+		container := builders.NewTaskContainerBuilder().WithName("Desserts").WithDescription("Pies and cakes").WithUsergroupId(groupId).Build()
+		require.NoError(t, repos.TaskContainerRepo.CreateContainer(*container))
+		otherContainer := builders.NewTaskContainerBuilder().WithName("Pies of strangers").WithUsergroupId(otherGroupId).Build()
+		require.NoError(t, repos.TaskContainerRepo.CreateContainer(*otherContainer))
+		titled := builders.NewTaskBuilder().WithName("Apple pie").MustBuild()
+		described := builders.NewTaskBuilder().WithName("Groceries").WithDescription("Flour for the pie").MustBuild()
+		_, err = repos.TaskRepo.CreateTask(container.Id, *titled)
+		require.NoError(t, err)
+		_, err = repos.TaskRepo.CreateTask(container.Id, *described)
+		require.NoError(t, err)
+
+		// Act
+		results, err := repos.SearchRepo.Search(searchRepo.SearchCriteria{RequesterId: user.UserId, Text: "pies", Limit: 10})
+		tasksOnly, tasksErr := repos.SearchRepo.Search(searchRepo.SearchCriteria{RequesterId: user.UserId, Text: "pie", Types: []string{domain.ResultTask}, GroupId: groupId, Limit: 10})
+
+		// Assert
+		require.NoError(t, err)
+		require.NoError(t, tasksErr)
+		require.Len(t, results, 3)
+		for _, result := range results {
+			assert.Equal(t, groupId, result.GroupId)
+			assert.Contains(t, result.Snippet, "<mark>")
+		}
+		assert.Equal(t, titled.TaskId, results[0].Id)
+		require.Len(t, tasksOnly, 2)
+		assert.Equal(t, container.Id, tasksOnly[0].ContainerId)
+	})
+}
@@ -8,12 +8,20 @@ import (
 	"os"
 	"testing"
 
+	auditRepo "github.com/happYness-Project/taskManagementGolang/internal/audit/repository"
+	feedRepo "github.com/happYness-Project/taskManagementGolang/internal/feed/repository"
+	labelRepo "github.com/happYness-Project/taskManagementGolang/internal/label/repository"
+	outboxRepo "github.com/happYness-Project/taskManagementGolang/internal/outbox/repository"
+	searchRepo "github.com/happYness-Project/taskManagementGolang/internal/search/repository"
 	taskRepo "github.com/happYness-Project/taskManagementGolang/internal/task/repository"
 	taskcontainerRepo "github.com/happYness-Project/taskManagementGolang/internal/taskcontainer/repository"
 	userRepo "github.com/happYness-Project/taskManagementGolang/internal/user/repository"
 	usergroupRepo "github.com/happYness-Project/taskManagementGolang/internal/usergroup/repository"
+	viewRepo "github.com/happYness-Project/taskManagementGolang/internal/view/repository"
+	webhookRepo "github.com/happYness-Project/taskManagementGolang/internal/webhook/repository"
 	"github.com/happYness-Project/taskManagementGolang/pkg/configs"
 	"github.com/happYness-Project/taskManagementGolang/pkg/dbs"
+	"github.com/happYness-Project/taskManagementGolang/pkg/dbs/migrations"
 	"github.com/stretchr/testify/require"
 )
 
@@ -25,6 +33,36 @@ type TestRepositories struct {
 	UserGroupRepo     *usergroupRepo.UserGroupRepo
 	TaskRepo          *taskRepo.TaskRepo
 	TaskContainerRepo *taskcontainerRepo.ContainerRepo
+	TemplateRepo      *taskcontainerRepo.TemplateRepo
+	ActivityRepo      *auditRepo.ActivityRepo
+	OutboxRepo        *outboxRepo.OutboxRepo
+	WebhookRepo       *webhookRepo.WebhookRepo
+	DeliveryRepo      *webhookRepo.DeliveryRepo
+	SearchRepo        *searchRepo.SearchRepo
+	ViewRepo          *viewRepo.ViewRepo
+	LabelRepo         *labelRepo.LabelRepo
+	FeedTokenRepo     *feedRepo.FeedTokenRepo
+	UnitOfWork        dbs.UnitOfWork
+}
+
+// newTestRepositories binds every repository to the given connection or transaction
+func newTestRepositories(db dbs.DBTX) *TestRepositories {
+	return &TestRepositories{
+		UserRepo:          userRepo.NewUserRepository(db),
+		UserGroupRepo:     usergroupRepo.NewUserGroupRepository(db),
+		TaskRepo:          taskRepo.NewTaskRepository(db),
+		TaskContainerRepo: taskcontainerRepo.NewContainerRepository(db),
+		TemplateRepo:      taskcontainerRepo.NewTemplateRepository(db),
+		ActivityRepo:      auditRepo.NewActivityRepository(db),
+		OutboxRepo:        outboxRepo.NewOutboxRepository(db),
+		WebhookRepo:       webhookRepo.NewWebhookRepository(db),
+		DeliveryRepo:      webhookRepo.NewDeliveryRepository(db),
+		SearchRepo:        searchRepo.NewSearchRepository(db),
+		ViewRepo:          viewRepo.NewViewRepository(db),
+		LabelRepo:         labelRepo.NewLabelRepository(db),
+		FeedTokenRepo:     feedRepo.NewFeedTokenRepository(db),
+		UnitOfWork:        dbs.NewUnitOfWork(db),
+	}
 }
 
 var repos *TestRepositories
@@ -57,13 +95,19 @@ func TestMain(m *testing.M) {
 
 	log.Println("Integration tests: Connected to test database")
 
-	// Initialize repositories once for all tests
-	repos = &TestRepositories{
-		UserRepo:          userRepo.NewUserRepository(testDB),
-		UserGroupRepo:     usergroupRepo.NewUserGroupRepository(testDB),
-		TaskRepo:          taskRepo.NewTaskRepository(testDB),
-		TaskContainerRepo: taskcontainerRepo.NewContainerRepository(testDB),
+	// Bring the schema up to date, whichever version the database was created with
+	migrator, err := migrations.New(testDB)
+	if err != nil {
+		log.Fatal("Failed to load migrations:", err)
+	}
+	applied, err := migrator.Up(context.Background())
+	if err != nil {
+		log.Fatal("Failed to migrate test database:", err)
 	}
+	log.Printf("Integration tests: Applied %d migrations", len(applied))
+
+	// Initialize repositories once for all tests
+	repos = newTestRepositories(testDB)
 
 	// Run tests
 	code := m.Run()
@@ -73,32 +117,56 @@ func TestMain(m *testing.M) {
 	os.Exit(code)
 }
 
-// setupTest provides test isolation by cleaning the database before each test
-// Returns a cleanup function that can be deferred
+// setupTest runs the test inside a transaction that is rolled back by the returned cleanup function.
+// The shared repos are rebound to that transaction, and the tables are truncated inside it,
+// so every test starts from an empty database and leaves nothing behind.
+// Repository calls that open their own unit of work run in savepoints of the test transaction.
 func setupTest(t *testing.T) func() {
 	t.Helper()
-	cleanDatabase(t, testDB)
+
+	tx, err := testDB.Begin()
+	require.NoError(t, err, "Failed to begin test transaction")
+	cleanDatabase(t, tx)
+
+	previous := repos
+	repos = newTestRepositories(tx)
 
 	return func() {
-		// Optional: cleanup after test if needed
-		// cleanDatabase(t, testDB)
+		repos = previous
+		if err := tx.Rollback(); err != nil {
+			t.Logf("Transaction rollback error: %v", err)
+		}
 	}
 }
 
 // cleanDatabase truncates all tables in the correct order to respect foreign key constraints
-func cleanDatabase(t *testing.T, db *sql.DB) {
+func cleanDatabase(t *testing.T, db dbs.DBTX) {
 	t.Helper()
 
 	ctx := context.Background()
 
 	// Order matters - delete child tables first, then parent tables
 	tables := []string{
-		"taskcontainer_task", // Join table - task to container relationship
-		"usergroup_user",     // Join table - user to group relationship
-		"task",               // Tasks
-		"taskcontainer",      // Containers
-		"usergroup",          // Groups
-		`"user"`,             // Users (quoted because "user" is a reserved keyword in PostgreSQL)
+		"taskcontainer_task",       // Join table - task to container relationship
+		"usergroup_user",           // Join table - user to group relationship
+		"activity",                 // Audit log
+		"outbox_event",             // Domain events awaiting delivery
+		"webhook_delivery_attempt", // Attempt history of webhook deliveries
+		"webhook_delivery",         // Events queued for webhooks
+		"webhook",                  // Webhooks of groups
+		"task_checklist_item",      // Checklist items of tasks
+		"saved_view",               // Saved task views of users
+		"feed_token",               // Calendar feed tokens of users
+		"email_delivery",           // Reminder emails waiting to be sent
+		"notification",             // Reminder inbox of users
+		"reminder_setting",         // Reminder offsets of users
+		"task_label",               // Join table - label to task relationship
+		"label",                    // Labels of groups
+		"task",                     // Tasks
+		"taskcontainer_template",   // Saved container templates
+		"taskcontainer",            // Containers
+		"usergroup",                // Groups
+		`"user"`,                   // Users (quoted because "user" is a reserved keyword in PostgreSQL)
 	}
 
 	for _, table := range tables {
@@ -128,7 +196,7 @@ func withTransaction(t *testing.T, db *sql.DB, fn func(*sql.Tx)) {
 }
 
 // Helper to execute a query and return the count
-func countRows(t *testing.T, db *sql.DB, table string) int {
+func countRows(t *testing.T, db dbs.DBTX, table string) int {
 	t.Helper()
 
 	var count int
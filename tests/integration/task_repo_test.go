@@ -1,8 +1,13 @@
 package integration
 
 import (
+	"errors"
 	"testing"
+	"time"
 
+	"github.com/google/uuid"
+	taskRepo "github.com/happYness-Project/taskManagementGolang/internal/task/repository"
+	"github.com/happYness-Project/taskManagementGolang/pkg/dbs"
 	"github.com/happYness-Project/taskManagementGolang/tests/builders"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -23,7 +28,6 @@ func TestTaskRepository_Create(t *testing.T) {
 			WithName("New Feature Implementation").
 			WithDescription("Implement user authentication").
 			WithPriority("high").
-			WithCategory("development").
 			MustBuild()
 
 		// Act
@@ -34,8 +38,7 @@ func TestTaskRepository_Create(t *testing.T) {
 		assert.Equal(t, task.TaskId, createdTask.TaskId)
 		assert.Equal(t, "New Feature Implementation", createdTask.TaskName)
 		assert.Equal(t, "Implement user authentication", createdTask.TaskDesc)
-		assert.Equal(t, "high", createdTask.Priority)
-		assert.Equal(t, "development", createdTask.Category)
+		assert.Equal(t, "high", createdTask.Priority.String())
 		assert.False(t, createdTask.IsCompleted)
 		assert.False(t, createdTask.IsImportant)
 	})
@@ -55,8 +58,8 @@ func TestTaskRepository_Create(t *testing.T) {
 		require.NoError(t, err)
 		assert.Equal(t, "Test Task", createdTask.TaskName)
 		assert.Equal(t, "Test Description", createdTask.TaskDesc)
-		assert.Equal(t, "medium", createdTask.Priority)
-		assert.Equal(t, "work", createdTask.Category)
+		assert.Equal(t, "medium", createdTask.Priority.String())
+		assert.Empty(t, createdTask.Labels)
 	})
 
 	t.Run("should create task with different priorities", func(t *testing.T) {
@@ -78,9 +81,9 @@ func TestTaskRepository_Create(t *testing.T) {
 		require.NoError(t, err1)
 		require.NoError(t, err2)
 		require.NoError(t, err3)
-		assert.Equal(t, "low", createdLow.Priority)
-		assert.Equal(t, "high", createdHigh.Priority)
-		assert.Equal(t, "urgent", createdUrgent.Priority)
+		assert.Equal(t, "low", createdLow.Priority.String())
+		assert.Equal(t, "high", createdHigh.Priority.String())
+		assert.Equal(t, "urgent", createdUrgent.Priority.String())
 	})
 
 	t.Run("should create task with different target dates", func(t *testing.T) {
@@ -128,7 +131,6 @@ func TestTaskRepository_UpdateTask(t *testing.T) {
 		createdTask.TaskName = "Updated Name"
 		createdTask.TaskDesc = "Updated Description"
 		createdTask.Priority = "urgent"
-		createdTask.Category = "personal"
 		err = repos.TaskRepo.UpdateTask(createdTask)
 
 		// Assert
@@ -137,8 +139,7 @@ func TestTaskRepository_UpdateTask(t *testing.T) {
 		require.NoError(t, err)
 		assert.Equal(t, "Updated Name", updatedTask.TaskName)
 		assert.Equal(t, "Updated Description", updatedTask.TaskDesc)
-		assert.Equal(t, "urgent", updatedTask.Priority)
-		assert.Equal(t, "personal", updatedTask.Category)
+		assert.Equal(t, "urgent", updatedTask.Priority.String())
 	})
 }
 
@@ -374,6 +375,52 @@ func TestTaskRepository_GetTasksByContainerId(t *testing.T) {
 	})
 }
 
+func TestTaskRepository_UnitOfWork(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	t.Run("should roll back every repository call when the work fails", func(t *testing.T) {
+		cleanup := setupTest(t)
+		defer cleanup()
+
+		// Arrange
+		containerId := setupTaskEnvironment(t)
+		task := builders.NewTaskBuilder().WithName("Rolled back task").MustBuild()
+
+		// Act
+		err := repos.UnitOfWork.Do(func(tx dbs.DBTX) error {
+			if _, err := repos.TaskRepo.WithTx(tx).CreateTask(containerId, *task); err != nil {
+				return err
+			}
+			return errors.New("failure after insert")
+		})
+
+		// Assert
+		require.EqualError(t, err, "failure after insert")
+		tasks, err := repos.TaskRepo.GetTasksByContainerId(containerId)
+		require.NoError(t, err)
+		assert.Len(t, tasks, 0)
+	})
+
+	t.Run("should not leave a task row behind when linking to the container fails", func(t *testing.T) {
+		cleanup := setupTest(t)
+		defer cleanup()
+
+		// Arrange
+		task := builders.NewTaskBuilder().WithName("Orphan candidate").MustBuild()
+
+		// Act
+		_, err := repos.TaskRepo.CreateTask(uuid.New().String(), *task)
+
+		// Assert
+		require.Error(t, err)
+		createdTask, err := repos.TaskRepo.GetTaskById(task.TaskId)
+		require.NoError(t, err)
+		assert.Nil(t, createdTask)
+	})
+}
+
 func setupTaskEnvironment(t *testing.T) string {
 	t.Helper()
 
@@ -391,3 +438,247 @@ func setupTaskEnvironment(t *testing.T) string {
 
 	return container.Id
 }
+
+func TestTaskRepository_Checklist(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	t.Run("should load the saved checklist in order with the task", func(t *testing.T) {
+		cleanup := setupTest(t)
+		defer cleanup()
+
+		// Arrange
+		containerId := setupTaskEnvironment(t)
+		task := builders.NewTaskBuilder().WithName("Apple pie ingredients").MustBuild()
+		_, err := repos.TaskRepo.CreateTask(containerId, *task)
+		require.NoError(t, err)
+		task.AddChecklistItem("Apples")
+		task.AddChecklistItem("Flour")
+		task.AddChecklistItem("Butter")
+		require.NoError(t, task.ReorderChecklist([]string{task.Checklist[2].ItemId, task.Checklist[0].ItemId, task.Checklist[1].ItemId}))
+		require.NoError(t, task.ToggleChecklistItem(task.Checklist[0].ItemId, true))
+
+		// Act
+		err = repos.TaskRepo.SaveChecklist(task.TaskId, task.Checklist)
+
+		// Assert
+		require.NoError(t, err)
+		savedTask, err := repos.TaskRepo.GetTaskById(task.TaskId)
+		require.NoError(t, err)
+		require.Len(t, savedTask.Checklist, 3)
+		assert.Equal(t, "Butter", savedTask.Checklist[0].Title)
+		assert.True(t, savedTask.Checklist[0].IsChecked)
+		assert.Equal(t, "Apples", savedTask.Checklist[1].Title)
+		assert.Equal(t, 2, savedTask.Checklist[2].Position)
+	})
+
+	t.Run("should replace the stored checklist and remove it with the task", func(t *testing.T) {
+		cleanup := setupTest(t)
+		defer cleanup()
+
+		// Arrange
+		containerId := setupTaskEnvironment(t)
+		task := builders.NewTaskBuilder().WithName("Apple pie ingredients").MustBuild()
+		task.AddChecklistItem("Apples")
+		task.AddChecklistItem("Flour")
+		_, err := repos.TaskRepo.CreateTask(containerId, *task)
+		require.NoError(t, err)
+
+		// Act
+		require.NoError(t, task.RemoveChecklistItem(task.Checklist[0].ItemId))
+		require.NoError(t, repos.TaskRepo.SaveChecklist(task.TaskId, task.Checklist))
+
+		// Assert
+		items, err := repos.TaskRepo.GetChecklistItems(task.TaskId)
+		require.NoError(t, err)
+		require.Len(t, items, 1)
+		assert.Equal(t, "Flour", items[0].Title)
+
+		require.NoError(t, repos.TaskRepo.DeleteTask(task.TaskId))
+		assert.Equal(t, 0, countRows(t, repos.TaskRepo.DB, "task_checklist_item"))
+	})
+}
+
+func TestTaskRepository_UpdateAssignee(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	t.Run("should store the assignee and list the task by assignee", func(t *testing.T) {
+		cleanup := setupTest(t)
+		defer cleanup()
+
+		// Arrange
+		user := builders.NewUserBuilder().Build()
+		require.NoError(t, repos.UserRepo.CreateUser(*user))
+		userFromDB, err := repos.UserRepo.GetUserByUserId(user.UserId)
+		require.NoError(t, err)
+		group := builders.NewUserGroupBuilder().MustBuild()
+		groupId, err := repos.UserGroupRepo.CreateGroupWithUsers(*group, userFromDB.Id)
+		require.NoError(t, err)
+		container := builders.NewTaskContainerBuilder().WithUsergroupId(groupId).Build()
+		require.NoError(t, repos.TaskContainerRepo.CreateContainer(*container))
+
+		assigned := builders.NewTaskBuilder().WithName("Assigned").MustBuild()
+		unassigned := builders.NewTaskBuilder().WithName("Unassigned").MustBuild()
+		_, err = repos.TaskRepo.CreateTask(container.Id, *assigned)
+		require.NoError(t, err)
+		_, err = repos.TaskRepo.CreateTask(container.Id, *unassigned)
+		require.NoError(t, err)
+
+		// Act
+		require.NoError(t, assigned.AssignTo(user.UserId))
+		err = repos.TaskRepo.UpdateAssignee(assigned.TaskId, assigned.AssigneeId, assigned.UpdatedAt)
+
+		// Assert
+		require.NoError(t, err)
+		savedTask, err := repos.TaskRepo.GetTaskById(assigned.TaskId)
+		require.NoError(t, err)
+		assert.Equal(t, user.UserId, savedTask.AssigneeId)
+
+		tasks, err := repos.TaskRepo.ListTasks(taskRepo.TaskListCriteria{
+			UserId: user.UserId,
+			Filter: taskRepo.TaskFilter{AssigneeId: user.UserId},
+			SortBy: taskRepo.SortByTargetDate,
+			Limit:  10,
+		})
+		require.NoError(t, err)
+		require.Len(t, tasks, 1)
+		assert.Equal(t, assigned.TaskId, tasks[0].TaskId)
+
+		require.NoError(t, repos.TaskRepo.UpdateAssignee(assigned.TaskId, "", assigned.UpdatedAt))
+		savedTask, err = repos.TaskRepo.GetTaskById(assigned.TaskId)
+		require.NoError(t, err)
+		assert.Empty(t, savedTask.AssigneeId)
+	})
+}
+
+func TestTaskRepository_IncrementVersion(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	t.Run("should bump the version only while the expected version is current", func(t *testing.T) {
+		cleanup := setupTest(t)
+		defer cleanup()
+
+		// Arrange
+		containerId := setupTaskEnvironment(t)
+		task := builders.NewTaskBuilder().WithName("Versioned").MustBuild()
+		_, err := repos.TaskRepo.CreateTask(containerId, *task)
+		require.NoError(t, err)
+
+		// Act
+		version, err := repos.TaskRepo.IncrementVersion(task.TaskId, 1)
+		require.NoError(t, err)
+		_, staleErr := repos.TaskRepo.IncrementVersion(task.TaskId, 1)
+
+		// Assert
+		assert.Equal(t, 2, version)
+		assert.ErrorIs(t, staleErr, dbs.ErrVersionConflict)
+		savedTask, err := repos.TaskRepo.GetTaskById(task.TaskId)
+		require.NoError(t, err)
+		assert.Equal(t, 2, savedTask.Version)
+	})
+}
+
+func TestTaskRepository_Trash(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	t.Run("should hide a soft-deleted task until it is restored", func(t *testing.T) {
+		cleanup := setupTest(t)
+		defer cleanup()
+
+		// Arrange
+		containerId := setupTaskEnvironment(t)
+		task := builders.NewTaskBuilder().WithName("Trashed").MustBuild()
+		_, err := repos.TaskRepo.CreateTask(containerId, *task)
+		require.NoError(t, err)
+
+		// Act
+		err = repos.TaskRepo.SoftDeleteTask(task.TaskId, time.Now().UTC())
+		require.NoError(t, err)
+
+		// Assert
+		liveTask, err := repos.TaskRepo.GetTaskById(task.TaskId)
+		require.NoError(t, err)
+		assert.Nil(t, liveTask)
+		deletedTask, err := repos.TaskRepo.GetDeletedTaskById(task.TaskId)
+		require.NoError(t, err)
+		require.NotNil(t, deletedTask)
+		assert.NotNil(t, deletedTask.DeletedAt)
+
+		require.NoError(t, repos.TaskRepo.RestoreTask(task.TaskId))
+		restoredTask, err := repos.TaskRepo.GetTaskById(task.TaskId)
+		require.NoError(t, err)
+		require.NotNil(t, restoredTask)
+		assert.Nil(t, restoredTask.DeletedAt)
+	})
+
+	t.Run("should restore only the tasks trashed together with their container", func(t *testing.T) {
+		cleanup := setupTest(t)
+		defer cleanup()
+
+		// Arrange
+		containerId := setupTaskEnvironment(t)
+		trashedEarlier := builders.NewTaskBuilder().WithName("Trashed earlier").MustBuild()
+		trashedWithContainer := builders.NewTaskBuilder().WithName("Trashed with container").MustBuild()
+		_, err := repos.TaskRepo.CreateTask(containerId, *trashedEarlier)
+		require.NoError(t, err)
+		_, err = repos.TaskRepo.CreateTask(containerId, *trashedWithContainer)
+		require.NoError(t, err)
+		require.NoError(t, repos.TaskRepo.SoftDeleteTask(trashedEarlier.TaskId, time.Now().UTC().Add(-time.Hour)))
+
+		deletedAt := time.Now().UTC()
+		require.NoError(t, repos.TaskContainerRepo.SoftDeleteTasksByContainerId(containerId, deletedAt))
+		require.NoError(t, repos.TaskContainerRepo.SoftDeleteContainer(containerId, deletedAt))
+
+		// Act
+		require.NoError(t, repos.TaskContainerRepo.RestoreTasksByContainerId(containerId))
+		require.NoError(t, repos.TaskContainerRepo.RestoreContainer(containerId))
+
+		// Assert
+		container, err := repos.TaskContainerRepo.GetById(containerId)
+		require.NoError(t, err)
+		assert.Equal(t, containerId, container.Id)
+		restored, err := repos.TaskRepo.GetTaskById(trashedWithContainer.TaskId)
+		require.NoError(t, err)
+		assert.NotNil(t, restored)
+		stillTrashed, err := repos.TaskRepo.GetTaskById(trashedEarlier.TaskId)
+		require.NoError(t, err)
+		assert.Nil(t, stillTrashed)
+	})
+}
+
+func TestTaskRepository_MoveTask(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	t.Run("should relink the task to the target container", func(t *testing.T) {
+		cleanup := setupTest(t)
+		defer cleanup()
+
+		// Arrange
+		containerId := setupTaskEnvironment(t)
+		source, err := repos.TaskContainerRepo.GetById(containerId)
+		require.NoError(t, err)
+		target := builders.NewTaskContainerBuilder().WithUsergroupId(source.UsergroupId).Build()
+		require.NoError(t, repos.TaskContainerRepo.CreateContainer(*target))
+		task := builders.NewTaskBuilder().WithName("Moving").MustBuild()
+		_, err = repos.TaskRepo.CreateTask(containerId, *task)
+		require.NoError(t, err)
+
+		// Act
+		err = repos.TaskRepo.MoveTask(task.TaskId, target.Id)
+
+		// Assert
+		require.NoError(t, err)
+		movedTo, err := repos.TaskRepo.GetContainerIdByTaskId(task.TaskId)
+		require.NoError(t, err)
+		assert.Equal(t, target.Id, movedTo)
+	})
+}
@@ -0,0 +1,71 @@
+package integration
+
+import (
+	"testing"
+	"time"
+
+	"github.com/happYness-Project/taskManagementGolang/internal/taskcontainer/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTemplateRepository(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	t.Run("should round-trip a template with its relative target dates", func(t *testing.T) {
+		cleanup := setupTest(t)
+		defer cleanup()
+
+		// Arrange
+		containerId := setupTaskEnvironment(t)
+		container, err := repos.TaskContainerRepo.GetById(containerId)
+		require.NoError(t, err)
+		template := &domain.ContainerTemplate{
+			Id:          "0195a1c2-7d7e-7c1a-9f0e-3c2b1a0d9e8f",
+			Name:        "Weekly groceries",
+			UsergroupId: container.UsergroupId,
+			CreatedBy:   "admin",
+			CreatedAt:   time.Now().UTC().Truncate(time.Microsecond),
+			Tasks: []domain.TemplateTask{
+				{Name: "Apple pie ingredients", TargetDate: &domain.RelativeDate{Days: 3}, Checklist: []string{"Apples", "Butter"}},
+			},
+		}
+		require.NoError(t, repos.TemplateRepo.CreateTemplate(*template))
+
+		// Act
+		found, err := repos.TemplateRepo.GetTemplateById(template.Id)
+		byGroup, groupErr := repos.TemplateRepo.GetTemplatesByGroupId(container.UsergroupId)
+
+		// Assert
+		require.NoError(t, err)
+		require.NoError(t, groupErr)
+		require.NotNil(t, found)
+		assert.Equal(t, template.Tasks, found.Tasks)
+		assert.Len(t, byGroup, 1)
+	})
+
+	t.Run("should report a missing template when deleting it twice", func(t *testing.T) {
+		cleanup := setupTest(t)
+		defer cleanup()
+
+		// Arrange
+		containerId := setupTaskEnvironment(t)
+		container, err := repos.TaskContainerRepo.GetById(containerId)
+		require.NoError(t, err)
+		template := &domain.ContainerTemplate{Id: "0195a1c2-7d7e-7c1a-9f0e-3c2b1a0d9e90", Name: "Chores", UsergroupId: container.UsergroupId, CreatedBy: "admin", CreatedAt: time.Now().UTC()}
+		require.NoError(t, repos.TemplateRepo.CreateTemplate(*template))
+
+		// Act
+		firstErr := repos.TemplateRepo.DeleteTemplate(template.Id)
+		secondErr := repos.TemplateRepo.DeleteTemplate(template.Id)
+
+		// Assert
+		assert.NoError(t, firstErr)
+		assert.ErrorIs(t, secondErr, domain.ErrTemplateNotFound)
+		found, err := repos.TemplateRepo.GetTemplateById(template.Id)
+		require.NoError(t, err)
+		assert.Nil(t, found)
+	})
+}
@@ -0,0 +1,63 @@
+package integration
+
+import (
+	"testing"
+
+	"github.com/happYness-Project/taskManagementGolang/internal/view/domain"
+	"github.com/happYness-Project/taskManagementGolang/tests/builders"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestViewRepository(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	t.Run("should round-trip a view with its filter", func(t *testing.T) {
+		cleanup := setupTest(t)
+		defer cleanup()
+
+		// Arrange
+		user := builders.NewUserBuilder().Build()
+		require.NoError(t, repos.UserRepo.CreateUser(*user))
+		important := true
+		view, err := domain.NewSavedView(user.UserId, "Important this week", domain.Filter{IsImportant: &important, TargetTo: "end_of_week", GroupId: 2}, "-priority")
+		require.NoError(t, err)
+		require.NoError(t, repos.ViewRepo.CreateView(*view))
+
+		// Act
+		found, err := repos.ViewRepo.GetViewById(view.Id)
+		byOwner, ownerErr := repos.ViewRepo.GetViewsByOwnerId(user.UserId)
+
+		// Assert
+		require.NoError(t, err)
+		require.NoError(t, ownerErr)
+		require.NotNil(t, found)
+		assert.Equal(t, user.UserId, found.OwnerId)
+		assert.Equal(t, view.Filter, found.Filter)
+		assert.Equal(t, "-priority", found.Sort)
+		assert.Len(t, byOwner, 1)
+	})
+
+	t.Run("should report a missing view when updating or deleting it after removal", func(t *testing.T) {
+		cleanup := setupTest(t)
+		defer cleanup()
+
+		// Arrange
+		user := builders.NewUserBuilder().Build()
+		require.NoError(t, repos.UserRepo.CreateUser(*user))
+		view, err := domain.NewSavedView(user.UserId, "Groceries", domain.Filter{Priority: "high"}, "")
+		require.NoError(t, err)
+		require.NoError(t, repos.ViewRepo.CreateView(*view))
+		require.NoError(t, repos.ViewRepo.DeleteView(view.Id))
+
+		// Act
+		updateErr := repos.ViewRepo.UpdateView(*view)
+		deleteErr := repos.ViewRepo.DeleteView(view.Id)
+
+		// Assert
+		assert.ErrorIs(t, updateErr, domain.ErrViewNotFound)
+		assert.ErrorIs(t, deleteErr, domain.ErrViewNotFound)
+	})
+}
@@ -0,0 +1,111 @@
+package integration
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	outboxDomain "github.com/happYness-Project/taskManagementGolang/internal/outbox/domain"
+	"github.com/happYness-Project/taskManagementGolang/internal/webhook/domain"
+	"github.com/happYness-Project/taskManagementGolang/tests/builders"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebhookRepository(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	setupWebhook := func(t *testing.T) *domain.Webhook {
+		t.Helper()
+		group := builders.NewUserGroupBuilder().MustBuild()
+		groupId, err := repos.UserGroupRepo.CreateGroup(*group)
+		require.NoError(t, err)
+		webhook, err := domain.NewWebhook(groupId, "https://hooks.example.com/tasks", []string{"task.created", "task.completed"}, "admin")
+		require.NoError(t, err)
+		require.NoError(t, repos.WebhookRepo.CreateWebhook(*webhook))
+		return webhook
+	}
+
+	t.Run("should round-trip a webhook with its event types and secret", func(t *testing.T) {
+		cleanup := setupTest(t)
+		defer cleanup()
+
+		// Arrange
+		webhook := setupWebhook(t)
+
+		// Act
+		found, err := repos.WebhookRepo.GetById(webhook.Id)
+		byGroup, groupErr := repos.WebhookRepo.GetByGroupId(webhook.GroupId)
+
+		// Assert
+		require.NoError(t, err)
+		require.NoError(t, groupErr)
+		require.NotNil(t, found)
+		assert.Equal(t, webhook.EventTypes, found.EventTypes)
+		assert.Equal(t, webhook.Secret, found.Secret)
+		assert.Len(t, byGroup, 1)
+	})
+
+	t.Run("should queue an event once per webhook and keep the attempt history", func(t *testing.T) {
+		cleanup := setupTest(t)
+		defer cleanup()
+
+		// Arrange
+		webhook := setupWebhook(t)
+		msg := outboxDomain.Message{EventId: uuid.New().String(), EventType: "task.created", GroupId: webhook.GroupId}
+		delivery, err := domain.NewDelivery(webhook.Id, msg)
+		require.NoError(t, err)
+		duplicate, err := domain.NewDelivery(webhook.Id, msg)
+		require.NoError(t, err)
+		require.NoError(t, repos.DeliveryRepo.Enqueue(*delivery))
+		require.NoError(t, repos.DeliveryRepo.Enqueue(*duplicate))
+
+		// Act
+		due, err := repos.DeliveryRepo.ClaimDue(time.Now().UTC().Add(time.Second), time.Now().UTC().Add(time.Minute), 10)
+		require.NoError(t, err)
+		require.Len(t, due, 1)
+		attempt := domain.DeliveryAttempt{AttemptedAt: time.Now().UTC(), StatusCode: 200, DurationMs: 12}
+		due[0].RecordAttempt(attempt, nil)
+		require.NoError(t, repos.DeliveryRepo.SaveAttempt(due[0], attempt))
+
+		// Assert
+		found, err := repos.DeliveryRepo.GetById(delivery.Id)
+		require.NoError(t, err)
+		require.NotNil(t, found)
+		assert.Equal(t, domain.DeliverySucceeded, found.Status)
+		require.Len(t, found.History, 1)
+		assert.Equal(t, 200, found.History[0].StatusCode)
+		stillDue, err := repos.DeliveryRepo.ClaimDue(time.Now().UTC().Add(time.Hour), time.Now().UTC().Add(2*time.Hour), 10)
+		require.NoError(t, err)
+		assert.Empty(t, stillDue)
+	})
+
+	t.Run("should not hand out claimed deliveries again before their lease ends", func(t *testing.T) {
+		cleanup := setupTest(t)
+		defer cleanup()
+
+		// Arrange
+		webhook := setupWebhook(t)
+		now := time.Now().UTC()
+		delivery, err := domain.NewDelivery(webhook.Id, outboxDomain.Message{EventId: uuid.New().String(), EventType: "task.created", GroupId: webhook.GroupId})
+		require.NoError(t, err)
+		require.NoError(t, repos.DeliveryRepo.Enqueue(*delivery))
+		leaseUntil := now.Add(time.Minute)
+		claimed, err := repos.DeliveryRepo.ClaimDue(now.Add(time.Second), leaseUntil, 10)
+		require.NoError(t, err)
+		require.Len(t, claimed, 1)
+
+		// Act
+		during, err := repos.DeliveryRepo.ClaimDue(now.Add(30*time.Second), now.Add(2*time.Minute), 10)
+		require.NoError(t, err)
+		after, err := repos.DeliveryRepo.ClaimDue(leaseUntil.Add(time.Second), leaseUntil.Add(time.Minute), 10)
+		require.NoError(t, err)
+
+		// Assert
+		assert.Empty(t, during)
+		require.Len(t, after, 1)
+		assert.Equal(t, delivery.Id, after[0].Id)
+	})
+}